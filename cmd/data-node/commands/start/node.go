@@ -244,6 +244,7 @@ func (l *NodeCommand) createGRPCServer(config api.Config) *api.GRPCServer {
 		l.ammPoolsService,
 		l.volumeRebateStatsService,
 		l.volumeRebateProgramService,
+		l.validatorSlashingService,
 	)
 	return grpcServer
 }
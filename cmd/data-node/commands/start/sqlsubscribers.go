@@ -86,6 +86,7 @@ type SQLSubscribers struct {
 	ammPoolsStore                     *sqlstore.AMMPools
 	volumeRebateStatsStore            *sqlstore.VolumeRebateStats
 	volumeRebateProgramsStore         *sqlstore.VolumeRebatePrograms
+	validatorSlashingsStore           *sqlstore.ValidatorSlashings
 
 	// Services
 	candleService                       *candlesv2.Svc
@@ -146,6 +147,7 @@ type SQLSubscribers struct {
 	ammPoolsService                     *service.AMMPools
 	volumeRebateStatsService            *service.VolumeRebateStats
 	volumeRebateProgramService          *service.VolumeRebatePrograms
+	validatorSlashingService            *service.ValidatorSlashing
 
 	// Subscribers
 	accountSub                      *sqlsubscribers.Account
@@ -202,6 +204,7 @@ type SQLSubscribers struct {
 	ammPoolsSub                     *sqlsubscribers.AMMPools
 	volumeRebateStatsSub            *sqlsubscribers.VolumeRebateStatsUpdated
 	volumeRebateProgramSub          *sqlsubscribers.VolumeRebateProgram
+	validatorSlashingSub            *sqlsubscribers.ValidatorSlashing
 }
 
 func (s *SQLSubscribers) GetSQLSubscribers() []broker.SQLBrokerSubscriber {
@@ -262,6 +265,7 @@ func (s *SQLSubscribers) GetSQLSubscribers() []broker.SQLBrokerSubscriber {
 		s.ammPoolsSub,
 		s.volumeRebateProgramSub,
 		s.volumeRebateStatsSub,
+		s.validatorSlashingSub,
 	}
 }
 
@@ -327,6 +331,7 @@ func (s *SQLSubscribers) CreateAllStores(ctx context.Context, Log *logging.Logge
 	s.ammPoolsStore = sqlstore.NewAMMPools(transactionalConnectionSource)
 	s.volumeRebateStatsStore = sqlstore.NewVolumeRebateStats(transactionalConnectionSource)
 	s.volumeRebateProgramsStore = sqlstore.NewVolumeRebatePrograms(transactionalConnectionSource)
+	s.validatorSlashingsStore = sqlstore.NewValidatorSlashings(transactionalConnectionSource)
 }
 
 func (s *SQLSubscribers) SetupServices(ctx context.Context, log *logging.Logger, cfg service.Config, candlesConfig candlesv2.Config) error {
@@ -386,6 +391,7 @@ func (s *SQLSubscribers) SetupServices(ctx context.Context, log *logging.Logger,
 	s.ammPoolsService = service.NewAMMPools(s.ammPoolsStore)
 	s.volumeRebateStatsService = service.NewVolumeRebateStats(s.volumeRebateStatsStore)
 	s.volumeRebateProgramService = service.NewVolumeRebatePrograms(s.volumeRebateProgramsStore)
+	s.validatorSlashingService = service.NewValidatorSlashing(s.validatorSlashingsStore, log)
 
 	s.marketDepthService = service.NewMarketDepth(
 		cfg.MarketDepth,
@@ -469,5 +475,6 @@ func (s *SQLSubscribers) SetupSQLSubscribers() {
 	s.gameScoreSub = sqlsubscribers.NewGameScore(s.gameScoreStore)
 	s.volumeRebateStatsSub = sqlsubscribers.NewVolumeRebateStatsUpdated(s.volumeRebateStatsService)
 	s.volumeRebateProgramSub = sqlsubscribers.NewVolumeRebateProgram(s.volumeRebateProgramService)
+	s.validatorSlashingSub = sqlsubscribers.NewValidatorSlashing(s.validatorSlashingService)
 	s.ammPoolsSub = sqlsubscribers.NewAMMPools(s.ammPoolsService, s.marketDepthService)
 }
@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"code.vegaprotocol.io/vega/core/checkpoint"
+	"code.vegaprotocol.io/vega/core/types"
+	vgjson "code.vegaprotocol.io/vega/libs/json"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type CheckpointCmd struct {
+	Diff checkpointDiffCmd `command:"diff" description:"Show the balance, stake, governance and network parameter differences between two checkpoint files"`
+}
+
+type checkpointDiffCmd struct {
+	Positional struct {
+		Old string `positional-arg-name:"<OLD_CHECKPOINT>" required:"true"`
+		New string `positional-arg-name:"<NEW_CHECKPOINT>" required:"true"`
+	} `positional-args:"true"`
+}
+
+func (opts *checkpointDiffCmd) Execute(_ []string) error {
+	oldCP, err := readCheckpointFile(opts.Positional.Old)
+	if err != nil {
+		return err
+	}
+	newCP, err := readCheckpointFile(opts.Positional.New)
+	if err != nil {
+		return err
+	}
+
+	diff, err := checkpoint.DiffCheckpoints(oldCP, newCP)
+	if err != nil {
+		return fmt.Errorf("couldn't compare the checkpoints: %w", err)
+	}
+	return vgjson.PrettyPrint(diff)
+}
+
+func readCheckpointFile(path string) (*types.CheckpointState, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read checkpoint file %q: %w", path, err)
+	}
+	cpt := &types.CheckpointState{}
+	if err := cpt.SetState(buf); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint file %q: %w", path, err)
+	}
+	return cpt, nil
+}
+
+var checkpointCmd CheckpointCmd
+
+func Checkpoint(ctx context.Context, parser *flags.Parser) error {
+	checkpointCmd = CheckpointCmd{}
+
+	_, err := parser.AddCommand("checkpoint", "Inspect checkpoint files", "", &checkpointCmd)
+	return err
+}
@@ -37,10 +37,11 @@ import (
 )
 
 type loadCheckpointCmd struct {
-	DryRun         bool   `description:"Display the genesis file without writing it" long:"dry-run"`
-	TmHome         string `description:"The home path of tendermint"                 long:"tm-home"         short:"t"`
-	GenesisFile    string `description:"A genesis file to be updated"                long:"genesis-file"    short:"g"`
-	CheckpointPath string `description:"The path to the checkpoint file to load"     long:"checkpoint-path" required:"true"`
+	DryRun         bool     `description:"Display the genesis file without writing it" long:"dry-run"`
+	TmHome         string   `description:"The home path of tendermint"                 long:"tm-home"         short:"t"`
+	GenesisFile    string   `description:"A genesis file to be updated"                long:"genesis-file"    short:"g"`
+	CheckpointPath string   `description:"The path to the checkpoint file to load"     long:"checkpoint-path" required:"true"`
+	Sections       []string `description:"Only restore the given checkpoint sections"  long:"section"`
 }
 
 func (opts *loadCheckpointCmd) Execute(_ []string) error {
@@ -104,6 +105,7 @@ func (opts *loadCheckpointCmd) Execute(_ []string) error {
 
 	appState.Checkpoint.CheckpointHash = expectHash
 	appState.Checkpoint.CheckpointState = base64.StdEncoding.EncodeToString(buf)
+	appState.Checkpoint.Sections = opts.Sections
 
 	if err := vgtm.AddAppStateToGenesis(genesisDoc, &appState); err != nil {
 		return fmt.Errorf("couldn't add app_state to genesis: %w", err)
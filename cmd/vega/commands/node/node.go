@@ -73,8 +73,7 @@ type Command struct {
 	primaryEthClient        *ethclient.PrimaryClient
 	primaryEthConfirmations *ethclient.EthereumConfirmations
 
-	secondaryEthClient        *ethclient.SecondaryClient
-	secondaryEthConfirmations *ethclient.EthereumConfirmations
+	evmBridgeClients *ethclient.EVMBridgeClients
 
 	l2Clients *ethclient.L2Clients
 
@@ -130,9 +129,8 @@ func (n *Command) Run(
 		n.stopBlockchain,
 		n.nodeWallets,
 		n.primaryEthClient,
-		n.secondaryEthClient,
 		n.primaryEthConfirmations,
-		n.secondaryEthConfirmations,
+		n.evmBridgeClients,
 		n.blockchainClient,
 		vegaPaths,
 		n.stats,
@@ -200,6 +198,10 @@ func (n *Command) wait(errCh <-chan error) error {
 			n.Log.Info("Caught signal", logging.String("name", fmt.Sprintf("%+v", sig)))
 			return nil
 		case e := <-errCh:
+			if errors.Is(e, nullchain.ErrReplayCompleted) {
+				n.Log.Info("Blockchain replay completed, stopping")
+				return nil
+			}
 			n.Log.Error("problem starting blockchain", logging.Error(e))
 			return e
 		case <-n.ctx.Done():
@@ -275,6 +277,10 @@ func (n *Command) startAPIs() error {
 		n.protocol.GetPoW(),
 		n.protocol.GetSpamEngine(),
 		n.protocol.GetPowEngine(),
+		n.protocol.GetStateQueries(),
+		n.protocol.GetExecutionEngine(),
+		n.protocol.GetBankingEngine(),
+		n.protocol.GetGovernanceEngine(),
 	)
 
 	n.coreService = coreapi.NewService(n.ctx, n.Log, n.conf.CoreAPI, n.protocol.GetBroker())
@@ -471,19 +477,13 @@ func (n *Command) startBlockchainClients() error {
 		return fmt.Errorf("could not instantiate primary ethereum client: %w", err)
 	}
 
-	n.secondaryEthClient, err = ethclient.SecondaryDial(n.ctx, n.conf.Ethereum)
+	n.evmBridgeClients, err = ethclient.DialEVMBridges(n.ctx, n.conf.Ethereum)
 	if err != nil {
-		return fmt.Errorf("could not instantiate secondary ethereum client: %w", err)
+		return fmt.Errorf("could not instantiate EVM bridge clients: %w", err)
 	}
 
 	n.primaryEthConfirmations = ethclient.NewEthereumConfirmations(n.conf.Ethereum, n.primaryEthClient, nil, ethclient.FinalityStateFinalized)
 
-	// for arbitrum the finality state of a block is in now way connected to the Arbitrum network reaching consensus so Vega gains nothing
-	// from waiting for safe/finalized. Instead we just wait for the event to be seen in the latest block and rely on the consensus check
-	// Vega performs itself with node-votes. If each validator is running their own Arbitrum node, or is using a node that they need trustworthy
-	// then this is sufficient. A far as is know, block reorgs do not happen on Arbitrum.
-	n.secondaryEthConfirmations = ethclient.NewEthereumConfirmations(n.conf.Ethereum, n.secondaryEthClient, nil, ethclient.FinalityStateLatest)
-
 	return nil
 }
 
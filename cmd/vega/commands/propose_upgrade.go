@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"strings"
 
+	"code.vegaprotocol.io/vega/core/blockchain/abci"
 	"code.vegaprotocol.io/vega/core/config"
 	"code.vegaprotocol.io/vega/core/protocolupgrade"
+	"code.vegaprotocol.io/vega/core/snapshot"
 	"code.vegaprotocol.io/vega/core/txn"
 	vgcrypto "code.vegaprotocol.io/vega/libs/crypto"
 	vgjson "code.vegaprotocol.io/vega/libs/json"
@@ -31,6 +33,7 @@ import (
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 
 	"github.com/blang/semver"
+	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/jessevdk/go-flags"
 )
 
@@ -39,8 +42,9 @@ type ProposeUpgradeCmd struct {
 	config.OutputFlag
 	config.Passphrase `long:"passphrase-file"`
 
-	VegaReleaseTag     string `description:"A valid vega core release tag for the upgrade proposal" long:"vega-release-tag" required:"true" short:"v"`
-	UpgradeBlockHeight uint64 `description:"The block height at which the upgrade should be made"   long:"height"           required:"true" short:"h"`
+	VegaReleaseTag     string `description:"A valid vega core release tag for the upgrade proposal"                                long:"vega-release-tag" required:"true" short:"v"`
+	UpgradeBlockHeight uint64 `description:"The block height at which the upgrade should be made"                                  long:"height"           required:"true" short:"h"`
+	PreflightBinary    string `description:"The candidate binary to run the pre-flight checks against before sending the proposal" long:"preflight-binary"`
 }
 
 var proposeUpgradeCmd ProposeUpgradeCmd
@@ -79,6 +83,27 @@ func (opts *ProposeUpgradeCmd) Execute(_ []string) error {
 		UpgradeBlockHeight: opts.UpgradeBlockHeight,
 	}
 
+	if len(opts.PreflightBinary) > 0 {
+		report, err := runPreflight(log, conf, vegaPaths, opts.VegaReleaseTag, opts.PreflightBinary, registryPass)
+		if err != nil {
+			return err
+		}
+		if output.IsHuman() {
+			fmt.Printf("Pre-flight checks of %s against the snapshot at height %d: ready=%v, blocks replayed=%d\n",
+				report.VegaReleaseTag, report.SnapshotHeight, report.Ready, report.BlocksReplayed)
+		}
+		if !report.Ready {
+			if output.IsJSON() {
+				_ = vgjson.Print(report)
+			}
+			return fmt.Errorf("candidate binary failed the pre-flight checks, the proposal is not sent: %s", report.Error)
+		}
+		cmd.Readiness = &commandspb.ProtocolUpgradeReadiness{
+			SnapshotHeight: report.SnapshotHeight,
+			BlocksReplayed: report.BlocksReplayed,
+		}
+	}
+
 	commander, blockData, cfunc, err := getNodeWalletCommander(log, registryPass, vegaPaths)
 	if err != nil {
 		return fmt.Errorf("failed to get commander: %w", err)
@@ -145,3 +170,98 @@ func ProposeProtocolUpgrade(ctx context.Context, parser *flags.Parser) error {
 	_, err := parser.AddCommand("protocol_upgrade_proposal", short, long, &proposeUpgradeCmd)
 	return err
 }
+
+func runPreflight(log *logging.Logger, conf *config.Config, vegaPaths paths.Paths, vegaReleaseTag, binary, passphrase string) (*protocolupgrade.PreflightReport, error) {
+	rpcAddr := conf.Blockchain.Tendermint.RPCAddr
+	if len(rpcAddr) <= 0 {
+		rpcAddr = "tcp://127.0.0.1:26657"
+		log.Warn("Blockchain.Tendermint.RPCAddr is empty, using default", logging.String("address", rpcAddr))
+	}
+
+	abciClient, err := abci.NewClient(rpcAddr)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't initialise ABCI client: %w", err)
+	}
+
+	snapshotConf := conf.Snapshot
+	snapshotConf.StartHeight = 0
+
+	preflight := protocolupgrade.NewPreflight(
+		log,
+		conf.ProtocolUpgrade.Preflight,
+		vegaPaths,
+		preflightChain{client: abciClient},
+		preflightSnapshots{log: log, config: snapshotConf},
+	)
+	return preflight.Run(context.Background(), vegaReleaseTag, binary, passphrase), nil
+}
+
+type preflightChain struct {
+	client *abci.Client
+}
+
+func (c preflightChain) Height(ctx context.Context) (int64, error) {
+	status, err := c.client.GetStatus(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (c preflightChain) Block(ctx context.Context, height int64) (*protocolupgrade.PreflightBlock, error) {
+	block, err := c.client.Block(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	// the app hash resulting from a block is part of the header of the next one
+	next, err := c.client.Block(ctx, height+1)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([][]byte, 0, len(block.Txs))
+	for _, tx := range block.Txs {
+		txs = append(txs, tx)
+	}
+	return &protocolupgrade.PreflightBlock{
+		Height:   height,
+		Time:     block.Time.UnixNano(),
+		Hash:     block.Hash(),
+		Proposer: block.ProposerAddress,
+		Txs:      txs,
+		AppHash:  next.AppHash,
+	}, nil
+}
+
+func (c preflightChain) Genesis(ctx context.Context) ([]byte, error) {
+	doc, err := c.client.Genesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tmjson.Marshal(doc)
+}
+
+type preflightSnapshots struct {
+	log    *logging.Logger
+	config snapshot.Config
+}
+
+func (s preflightSnapshots) LatestSnapshotHeight(vegaPaths paths.Paths) (uint64, error) {
+	engine, err := snapshot.NewEngine(vegaPaths, s.config, s.log, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer engine.Close()
+
+	snapshots, err := engine.ListLatestSnapshots()
+	if err != nil {
+		return 0, err
+	}
+	var height uint64
+	for _, s := range snapshots {
+		if s.Height > height {
+			height = s.Height
+		}
+	}
+	return height, nil
+}
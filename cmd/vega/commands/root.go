@@ -66,6 +66,7 @@ func Main(ctx context.Context) error {
 		Init,
 		nodewallet.NodeWallet,
 		Verify,
+		Checkpoint,
 		Version,
 		Wallet,
 		Datanode,
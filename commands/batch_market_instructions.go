@@ -16,6 +16,8 @@
 package commands
 
 import (
+	"fmt"
+
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 )
 
@@ -41,5 +43,41 @@ func checkBatchMarketInstructions(cmd *commandspb.BatchMarketInstructions) Error
 		return errs.FinalAddForProperty("batch_market_instructions", ErrEmptyBatchMarketInstructions)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("batch_market_instructions.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
+	// the sub-account applies to the whole batch, it cannot be set per instruction.
+	for i, c := range cmd.Cancellations {
+		if c.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.cancellations.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+	for i, a := range cmd.Amendments {
+		if a.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.amendments.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+	for i, s := range cmd.Submissions {
+		if s.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.submissions.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+	for i, s := range cmd.StopOrdersSubmission {
+		if s.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.stop_orders_submission.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+	for i, c := range cmd.StopOrdersCancellation {
+		if c.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.stop_orders_cancellation.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+	for i, u := range cmd.UpdateMarginMode {
+		if u.SubAccount != nil {
+			errs.AddForProperty(fmt.Sprintf("batch_market_instructions.update_margin_mode.%d.sub_account", i), ErrSubAccountMustBeSetOnBatch)
+		}
+	}
+
 	return errs
 }
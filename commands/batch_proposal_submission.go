@@ -197,7 +197,7 @@ func checkNetworkParameterUpdateBatchChanges(change *protoTypes.BatchProposalTer
 		return errs.FinalAddForProperty("batch_proposal_submission.terms.changes.update_network_parameter.changes", ErrIsRequired)
 	}
 
-	return checkNetworkParameterUpdate(change.UpdateNetworkParameter.Changes).AddPrefix("batch_proposal_submission.terms.changes.")
+	return checkNetworkParameterUpdate(change.UpdateNetworkParameter).AddPrefix("batch_proposal_submission.terms.changes.")
 }
 
 func checkUpdateAssetBatchChanges(change *protoTypes.BatchProposalTermsChange_UpdateAsset) Errors {
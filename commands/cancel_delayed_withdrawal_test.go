@@ -0,0 +1,58 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	vgtest "code.vegaprotocol.io/vega/libs/test"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelDelayedWithdrawal(t *testing.T) {
+	t.Run("Cancelling a delayed withdrawal succeeds", testCancelDelayedWithdrawalSucceeds)
+	t.Run("Cancelling a delayed withdrawal without ID fails", testCancelDelayedWithdrawalWithoutIDFails)
+}
+
+func testCancelDelayedWithdrawalSucceeds(t *testing.T) {
+	err := checkCancelDelayedWithdrawal(t, &commandspb.CancelDelayedWithdrawal{
+		WithdrawalId: vgtest.RandomVegaID(),
+	})
+
+	assert.Empty(t, err)
+}
+
+func testCancelDelayedWithdrawalWithoutIDFails(t *testing.T) {
+	err := checkCancelDelayedWithdrawal(t, &commandspb.CancelDelayedWithdrawal{})
+
+	assert.Contains(t, err.Get("cancel_delayed_withdrawal.withdrawal_id"), commands.ErrShouldBeAValidVegaID)
+}
+
+func checkCancelDelayedWithdrawal(t *testing.T, cmd *commandspb.CancelDelayedWithdrawal) commands.Errors {
+	t.Helper()
+
+	err := commands.CheckCancelDelayedWithdrawal(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands
+
+import (
+	"errors"
+
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+// MaxSubAccountIndex is the highest index a sub-account can be opened with.
+const MaxSubAccountIndex = 64
+
+func CheckCreateSubAccount(cmd *commandspb.CreateSubAccount) error {
+	return checkCreateSubAccount(cmd).ErrorOrNil()
+}
+
+func checkCreateSubAccount(cmd *commandspb.CreateSubAccount) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("create_sub_account", ErrIsRequired)
+	}
+
+	if cmd.Index == 0 || cmd.Index > MaxSubAccountIndex {
+		errs.AddForProperty("create_sub_account.index", errors.New("must be between 1 and 64"))
+	}
+
+	return errs
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubAccount(t *testing.T) {
+	t.Run("Creating a sub-account succeeds", testCreateSubAccountSucceeds)
+	t.Run("Creating a sub-account with an out of range index fails", testCreateSubAccountWithOutOfRangeIndexFails)
+}
+
+func testCreateSubAccountSucceeds(t *testing.T) {
+	for _, index := range []uint64{1, 10, commands.MaxSubAccountIndex} {
+		err := checkCreateSubAccount(t, &commandspb.CreateSubAccount{
+			Index: index,
+		})
+
+		assert.Empty(t, err)
+	}
+}
+
+func testCreateSubAccountWithOutOfRangeIndexFails(t *testing.T) {
+	for _, index := range []uint64{0, commands.MaxSubAccountIndex + 1} {
+		err := checkCreateSubAccount(t, &commandspb.CreateSubAccount{
+			Index: index,
+		})
+
+		assert.NotEmpty(t, err.Get("create_sub_account.index"))
+	}
+}
+
+func checkCreateSubAccount(t *testing.T, cmd *commandspb.CreateSubAccount) commands.Errors {
+	t.Helper()
+
+	err := commands.CheckCreateSubAccount(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
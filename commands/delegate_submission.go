@@ -53,6 +53,57 @@ func checkDelegateSubmission(cmd *commandspb.DelegateSubmission) Errors {
 	return errs
 }
 
+func CheckRedelegateSubmission(cmd *commandspb.RedelegateSubmission) error {
+	return checkRedelegateSubmission(cmd).ErrorOrNil()
+}
+
+func checkRedelegateSubmission(cmd *commandspb.RedelegateSubmission) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("redelegate_submission", ErrIsRequired)
+	}
+
+	// an empty amount moves the whole stake delegated to the node.
+	if len(cmd.Amount) > 0 {
+		if amount, ok := big.NewInt(0).SetString(cmd.Amount, 10); !ok {
+			errs.AddForProperty("redelegate_submission.amount", ErrNotAValidInteger)
+		} else if amount.Cmp(big.NewInt(0)) < 0 {
+			errs.AddForProperty("redelegate_submission.amount", ErrMustBePositiveOrZero)
+		}
+	}
+
+	if len(cmd.FromNodeId) <= 0 {
+		errs.AddForProperty("redelegate_submission.from_node_id", ErrIsRequired)
+	} else if !IsVegaPublicKey(cmd.FromNodeId) {
+		errs.AddForProperty("redelegate_submission.from_node_id", ErrShouldBeAValidVegaPublicKey)
+	}
+
+	if len(cmd.ToNodeId) <= 0 {
+		errs.AddForProperty("redelegate_submission.to_node_id", ErrIsRequired)
+	} else if !IsVegaPublicKey(cmd.ToNodeId) {
+		errs.AddForProperty("redelegate_submission.to_node_id", ErrShouldBeAValidVegaPublicKey)
+	} else if cmd.ToNodeId == cmd.FromNodeId {
+		errs.AddForProperty("redelegate_submission.to_node_id", ErrMustBeDifferentFromSourceNode)
+	}
+
+	return errs
+}
+
+func CheckAutoCompoundSubmission(cmd *commandspb.AutoCompoundSubmission) error {
+	return checkAutoCompoundSubmission(cmd).ErrorOrNil()
+}
+
+func checkAutoCompoundSubmission(cmd *commandspb.AutoCompoundSubmission) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("auto_compound_submission", ErrIsRequired)
+	}
+
+	return errs
+}
+
 func CheckUndelegateSubmission(cmd *commandspb.UndelegateSubmission) error {
 	return checkUndelegateSubmission(cmd).ErrorOrNil()
 }
@@ -115,3 +115,88 @@ func checkUndelegateSubmission(cmd *commandspb.UndelegateSubmission) commands.Er
 	}
 	return e
 }
+
+// REDELEGATION
+
+func TestSubmittingNoRedelegateCommandFails(t *testing.T) {
+	err := checkRedelegateSubmission(nil)
+
+	assert.Contains(t, err.Get("redelegate_submission"), commands.ErrIsRequired)
+}
+
+func TestSubmittingNoRedelegateNodeIdsFails(t *testing.T) {
+	cmd := &commandspb.RedelegateSubmission{
+		Amount: "1000",
+	}
+	err := checkRedelegateSubmission(cmd)
+
+	assert.Contains(t, err.Get("redelegate_submission.from_node_id"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("redelegate_submission.to_node_id"), commands.ErrIsRequired)
+}
+
+func TestSubmittingRedelegateToSameNodeFails(t *testing.T) {
+	cmd := &commandspb.RedelegateSubmission{
+		FromNodeId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+		ToNodeId:   "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+	}
+	err := checkRedelegateSubmission(cmd)
+
+	assert.Contains(t, err.Get("redelegate_submission.to_node_id"), commands.ErrMustBeDifferentFromSourceNode)
+}
+
+func TestSubmittingRedelegateWithInvalidAmountFails(t *testing.T) {
+	cmd := &commandspb.RedelegateSubmission{
+		FromNodeId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+		ToNodeId:   "3cb2b3e4bad7ad2d4da2e6e9fa8d0e0b0c6a4eea1a4e5c3b0a3b5d3e6b1f2a11",
+		Amount:     "abc",
+	}
+	err := checkRedelegateSubmission(cmd)
+
+	assert.Contains(t, err.Get("redelegate_submission.amount"), commands.ErrNotAValidInteger)
+}
+
+func TestSubmittingRedelegateWithoutAmountSucceeds(t *testing.T) {
+	cmd := &commandspb.RedelegateSubmission{
+		FromNodeId: "08dce6ebf50e34fedee32860b6f459824e4b834762ea66a96504fdc57a9c4741",
+		ToNodeId:   "3cb2b3e4bad7ad2d4da2e6e9fa8d0e0b0c6a4eea1a4e5c3b0a3b5d3e6b1f2a11",
+	}
+	err := checkRedelegateSubmission(cmd)
+
+	assert.Empty(t, err)
+}
+
+func checkRedelegateSubmission(cmd *commandspb.RedelegateSubmission) commands.Errors {
+	err := commands.CheckRedelegateSubmission(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+	return e
+}
+
+// AUTO-COMPOUNDING
+
+func TestSubmittingNoAutoCompoundCommandFails(t *testing.T) {
+	err := checkAutoCompoundSubmission(nil)
+
+	assert.Contains(t, err.Get("auto_compound_submission"), commands.ErrIsRequired)
+}
+
+func TestSubmittingAutoCompoundCommandSucceeds(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		err := checkAutoCompoundSubmission(&commandspb.AutoCompoundSubmission{Enabled: enabled})
+
+		assert.Empty(t, err)
+	}
+}
+
+func checkAutoCompoundSubmission(cmd *commandspb.AutoCompoundSubmission) commands.Errors {
+	err := commands.CheckAutoCompoundSubmission(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+	return e
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands
+
+import (
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckDelegateVote(cmd *commandspb.DelegateVote) error {
+	return checkDelegateVote(cmd).ErrorOrNil()
+}
+
+func checkDelegateVote(cmd *commandspb.DelegateVote) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("delegate_vote", ErrIsRequired)
+	}
+
+	// An empty delegate revokes the delegation.
+	if len(cmd.Delegate) > 0 && !IsVegaPublicKey(cmd.Delegate) {
+		errs.AddForProperty("delegate_vote.delegate", ErrIsNotValidVegaPubkey)
+	}
+
+	if cmd.ProposalType != nil {
+		if *cmd.ProposalType == vega.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+			errs.AddForProperty("delegate_vote.proposal_type", ErrIsRequired)
+		} else if _, ok := vega.ProposalType_name[int32(*cmd.ProposalType)]; !ok {
+			errs.AddForProperty("delegate_vote.proposal_type", ErrIsNotValid)
+		}
+	}
+
+	return errs
+}
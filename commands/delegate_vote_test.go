@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	vgtest "code.vegaprotocol.io/vega/libs/test"
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegateVote(t *testing.T) {
+	t.Run("Delegating a vote succeeds", testDelegateVoteSucceeds)
+	t.Run("Revoking a vote delegation succeeds", testRevokeVoteDelegationSucceeds)
+	t.Run("Delegating a vote to an invalid key fails", testDelegateVoteWithInvalidDelegateFails)
+	t.Run("Delegating a vote with an invalid proposal type fails", testDelegateVoteWithInvalidProposalTypeFails)
+}
+
+func testDelegateVoteSucceeds(t *testing.T) {
+	proposalType := vega.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET
+	err := checkDelegateVote(t, &commandspb.DelegateVote{
+		Delegate:     vgtest.RandomVegaID(),
+		ProposalType: &proposalType,
+	})
+
+	assert.Empty(t, err)
+}
+
+func testRevokeVoteDelegationSucceeds(t *testing.T) {
+	err := checkDelegateVote(t, &commandspb.DelegateVote{})
+
+	assert.Empty(t, err)
+}
+
+func testDelegateVoteWithInvalidDelegateFails(t *testing.T) {
+	err := checkDelegateVote(t, &commandspb.DelegateVote{
+		Delegate: "not-a-key",
+	})
+
+	assert.Contains(t, err.Get("delegate_vote.delegate"), commands.ErrIsNotValidVegaPubkey)
+}
+
+func testDelegateVoteWithInvalidProposalTypeFails(t *testing.T) {
+	unspecified := vega.ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+	err := checkDelegateVote(t, &commandspb.DelegateVote{
+		Delegate:     vgtest.RandomVegaID(),
+		ProposalType: &unspecified,
+	})
+
+	assert.Contains(t, err.Get("delegate_vote.proposal_type"), commands.ErrIsRequired)
+
+	unknown := vega.ProposalType(-42)
+	err = checkDelegateVote(t, &commandspb.DelegateVote{
+		Delegate:     vgtest.RandomVegaID(),
+		ProposalType: &unknown,
+	})
+
+	assert.Contains(t, err.Get("delegate_vote.proposal_type"), commands.ErrIsNotValid)
+}
+
+func checkDelegateVote(t *testing.T, cmd *commandspb.DelegateVote) commands.Errors {
+	t.Helper()
+
+	err := commands.CheckDelegateVote(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
@@ -57,6 +57,8 @@ var (
 	ErrInvalidSignature                                = errors.New("invalid signature")
 	ErrUnsupportedAlgorithm                            = errors.New("unsupported algorithm")
 	ErrEmptyBatchMarketInstructions                    = errors.New("empty batch market instructions")
+	ErrSubAccountMustBeSetOnBatch                      = errors.New("sub-account must be set on the batch instead")
+	ErrSubAccountMustBeSetOnStopOrders                 = errors.New("sub-account must be set on the stop orders submission instead")
 	ErrIsNotValidVegaPubkey                            = errors.New("is not a valid vega public key")
 	ErrIsNotValidEthereumAddress                       = errors.New("is not a valid ethereum address")
 	ErrEmptyEthereumCallSpec                           = errors.New("ethereum call spec is required")
@@ -97,6 +99,7 @@ var (
 	ErrMustBeAtMost250                                 = errors.New("must be at most 250")
 	ErrNoUpdatesProvided                               = errors.New("no updates provided")
 	ErrMaxPriceMustRespectTickSize                     = errors.New("must respect tick size")
+	ErrMustBeDifferentFromSourceNode                   = errors.New("must be different from the source node")
 )
 
 type Errors map[string][]error
@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+func CheckNewTeamCompetition(cmd *commandspb.NewTeamCompetition) error {
+	return checkNewTeamCompetition(cmd).ErrorOrNil()
+}
+
+func checkNewTeamCompetition(cmd *commandspb.NewTeamCompetition) Errors {
+	errs := NewErrors()
+
+	if cmd == nil {
+		return errs.FinalAddForProperty("new_team_competition", ErrIsRequired)
+	}
+
+	if len(cmd.Asset) <= 0 {
+		errs.AddForProperty("new_team_competition.asset", ErrIsRequired)
+	} else if !IsVegaID(cmd.Asset) {
+		errs.AddForProperty("new_team_competition.asset", ErrShouldBeAValidVegaID)
+	}
+
+	if len(cmd.PrizePool) <= 0 {
+		errs.AddForProperty("new_team_competition.prize_pool", ErrIsRequired)
+	} else if prizePool, ok := big.NewInt(0).SetString(cmd.PrizePool, 10); !ok {
+		errs.AddForProperty("new_team_competition.prize_pool", ErrNotAValidInteger)
+	} else if prizePool.Cmp(big.NewInt(0)) <= 0 {
+		errs.AddForProperty("new_team_competition.prize_pool", ErrMustBePositive)
+	}
+
+	if cmd.StartEpoch == 0 {
+		errs.AddForProperty("new_team_competition.start_epoch", ErrMustBePositive)
+	}
+	if cmd.EndEpoch < cmd.StartEpoch {
+		errs.AddForProperty("new_team_competition.end_epoch", errors.New("must be greater than or equal to start_epoch"))
+	} else if cmd.EndEpoch-cmd.StartEpoch >= 100 {
+		errs.AddForProperty("new_team_competition.end_epoch", errors.New("competition cannot run for more than 100 epochs"))
+	}
+
+	for i, mkt := range cmd.Markets {
+		if !IsVegaID(mkt) {
+			errs.AddForProperty(fmt.Sprintf("new_team_competition.markets.%d", i), ErrShouldBeAValidVegaID)
+		}
+	}
+
+	switch cmd.Metric {
+	case vega.DispatchMetric_DISPATCH_METRIC_UNSPECIFIED:
+		errs.AddForProperty("new_team_competition.metric", ErrIsRequired)
+	case vega.DispatchMetric_DISPATCH_METRIC_MARKET_VALUE,
+		vega.DispatchMetric_DISPATCH_METRIC_VALIDATOR_RANKING,
+		vega.DispatchMetric_DISPATCH_METRIC_ELIGIBLE_ENTITIES:
+		errs.AddForProperty("new_team_competition.metric", errors.New("is not supported for team competitions"))
+	default:
+		if _, ok := vega.DispatchMetric_name[int32(cmd.Metric)]; !ok {
+			errs.AddForProperty("new_team_competition.metric", ErrIsNotValid)
+		}
+	}
+
+	if len(cmd.RankTable) == 0 {
+		errs.AddForProperty("new_team_competition.rank_table", ErrIsRequired)
+	} else if len(cmd.RankTable) > 500 {
+		errs.AddForProperty("new_team_competition.rank_table", ErrMustBeAtMost500)
+	}
+	for i := 1; i < len(cmd.RankTable); i++ {
+		if cmd.RankTable[i].StartRank <= cmd.RankTable[i-1].StartRank {
+			errs.AddForProperty(fmt.Sprintf("new_team_competition.rank_table.%d.start_rank", i), fmt.Errorf("must be greater than start_rank of element #%d", i-1))
+			break
+		}
+	}
+
+	return errs
+}
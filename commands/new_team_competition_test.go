@@ -0,0 +1,116 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	vgtest "code.vegaprotocol.io/vega/libs/test"
+	"code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTeamCompetition(t *testing.T) {
+	t.Run("Creating a team competition succeeds", testNewTeamCompetitionSucceeds)
+	t.Run("Creating a team competition without prize pool fails", testNewTeamCompetitionWithoutPrizePoolFails)
+	t.Run("Creating a team competition with invalid epochs fails", testNewTeamCompetitionWithInvalidEpochsFails)
+	t.Run("Creating a team competition with unsupported metric fails", testNewTeamCompetitionWithUnsupportedMetricFails)
+	t.Run("Creating a team competition with unordered rank table fails", testNewTeamCompetitionWithUnorderedRankTableFails)
+}
+
+func newTeamCompetitionCmd() *commandspb.NewTeamCompetition {
+	return &commandspb.NewTeamCompetition{
+		Asset:      vgtest.RandomVegaID(),
+		PrizePool:  "1000",
+		StartEpoch: 10,
+		EndEpoch:   12,
+		Markets:    []string{vgtest.RandomVegaID()},
+		Metric:     vega.DispatchMetric_DISPATCH_METRIC_MAKER_FEES_PAID,
+		RankTable: []*vega.Rank{
+			{StartRank: 1, ShareRatio: 10},
+			{StartRank: 2, ShareRatio: 5},
+		},
+	}
+}
+
+func testNewTeamCompetitionSucceeds(t *testing.T) {
+	err := checkNewTeamCompetition(t, newTeamCompetitionCmd())
+
+	assert.Empty(t, err)
+}
+
+func testNewTeamCompetitionWithoutPrizePoolFails(t *testing.T) {
+	cmd := newTeamCompetitionCmd()
+	cmd.PrizePool = ""
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.prize_pool"), commands.ErrIsRequired)
+
+	cmd.PrizePool = "0"
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.prize_pool"), commands.ErrMustBePositive)
+
+	cmd.PrizePool = "not-a-number"
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.prize_pool"), commands.ErrNotAValidInteger)
+}
+
+func testNewTeamCompetitionWithInvalidEpochsFails(t *testing.T) {
+	cmd := newTeamCompetitionCmd()
+	cmd.StartEpoch = 0
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.start_epoch"), commands.ErrMustBePositive)
+
+	cmd = newTeamCompetitionCmd()
+	cmd.EndEpoch = cmd.StartEpoch - 1
+	assert.NotEmpty(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.end_epoch"))
+
+	cmd = newTeamCompetitionCmd()
+	cmd.EndEpoch = cmd.StartEpoch + 100
+	assert.NotEmpty(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.end_epoch"))
+}
+
+func testNewTeamCompetitionWithUnsupportedMetricFails(t *testing.T) {
+	cmd := newTeamCompetitionCmd()
+	cmd.Metric = vega.DispatchMetric_DISPATCH_METRIC_UNSPECIFIED
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.metric"), commands.ErrIsRequired)
+
+	cmd.Metric = vega.DispatchMetric_DISPATCH_METRIC_VALIDATOR_RANKING
+	assert.NotEmpty(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.metric"))
+}
+
+func testNewTeamCompetitionWithUnorderedRankTableFails(t *testing.T) {
+	cmd := newTeamCompetitionCmd()
+	cmd.RankTable = nil
+	assert.Contains(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.rank_table"), commands.ErrIsRequired)
+
+	cmd.RankTable = []*vega.Rank{
+		{StartRank: 2, ShareRatio: 10},
+		{StartRank: 1, ShareRatio: 5},
+	}
+	assert.NotEmpty(t, checkNewTeamCompetition(t, cmd).Get("new_team_competition.rank_table.1.start_rank"))
+}
+
+func checkNewTeamCompetition(t *testing.T, cmd *commandspb.NewTeamCompetition) commands.Errors {
+	t.Helper()
+
+	err := commands.CheckNewTeamCompetition(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
@@ -50,6 +50,10 @@ func checkOrderAmendment(cmd *commandspb.OrderAmendment) Errors {
 		errs.AddForProperty("order_amendment.market_id", ErrShouldBeAValidVegaID)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("order_amendment.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
 	// Check we are not trying to amend to a GFA
 	if cmd.TimeInForce == types.Order_TIME_IN_FORCE_GFA {
 		errs.AddForProperty("order_amendment.time_in_force", ErrCannotAmendToGFA)
@@ -38,5 +38,9 @@ func checkOrderCancellation(cmd *commandspb.OrderCancellation) Errors {
 		errs.AddForProperty("order_cancellation.order_id", ErrShouldBeAValidVegaID)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("order_cancellation.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
 	return errs
 }
@@ -45,6 +45,10 @@ func checkOrderSubmission(cmd *commandspb.OrderSubmission) Errors {
 		errs.AddForProperty("order_submission.market_id", ErrShouldBeAValidVegaID)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("order_submission.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
 	if cmd.Side == types.Side_SIDE_UNSPECIFIED {
 		errs.AddForProperty("order_submission.side", ErrIsRequired)
 	}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"code.vegaprotocol.io/vega/commands"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	"code.vegaprotocol.io/vega/libs/test"
 	types "code.vegaprotocol.io/vega/protos/vega"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
@@ -32,6 +33,7 @@ func TestCheckOrderSubmission(t *testing.T) {
 	t.Run("Submitting a nil command fails", testNilOrderSubmissionFails)
 	t.Run("Submitting an empty order fails", testEmptyOrderSubmissionFails)
 	t.Run("Submitting an order without market ID fails", testOrderSubmissionWithoutMarketIDFails)
+	t.Run("Submitting an order with an invalid sub-account fails", testOrderSubmissionWithInvalidSubAccountFails)
 	t.Run("Submitting an order with unspecified side fails", testOrderSubmissionWithUnspecifiedSideFails)
 	t.Run("Submitting an order with undefined side fails", testOrderSubmissionWithUndefinedSideFails)
 	t.Run("Submitting an order with unspecified type fails", testOrderSubmissionWithUnspecifiedTypeFails)
@@ -265,6 +267,14 @@ func testOrderSubmissionWithoutMarketIDFails(t *testing.T) {
 	assert.Contains(t, err.Get("order_submission.market_id"), commands.ErrIsRequired)
 }
 
+func testOrderSubmissionWithInvalidSubAccountFails(t *testing.T) {
+	err := checkOrderSubmission(&commandspb.OrderSubmission{
+		SubAccount: ptr.From("not-a-key"),
+	})
+
+	assert.Contains(t, err.Get("order_submission.sub_account"), commands.ErrShouldBeAValidVegaPublicKey)
+}
+
 func testOrderSubmissionWithUnspecifiedSideFails(t *testing.T) {
 	err := checkOrderSubmission(&commandspb.OrderSubmission{
 		Side: types.Side_SIDE_UNSPECIFIED,
@@ -63,6 +63,7 @@ var validTransfers = map[vegapb.AccountType]map[vegapb.AccountType]struct{}{
 		vegapb.AccountType_ACCOUNT_TYPE_REWARD_VALIDATOR_RANKING:   {},
 		vegapb.AccountType_ACCOUNT_TYPE_REWARD_REALISED_RETURN:     {},
 		vegapb.AccountType_ACCOUNT_TYPE_REWARD_ELIGIBLE_ENTITIES:   {},
+		vegapb.AccountType_ACCOUNT_TYPE_MAKER_FEE_REBATES:          {},
 	},
 	vegapb.AccountType_ACCOUNT_TYPE_INSURANCE: {
 		vegapb.AccountType_ACCOUNT_TYPE_GENERAL:                    {},
@@ -217,6 +218,8 @@ func checkProposalChanges(terms *vegapb.ProposalTerms) Errors {
 		errs.Merge(checkNewTransferChanges(c))
 	case *vegapb.ProposalTerms_CancelTransfer:
 		errs.Merge(checkCancelTransferChanges(c))
+	case *vegapb.ProposalTerms_CancelDelayedWithdrawal:
+		errs.Merge(checkCancelDelayedWithdrawalChanges(c))
 	case *vegapb.ProposalTerms_UpdateMarketState:
 		errs.Merge(checkMarketUpdateState(c))
 	case *vegapb.ProposalTerms_UpdateReferralProgram:
@@ -245,12 +248,13 @@ func checkNetworkParameterUpdateChanges(change *vegapb.ProposalTerms_UpdateNetwo
 		return errs.FinalAddForProperty("proposal_submission.terms.change.update_network_parameter.changes", ErrIsRequired)
 	}
 
-	return checkNetworkParameterUpdate(change.UpdateNetworkParameter.Changes).AddPrefix("proposal_submission.terms.change.")
+	return checkNetworkParameterUpdate(change.UpdateNetworkParameter).AddPrefix("proposal_submission.terms.change.")
 }
 
-func checkNetworkParameterUpdate(parameter *vegapb.NetworkParameter) Errors {
+func checkNetworkParameterUpdate(update *vegapb.UpdateNetworkParameter) Errors {
 	errs := NewErrors()
 
+	parameter := update.Changes
 	if len(parameter.Key) == 0 {
 		errs.AddForProperty("update_network_parameter.changes.key", ErrIsRequired)
 	}
@@ -258,6 +262,23 @@ func checkNetworkParameterUpdate(parameter *vegapb.NetworkParameter) Errors {
 	if len(parameter.Value) == 0 {
 		errs.AddForProperty("update_network_parameter.changes.value", ErrIsRequired)
 	}
+
+	if schedule := update.Schedule; schedule != nil {
+		switch schedule.Type {
+		case vegapb.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_UNSPECIFIED:
+			errs.AddForProperty("update_network_parameter.schedule.type", ErrIsRequired)
+		case vegapb.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_RAMP:
+			if schedule.Epochs == 0 {
+				errs.AddForProperty("update_network_parameter.schedule.epochs", ErrMustBePositive)
+			}
+		case vegapb.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_AT_HEIGHT:
+			if schedule.Height == 0 {
+				errs.AddForProperty("update_network_parameter.schedule.height", ErrMustBePositive)
+			}
+		default:
+			errs.AddForProperty("update_network_parameter.schedule.type", ErrIsNotValid)
+		}
+	}
 	return errs
 }
 
@@ -371,6 +392,22 @@ func checkCancelTransferChanges(change *vegapb.ProposalTerms_CancelTransfer) Err
 	return errs
 }
 
+func checkCancelDelayedWithdrawalChanges(change *vegapb.ProposalTerms_CancelDelayedWithdrawal) Errors {
+	errs := NewErrors()
+	if change.CancelDelayedWithdrawal == nil {
+		return errs.FinalAddForProperty("proposal_submission.terms.change.cancel_delayed_withdrawal", ErrIsRequired)
+	}
+
+	if change.CancelDelayedWithdrawal.Changes == nil {
+		return errs.FinalAddForProperty("proposal_submission.terms.change.cancel_delayed_withdrawal.changes", ErrIsRequired)
+	}
+
+	if !IsVegaID(change.CancelDelayedWithdrawal.Changes.WithdrawalId) {
+		return errs.FinalAddForProperty("proposal_submission.terms.change.cancel_delayed_withdrawal.changes.withdrawal_id", ErrShouldBeAValidVegaID)
+	}
+	return errs
+}
+
 func checkUpdateReferralProgram(terms *vegapb.ProposalTerms, change *vegapb.ProposalTerms_UpdateReferralProgram) Errors {
 	errs := NewErrors()
 	if change.UpdateReferralProgram == nil {
@@ -963,6 +1000,13 @@ func checkNewTransferConfiguration(changes *vegapb.NewTransferConfiguration) Err
 
 			validateDispatchStrategy(changes.DestinationType, recurring.DispatchStrategy, errs, "new_transfer.changes.recurring.dispatch_strategy", "new_transfer.changes.destination_type")
 		}
+
+		if recurring.VestingSchedule != nil {
+			if recurring.DispatchStrategy == nil {
+				errs.AddForProperty("new_transfer.changes.recurring.vesting_schedule", ErrIsNotValid)
+			}
+			checkVestingSchedule(recurring.VestingSchedule, errs, "new_transfer.changes.recurring.vesting_schedule")
+		}
 	}
 
 	if changes.GetRecurring() == nil && changes.GetOneOff() == nil {
@@ -1010,6 +1054,21 @@ func checkERC20AssetSource(s *vegapb.AssetDetails_Erc20) Errors {
 	if len(asset.ContractAddress) == 0 {
 		errs.AddForProperty("proposal_submission.terms.change.new_asset.changes.source.erc20.contract_address", ErrIsRequired)
 	}
+
+	chainIDs := map[string]struct{}{asset.ChainId: {}}
+	for i, c := range asset.AdditionalChains {
+		property := fmt.Sprintf("proposal_submission.terms.change.new_asset.changes.source.erc20.additional_chains.%d", i)
+		if len(c.ChainId) == 0 {
+			errs.AddForProperty(property+".chain_id", ErrIsRequired)
+		} else if _, ok := chainIDs[c.ChainId]; ok {
+			errs.AddForProperty(property+".chain_id", ErrIsDuplicated)
+		}
+		chainIDs[c.ChainId] = struct{}{}
+		if len(c.ContractAddress) == 0 {
+			errs.AddForProperty(property+".contract_address", ErrIsRequired)
+		}
+	}
+
 	if len(asset.LifetimeLimit) == 0 {
 		errs.AddForProperty("proposal_submission.terms.change.new_asset.changes.source.erc20.lifetime_limit", ErrIsRequired)
 	} else {
@@ -1171,6 +1230,7 @@ func checkNewSpotMarketConfiguration(changes *vegapb.NewSpotMarketConfiguration)
 	errs.Merge(checkSLAParams(changes.SlaParams, "new_spot_market.changes.sla_params"))
 	errs.Merge(checkTickSize(changes.TickSize, "new_spot_market.changes"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "new_spot_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkMakerFeeTiers(changes.MakerFeeTiers, "new_spot_market.changes.maker_fee_tiers"))
 
 	return errs
 }
@@ -1253,6 +1313,7 @@ func checkNewMarketChangesConfiguration(changes *vegapb.NewMarketConfiguration)
 	errs.Merge(checkNewRiskParameters(changes))
 	errs.Merge(checkSLAParams(changes.LiquiditySlaParameters, "new_market.changes.sla_params"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "new_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkMakerFeeTiers(changes.MakerFeeTiers, "new_market.changes.maker_fee_tiers"))
 	errs.Merge(checkCompositePriceConfiguration(changes.MarkPriceConfiguration, "new_market.changes.mark_price_configuration"))
 	errs.Merge(checkTickSize(changes.TickSize, "new_market.changes"))
 
@@ -1320,6 +1381,7 @@ func checkUpdateMarket(updateMarket *vegapb.UpdateMarket) Errors {
 	errs.Merge(checkUpdateRiskParameters(changes))
 	errs.Merge(checkSLAParams(changes.LiquiditySlaParameters, "update_market.changes.sla_params"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "update_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkMakerFeeTiers(changes.MakerFeeTiers, "update_market.changes.maker_fee_tiers"))
 	errs.Merge(checkCompositePriceConfiguration(changes.MarkPriceConfiguration, "update_market.changes.mark_price_configuration"))
 	errs.Merge(checkTickSize(changes.TickSize, "update_market.changes"))
 	return errs
@@ -1355,6 +1417,7 @@ func checkUpdateSpotMarket(updateSpotMarket *vegapb.UpdateSpotMarket) Errors {
 	errs.Merge(checkSLAParams(changes.SlaParams, "update_spot_market.changes.sla_params"))
 	errs.Merge(checkTickSize(changes.TickSize, "update_spot_market.changes"))
 	errs.Merge(checkLiquidityFeeSettings(changes.LiquidityFeeSettings, "update_spot_market.changes.liquidity_fee_settings"))
+	errs.Merge(checkMakerFeeTiers(changes.MakerFeeTiers, "update_spot_market.changes.maker_fee_tiers"))
 	return errs
 }
 
@@ -2270,6 +2333,31 @@ func checkLiquidityFeeSettings(config *vegapb.LiquidityFeeSettings, parent strin
 	return errs
 }
 
+func checkMakerFeeTiers(tiers []*vegapb.MakerFeeTier, parent string) Errors {
+	errs := NewErrors()
+	for i, t := range tiers {
+		if t == nil {
+			errs.AddForProperty(fmt.Sprintf("%s.%d", parent, i), ErrIsRequired)
+			continue
+		}
+		fraction, err := num.DecimalFromString(t.MinimumMakerVolumeFraction)
+		switch {
+		case err != nil:
+			errs.AddForProperty(fmt.Sprintf("%s.%d.minimum_maker_volume_fraction", parent, i), ErrIsNotValidNumber)
+		case fraction.IsNegative() || fraction.GreaterThan(num.DecimalOne()):
+			errs.AddForProperty(fmt.Sprintf("%s.%d.minimum_maker_volume_fraction", parent, i), ErrMustBeWithinRange01)
+		}
+		fee, err := num.DecimalFromString(t.MakerFee)
+		switch {
+		case err != nil:
+			errs.AddForProperty(fmt.Sprintf("%s.%d.maker_fee", parent, i), ErrIsNotValidNumber)
+		case fee.LessThan(num.DecimalOne().Neg()) || fee.GreaterThan(num.DecimalOne()):
+			errs.AddForProperty(fmt.Sprintf("%s.%d.maker_fee", parent, i), ErrMustBeWithinRange11)
+		}
+	}
+	return errs
+}
+
 func checkCompositePriceConfiguration(config *vegapb.CompositePriceConfiguration, parent string) Errors {
 	errs := NewErrors()
 	if config == nil {
@@ -32,6 +32,8 @@ func TestCheckProposalSubmissionForNetworkParameterUpdate(t *testing.T) {
 	t.Run("Submitting a network parameter change with key succeeds", testNetworkParameterChangeSubmissionWithKeySucceeds)
 	t.Run("Submitting a network parameter change without value fails", testNetworkParameterChangeSubmissionWithoutValueFails)
 	t.Run("Submitting a network parameter change with value succeeds", testNetworkParameterChangeSubmissionWithValueSucceeds)
+	t.Run("Submitting a network parameter change with an invalid schedule fails", testNetworkParameterChangeSubmissionWithInvalidScheduleFails)
+	t.Run("Submitting a network parameter change with a schedule succeeds", testNetworkParameterChangeSubmissionWithScheduleSucceeds)
 }
 
 func testNetworkParameterChangeSubmissionWithoutNetworkParameterFails(t *testing.T) {
@@ -119,3 +121,72 @@ func testNetworkParameterChangeSubmissionWithValueSucceeds(t *testing.T) {
 
 	assert.NotContains(t, err.Get("proposal_submission.terms.change.update_network_parameter.changes.value"), commands.ErrIsRequired)
 }
+
+func testNetworkParameterChangeSubmissionWithInvalidScheduleFails(t *testing.T) {
+	cases := []struct {
+		schedule *types.NetworkParameterSchedule
+		field    string
+		err      error
+	}{
+		{
+			schedule: &types.NetworkParameterSchedule{},
+			field:    "type",
+			err:      commands.ErrIsRequired,
+		},
+		{
+			schedule: &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleType(42)},
+			field:    "type",
+			err:      commands.ErrIsNotValid,
+		},
+		{
+			schedule: &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_RAMP},
+			field:    "epochs",
+			err:      commands.ErrMustBePositive,
+		},
+		{
+			schedule: &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_AT_HEIGHT},
+			field:    "height",
+			err:      commands.ErrMustBePositive,
+		},
+	}
+
+	for _, c := range cases {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &types.ProposalTerms{
+				Change: &types.ProposalTerms_UpdateNetworkParameter{
+					UpdateNetworkParameter: &types.UpdateNetworkParameter{
+						Changes: &types.NetworkParameter{
+							Key:   "My key",
+							Value: "My value",
+						},
+						Schedule: c.schedule,
+					},
+				},
+			},
+		})
+
+		assert.Contains(t, err.Get("proposal_submission.terms.change.update_network_parameter.schedule."+c.field), c.err)
+	}
+}
+
+func testNetworkParameterChangeSubmissionWithScheduleSucceeds(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_UpdateNetworkParameter{
+				UpdateNetworkParameter: &types.UpdateNetworkParameter{
+					Changes: &types.NetworkParameter{
+						Key:   "My key",
+						Value: "My value",
+					},
+					Schedule: &types.NetworkParameterSchedule{
+						Type:   types.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_RAMP,
+						Epochs: 10,
+					},
+				},
+			},
+		},
+	})
+
+	assert.Empty(t, err.Get("proposal_submission.terms.change.update_network_parameter.schedule.type"))
+	assert.Empty(t, err.Get("proposal_submission.terms.change.update_network_parameter.schedule.epochs"))
+}
@@ -44,6 +44,7 @@ func TestCheckProposalSubmissionForNewAsset(t *testing.T) {
 	t.Run("Submitting an ERC20 asset change without ERC20 asset fails", testNewERC20AssetChangeSubmissionWithoutErc20AssetFails)
 	t.Run("Submitting an ERC20 asset change without chain id fails", testNewERC20AssetChangeSubmissionWithoutChainIDFails)
 	t.Run("Submitting an ERC20 asset change without contract address fails", testNewERC20AssetChangeSubmissionWithoutContractAddressFails)
+	t.Run("Submitting an ERC20 asset change with invalid additional chains fails", testNewERC20AssetChangeSubmissionWithInvalidAdditionalChainsFails)
 	t.Run("Submitting an ERC20 asset change with contract address succeeds", testNewERC20AssetChangeSubmissionWithContractAddressSucceeds)
 	t.Run("Submitting an ERC20 asset change with invalid lifetime limit fails", testNewERC20AssetChangeSubmissionWithInvalidLifetimeLimitFails)
 	t.Run("Submitting an ERC20 asset change with valid lifetime limit succeeds", testNewERC20AssetChangeSubmissionWithValidLifetimeLimitSucceeds)
@@ -379,6 +380,33 @@ func testNewERC20AssetChangeSubmissionWithoutChainIDFails(t *testing.T) {
 	assert.Contains(t, err.Get("proposal_submission.terms.change.new_asset.changes.source.erc20.chain_id"), commands.ErrIsRequired)
 }
 
+func testNewERC20AssetChangeSubmissionWithInvalidAdditionalChainsFails(t *testing.T) {
+	err := checkProposalSubmission(&commandspb.ProposalSubmission{
+		Terms: &types.ProposalTerms{
+			Change: &types.ProposalTerms_NewAsset{
+				NewAsset: &types.NewAsset{
+					Changes: &types.AssetDetails{
+						Source: &types.AssetDetails_Erc20{
+							Erc20: &types.ERC20{
+								ChainId: "1",
+								AdditionalChains: []*types.ERC20ChainContract{
+									{ChainId: "1", ContractAddress: "0x1FaA74E181092A97Fecc923015293ce57eE1208A"},
+									{ChainId: "", ContractAddress: "0x1FaA74E181092A97Fecc923015293ce57eE1208A"},
+									{ChainId: "42161", ContractAddress: ""},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_asset.changes.source.erc20.additional_chains.0.chain_id"), commands.ErrIsDuplicated)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_asset.changes.source.erc20.additional_chains.1.chain_id"), commands.ErrIsRequired)
+	assert.Contains(t, err.Get("proposal_submission.terms.change.new_asset.changes.source.erc20.additional_chains.2.contract_address"), commands.ErrIsRequired)
+}
+
 func testNewERC20AssetChangeSubmissionWithoutContractAddressFails(t *testing.T) {
 	err := checkProposalSubmission(&commandspb.ProposalSubmission{
 		Terms: &types.ProposalTerms{
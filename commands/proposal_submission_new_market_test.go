@@ -189,6 +189,7 @@ func TestCheckProposalSubmissionForNewMarket(t *testing.T) {
 	t.Run("Submitting a new market with valid hysteresis epochs succeeds", testNewMarketChangeSubmissionWithValidPerformanceHysteresisEpochsSucceeds)
 	t.Run("Submitting a new market with invalid liquidity fee settings", testLiquidityFeeSettings)
 	t.Run("Submitting a new spot market with invalid liquidity fee settings", testLiquidityFeeSettingsSpot)
+	t.Run("Submitting a new market with invalid maker fee tiers", testMakerFeeTiers)
 	t.Run("Submitting a new market with invalid mark price configuration ", testCompositePriceConfiguration)
 	t.Run("Submitting a new market with invalid tick size fails and with valid tick size succeeds", testNewMarketTickSize)
 
@@ -6209,6 +6210,55 @@ func testLiquidityFeeSettings(t *testing.T) {
 	}
 }
 
+func testMakerFeeTiers(t *testing.T) {
+	cases := []struct {
+		tier  *vega.MakerFeeTier
+		field string
+		err   error
+	}{
+		{
+			tier:  &vega.MakerFeeTier{MinimumMakerVolumeFraction: "hello", MakerFee: "0.001"},
+			field: "minimum_maker_volume_fraction",
+			err:   commands.ErrIsNotValidNumber,
+		},
+		{
+			tier:  &vega.MakerFeeTier{MinimumMakerVolumeFraction: "1.1", MakerFee: "0.001"},
+			field: "minimum_maker_volume_fraction",
+			err:   commands.ErrMustBeWithinRange01,
+		},
+		{
+			tier:  &vega.MakerFeeTier{MinimumMakerVolumeFraction: "0.1", MakerFee: ""},
+			field: "maker_fee",
+			err:   commands.ErrIsNotValidNumber,
+		},
+		{
+			tier:  &vega.MakerFeeTier{MinimumMakerVolumeFraction: "0.1", MakerFee: "-1.5"},
+			field: "maker_fee",
+			err:   commands.ErrMustBeWithinRange11,
+		},
+	}
+
+	for _, c := range cases {
+		err := checkProposalSubmission(&commandspb.ProposalSubmission{
+			Terms: &vegapb.ProposalTerms{
+				Change: &vegapb.ProposalTerms_NewMarket{
+					NewMarket: &vegapb.NewMarket{
+						Changes: &vegapb.NewMarketConfiguration{
+							MakerFeeTiers: []*vega.MakerFeeTier{
+								{MinimumMakerVolumeFraction: "0", MakerFee: "-0.0001"},
+								c.tier,
+							},
+						},
+					},
+				},
+			},
+		})
+		assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.maker_fee_tiers.0.minimum_maker_volume_fraction"))
+		assert.Empty(t, err.Get("proposal_submission.terms.change.new_market.changes.maker_fee_tiers.0.maker_fee"))
+		assert.Contains(t, err.Get("proposal_submission.terms.change.new_market.changes.maker_fee_tiers.1."+c.field), c.err)
+	}
+}
+
 func testLiquidityFeeSettingsSpot(t *testing.T) {
 	cases := []struct {
 		lfs   *vega.LiquidityFeeSettings
@@ -16,6 +16,8 @@
 package commands
 
 import (
+	"errors"
+
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 )
 
@@ -33,5 +35,16 @@ func checkProtocolUpgradeProposal(cmd *commandspb.ProtocolUpgradeProposal) Error
 		errs.AddForProperty("protocol_upgrade_proposal.vega_release_tag", ErrIsRequired)
 	}
 
+	if cmd.Readiness != nil {
+		if cmd.Readiness.SnapshotHeight == 0 {
+			errs.AddForProperty("protocol_upgrade_proposal.readiness.snapshot_height", ErrMustBePositive)
+		} else if cmd.Readiness.SnapshotHeight >= cmd.UpgradeBlockHeight {
+			errs.AddForProperty("protocol_upgrade_proposal.readiness.snapshot_height", errors.New("must be lower than the upgrade block height"))
+		}
+		if cmd.Readiness.BlocksReplayed == 0 {
+			errs.AddForProperty("protocol_upgrade_proposal.readiness.blocks_replayed", ErrMustBePositive)
+		}
+	}
+
 	return errs
 }
@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package commands_test
+
+import (
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/commands"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolUpgradeProposal(t *testing.T) {
+	t.Run("Proposing an upgrade succeeds", testProtocolUpgradeProposalSucceeds)
+	t.Run("Proposing an upgrade without release tag fails", testProtocolUpgradeProposalWithoutReleaseTagFails)
+	t.Run("Proposing an upgrade with invalid readiness fails", testProtocolUpgradeProposalWithInvalidReadinessFails)
+}
+
+func testProtocolUpgradeProposalSucceeds(t *testing.T) {
+	err := checkProtocolUpgradeProposal(t, &commandspb.ProtocolUpgradeProposal{
+		UpgradeBlockHeight: 1000,
+		VegaReleaseTag:     "v0.77.0",
+	})
+	assert.Empty(t, err)
+
+	err = checkProtocolUpgradeProposal(t, &commandspb.ProtocolUpgradeProposal{
+		UpgradeBlockHeight: 1000,
+		VegaReleaseTag:     "v0.77.0",
+		Readiness: &commandspb.ProtocolUpgradeReadiness{
+			SnapshotHeight: 500,
+			BlocksReplayed: 100,
+		},
+	})
+	assert.Empty(t, err)
+}
+
+func testProtocolUpgradeProposalWithoutReleaseTagFails(t *testing.T) {
+	err := checkProtocolUpgradeProposal(t, &commandspb.ProtocolUpgradeProposal{
+		UpgradeBlockHeight: 1000,
+	})
+
+	assert.Contains(t, err.Get("protocol_upgrade_proposal.vega_release_tag"), commands.ErrIsRequired)
+}
+
+func testProtocolUpgradeProposalWithInvalidReadinessFails(t *testing.T) {
+	err := checkProtocolUpgradeProposal(t, &commandspb.ProtocolUpgradeProposal{
+		UpgradeBlockHeight: 1000,
+		VegaReleaseTag:     "v0.77.0",
+		Readiness:          &commandspb.ProtocolUpgradeReadiness{},
+	})
+	assert.Contains(t, err.Get("protocol_upgrade_proposal.readiness.snapshot_height"), commands.ErrMustBePositive)
+	assert.Contains(t, err.Get("protocol_upgrade_proposal.readiness.blocks_replayed"), commands.ErrMustBePositive)
+
+	err = checkProtocolUpgradeProposal(t, &commandspb.ProtocolUpgradeProposal{
+		UpgradeBlockHeight: 1000,
+		VegaReleaseTag:     "v0.77.0",
+		Readiness: &commandspb.ProtocolUpgradeReadiness{
+			SnapshotHeight: 1000,
+			BlocksReplayed: 100,
+		},
+	})
+	assert.NotEmpty(t, err.Get("protocol_upgrade_proposal.readiness.snapshot_height"))
+}
+
+func checkProtocolUpgradeProposal(t *testing.T, cmd *commandspb.ProtocolUpgradeProposal) commands.Errors {
+	t.Helper()
+
+	err := commands.CheckProtocolUpgradeProposal(cmd)
+
+	var e commands.Errors
+	if ok := errors.As(err, &e); !ok {
+		return commands.NewErrors()
+	}
+
+	return e
+}
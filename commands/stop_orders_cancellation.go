@@ -38,5 +38,9 @@ func checkStopOrdersCancellation(cmd *commandspb.StopOrdersCancellation) Errors
 		errs.AddForProperty("stop_orders_cancellation.stop_order_id", ErrShouldBeAValidVegaID)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("stop_orders_cancellation.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
 	return errs
 }
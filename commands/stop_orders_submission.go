@@ -35,6 +35,10 @@ func checkStopOrdersSubmission(cmd *commandspb.StopOrdersSubmission) Errors {
 		return errs.FinalAddForProperty("stop_orders_submission", ErrIsRequired)
 	}
 
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("stop_orders_submission.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
+
 	var market1, market2 string
 	if cmd.FallsBelow != nil {
 		checkStopOrderSetup(
@@ -109,6 +113,11 @@ func checkStopOrderSetup(
 		errs.Merge(err)
 	}
 
+	// the sub-account applies to both stop orders, it cannot be set on the order.
+	if setup.OrderSubmission != nil && setup.OrderSubmission.SubAccount != nil {
+		errs.AddForProperty(fmt.Sprintf("%s.order_submission.sub_account", fieldName), ErrSubAccountMustBeSetOnStopOrders)
+	}
+
 	if setup.OrderSubmission != nil && setup.OrderSubmission.TimeInForce == types.Order_TIME_IN_FORCE_GFA {
 		errs.AddForProperty(fmt.Sprintf("%s.order_submission.time_in_force", fieldName), ErrIsNotValid)
 	}
@@ -264,6 +264,22 @@ func CheckInputData(rawInputData []byte) (*commandspb.InputData, Errors) {
 			errs.Merge(checkAmendAMM(cmd.AmendAmm))
 		case *commandspb.InputData_CancelAmm:
 			errs.Merge(checkCancelAMM(cmd.CancelAmm))
+		case *commandspb.InputData_NewTeamCompetition:
+			errs.Merge(checkNewTeamCompetition(cmd.NewTeamCompetition))
+		case *commandspb.InputData_CancelTeamCompetition:
+			errs.Merge(checkCancelTeamCompetition(cmd.CancelTeamCompetition))
+		case *commandspb.InputData_CreateSubAccount:
+			errs.Merge(checkCreateSubAccount(cmd.CreateSubAccount))
+		case *commandspb.InputData_CancelDelayedWithdrawal:
+			errs.Merge(checkCancelDelayedWithdrawal(cmd.CancelDelayedWithdrawal))
+		case *commandspb.InputData_DelegateVote:
+			errs.Merge(checkDelegateVote(cmd.DelegateVote))
+		case *commandspb.InputData_VetoProposal:
+			errs.Merge(checkVetoProposal(cmd.VetoProposal))
+		case *commandspb.InputData_RedelegateSubmission:
+			errs.Merge(checkRedelegateSubmission(cmd.RedelegateSubmission))
+		case *commandspb.InputData_AutoCompoundSubmission:
+			errs.Merge(checkAutoCompoundSubmission(cmd.AutoCompoundSubmission))
 		case *commandspb.InputData_DelayedTransactionsWrapper:
 			break
 		default:
@@ -96,8 +96,10 @@ func checkTransfer(cmd *commandspb.Transfer) (e Errors) {
 			errs.AddForProperty("transfer.from", ErrShouldBeAValidVegaPublicKey)
 		}
 
-		if cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_VESTED_REWARDS {
-			errs.AddForProperty("transfer.from", errors.New("from can only be set for vested rewards"))
+		// a derived key can release its vested rewards, and a sub-account can move
+		// its general account balance back to its owner.
+		if cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_VESTED_REWARDS && cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL {
+			errs.AddForProperty("transfer.from", errors.New("from can only be set for vested rewards or general accounts"))
 		}
 
 		if cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL {
@@ -110,12 +112,22 @@ func checkTransfer(cmd *commandspb.Transfer) (e Errors) {
 	} else {
 		switch k := cmd.Kind.(type) {
 		case *commandspb.Transfer_OneOff:
-			if cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GLOBAL_REWARD && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_UNSPECIFIED && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_NETWORK_TREASURY && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_BUY_BACK_FEES && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_LOCKED_FOR_STAKING {
+			if cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GLOBAL_REWARD && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_UNSPECIFIED && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_NETWORK_TREASURY && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_BUY_BACK_FEES && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_LOCKED_FOR_STAKING && cmd.ToAccountType != vega.AccountType_ACCOUNT_TYPE_MAKER_FEE_REBATES {
 				errs.AddForProperty("transfer.to_account_type", errors.New("account type is not valid for one off transfer"))
 			}
 			if k.OneOff.GetDeliverOn() < 0 {
 				errs.AddForProperty("transfer.kind.deliver_on", ErrMustBePositiveOrZero)
 			}
+			if k.OneOff.GetCondition() != nil {
+				if k.OneOff.GetDeliverOn() > 0 {
+					errs.AddForProperty("transfer.kind.condition", errors.New("a conditional transfer cannot have a delivery time"))
+				}
+				// the escrowed funds are returned to the general account of the sender if the transfer is cancelled.
+				if cmd.From != nil || cmd.FromAccountType != vega.AccountType_ACCOUNT_TYPE_GENERAL {
+					errs.AddForProperty("transfer.kind.condition", errors.New("a conditional transfer can only be made from a general account"))
+				}
+				checkTransferCondition(k.OneOff.Condition, errs, "transfer.kind.condition")
+			}
 			// do not allow for one off transfer to one of the metric based accounts
 			if cmd.ToAccountType == vega.AccountType_ACCOUNT_TYPE_REWARD_LP_RECEIVED_FEES ||
 				cmd.ToAccountType == vega.AccountType_ACCOUNT_TYPE_REWARD_MAKER_RECEIVED_FEES ||
@@ -167,6 +179,12 @@ func checkTransfer(cmd *commandspb.Transfer) (e Errors) {
 			if k.Recurring.DispatchStrategy != nil {
 				validateDispatchStrategy(cmd.ToAccountType, k.Recurring.DispatchStrategy, errs, "transfer.kind.dispatch_strategy", "transfer.account.to")
 			}
+			if k.Recurring.VestingSchedule != nil {
+				if k.Recurring.DispatchStrategy == nil {
+					errs.AddForProperty("transfer.kind.vesting_schedule", ErrIsNotValid)
+				}
+				checkVestingSchedule(k.Recurring.VestingSchedule, errs, "transfer.kind.vesting_schedule")
+			}
 
 		default:
 			errs.AddForProperty("transfer.kind", ErrIsNotSupported)
@@ -180,6 +198,71 @@ func mismatchingAccountTypeError(tp vega.AccountType, metric vega.DispatchMetric
 	return errors.New("cannot set toAccountType to " + tp.String() + " when dispatch metric is set to " + metric.String())
 }
 
+func checkTransferCondition(condition *commandspb.TransferCondition, errs Errors, prefix string) {
+	switch c := condition.Condition.(type) {
+	case *commandspb.TransferCondition_DataSource:
+		errs.Merge(checkDataSourceSpec(c.DataSource, "data_source", prefix, false))
+	case *commandspb.TransferCondition_MarketState:
+		if len(c.MarketState.MarketId) == 0 {
+			errs.AddForProperty(prefix+".market_state.market_id", ErrIsRequired)
+		} else if !IsVegaID(c.MarketState.MarketId) {
+			errs.AddForProperty(prefix+".market_state.market_id", ErrShouldBeAValidVegaID)
+		}
+		if c.MarketState.State == vega.Market_STATE_UNSPECIFIED {
+			errs.AddForProperty(prefix+".market_state.state", ErrIsRequired)
+		} else if _, ok := vega.Market_State_name[int32(c.MarketState.State)]; !ok {
+			errs.AddForProperty(prefix+".market_state.state", ErrIsNotValid)
+		}
+	case *commandspb.TransferCondition_Balance:
+		if len(c.Balance.Party) == 0 {
+			errs.AddForProperty(prefix+".balance.party", ErrIsRequired)
+		} else if !IsVegaPublicKey(c.Balance.Party) {
+			errs.AddForProperty(prefix+".balance.party", ErrShouldBeAValidVegaPublicKey)
+		}
+		if len(c.Balance.Asset) == 0 {
+			errs.AddForProperty(prefix+".balance.asset", ErrIsRequired)
+		} else if !IsVegaID(c.Balance.Asset) {
+			errs.AddForProperty(prefix+".balance.asset", ErrShouldBeAValidVegaID)
+		}
+		if len(c.Balance.Threshold) == 0 {
+			errs.AddForProperty(prefix+".balance.threshold", ErrIsRequired)
+		} else if _, overflow := num.UintFromString(c.Balance.Threshold, 10); overflow {
+			errs.AddForProperty(prefix+".balance.threshold", ErrIsNotValidNumber)
+		}
+	default:
+		errs.AddForProperty(prefix, ErrIsRequired)
+	}
+}
+
+func checkVestingSchedule(schedule *vega.VestingSchedule, errs Errors, prefix string) {
+	switch schedule.Type {
+	case vega.VestingSchedule_TYPE_LINEAR, vega.VestingSchedule_TYPE_EXPONENTIAL:
+		if schedule.DurationEpochs == 0 {
+			errs.AddForProperty(prefix+".duration_epochs", ErrMustBePositive)
+		}
+		if schedule.Type == vega.VestingSchedule_TYPE_EXPONENTIAL {
+			if decayRate, err := num.DecimalFromString(schedule.DecayRate); err != nil {
+				errs.AddForProperty(prefix+".decay_rate", ErrIsNotValidNumber)
+			} else if !decayRate.IsPositive() || decayRate.GreaterThan(num.DecimalOne()) {
+				errs.AddForProperty(prefix+".decay_rate", ErrIsNotValid)
+			}
+		}
+	case vega.VestingSchedule_TYPE_STEP:
+		if len(schedule.Steps) == 0 {
+			errs.AddForProperty(prefix+".steps", ErrIsRequired)
+		}
+		for i, step := range schedule.Steps {
+			if _, err := num.DecimalFromString(step.Fraction); err != nil {
+				errs.AddForProperty(fmt.Sprintf("%s.steps.%d.fraction", prefix, i), ErrIsNotValidNumber)
+			}
+		}
+	case vega.VestingSchedule_TYPE_UNSPECIFIED:
+		errs.AddForProperty(prefix+".type", ErrIsRequired)
+	default:
+		errs.AddForProperty(prefix+".type", ErrIsNotValid)
+	}
+}
+
 func validateDispatchStrategy(toAccountType vega.AccountType, dispatchStrategy *vega.DispatchStrategy, errs Errors, prefix string, destinationPrefixErr string) {
 	// check account type is one of the relevant reward accounts
 	if toAccountType != vega.AccountType_ACCOUNT_TYPE_REWARD_LP_RECEIVED_FEES &&
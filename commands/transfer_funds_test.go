@@ -69,6 +69,163 @@ func TestTransferFunds(t *testing.T) {
 				Reference: "testing",
 			},
 		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_Balance{
+								Balance: &commandspb.BalanceTransferCondition{
+									Party:     "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+									Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+									Threshold: "100",
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_MarketState{
+								MarketState: &commandspb.MarketStateTransferCondition{
+									MarketId: "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+									State:    vega.Market_STATE_SETTLED,
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_Balance{
+								Balance: &commandspb.BalanceTransferCondition{
+									Party:     "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+									Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+									Threshold: "banana",
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.condition.balance.threshold (is not a valid number)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_MarketState{
+								MarketState: &commandspb.MarketStateTransferCondition{
+									MarketId: "",
+									State:    vega.Market_STATE_SETTLED,
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.condition.market_state.market_id (is required)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.condition (is required)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						DeliverOn: 10,
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_Balance{
+								Balance: &commandspb.BalanceTransferCondition{
+									Party:     "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+									Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+									Threshold: "100",
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.condition (a conditional transfer cannot have a delivery time)",
+		},
+		{
+			transfer: commandspb.Transfer{
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_VESTED_REWARDS,
+				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				Kind: &commandspb.Transfer_OneOff{
+					OneOff: &commandspb.OneOffTransfer{
+						Condition: &commandspb.TransferCondition{
+							Condition: &commandspb.TransferCondition_Balance{
+								Balance: &commandspb.BalanceTransferCondition{
+									Party:     "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+									Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+									Threshold: "100",
+								},
+							},
+						},
+					},
+				},
+				To:        "84e2b15102a8d6c1c6b4bdf40af8a0dc21b040eaaa1c94cd10d17604b75fdc35",
+				Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+				Amount:    "1",
+				Reference: "testing",
+			},
+			errString: "transfer.kind.condition (a conditional transfer can only be made from a general account)",
+		},
 		{
 			transfer: commandspb.Transfer{
 				FromAccountType: vega.AccountType_ACCOUNT_TYPE_MARGIN,
@@ -1404,7 +1561,7 @@ func TestTransferFunds(t *testing.T) {
 		},
 		{
 			transfer: commandspb.Transfer{
-				FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+				FromAccountType: vega.AccountType_ACCOUNT_TYPE_LOCKED_FOR_STAKING,
 				ToAccountType:   vega.AccountType_ACCOUNT_TYPE_GENERAL,
 				From:            toPointer("171538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff"),
 				Kind: &commandspb.Transfer_OneOff{
@@ -1415,7 +1572,7 @@ func TestTransferFunds(t *testing.T) {
 				Amount:    "1",
 				Reference: "testing",
 			},
-			errString: "transfer.from (from can only be set for vested rewards)",
+			errString: "transfer.from (from can only be set for vested rewards or general accounts)",
 		},
 		{
 			transfer: commandspb.Transfer{
@@ -1650,3 +1807,53 @@ func checkTransfer(cmd *commandspb.Transfer) commands.Errors {
 func toPointer[T any](val T) *T {
 	return &val
 }
+
+func TestTransferFundsVestingSchedule(t *testing.T) {
+	newTransfer := func(ds *vega.DispatchStrategy, schedule *vega.VestingSchedule) *commandspb.Transfer {
+		return &commandspb.Transfer{
+			FromAccountType: vega.AccountType_ACCOUNT_TYPE_GENERAL,
+			ToAccountType:   vega.AccountType_ACCOUNT_TYPE_REWARD_MAKER_PAID_FEES,
+			Kind: &commandspb.Transfer_Recurring{
+				Recurring: &commandspb.RecurringTransfer{
+					StartEpoch:       10,
+					Factor:           "1",
+					DispatchStrategy: ds,
+					VestingSchedule:  schedule,
+				},
+			},
+			To:        "0000000000000000000000000000000000000000000000000000000000000000",
+			Asset:     "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+			Amount:    "1",
+			Reference: "testing",
+		}
+	}
+	ds := &vega.DispatchStrategy{
+		AssetForMetric:       "080538b7cc2249de568cb4272a17f4d5e0b0a69a1a240acbf5119d816178daff",
+		Metric:               vega.DispatchMetric_DISPATCH_METRIC_MAKER_FEES_PAID,
+		EntityScope:          vega.EntityScope_ENTITY_SCOPE_INDIVIDUALS,
+		IndividualScope:      vega.IndividualScope_INDIVIDUAL_SCOPE_ALL,
+		WindowLength:         1,
+		DistributionStrategy: vega.DistributionStrategy_DISTRIBUTION_STRATEGY_PRO_RATA,
+	}
+
+	err := checkTransfer(newTransfer(ds, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_LINEAR, CliffEpochs: 1, DurationEpochs: 5}))
+	assert.NoError(t, err.ErrorOrNil())
+
+	err = checkTransfer(newTransfer(nil, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_LINEAR, DurationEpochs: 5}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule"), commands.ErrIsNotValid)
+
+	err = checkTransfer(newTransfer(ds, &vega.VestingSchedule{}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule.type"), commands.ErrIsRequired)
+
+	err = checkTransfer(newTransfer(ds, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_LINEAR}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule.duration_epochs"), commands.ErrMustBePositive)
+
+	err = checkTransfer(newTransfer(ds, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_EXPONENTIAL, DurationEpochs: 5, DecayRate: "1.5"}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule.decay_rate"), commands.ErrIsNotValid)
+
+	err = checkTransfer(newTransfer(ds, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_STEP}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule.steps"), commands.ErrIsRequired)
+
+	err = checkTransfer(newTransfer(ds, &vega.VestingSchedule{Type: vega.VestingSchedule_TYPE_STEP, Steps: []*vega.VestingStep{{Epochs: 1, Fraction: "banana"}}}))
+	assert.Contains(t, err.Get("transfer.kind.vesting_schedule.steps.0.fraction"), commands.ErrIsNotValidNumber)
+}
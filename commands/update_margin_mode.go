@@ -59,6 +59,9 @@ func checkUpdateMarginMode(cmd *commandspb.UpdateMarginMode) Errors {
 	if len(cmd.MarketId) == 0 {
 		errs.AddForProperty("update_margin_mode.market_id", ErrIsRequired)
 	}
+	if cmd.SubAccount != nil && !IsVegaPublicKey(*cmd.SubAccount) {
+		errs.AddForProperty("update_margin_mode.sub_account", ErrShouldBeAValidVegaPublicKey)
+	}
 
 	return errs
 }
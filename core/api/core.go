@@ -26,12 +26,16 @@ import (
 	"sync/atomic"
 	"time"
 
+	"code.vegaprotocol.io/vega/core/banking"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/evtforward"
+	"code.vegaprotocol.io/vega/core/governance"
 	"code.vegaprotocol.io/vega/core/metrics"
 	"code.vegaprotocol.io/vega/core/stats"
+	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/core/vegatime"
 	"code.vegaprotocol.io/vega/libs/proto"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	"code.vegaprotocol.io/vega/libs/subscribers"
 	"code.vegaprotocol.io/vega/logging"
 	ptypes "code.vegaprotocol.io/vega/protos/vega"
@@ -68,6 +72,10 @@ type coreService struct {
 	powParams    ProofOfWorkParams
 	spamEngine   SpamEngine
 	powEngine    PowEngine
+	queries      *StateQueries
+	execution    ExecutionEngine
+	banking      BankingEngine
+	governance   GovernanceEngine
 
 	chainID                  string
 	genesisTime              time.Time
@@ -721,3 +729,177 @@ func (s *coreService) GetSpamStatistics(ctx context.Context, req *protoapi.GetSp
 
 	return resp, nil
 }
+
+func (s *coreService) GetLiquidityProviderSLAForecast(ctx context.Context, req *protoapi.GetLiquidityProviderSLAForecastRequest) (*protoapi.GetLiquidityProviderSLAForecastResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("GetLiquidityProviderSLAForecast")()
+
+	if req.MarketId == "" {
+		return nil, apiError(codes.InvalidArgument, ErrEmptyMissingMarketID)
+	}
+	if req.PartyId == "" {
+		return nil, apiError(codes.InvalidArgument, ErrEmptyMissingPartyID)
+	}
+
+	var (
+		forecast *types.LiquidityProviderSLAForecast
+		err      error
+	)
+	if qerr := s.queries.Do(ctx, func() {
+		forecast, err = s.execution.GetLiquidityProviderSLAForecast(req.MarketId, req.PartyId)
+	}); qerr != nil {
+		return nil, apiError(codes.Unavailable, qerr)
+	}
+	if err != nil {
+		return nil, apiError(codes.NotFound, err)
+	}
+
+	return &protoapi.GetLiquidityProviderSLAForecastResponse{
+		Forecast: &protoapi.LiquidityProviderSLAForecast{
+			PartyId:                            forecast.Party,
+			CurrentEpochFractionOfTimeOnBook:   forecast.CurrentEpochFractionOfTimeOnBook.String(),
+			ProjectedEpochFractionOfTimeOnBook: forecast.ProjectedEpochFractionOfTimeOnBook.String(),
+			MeetsCommitment:                    forecast.MeetsCommitment,
+			ProjectedFeePenalty:                forecast.ProjectedFeePenalty.String(),
+			ProjectedBondPenalty:               forecast.ProjectedBondPenalty.String(),
+			OrdersOutsidePriceRange:            forecast.OrdersOutsidePriceRange,
+		},
+	}, nil
+}
+
+func (s *coreService) GetWithdrawalBatchProof(ctx context.Context, req *protoapi.GetWithdrawalBatchProofRequest) (*protoapi.GetWithdrawalBatchProofResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("GetWithdrawalBatchProof")()
+
+	if req.WithdrawalId == "" {
+		return nil, apiError(codes.InvalidArgument, ErrEmptyMissingWithdrawalID)
+	}
+
+	var (
+		proof *banking.WithdrawalBatchProof
+		err   error
+	)
+	if qerr := s.queries.Do(ctx, func() {
+		proof, err = s.banking.GetWithdrawalBatchProof(ctx, req.WithdrawalId)
+	}); qerr != nil {
+		return nil, apiError(codes.Unavailable, qerr)
+	}
+	if err != nil {
+		return nil, apiError(codes.NotFound, err)
+	}
+
+	hashes := make([]string, 0, len(proof.Proof))
+	for _, h := range proof.Proof {
+		hashes = append(hashes, "0x"+hex.EncodeToString(h))
+	}
+	signatures := ""
+	if len(proof.Signatures) > 0 {
+		signatures = "0x"
+	}
+	for _, v := range proof.Signatures {
+		signatures += hex.EncodeToString(v.Sig)
+	}
+
+	return &protoapi.GetWithdrawalBatchProofResponse{
+		Proof: &protoapi.WithdrawalBatchProof{
+			BatchId:    proof.BatchID,
+			Nonce:      proof.Nonce.String(),
+			Root:       "0x" + hex.EncodeToString(proof.Root),
+			Leaf:       "0x" + hex.EncodeToString(proof.Leaf),
+			Proof:      hashes,
+			Signatures: signatures,
+		},
+	}, nil
+}
+
+func (s *coreService) ListDelayedWithdrawals(ctx context.Context, req *protoapi.ListDelayedWithdrawalsRequest) (*protoapi.ListDelayedWithdrawalsResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListDelayedWithdrawals")()
+
+	out := []*protoapi.DelayedWithdrawal{}
+	if qerr := s.queries.Do(ctx, func() {
+		for _, d := range s.banking.GetDelayedWithdrawals() {
+			if req.PartyId != nil && d.Withdrawal.PartyID != *req.PartyId {
+				continue
+			}
+			out = append(out, &protoapi.DelayedWithdrawal{
+				Withdrawal: d.Withdrawal.IntoProto(),
+				ReleaseAt:  d.ReleaseAt.UnixNano(),
+			})
+		}
+	}); qerr != nil {
+		return nil, apiError(codes.Unavailable, qerr)
+	}
+
+	return &protoapi.ListDelayedWithdrawalsResponse{
+		DelayedWithdrawals: out,
+	}, nil
+}
+
+func (s *coreService) SimulateProposal(ctx context.Context, req *protoapi.SimulateProposalRequest) (*protoapi.SimulateProposalResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("SimulateProposal")()
+
+	if req.Terms == nil {
+		return nil, apiError(codes.InvalidArgument, ErrEmptyMissingProposalTerms)
+	}
+	if req.PartyId == "" {
+		return nil, apiError(codes.InvalidArgument, ErrEmptyMissingPartyID)
+	}
+	terms, err := types.ProposalTermsFromProto(req.Terms)
+	if err != nil {
+		return nil, apiError(codes.InvalidArgument, err)
+	}
+	if terms.Change == nil {
+		return nil, apiError(codes.InvalidArgument, ErrUnsupportedProposalTerms)
+	}
+
+	var sim *governance.ProposalSimulation
+	if qerr := s.queries.Do(ctx, func() {
+		sim = s.governance.SimulateProposal(terms, req.PartyId)
+	}); qerr != nil {
+		return nil, apiError(codes.Unavailable, qerr)
+	}
+
+	resp := &protoapi.SimulateProposalResponse{
+		Error:                   sim.Error,
+		RequiredMajority:        sim.RequiredMajority.String(),
+		RequiredParticipation:   sim.RequiredParticipation.String(),
+		RequiredLpMajority:      sim.RequiredLPMajority.String(),
+		RequiredLpParticipation: sim.RequiredLPParticipation.String(),
+	}
+	if sim.Reason != nil {
+		resp.Reason = ptr.From(sim.Reason.Error())
+	}
+	if sim.Market != nil {
+		resp.Market = sim.Market.IntoProto()
+	}
+	if np := sim.NetworkParameter; np != nil {
+		resp.NetworkParameter = &protoapi.SimulatedNetworkParameter{
+			Key:          np.Key,
+			CurrentValue: np.CurrentValue,
+			Value:        np.Value,
+		}
+	}
+	if mi := sim.MarginImpact; mi != nil {
+		impact := &protoapi.SimulatedMarginImpact{
+			CurrentRiskFactor:  mi.CurrentRiskFactor.IntoProto(),
+			ProposedRiskFactor: mi.ProposedRiskFactor.IntoProto(),
+			LongRatio:          mi.LongRatio.String(),
+			ShortRatio:         mi.ShortRatio.String(),
+			Positions:          make([]*protoapi.SimulatedPositionMarginImpact, 0, len(mi.Positions)),
+		}
+		if mi.CurrentScalingFactors != nil {
+			impact.CurrentScalingFactors = mi.CurrentScalingFactors.IntoProto()
+		}
+		if mi.ProposedScalingFactors != nil {
+			impact.ProposedScalingFactors = mi.ProposedScalingFactors.IntoProto()
+		}
+		for _, p := range mi.Positions {
+			impact.Positions = append(impact.Positions, &protoapi.SimulatedPositionMarginImpact{
+				PartyId:                   p.Party,
+				OpenVolume:                p.OpenVolume,
+				CurrentMaintenanceMargin:  p.CurrentMaintenanceMargin.String(),
+				ProposedMaintenanceMargin: p.ProposedMaintenanceMargin.String(),
+			})
+		}
+		resp.MarginImpact = impact
+	}
+	return resp, nil
+}
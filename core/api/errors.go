@@ -42,6 +42,15 @@ var (
 	// ErrEmptyMissingPartyID signals to the caller that the request expected a
 	// party id but the field is missing or empty.
 	ErrEmptyMissingPartyID = errors.New("empty or missing party ID")
+	// ErrEmptyMissingWithdrawalID signals to the caller that the request expected a
+	// withdrawal id but the field is missing or empty.
+	ErrEmptyMissingWithdrawalID = errors.New("empty or missing withdrawal ID")
+	// ErrEmptyMissingProposalTerms signals to the caller that the request expected
+	// proposal terms but the field is missing.
+	ErrEmptyMissingProposalTerms = errors.New("empty or missing proposal terms")
+	// ErrUnsupportedProposalTerms signals to the caller that the proposal terms
+	// don't have a change the simulation supports.
+	ErrUnsupportedProposalTerms = errors.New("unsupported proposal terms")
 	// ErrEmptyMissingSinceTimestamp signals to the caller that the request expected a
 	// timestamp but the field is missing or empty.
 	ErrEmptyMissingSinceTimestamp = errors.New("empty or missing since-timestamp")
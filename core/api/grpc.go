@@ -21,8 +21,11 @@ import (
 	"strconv"
 	"time"
 
+	"code.vegaprotocol.io/vega/core/banking"
 	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/governance"
 	"code.vegaprotocol.io/vega/core/stats"
+	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/core/vegatime"
 	vgcontext "code.vegaprotocol.io/vega/libs/context"
 	"code.vegaprotocol.io/vega/libs/subscribers"
@@ -95,6 +98,19 @@ type PowEngine interface {
 	GetSpamStatistics(partyID string) *protoapi.PoWStatistic
 }
 
+type ExecutionEngine interface {
+	GetLiquidityProviderSLAForecast(market, party string) (*types.LiquidityProviderSLAForecast, error)
+}
+
+type BankingEngine interface {
+	GetWithdrawalBatchProof(ctx context.Context, withdrawalID string) (*banking.WithdrawalBatchProof, error)
+	GetDelayedWithdrawals() []banking.DelayedWithdrawal
+}
+
+type GovernanceEngine interface {
+	SimulateProposal(terms *types.ProposalTerms, party string) *governance.ProposalSimulation
+}
+
 // GRPCServer represent the grpc api provided by the vega node.
 type GRPC struct {
 	Config
@@ -109,6 +125,10 @@ type GRPC struct {
 	powParams  ProofOfWorkParams
 	spamEngine SpamEngine
 	powEngine  PowEngine
+	queries    *StateQueries
+	execution  ExecutionEngine
+	banking    BankingEngine
+	governance GovernanceEngine
 
 	// used in order to gracefully close streams
 	ctx   context.Context
@@ -131,6 +151,10 @@ func NewGRPC(
 	powParams ProofOfWorkParams,
 	spamEngine SpamEngine,
 	powEngine PowEngine,
+	queries *StateQueries,
+	execution ExecutionEngine,
+	banking BankingEngine,
+	governance GovernanceEngine,
 ) *GRPC {
 	// setup logger
 	log = log.Named(namedLogger)
@@ -150,6 +174,10 @@ func NewGRPC(
 		powParams:  powParams,
 		spamEngine: spamEngine,
 		powEngine:  powEngine,
+		queries:    queries,
+		execution:  execution,
+		banking:    banking,
+		governance: governance,
 	}
 }
 
@@ -265,6 +293,10 @@ func (g *GRPC) Start() {
 		powParams:    g.powParams,
 		spamEngine:   g.spamEngine,
 		powEngine:    g.powEngine,
+		queries:      g.queries,
+		execution:    g.execution,
+		banking:      g.banking,
+		governance:   g.governance,
 	}
 	g.core = coreSvc
 	protoapi.RegisterCoreServiceServer(g.srv, coreSvc)
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// StateQueries runs the read-only queries of the API against the protocol state.
+// The state is only consistent between two blocks, so the queries wait for the block
+// being processed to be committed. They run on the API goroutines, outside of the
+// processor, and give up once the query timeout is reached.
+type StateQueries struct {
+	timeout time.Duration
+
+	// state is locked by the processor while a block is processed, and read-locked
+	// by the queries while they run.
+	state sync.RWMutex
+	// mu protects processing.
+	mu         sync.Mutex
+	processing bool
+}
+
+func NewStateQueries(timeout time.Duration) *StateQueries {
+	q := &StateQueries{
+		timeout: timeout,
+	}
+	// the state can't be queried until the first block is committed
+	q.BlockStarted()
+	return q
+}
+
+// Do runs the given query once no block is being processed. It returns an error
+// without running the query if the context is done, or the timeout reached, first.
+func (q *StateQueries) Do(ctx context.Context, query func()) error {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+
+	locked := make(chan struct{})
+	go func() {
+		q.state.RLock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+	case <-ctx.Done():
+		// the lock is released as soon as it is acquired, the query isn't run
+		go func() {
+			<-locked
+			q.state.RUnlock()
+		}()
+		return ctx.Err()
+	}
+
+	defer q.state.RUnlock()
+	query()
+	return nil
+}
+
+// BlockStarted is called by the processor when it starts processing a block. It
+// waits for the queries running to be done, and holds the new ones.
+func (q *StateQueries) BlockStarted() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.processing {
+		q.state.Lock()
+		q.processing = true
+	}
+}
+
+// BlockCommitted is called by the processor once the block is committed, the
+// queries held can run.
+func (q *StateQueries) BlockCommitted() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.processing {
+		q.state.Unlock()
+		q.processing = false
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package api_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/api"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateQueries(t *testing.T) {
+	t.Run("queries wait for the block to be committed", testQueriesWaitForCommit)
+	t.Run("queries time out while a block is processed", testQueriesTimeout)
+	t.Run("cancelled queries are not waited for", testCancelledQueries)
+}
+
+func testQueriesWaitForCommit(t *testing.T) {
+	queries := api.NewStateQueries(time.Minute)
+
+	result := 0
+	done := make(chan error)
+	go func() {
+		done <- queries.Do(context.Background(), func() { result = 42 })
+	}()
+
+	// nothing runs until the first block is committed
+	select {
+	case <-done:
+		t.Fatal("the query should wait for the block to be committed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	queries.BlockCommitted()
+	require.NoError(t, <-done)
+	assert.Equal(t, 42, result)
+
+	// once committed, the queries run straight away
+	require.NoError(t, queries.Do(context.Background(), func() { result = 43 }))
+	assert.Equal(t, 43, result)
+}
+
+func testQueriesTimeout(t *testing.T) {
+	queries := api.NewStateQueries(50 * time.Millisecond)
+	queries.BlockCommitted()
+	queries.BlockStarted()
+
+	ran := false
+	require.ErrorIs(t, queries.Do(context.Background(), func() { ran = true }), context.DeadlineExceeded)
+	assert.False(t, ran)
+
+	// the query giving up doesn't hold the next block
+	queries.BlockCommitted()
+	queries.BlockStarted()
+	queries.BlockCommitted()
+}
+
+func testCancelledQueries(t *testing.T) {
+	queries := api.NewStateQueries(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	require.ErrorIs(t, queries.Do(ctx, func() {}), context.Canceled)
+}
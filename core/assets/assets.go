@@ -75,9 +75,8 @@ type Service struct {
 	primaryEthClient  erc20.ETHClient
 	primaryBridgeView ERC20BridgeView
 
-	secondaryEthChainID string
-	secondaryEthClient  erc20.ETHClient
-	secondaryBridgeView ERC20BridgeView
+	// chain-id -> bridge, for all the EVM chains other than the primary one.
+	evmBridges map[string]*evmBridge
 
 	notary Notary
 	ass    *assetsSnapshotState
@@ -86,16 +85,21 @@ type Service struct {
 	isValidator bool
 }
 
+// evmBridge holds what a validator needs to interact with the collateral bridge
+// of an EVM chain, non-validators only know the chain exists.
+type evmBridge struct {
+	client     erc20.ETHClient
+	bridgeView ERC20BridgeView
+}
+
 func New(
 	ctx context.Context,
 	log *logging.Logger,
 	cfg Config,
 	nw nweth.EthereumWallet,
 	primaryEthClient erc20.ETHClient,
-	secondaryEthClient erc20.ETHClient,
 	broker broker.Interface,
 	primaryBridgeView ERC20BridgeView,
-	secondaryBridgeView ERC20BridgeView,
 	notary Notary,
 	isValidator bool,
 ) (*Service, error) {
@@ -111,13 +115,12 @@ func New(
 		pendingAssetUpdates: map[string]*Asset{},
 		ethWallet:           nw,
 		primaryEthClient:    primaryEthClient,
-		secondaryEthClient:  secondaryEthClient,
+		evmBridges:          map[string]*evmBridge{},
 		notary:              notary,
 		ass:                 &assetsSnapshotState{},
 		isValidator:         isValidator,
 		ethToVega:           map[string]string{},
 		primaryBridgeView:   primaryBridgeView,
-		secondaryBridgeView: secondaryBridgeView,
 	}
 
 	if isValidator {
@@ -126,12 +129,6 @@ func New(
 			return nil, fmt.Errorf("could not fetch chain ID from the primary ethereum client: %w", err)
 		}
 		s.primaryEthChainID = primaryChainID.String()
-
-		secondaryChainID, err := s.secondaryEthClient.ChainID(ctx)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch chain ID from the secondary ethereum client: %w", err)
-		}
-		s.secondaryEthChainID = secondaryChainID.String()
 	}
 
 	return s, nil
@@ -167,6 +164,9 @@ func (s *Service) Enable(ctx context.Context, assetID string) error {
 	if asset.IsERC20() {
 		eth, _ := asset.ERC20()
 		s.ethToVega[eth.ProtoAsset().GetDetails().GetErc20().GetContractAddress()] = assetID
+		for _, c := range eth.ProtoAsset().GetDetails().GetErc20().GetAdditionalChains() {
+			s.ethToVega[c.ContractAddress] = assetID
+		}
 	}
 	delete(s.pendingAssets, assetID)
 	s.broker.Send(events.NewAssetEvent(ctx, *asset.Type()))
@@ -199,30 +199,32 @@ func (s *Service) EnactPendingAsset(id string) {
 // ValidateEthereumAddress checks that the given ERC20 address and chainID corresponds to one of Vega's bridges
 // and isn't the address of an asset that already exists.
 func (s *Service) ValidateEthereumAddress(address, chainID string) error {
-	if chainID != s.primaryEthChainID && chainID != s.secondaryEthChainID {
+	if _, ok := s.evmBridges[chainID]; !ok && chainID != s.primaryEthChainID {
 		return ErrUnknownChainID
 	}
 
 	for _, a := range s.assets {
 		if source, ok := a.ERC20(); ok {
-			if source.ChainID() != chainID {
+			existing, ok := source.ContractAddressOnChain(chainID)
+			if !ok {
 				// asset is on a different chain, definitely is not a dupe of it
 				continue
 			}
 
-			if strings.EqualFold(source.Address(), address) {
+			if strings.EqualFold(existing, address) {
 				return ErrErc20AddressAlreadyInUse
 			}
 		}
 	}
 	for _, a := range s.pendingAssets {
 		if source, ok := a.ERC20(); ok {
-			if source.ChainID() != chainID {
+			existing, ok := source.ContractAddressOnChain(chainID)
+			if !ok {
 				// asset is on a different chain, definitely is not a dupe of it
 				continue
 			}
 
-			if strings.EqualFold(source.Address(), address) {
+			if strings.EqualFold(existing, address) {
 				return ErrErc20AddressAlreadyInUse
 			}
 		}
@@ -283,7 +285,18 @@ func (s *Service) SetBridgeChainID(chainID string, primary bool) {
 		s.primaryEthChainID = chainID
 		return
 	}
-	s.secondaryEthChainID = chainID
+	if _, ok := s.evmBridges[chainID]; !ok {
+		s.evmBridges[chainID] = &evmBridge{}
+	}
+}
+
+// RegisterEVMBridge sets the client and the view used by a validator to interact
+// with the collateral bridge of an EVM chain.
+func (s *Service) RegisterEVMBridge(chainID string, client erc20.ETHClient, bridgeView ERC20BridgeView) {
+	s.evmBridges[chainID] = &evmBridge{
+		client:     client,
+		bridgeView: bridgeView,
+	}
 }
 
 func (s *Service) OnTick(_ context.Context, _ time.Time) {
@@ -530,6 +543,19 @@ func (s *Service) validateAsset(a *Asset) error {
 		if err := bridgeView.FindAsset(details); err != nil {
 			return err
 		}
+		// the token must also exist on all the other chains the asset lives on.
+		for _, c := range details.GetERC20().AdditionalChains {
+			bridgeView, err := s.bridgeViewByChainID(c.ChainID)
+			if err != nil {
+				return err
+			}
+			onChain := details.DeepClone()
+			onChain.GetERC20().ChainID = c.ChainID
+			onChain.GetERC20().ContractAddress = c.ContractAddress
+			if err := bridgeView.FindAsset(onChain); err != nil {
+				return err
+			}
+		}
 		// no error, our asset exists on chain
 		erc20Asset.SetValid()
 	}
@@ -537,23 +563,21 @@ func (s *Service) validateAsset(a *Asset) error {
 }
 
 func (s *Service) ethClientByChainID(chainID string) (erc20.ETHClient, error) {
-	switch chainID {
-	case s.primaryEthChainID:
+	if chainID == s.primaryEthChainID {
 		return s.primaryEthClient, nil
-	case s.secondaryEthChainID:
-		return s.secondaryEthClient, nil
-	default:
-		return nil, fmt.Errorf("chain id %q is not supported", chainID)
 	}
+	if bridge, ok := s.evmBridges[chainID]; ok && bridge.client != nil {
+		return bridge.client, nil
+	}
+	return nil, fmt.Errorf("chain id %q is not supported", chainID)
 }
 
 func (s *Service) bridgeViewByChainID(chainID string) (ERC20BridgeView, error) {
-	switch chainID {
-	case s.primaryEthChainID:
+	if chainID == s.primaryEthChainID {
 		return s.primaryBridgeView, nil
-	case s.secondaryEthChainID:
-		return s.secondaryBridgeView, nil
-	default:
-		return nil, fmt.Errorf("chain id %q is not supported", chainID)
 	}
+	if bridge, ok := s.evmBridges[chainID]; ok && bridge.bridgeView != nil {
+		return bridge.bridgeView, nil
+	}
+	return nil, fmt.Errorf("chain id %q is not supported", chainID)
 }
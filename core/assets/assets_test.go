@@ -52,6 +52,7 @@ func TestAssets(t *testing.T) {
 	t.Run("Staging asset update for unknown asset fails", testStagingAssetUpdateForUnknownAssetFails)
 	t.Run("Offers signature on tick success", testOffersSignaturesOnTickSuccess)
 	t.Run("Checking an assets address when chain id is unknown", testValidateUnknownChainID)
+	t.Run("Checking an assets address on an additional EVM chain", testValidateAdditionalEVMChainID)
 }
 
 func testOffersSignaturesOnTickSuccess(t *testing.T) {
@@ -134,6 +135,22 @@ func testValidateUnknownChainID(t *testing.T) {
 	require.ErrorIs(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "666"), assets.ErrUnknownChainID)
 }
 
+func testValidateAdditionalEVMChainID(t *testing.T) {
+	service := getTestService(t)
+
+	require.ErrorIs(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "42161"), assets.ErrUnknownChainID)
+
+	// known from the network parameters.
+	service.SetBridgeChainID("42161", false)
+	require.NoError(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "42161"))
+
+	// the bridge of the other chains are still known.
+	service.RegisterEVMBridge("10", erc20mocks.NewMockETHClient(service.ctrl), mocks.NewMockERC20BridgeView(service.ctrl))
+	require.NoError(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "10"))
+	require.NoError(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "2"))
+	require.NoError(t, service.ValidateEthereumAddress(vgrand.RandomStr(5), "1"))
+}
+
 func getTestService(t *testing.T) *testService {
 	t.Helper()
 	conf := assets.NewDefaultConfig()
@@ -151,7 +168,8 @@ func getTestService(t *testing.T) *testService {
 	notary := mocks.NewMockNotary(ctrl)
 	ethWallet := nwethmocks.NewMockEthereumWallet(ctrl)
 
-	service, _ := assets.New(context.Background(), logger, conf, ethWallet, primaryEthClient, secondaryEthClient, broker, primaryBridgeView, secondaryBridgeView, notary, true)
+	service, _ := assets.New(context.Background(), logger, conf, ethWallet, primaryEthClient, broker, primaryBridgeView, notary, true)
+	service.RegisterEVMBridge("2", secondaryEthClient, secondaryBridgeView)
 	return &testService{
 		Service:             service,
 		broker:              broker,
@@ -120,6 +120,20 @@ func (e ERC20) ChainID() string {
 	return e.chainID
 }
 
+// IsOnChain returns whether the token lives on the given chain.
+func (e ERC20) IsOnChain(chainID string) bool {
+	_, ok := e.ContractAddressOnChain(chainID)
+	return ok
+}
+
+// ContractAddressOnChain returns the address of the token on the given chain.
+func (e ERC20) ContractAddressOnChain(chainID string) (string, bool) {
+	if chainID == e.chainID {
+		return e.address, true
+	}
+	return e.asset.Details.GetERC20().ContractAddressOnChain(chainID)
+}
+
 func (e *ERC20) GetAssetClass() common.AssetClass {
 	return common.ERC20
 }
@@ -182,6 +196,32 @@ func (e *ERC20) SignWithdrawal(
 	return bundle.Message, bundle.Signature, nil
 }
 
+// WithdrawalLeaf returns the leaf of the withdrawal in the Merkle tree of a
+// withdrawal batch.
+func (e *ERC20) WithdrawalLeaf(
+	amount *num.Uint,
+	ethPartyAddress string,
+	withdrawRef *big.Int,
+	now time.Time,
+) ([]byte, error) {
+	nonce, _ := num.UintFromBig(withdrawRef)
+	return bridges.WithdrawalLeaf(e.address, amount, ethPartyAddress, now, nonce)
+}
+
+// SignWithdrawalBatch signs the Merkle root of a batch of withdrawals made on the
+// bridge of the asset.
+func (e *ERC20) SignWithdrawalBatch(root []byte, batchRef *big.Int) (msg []byte, sig []byte, err error) {
+	nonce, _ := num.UintFromBig(batchRef)
+	bridgeAddress := e.ethClient.CollateralBridgeAddress().Hex()
+	bundle, err := bridges.NewERC20Logic(e.wallet, bridgeAddress, e.chainID, false).
+		WithdrawAssetBatch(root, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bundle.Message, bundle.Signature, nil
+}
+
 func (e *ERC20) String() string {
 	return fmt.Sprintf("id(%v) name(%v) symbol(%v) decimals(%v)",
 		e.asset.ID, e.asset.Details.Name, e.asset.Details.Symbol, e.asset.Details.Decimals)
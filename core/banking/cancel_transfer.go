@@ -33,6 +33,11 @@ func (e *Engine) CancelTransferFunds(
 	ctx context.Context,
 	cancel *types.CancelTransferFunds,
 ) error {
+	// a one-off transfer can be cancelled while it waits for its condition.
+	if _, ok := e.conditionalTransfersMap[cancel.TransferID]; ok {
+		return e.cancelConditionalTransfer(ctx, cancel.Party, cancel.TransferID)
+	}
+
 	// validation is simple, does the transfer
 	// exists
 	transfer, ok := e.recurringTransfersMap[cancel.TransferID]
@@ -47,8 +47,11 @@ func (e *Engine) Checkpoint() ([]byte, error) {
 		LastSeenPrimaryEthBlock:      e.lastSeenPrimaryEthBlock,
 		GovernanceTransfersAtTime:    e.getScheduledGovernanceTransfers(),
 		RecurringGovernanceTransfers: e.getRecurringGovernanceTransfers(),
-		SecondaryBridgeState:         e.getSecondaryBridgeState(),
-		LastSeenSecondaryEthBlock:    e.lastSeenSecondaryEthBlock,
+		TeamCompetitions:             e.getTeamCompetitions(),
+		EvmBridgeStates:              e.getEVMBridgeStates(),
+		ConditionalTransfers:         e.getConditionalTransfers(),
+		PendingWithdrawalBatches:     e.getCheckpointWithdrawalBatches(),
+		DelayedWithdrawals:           e.getCheckpointDelayedWithdrawals(),
 	}
 
 	msg.SeenRefs = make([]string, 0, e.seenAssetActions.Size())
@@ -84,9 +87,35 @@ func (e *Engine) Load(ctx context.Context, data []byte) error {
 
 	evts = append(evts, e.loadScheduledGovernanceTransfers(ctx, b.GovernanceTransfersAtTime)...)
 	evts = append(evts, e.loadRecurringGovernanceTransfers(ctx, b.RecurringGovernanceTransfers)...)
+	evts = append(evts, e.loadTeamCompetitions(ctx, b.TeamCompetitions)...)
+	if err := e.loadConditionalTransfers(ctx, b.ConditionalTransfers); err != nil {
+		return err
+	}
+	for _, ct := range e.conditionalTransfers {
+		evts = append(evts, events.NewOneOffTransferFundsEvent(ctx, ct.oneoff))
+	}
+	batchEvts, err := e.loadCheckpointWithdrawalBatches(ctx, b.PendingWithdrawalBatches)
+	if err != nil {
+		return err
+	}
+	evts = append(evts, batchEvts...)
+	delayedEvts, err := e.loadCheckpointDelayedWithdrawals(ctx, b.DelayedWithdrawals)
+	if err != nil {
+		return err
+	}
+	evts = append(evts, delayedEvts...)
 
 	e.loadPrimaryBridgeState(b.PrimaryBridgeState)
-	e.loadSecondaryBridgeState(b.SecondaryBridgeState)
+	if len(b.EvmBridgeStates) > 0 {
+		for _, state := range b.EvmBridgeStates {
+			e.loadEVMBridgeState(state)
+		}
+	} else {
+		e.loadSecondaryBridgeState(b.SecondaryBridgeState)
+		if b.LastSeenSecondaryEthBlock != 0 {
+			e.secondaryEVMBridge().lastSeenBlock = b.LastSeenSecondaryEthBlock
+		}
+	}
 
 	e.seenAssetActions = treeset.NewWithStringComparator()
 	for _, v := range b.SeenRefs {
@@ -99,11 +128,7 @@ func (e *Engine) Load(ctx context.Context, data []byte) error {
 		e.ethEventSource.UpdateContractBlock(e.bridgeAddresses[e.primaryEthChainID], e.primaryEthChainID, e.lastSeenPrimaryEthBlock)
 	}
 
-	e.lastSeenSecondaryEthBlock = b.LastSeenSecondaryEthBlock
-	if e.lastSeenSecondaryEthBlock != 0 {
-		e.log.Info("restoring secondary collateral bridge starting block", logging.Uint64("block", e.lastSeenSecondaryEthBlock))
-		e.ethEventSource.UpdateContractBlock(e.bridgeAddresses[e.secondaryEthChainID], e.secondaryEthChainID, e.lastSeenSecondaryEthBlock)
-	}
+	e.updateEVMBridgesContractBlock()
 
 	aa := make([]*types.AssetAction, 0, len(b.AssetActions))
 	for _, a := range b.AssetActions {
@@ -219,19 +244,11 @@ func (e *Engine) loadPrimaryBridgeState(state *checkpoint.BridgeState) {
 func (e *Engine) loadSecondaryBridgeState(state *checkpoint.BridgeState) {
 	// this would eventually be nil if we restore from a checkpoint
 	// which have been produce from an old version of the core.
-	// we set it to active by default in the case
+	// the bridges are active by default in the case
 	if state == nil {
-		e.secondaryBridgeState = &bridgeState{
-			active: true,
-		}
 		return
 	}
-
-	e.secondaryBridgeState = &bridgeState{
-		active:   state.Active,
-		block:    state.BlockHeight,
-		logIndex: state.LogIndex,
-	}
+	e.loadEVMBridgeState(state)
 }
 
 func (e *Engine) loadScheduledGovernanceTransfers(ctx context.Context, r []*checkpoint.ScheduledGovernanceTransferAtTime) []events.Event {
@@ -284,6 +301,7 @@ func (e *Engine) loadRecurringTransfers(
 				e.applyMigrationDefaults(transfer.DispatchStrategy)
 			}
 			e.registerDispatchStrategy(transfer.DispatchStrategy)
+			e.setVestingSchedule(transfer.DispatchStrategy, transfer.VestingSchedule)
 		}
 		evts = append(evts, events.NewRecurringTransferFundsEvent(ctx, transfer, e.getGameID(transfer)))
 	}
@@ -305,6 +323,7 @@ func (e *Engine) loadRecurringGovernanceTransfers(ctx context.Context, transfers
 		e.recurringGovernanceTransfersMap[transfer.ID] = transfer
 		if transfer.Config.RecurringTransferConfig.DispatchStrategy != nil {
 			e.registerDispatchStrategy(transfer.Config.RecurringTransferConfig.DispatchStrategy)
+			e.setVestingSchedule(transfer.Config.RecurringTransferConfig.DispatchStrategy, transfer.Config.VestingSchedule)
 		}
 		evts = append(evts, events.NewGovTransferFundsEvent(ctx, transfer, transfer.Config.MaxAmount, e.getGovGameID(transfer)))
 	}
@@ -319,14 +338,6 @@ func (e *Engine) getPrimaryBridgeState() *checkpoint.BridgeState {
 	}
 }
 
-func (e *Engine) getSecondaryBridgeState() *checkpoint.BridgeState {
-	return &checkpoint.BridgeState{
-		Active:      e.secondaryBridgeState.active,
-		BlockHeight: e.secondaryBridgeState.block,
-		LogIndex:    e.secondaryBridgeState.logIndex,
-	}
-}
-
 func (e *Engine) getRecurringTransfers() *checkpoint.RecurringTransfers {
 	out := &checkpoint.RecurringTransfers{
 		RecurringTransfers: make([]*eventspb.Transfer, 0, len(e.recurringTransfers)),
@@ -0,0 +1,229 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/datasource"
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+)
+
+var ErrCannotCancelOtherPartiesConditionalTransfers = errors.New("cannot cancel other parties conditional transfers")
+
+// conditionalTransfer is a one-off transfer whose funds are held in the
+// pending transfers account until its condition is met.
+type conditionalTransfer struct {
+	scheduledTransfer
+	// triggered is set when data matching the data source of the condition is received.
+	triggered      bool
+	subscriptionID spec.SubscriptionID
+	unsubscribe    spec.Unsubscriber
+}
+
+// SetMarkets sets the source of the market states the conditional transfers can wait for.
+func (e *Engine) SetMarkets(markets Markets) {
+	e.markets = markets
+}
+
+func (e *Engine) addConditionalTransfer(
+	ctx context.Context,
+	oneoff *types.OneOffTransfer,
+	t *types.Transfer,
+	ty types.AccountType,
+	reference string,
+) error {
+	ct := &conditionalTransfer{
+		scheduledTransfer: scheduledTransfer{
+			oneoff:      oneoff,
+			transfer:    t,
+			accountType: ty,
+			reference:   reference,
+		},
+	}
+
+	if err := e.subscribeConditionalTransfer(ctx, ct); err != nil {
+		return err
+	}
+
+	e.conditionalTransfers = append(e.conditionalTransfers, ct)
+	e.conditionalTransfersMap[oneoff.ID] = ct
+	return nil
+}
+
+// subscribeConditionalTransfer subscribes to the data source of the condition, if any.
+func (e *Engine) subscribeConditionalTransfer(ctx context.Context, ct *conditionalTransfer) error {
+	if ct.oneoff.Condition.Type != types.TransferConditionTypeDataSource {
+		return nil
+	}
+
+	os, err := spec.New(*datasource.SpecFromDefinition(*ct.oneoff.Condition.DataSource.Data))
+	if err != nil {
+		return fmt.Errorf("invalid transfer condition data source: %w", err)
+	}
+	id := ct.oneoff.ID
+	ct.subscriptionID, ct.unsubscribe, err = e.oracleEngine.Subscribe(ctx, *os, func(_ context.Context, _ dscommon.Data) error {
+		// the transfer is delivered on the next tick, so we don't
+		// unsubscribe while the data is being dispatched.
+		if ct, ok := e.conditionalTransfersMap[id]; ok {
+			ct.triggered = true
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not subscribe to transfer condition data source: %w", err)
+	}
+	return nil
+}
+
+func (e *Engine) removeConditionalTransfer(ctx context.Context, id string) {
+	ct, ok := e.conditionalTransfersMap[id]
+	if !ok {
+		return
+	}
+	if ct.unsubscribe != nil {
+		ct.unsubscribe(ctx, ct.subscriptionID)
+	}
+	delete(e.conditionalTransfersMap, id)
+	for i, v := range e.conditionalTransfers {
+		if v.oneoff.ID == id {
+			e.conditionalTransfers = append(e.conditionalTransfers[:i], e.conditionalTransfers[i+1:]...)
+			break
+		}
+	}
+}
+
+// conditionMet returns true if the condition of the transfer holds.
+func (e *Engine) conditionMet(ct *conditionalTransfer) bool {
+	condition := ct.oneoff.Condition
+	switch condition.Type {
+	case types.TransferConditionTypeDataSource:
+		return ct.triggered
+	case types.TransferConditionTypeMarketState:
+		if e.markets == nil {
+			return false
+		}
+		// a settled market may not be running anymore.
+		mkt, ok := e.markets.GetMarket(condition.Market, true)
+		return ok && mkt.State == condition.MarketState
+	case types.TransferConditionTypeBalance:
+		balance := num.UintZero()
+		if acc, err := e.col.GetPartyGeneralAccount(condition.Party, condition.Asset); err == nil {
+			balance = acc.Balance
+		}
+		return condition.BalanceReached(balance)
+	default:
+		return false
+	}
+}
+
+// distributeConditionalTransfers delivers the transfers whose condition is met,
+// in the order they were made. Each transfer is delivered on its own, so one
+// failing doesn't hold the others.
+func (e *Engine) distributeConditionalTransfers(ctx context.Context) {
+	done := []*conditionalTransfer{}
+	for _, ct := range e.conditionalTransfers {
+		if e.conditionMet(ct) {
+			done = append(done, ct)
+		}
+	}
+
+	for _, ct := range done {
+		tresps, err := e.col.TransferFunds(
+			ctx, []*types.Transfer{ct.transfer}, []types.AccountType{ct.accountType}, []string{ct.reference}, nil, nil, // the fees have been paid when the funds were escrowed
+		)
+		if err != nil {
+			// the transfer stays pending, and is tried again on the next tick.
+			e.log.Error("could not deliver conditional transfer",
+				logging.String("transfer-id", ct.oneoff.ID),
+				logging.Error(err),
+			)
+			continue
+		}
+
+		e.removeConditionalTransfer(ctx, ct.oneoff.ID)
+		ct.oneoff.Status = types.TransferStatusDone
+		e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+		e.broker.Send(events.NewOneOffTransferFundsEvent(ctx, ct.oneoff))
+	}
+}
+
+func (e *Engine) getConditionalTransfers() []*checkpoint.ConditionalTransfer {
+	out := make([]*checkpoint.ConditionalTransfer, 0, len(e.conditionalTransfers))
+	for _, ct := range e.conditionalTransfers {
+		out = append(out, &checkpoint.ConditionalTransfer{
+			Transfer:  ct.ToProto(),
+			Triggered: ct.triggered,
+		})
+	}
+	return out
+}
+
+func (e *Engine) loadConditionalTransfers(ctx context.Context, transfers []*checkpoint.ConditionalTransfer) error {
+	e.conditionalTransfers = make([]*conditionalTransfer, 0, len(transfers))
+	e.conditionalTransfersMap = make(map[string]*conditionalTransfer, len(transfers))
+	for _, v := range transfers {
+		transfer, err := scheduledTransferFromProto(v.Transfer)
+		if err != nil {
+			return err
+		}
+		ct := &conditionalTransfer{
+			scheduledTransfer: transfer,
+			triggered:         v.Triggered,
+		}
+		if err := e.subscribeConditionalTransfer(ctx, ct); err != nil {
+			return err
+		}
+		e.conditionalTransfers = append(e.conditionalTransfers, ct)
+		e.conditionalTransfersMap[ct.oneoff.ID] = ct
+	}
+	return nil
+}
+
+// cancelConditionalTransfer refunds the escrowed funds of a conditional transfer
+// to the general account of its sender.
+func (e *Engine) cancelConditionalTransfer(ctx context.Context, party, id string) error {
+	ct := e.conditionalTransfersMap[id]
+	if ct.oneoff.From != party {
+		return ErrCannotCancelOtherPartiesConditionalTransfers
+	}
+
+	_, refund := e.makeTransfers("", ct.oneoff.From, ct.oneoff.Asset, "", "", ct.oneoff.Amount, &ct.oneoff.ID)
+	tresps, err := e.col.TransferFunds(
+		ctx, []*types.Transfer{refund}, []types.AccountType{types.AccountTypeGeneral}, []string{ct.reference}, nil, nil,
+	)
+	if err != nil {
+		e.log.Error("could not refund conditional transfer",
+			logging.String("transfer-id", id),
+			logging.Error(err),
+		)
+		return err
+	}
+	e.removeConditionalTransfer(ctx, id)
+
+	ct.oneoff.Status = types.TransferStatusCancelled
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+	e.broker.Send(events.NewOneOffTransferFundsEventWithReason(ctx, ct.oneoff, "transfer cancelled"))
+	return nil
+}
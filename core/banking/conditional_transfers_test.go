@@ -0,0 +1,391 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/datasource"
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	datapb "code.vegaprotocol.io/vega/protos/vega/data/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	conditionalSender   = "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301"
+	conditionalReceiver = "2e05fd230f3c9f4eaf0bdc5bfb7ca0c9d00278afc44637aab60da76653d7ccf0"
+)
+
+func TestConditionalTransfers(t *testing.T) {
+	t.Run("transfer is delivered once a balance threshold is reached", testConditionalTransferOnBalance)
+	t.Run("transfer is delivered once data source data is received", testConditionalTransferOnDataSource)
+	t.Run("transfer can be cancelled by its sender", testCancelConditionalTransfer)
+	t.Run("transfer cannot be both conditional and scheduled", testConditionalTransferWithDeliverOn)
+	t.Run("failed delivery is retried", testConditionalTransferFailedDeliveryIsRetried)
+	t.Run("failed delivery doesn't hold the other transfers", testConditionalTransferFailedDeliveryDoesNotHoldOthers)
+	t.Run("pending transfers are restored from a snapshot", testConditionalTransfersSnapshotRoundTrip)
+	t.Run("pending transfers are restored from a checkpoint", testConditionalTransfersCheckpointRoundTrip)
+}
+
+func newConditionalTransfer(condition *types.TransferCondition) *types.TransferFunds {
+	return &types.TransferFunds{
+		Kind: types.TransferCommandKindOneOff,
+		OneOff: &types.OneOffTransfer{
+			TransferBase: &types.TransferBase{
+				ID:              "conditional-transfer",
+				From:            conditionalSender,
+				FromAccountType: types.AccountTypeGeneral,
+				To:              conditionalReceiver,
+				ToAccountType:   types.AccountTypeGeneral,
+				Asset:           assetNameETH,
+				Amount:          num.NewUint(10),
+				Reference:       "escrow",
+			},
+			Condition: condition,
+		},
+	}
+}
+
+// expectEscrow expects the funds of the transfer to only be sent to the pending
+// transfers account.
+func expectEscrow(t *testing.T, e *testEngine) {
+	t.Helper()
+	e.assets.EXPECT().Get(gomock.Any()).Times(1).Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(1)}), nil)
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalSender, assetNameETH).Times(1).Return(&types.Account{Balance: num.NewUint(100)}, nil)
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, _ []types.AccountType, _ []string, _ []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, conditionalSender, transfers[0].Owner)
+			assert.Equal(t, types.TransferTypeTransferFundsSend, transfers[0].Type)
+			return nil, nil
+		})
+}
+
+// expectDelivery expects the escrowed funds to be sent to the given party.
+func expectDelivery(t *testing.T, e *testEngine, to string) {
+	t.Helper()
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, _ []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, to, transfers[0].Owner)
+			assert.Equal(t, types.TransferTypeTransferFundsDistribute, transfers[0].Type)
+			assert.Equal(t, "10", transfers[0].Amount.Amount.String())
+			assert.Equal(t, types.AccountTypeGeneral, accountTypes[0])
+			return nil, nil
+		})
+}
+
+func testConditionalTransferOnBalance(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	expectEscrow(t, e)
+	transfer := newConditionalTransfer(&types.TransferCondition{
+		Type:      types.TransferConditionTypeBalance,
+		Party:     conditionalReceiver,
+		Asset:     assetNameETH,
+		Threshold: num.NewUint(5),
+		Below:     true,
+	})
+	require.NoError(t, e.TransferFunds(ctx, transfer))
+	require.Equal(t, types.TransferStatusPending, transfer.OneOff.Status)
+
+	// the balance of the receiver is still above the threshold.
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalReceiver, assetNameETH).Times(1).Return(&types.Account{Balance: num.NewUint(6)}, nil)
+	e.OnTick(ctx, time.Unix(10, 0))
+
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalReceiver, assetNameETH).Times(1).Return(&types.Account{Balance: num.NewUint(5)}, nil)
+	expectDelivery(t, e, conditionalReceiver)
+	e.OnTick(ctx, time.Unix(11, 0))
+	require.Equal(t, types.TransferStatusDone, transfer.OneOff.Status)
+
+	// delivered only once.
+	e.OnTick(ctx, time.Unix(12, 0))
+}
+
+func newTestDataSource() *datasource.Spec {
+	return datasource.SpecFromProto(vegapb.NewDataSourceSpec(
+		vegapb.NewDataSourceDefinition(
+			vegapb.DataSourceContentTypeOracle,
+		).SetOracleConfig(
+			&vegapb.DataSourceDefinitionExternal_Oracle{
+				Oracle: &vegapb.DataSourceSpecConfiguration{
+					Signers: []*datapb.Signer{
+						{Signer: &datapb.Signer_PubKey{PubKey: &datapb.PubKey{Key: "0xCAFED00D"}}},
+					},
+					Filters: []*datapb.Filter{
+						{
+							Key: &datapb.PropertyKey{Name: "match.result", Type: datapb.PropertyKey_TYPE_INTEGER},
+							Conditions: []*datapb.Condition{
+								{Operator: datapb.Condition_OPERATOR_EQUALS, Value: "1"},
+							},
+						},
+					},
+				},
+			},
+		),
+	))
+}
+
+func testConditionalTransferOnDataSource(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	var onData spec.OnMatchedData
+	unsubscribed := false
+	e.oracleEngine.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			onData = cb
+			return spec.SubscriptionID(1), func(_ context.Context, id spec.SubscriptionID) {
+				assert.Equal(t, spec.SubscriptionID(1), id)
+				unsubscribed = true
+			}, nil
+		})
+
+	expectEscrow(t, e)
+	transfer := newConditionalTransfer(&types.TransferCondition{
+		Type:       types.TransferConditionTypeDataSource,
+		DataSource: newTestDataSource(),
+	})
+	require.NoError(t, e.TransferFunds(ctx, transfer))
+
+	// nothing happens until the data is received.
+	e.OnTick(ctx, time.Unix(10, 0))
+	require.Equal(t, types.TransferStatusPending, transfer.OneOff.Status)
+
+	require.NotNil(t, onData)
+	require.NoError(t, onData(ctx, dscommon.Data{Data: map[string]string{"match.result": "1"}}))
+	require.False(t, unsubscribed)
+
+	expectDelivery(t, e, conditionalReceiver)
+	e.OnTick(ctx, time.Unix(11, 0))
+	require.Equal(t, types.TransferStatusDone, transfer.OneOff.Status)
+	require.True(t, unsubscribed)
+}
+
+func testCancelConditionalTransfer(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	expectEscrow(t, e)
+	transfer := newConditionalTransfer(&types.TransferCondition{
+		Type:        types.TransferConditionTypeMarketState,
+		Market:      "market",
+		MarketState: types.MarketStateSettled,
+	})
+	require.NoError(t, e.TransferFunds(ctx, transfer))
+
+	require.ErrorIs(t, e.CancelTransferFunds(ctx, &types.CancelTransferFunds{
+		Party:      conditionalReceiver,
+		TransferID: "conditional-transfer",
+	}), banking.ErrCannotCancelOtherPartiesConditionalTransfers)
+
+	expectDelivery(t, e, conditionalSender)
+	require.NoError(t, e.CancelTransferFunds(ctx, &types.CancelTransferFunds{
+		Party:      conditionalSender,
+		TransferID: "conditional-transfer",
+	}))
+	require.Equal(t, types.TransferStatusCancelled, transfer.OneOff.Status)
+
+	// it's gone.
+	require.ErrorIs(t, e.CancelTransferFunds(ctx, &types.CancelTransferFunds{
+		Party:      conditionalSender,
+		TransferID: "conditional-transfer",
+	}), banking.ErrRecurringTransferDoesNotExists)
+}
+
+func testConditionalTransferWithDeliverOn(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).Times(1)
+	e.assets.EXPECT().Get(gomock.Any()).Times(1).Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(1)}), nil)
+
+	transfer := newConditionalTransfer(&types.TransferCondition{
+		Type:        types.TransferConditionTypeMarketState,
+		Market:      "market",
+		MarketState: types.MarketStateSettled,
+	})
+	deliverOn := time.Unix(20, 0)
+	transfer.OneOff.DeliverOn = &deliverOn
+	require.ErrorIs(t, e.TransferFunds(ctx, transfer), types.ErrConditionalTransferWithDeliverOn)
+}
+
+func testConditionalTransferFailedDeliveryIsRetried(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	expectEscrow(t, e)
+	transfer := newConditionalTransfer(&types.TransferCondition{
+		Type:      types.TransferConditionTypeBalance,
+		Party:     conditionalReceiver,
+		Asset:     assetNameETH,
+		Threshold: num.NewUint(5),
+	})
+	require.NoError(t, e.TransferFunds(ctx, transfer))
+
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalReceiver, assetNameETH).Times(2).Return(&types.Account{Balance: num.NewUint(5)}, nil)
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("boom"))
+	e.OnTick(ctx, time.Unix(10, 0))
+	require.Equal(t, types.TransferStatusPending, transfer.OneOff.Status)
+
+	expectDelivery(t, e, conditionalReceiver)
+	e.OnTick(ctx, time.Unix(11, 0))
+	require.Equal(t, types.TransferStatusDone, transfer.OneOff.Status)
+}
+
+func testConditionalTransferFailedDeliveryDoesNotHoldOthers(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	condition := &types.TransferCondition{
+		Type:      types.TransferConditionTypeBalance,
+		Party:     conditionalReceiver,
+		Asset:     assetNameETH,
+		Threshold: num.NewUint(5),
+	}
+	expectEscrow(t, e)
+	first := newConditionalTransfer(condition)
+	require.NoError(t, e.TransferFunds(ctx, first))
+	expectEscrow(t, e)
+	second := newConditionalTransfer(condition)
+	second.OneOff.ID = "conditional-transfer-2"
+	require.NoError(t, e.TransferFunds(ctx, second))
+
+	// the first delivery fails, the second still goes through.
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalReceiver, assetNameETH).Times(2).Return(&types.Account{Balance: num.NewUint(5)}, nil)
+	gomock.InOrder(
+		e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("boom")),
+		e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil),
+	)
+	e.OnTick(ctx, time.Unix(10, 0))
+	require.Equal(t, types.TransferStatusPending, first.OneOff.Status)
+	require.Equal(t, types.TransferStatusDone, second.OneOff.Status)
+
+	// the first one is tried again on its own.
+	e.col.EXPECT().GetPartyGeneralAccount(conditionalReceiver, assetNameETH).Times(1).Return(&types.Account{Balance: num.NewUint(5)}, nil)
+	expectDelivery(t, e, conditionalReceiver)
+	e.OnTick(ctx, time.Unix(11, 0))
+	require.Equal(t, types.TransferStatusDone, first.OneOff.Status)
+}
+
+func testConditionalTransfersCheckpointRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	var onData spec.OnMatchedData
+	e.oracleEngine.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			onData = cb
+			return spec.SubscriptionID(1), func(context.Context, spec.SubscriptionID) {}, nil
+		})
+	expectEscrow(t, e)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(&types.TransferCondition{
+		Type:       types.TransferConditionTypeDataSource,
+		DataSource: newTestDataSource(),
+	})))
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	loaded := getTestEngine(t)
+	loaded.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	loaded.broker.EXPECT().SendBatch(gomock.Any()).Times(1)
+	// the data source subscription is made again on load.
+	var onLoadedData spec.OnMatchedData
+	loaded.oracleEngine.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			onLoadedData = cb
+			return spec.SubscriptionID(1), func(context.Context, spec.SubscriptionID) {}, nil
+		})
+	require.NoError(t, loaded.Load(ctx, cp))
+
+	cpPostLoad, err := loaded.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, cp, cpPostLoad)
+
+	// the escrowed funds are delivered once the data is received.
+	loaded.OnTick(ctx, time.Unix(10, 0))
+	require.NotNil(t, onData)
+	require.NoError(t, onLoadedData(ctx, dscommon.Data{Data: map[string]string{"match.result": "1"}}))
+	expectDelivery(t, loaded, conditionalReceiver)
+	loaded.OnTick(ctx, time.Unix(11, 0))
+}
+
+func testConditionalTransfersSnapshotRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	key := (&types.PayloadBankingConditionalTransfers{}).Key()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	var onData spec.OnMatchedData
+	e.oracleEngine.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, cb spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			onData = cb
+			return spec.SubscriptionID(1), func(context.Context, spec.SubscriptionID) {}, nil
+		})
+	expectEscrow(t, e)
+	require.NoError(t, e.TransferFunds(ctx, newConditionalTransfer(&types.TransferCondition{
+		Type:       types.TransferConditionTypeDataSource,
+		DataSource: newTestDataSource(),
+	})))
+	require.NoError(t, onData(ctx, dscommon.Data{Data: map[string]string{"match.result": "1"}}))
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+	snap := getTestEngine(t)
+	snap.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	snap.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	// the data source subscription is made again on restore.
+	snap.oracleEngine.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+		spec.SubscriptionID(1), func(context.Context, spec.SubscriptionID) {}, nil)
+	_, err = snap.LoadState(ctx, types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	statePostReload, _, err := snap.GetState(key)
+	require.NoError(t, err)
+	require.Equal(t, state, statePostReload)
+
+	// the data was received before the snapshot, so the transfer is delivered.
+	expectDelivery(t, snap, conditionalReceiver)
+	snap.OnTick(ctx, time.Unix(10, 0))
+}
@@ -18,7 +18,6 @@ package banking
 import (
 	"context"
 	"errors"
-	"fmt"
 	"math/big"
 	"slices"
 	"sort"
@@ -28,6 +27,7 @@ import (
 
 	"code.vegaprotocol.io/vega/core/assets"
 	"code.vegaprotocol.io/vega/core/broker"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/core/validators"
@@ -40,11 +40,12 @@ import (
 	"golang.org/x/exp/maps"
 )
 
-//go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/banking Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,StakeAccounting
+//go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/banking Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,StakeAccounting,OracleEngine,Markets
 
 var (
 	ErrWrongAssetTypeUsedInBuiltinAssetChainEvent = errors.New("non builtin asset used for builtin asset chain event")
 	ErrWrongAssetTypeUsedInERC20ChainEvent        = errors.New("non ERC20 for ERC20 chain event")
+	ErrAssetNotOnChain                            = errors.New("asset does not live on the chain of the event")
 	ErrWrongAssetUsedForERC20Withdraw             = errors.New("non erc20 asset used for lock withdraw")
 	ErrInvalidWithdrawalState                     = errors.New("invalid withdrawal state")
 	ErrNotMatchingWithdrawalForReference          = errors.New("invalid reference for withdrawal chain event")
@@ -128,8 +129,19 @@ type EthereumEventSource interface {
 	UpdateContractBlock(string, string, uint64)
 }
 
+// OracleEngine lets conditional transfers wait for data from a data source.
+type OracleEngine interface {
+	Subscribe(context.Context, spec.Spec, spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error)
+}
+
+// Markets lets conditional transfers wait for a market to reach a given state.
+type Markets interface {
+	GetMarket(market string, settled bool) (types.Market, bool)
+}
+
 type Parties interface {
 	CheckDerivedKeyOwnership(party types.PartyID, derivedKey string) bool
+	CheckSubAccountOwnership(party types.PartyID, subAccount string) bool
 }
 
 const (
@@ -143,6 +155,8 @@ var defaultValidationDuration = 30 * 24 * time.Hour
 type dispatchStrategyCacheEntry struct {
 	ds       *proto.DispatchStrategy
 	refCount int
+	// vestingSchedule is applied to the rewards paid from the reward account of the dispatch strategy.
+	vestingSchedule *types.VestingSchedule
 }
 
 type Engine struct {
@@ -173,12 +187,11 @@ type Engine struct {
 	primaryBridgeState      *bridgeState
 	primaryBridgeView       ERC20BridgeView
 
-	// lastSeenSecondaryEthBlock holds the block height of the latest ERC20 chain
-	// event, from the secondary chain, processed by the engine.
-	lastSeenSecondaryEthBlock uint64
-	secondaryEthChainID       string
-	secondaryBridgeState      *bridgeState
-	secondaryBridgeView       ERC20BridgeView
+	// chain-id -> collateral bridge, for all the EVM chains other than the
+	// primary one.
+	evmBridges map[string]*evmBridge
+	// evmChainIDs holds the chain ids of evmBridges in the order they were registered.
+	evmChainIDs []string
 
 	// map from chain-id -> collateral contract address
 	bridgeAddresses map[string]string
@@ -232,6 +245,33 @@ type Engine struct {
 
 	stakingAsset    string
 	stakeAccounting StakeAccounting
+
+	// team competitions by ID, kept once ended so their results can be queried.
+	teamCompetitions map[string]*types.TeamCompetition
+
+	oracleEngine OracleEngine
+	markets      Markets
+	// conditional transfers in the order they were made
+	conditionalTransfers []*conditionalTransfer
+	// transfer id to conditionalTransfers
+	conditionalTransfersMap map[string]*conditionalTransfer
+
+	// withdrawalBatching enables aggregating the withdrawals of an epoch
+	// in a single bundle per bridge.
+	withdrawalBatching bool
+	// chain id -> withdrawals waiting for the end of the epoch to be batched
+	pendingWithdrawalBatches map[string][]string
+	withdrawalBatches        map[string]*withdrawalBatch
+	// withdrawal id -> batch id
+	withdrawalToBatch map[string]string
+
+	// asset -> amount above which a withdrawal is delayed
+	withdrawalDelayThresholds map[string]*num.Uint
+	withdrawalDelayPeriod     time.Duration
+	// keys allowed to cancel a delayed withdrawal
+	withdrawalGuardians map[string]struct{}
+	// withdrawals in the delay queue, in the order they were made
+	delayedWithdrawals []*DelayedWithdrawal
 }
 
 type withdrawalRef struct {
@@ -250,10 +290,10 @@ func New(log *logging.Logger,
 	top Topology,
 	marketActivityTracker MarketActivityTracker,
 	primaryBridgeView ERC20BridgeView,
-	secondaryBridgeView ERC20BridgeView,
 	ethEventSource EthereumEventSource,
 	parties Parties,
 	stakeAccounting StakeAccounting,
+	oracleEngine OracleEngine,
 ) (e *Engine) {
 	log = log.Named(namedLogger)
 	log.SetLevel(cfg.Level.Get())
@@ -288,17 +328,22 @@ func New(log *logging.Logger,
 		marketActivityTracker:           marketActivityTracker,
 		nextMetricUpdate:                time.Time{},
 		hashToStrategy:                  map[string]*dispatchStrategyCacheEntry{},
+		teamCompetitions:                map[string]*types.TeamCompetition{},
+		oracleEngine:                    oracleEngine,
+		conditionalTransfersMap:         map[string]*conditionalTransfer{},
+		pendingWithdrawalBatches:        map[string][]string{},
+		withdrawalBatches:               map[string]*withdrawalBatch{},
+		withdrawalToBatch:               map[string]string{},
+		withdrawalDelayThresholds:       map[string]*num.Uint{},
+		withdrawalGuardians:             map[string]struct{}{},
+		evmBridges:                      map[string]*evmBridge{},
 		primaryBridgeState: &bridgeState{
 			active: true,
 		},
-		secondaryBridgeState: &bridgeState{
-			active: true,
-		},
 		bridgeAddresses:                           map[string]string{},
 		feeDiscountPerPartyAndAsset:               map[partyAssetKey]*num.Uint{},
 		pendingPerAssetAndPartyFeeDiscountUpdates: map[string]map[string]*num.Uint{},
 		primaryBridgeView:                         primaryBridgeView,
-		secondaryBridgeView:                       secondaryBridgeView,
 		dispatchRequiredCache:                     map[string]bool{},
 		stakeAccounting:                           stakeAccounting,
 	}
@@ -329,11 +374,6 @@ func (e *Engine) OnPrimaryEthChainIDUpdated(chainID, collateralAddress string) {
 	e.bridgeAddresses[chainID] = collateralAddress
 }
 
-func (e *Engine) OnSecondaryEthChainIDUpdated(chainID, collateralAddress string) {
-	e.secondaryEthChainID = chainID
-	e.bridgeAddresses[chainID] = collateralAddress
-}
-
 // ReloadConf updates the internal configuration.
 func (e *Engine) ReloadConf(cfg Config) {
 	e.log.Info("reloading configuration")
@@ -375,9 +415,12 @@ func (e *Engine) OnEpoch(ctx context.Context, ep types.Epoch) {
 	case proto.EpochAction_EPOCH_ACTION_START:
 		e.currentEpoch = ep.Seq
 		e.cleanupStaleDispatchStrategies()
+		e.startTeamCompetitions(ctx, ep.Seq)
 	case proto.EpochAction_EPOCH_ACTION_END:
 		e.distributeRecurringTransfers(ctx, e.currentEpoch)
 		e.distributeRecurringGovernanceTransfers(ctx)
+		e.settleTeamCompetitions(ctx, ep.Seq)
+		e.startWithdrawalBatches()
 		e.applyPendingFeeDiscountsUpdates(ctx)
 		e.sendTeamsStats(ctx, ep.Seq)
 		e.dispatchRequiredCache = map[string]bool{}
@@ -391,6 +434,8 @@ func (e *Engine) OnEpoch(ctx context.Context, ep types.Epoch) {
 func (e *Engine) OnTick(ctx context.Context, now time.Time) {
 	e.processAssetActions(ctx, now)
 
+	e.releaseDelayedWithdrawals(ctx, now)
+
 	e.notary.OfferSignatures(types.NodeSignatureKindAssetWithdrawal, e.offerERC20NotarySignatures)
 
 	// then process all scheduledTransfers
@@ -402,6 +447,9 @@ func (e *Engine) OnTick(ctx context.Context, now time.Time) {
 
 	// process governance transfers
 	e.distributeScheduledGovernanceTransfers(ctx, now)
+
+	// then all conditional transfers which condition is now met
+	e.distributeConditionalTransfers(ctx)
 }
 
 func (e *Engine) processAssetActions(ctx context.Context, now time.Time) {
@@ -693,28 +741,6 @@ func (e *Engine) sendTeamsStats(ctx context.Context, seq uint64) {
 	}
 }
 
-func (e *Engine) bridgeViewForChainID(chainID string) (ERC20BridgeView, error) {
-	switch chainID {
-	case e.primaryEthChainID:
-		return e.primaryBridgeView, nil
-	case e.secondaryEthChainID:
-		return e.secondaryBridgeView, nil
-	default:
-		return nil, fmt.Errorf("chain id %q is not supported", chainID)
-	}
-}
-
-func (e *Engine) bridgeStateForChainID(chainID string) (*bridgeState, error) {
-	switch chainID {
-	case e.primaryEthChainID:
-		return e.primaryBridgeState, nil
-	case e.secondaryEthChainID:
-		return e.secondaryBridgeState, nil
-	default:
-		return nil, fmt.Errorf("chain id %q is not supported", chainID)
-	}
-}
-
 func newPendingState() *atomic.Uint32 {
 	state := &atomic.Uint32{}
 	state.Store(pendingState)
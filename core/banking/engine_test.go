@@ -47,6 +47,7 @@ type testEngine struct {
 	witness               *fakeWitness
 	col                   *mocks.MockCollateral
 	assets                *mocks.MockAssets
+	notary                *mocks.MockNotary
 	tsvc                  *mocks.MockTimeService
 	top                   *mocks.MockTopology
 	broker                *bmocks.MockBroker
@@ -57,6 +58,9 @@ type testEngine struct {
 	secondaryBridgeView   *mocks.MockERC20BridgeView
 	parties               *mocks.MockParties
 	stakeAccounting       *mocks.MockStakeAccounting
+	oracleEngine          *mocks.MockOracleEngine
+	// subAccounts maps the sub-accounts to their owner.
+	subAccounts map[string]string
 }
 
 func getTestEngine(t *testing.T) *testEngine {
@@ -84,11 +88,17 @@ func getTestEngine(t *testing.T) *testEngine {
 	notary.EXPECT().OfferSignatures(gomock.Any(), gomock.Any()).AnyTimes()
 	epoch.EXPECT().NotifyOnEpoch(gomock.Any(), gomock.Any()).AnyTimes()
 	parties := mocks.NewMockParties(ctrl)
-	eng := banking.New(logging.NewTestLogger(), banking.NewDefaultConfig(), col, witness, tsvc, assets, notary, broker, top, marketActivityTracker, primaryBridgeView, secondaryBridgeView, ethSource, parties, stakeAccounting)
+	oracleEngine := mocks.NewMockOracleEngine(ctrl)
+	subAccounts := map[string]string{}
+	parties.EXPECT().CheckSubAccountOwnership(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(party types.PartyID, subAccount string) bool {
+			return subAccounts[subAccount] == string(party)
+		}).AnyTimes()
+	eng := banking.New(logging.NewTestLogger(), banking.NewDefaultConfig(), col, witness, tsvc, assets, notary, broker, top, marketActivityTracker, primaryBridgeView, ethSource, parties, stakeAccounting, oracleEngine)
 
 	require.NoError(t, eng.OnMaxQuantumAmountUpdate(context.Background(), num.DecimalOne()))
 	eng.OnPrimaryEthChainIDUpdated("1", "hello")
-	eng.OnSecondaryEthChainIDUpdated("2", "hello2")
+	eng.RegisterEVMBridge("2", "hello2", secondaryBridgeView)
 
 	return &testEngine{
 		Engine:                eng,
@@ -96,6 +106,7 @@ func getTestEngine(t *testing.T) *testEngine {
 		witness:               witness,
 		col:                   col,
 		assets:                assets,
+		notary:                notary,
 		tsvc:                  tsvc,
 		broker:                broker,
 		top:                   top,
@@ -106,6 +117,8 @@ func getTestEngine(t *testing.T) *testEngine {
 		ethSource:             ethSource,
 		parties:               parties,
 		stakeAccounting:       stakeAccounting,
+		oracleEngine:          oracleEngine,
+		subAccounts:           subAccounts,
 	}
 }
 
@@ -138,6 +138,12 @@ func (e *Engine) DepositERC20(
 		return fmt.Errorf("%v: %w", asset.String(), ErrWrongAssetTypeUsedInERC20ChainEvent)
 	}
 
+	if erc20Token, _ := asset.ERC20(); !erc20Token.IsOnChain(chainID) {
+		dep.Status = types.DepositStatusCancelled
+		e.broker.Send(events.NewDepositEvent(ctx, *dep))
+		return fmt.Errorf("%v: %w", asset.String(), ErrAssetNotOnChain)
+	}
+
 	bridgeView, err := e.bridgeViewForChainID(chainID)
 	if err != nil {
 		return err
@@ -175,14 +181,14 @@ func (e *Engine) ERC20WithdrawalEvent(ctx context.Context, w *types.ERC20Withdra
 	if withd.Status != types.WithdrawalStatusFinalized {
 		return fmt.Errorf("%s: %w", withd.ID, ErrInvalidWithdrawalState)
 	}
-	if _, ok := e.notary.IsSigned(ctx, withd.ID, types.NodeSignatureKindAssetWithdrawal); !ok {
+	if !e.isWithdrawalSigned(ctx, withd.ID) {
 		return ErrWithdrawalNotReady
 	}
 
 	if e.primaryEthChainID == chainID && blockNumber > e.lastSeenPrimaryEthBlock {
 		e.lastSeenPrimaryEthBlock = blockNumber
-	} else if e.secondaryEthChainID == chainID && blockNumber > e.lastSeenSecondaryEthBlock {
-		e.lastSeenSecondaryEthBlock = blockNumber
+	} else if bridge, ok := e.evmBridges[chainID]; ok && blockNumber > bridge.lastSeenBlock {
+		bridge.lastSeenBlock = blockNumber
 	}
 	withd.WithdrawalDate = e.timeService.GetTimeNow().UnixNano()
 	withd.TxHash = txHash
@@ -262,6 +268,11 @@ func (e *Engine) WithdrawERC20(
 		}
 	}
 
+	// withdrawals above the delay threshold of the asset wait in the delay queue
+	if e.isWithdrawalDelayed(w) {
+		return e.delayWithdrawal(ctx, w)
+	}
+
 	// try to withdraw if no error, this'll just abort
 	if err := e.finalizeWithdraw(ctx, w); err != nil {
 		return err
@@ -270,6 +281,12 @@ func (e *Engine) WithdrawERC20(
 	// no check error as we checked earlier we had an erc20 asset.
 	erc20asset, _ := asset.ERC20()
 
+	// the bundle will be created with the other withdrawals of the epoch
+	if e.withdrawalBatching {
+		e.addToWithdrawalBatch(w, erc20asset)
+		return nil
+	}
+
 	// startup aggregating signature for the bundle
 	return e.startERC20Signatures(w, erc20asset, ref)
 }
@@ -308,6 +325,10 @@ func (e *Engine) offerERC20NotarySignatures(resource string) []byte {
 		return nil
 	}
 
+	if batch, ok := e.withdrawalBatches[resource]; ok {
+		return e.signWithdrawalBatch(batch)
+	}
+
 	wref, ok := e.withdrawals[resource]
 	if !ok {
 		// there's no reason we cannot find the withdrawal here
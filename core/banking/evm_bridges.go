@@ -0,0 +1,148 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/logging"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+)
+
+// evmBridge holds the state of the collateral bridge of an EVM chain other
+// than the primary Ethereum chain.
+type evmBridge struct {
+	chainID           string
+	collateralAddress string
+	state             *bridgeState
+	// view is only used by validators, to verify the events of the bridge.
+	view ERC20BridgeView
+	// lastSeenBlock holds the block height of the latest ERC20 chain
+	// event, from this chain, processed by the engine.
+	lastSeenBlock uint64
+}
+
+// RegisterEVMBridge adds the collateral bridge of an EVM chain, or updates it
+// if the chain is already known. The bridges are expected to be registered in
+// the order of the network parameter configuring them.
+func (e *Engine) RegisterEVMBridge(chainID, collateralAddress string, view ERC20BridgeView) {
+	bridge := e.evmBridgeForChainID(chainID)
+	bridge.collateralAddress = collateralAddress
+	bridge.view = view
+	e.bridgeAddresses[chainID] = collateralAddress
+}
+
+// evmBridgeForChainID returns the bridge of the chain, creating it if needed.
+// A bridge can be restored before it is registered.
+func (e *Engine) evmBridgeForChainID(chainID string) *evmBridge {
+	if bridge, ok := e.evmBridges[chainID]; ok {
+		return bridge
+	}
+
+	// a state restored from a payload that isn't associated to a chain ID
+	// belongs to the first bridge.
+	if legacy, ok := e.evmBridges[""]; ok && len(chainID) > 0 && e.evmChainIDs[0] == "" {
+		delete(e.evmBridges, "")
+		legacy.chainID = chainID
+		e.evmBridges[chainID] = legacy
+		e.evmChainIDs[0] = chainID
+		return legacy
+	}
+
+	bridge := &evmBridge{
+		chainID: chainID,
+		state: &bridgeState{
+			active: true,
+		},
+	}
+	e.evmBridges[chainID] = bridge
+	e.evmChainIDs = append(e.evmChainIDs, chainID)
+	return bridge
+}
+
+// secondaryEVMBridge returns the first EVM bridge, the one the checkpoints and
+// snapshots produced before the bridge registry refer to as the secondary bridge.
+func (e *Engine) secondaryEVMBridge() *evmBridge {
+	if len(e.evmChainIDs) > 0 {
+		return e.evmBridges[e.evmChainIDs[0]]
+	}
+	return e.evmBridgeForChainID("")
+}
+
+func (e *Engine) getEVMBridgeStates() []*checkpoint.BridgeState {
+	states := make([]*checkpoint.BridgeState, 0, len(e.evmChainIDs))
+	for _, chainID := range e.evmChainIDs {
+		bridge := e.evmBridges[chainID]
+		states = append(states, &checkpoint.BridgeState{
+			Active:        bridge.state.active,
+			BlockHeight:   bridge.state.block,
+			LogIndex:      bridge.state.logIndex,
+			ChainId:       bridge.chainID,
+			LastSeenBlock: bridge.lastSeenBlock,
+		})
+	}
+	return states
+}
+
+func (e *Engine) loadEVMBridgeState(state *checkpoint.BridgeState) {
+	bridge := e.evmBridgeForChainID(state.ChainId)
+	bridge.state = &bridgeState{
+		active:   state.Active,
+		block:    state.BlockHeight,
+		logIndex: state.LogIndex,
+	}
+	// states produced before the bridge registry carry the last seen block
+	// separately.
+	if state.LastSeenBlock != 0 {
+		bridge.lastSeenBlock = state.LastSeenBlock
+	}
+}
+
+// updateEVMBridgesContractBlock tells the event source where each EVM bridge
+// got up to, so the events already processed are not sent again.
+func (e *Engine) updateEVMBridgesContractBlock() {
+	for _, chainID := range e.evmChainIDs {
+		bridge := e.evmBridges[chainID]
+		if bridge.lastSeenBlock == 0 {
+			continue
+		}
+		e.log.Info("restoring EVM collateral bridge starting block",
+			logging.String("chain-id", bridge.chainID),
+			logging.Uint64("block", bridge.lastSeenBlock),
+		)
+		e.ethEventSource.UpdateContractBlock(bridge.collateralAddress, bridge.chainID, bridge.lastSeenBlock)
+	}
+}
+
+func (e *Engine) bridgeViewForChainID(chainID string) (ERC20BridgeView, error) {
+	if chainID == e.primaryEthChainID {
+		return e.primaryBridgeView, nil
+	}
+	if bridge, ok := e.evmBridges[chainID]; ok && bridge.view != nil {
+		return bridge.view, nil
+	}
+	return nil, fmt.Errorf("chain id %q is not supported", chainID)
+}
+
+func (e *Engine) bridgeStateForChainID(chainID string) (*bridgeState, error) {
+	if chainID == e.primaryEthChainID {
+		return e.primaryBridgeState, nil
+	}
+	if bridge, ok := e.evmBridges[chainID]; ok {
+		return bridge.state, nil
+	}
+	return nil, fmt.Errorf("chain id %q is not supported", chainID)
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/assets/erc20"
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEVMBridges(t *testing.T) {
+	t.Run("a bridge can be stopped on any registered chain", testStopBridgeOnAdditionalEVMChain)
+	t.Run("events from an unknown chain are rejected", testUnknownEVMChain)
+	t.Run("the state of all the bridges is snapshotted", testEVMBridgesSnapshotRoundTrip)
+	t.Run("the state of all the bridges is checkpointed", testEVMBridgesCheckpointRoundTrip)
+	t.Run("deposits are only accepted from the chains the asset lives on", testDepositOnAssetChains)
+}
+
+func newTestERC20AssetOnChain(t *testing.T, chainID string) *assets.Asset {
+	t.Helper()
+	erc20Asset, err := erc20.New("erc20-asset", &types.AssetDetails{
+		Quantum: num.DecimalOne(),
+		Source: &types.AssetDetailsErc20{
+			ERC20: &types.ERC20{
+				ChainID:         chainID,
+				ContractAddress: "0x1FaA74E181092A97Fecc923015293ce57eE1208A",
+			},
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+	return assets.NewAsset(erc20Asset)
+}
+
+func stopBridge(t *testing.T, e *testEngine, chainID string) {
+	t.Helper()
+	require.NoError(t, e.BridgeStopped(context.Background(), true, "stop-"+chainID, 100, 1, "hash-"+chainID, chainID))
+	e.witness.f(e.witness.r, true)
+	e.OnTick(context.Background(), time.Unix(10, 0))
+}
+
+func testStopBridgeOnAdditionalEVMChain(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.ethSource.EXPECT().UpdateContractBlock(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	e.RegisterEVMBridge("42161", "0xbridge42161", e.secondaryBridgeView)
+	e.RegisterEVMBridge("10", "0xbridge10", e.secondaryBridgeView)
+	stopBridge(t, e, "42161")
+
+	receiver := &types.Erc20WithdrawExt{ReceiverAddress: "0x1ebe188952ab6035adad21ea1c4f64fd2eac60e1"}
+
+	// withdrawals are disabled on the stopped bridge only.
+	e.assets.EXPECT().Get("erc20-asset").Times(1).Return(newTestERC20AssetOnChain(t, "42161"), nil)
+	require.ErrorIs(t, e.WithdrawERC20(ctx, "w1", "party1", "erc20-asset", num.NewUint(10), receiver), banking.ErrWithdrawalDisabledWhenBridgeIsStopped)
+
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20AssetOnChain(t, "10"), nil)
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(1).Return(&types.LedgerMovement{}, nil)
+	e.notary.EXPECT().StartAggregate("w2", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+	require.NoError(t, e.WithdrawERC20(ctx, "w2", "party1", "erc20-asset", num.NewUint(10), receiver))
+}
+
+func testUnknownEVMChain(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+
+	require.Error(t, e.BridgeStopped(ctx, true, "stop", 100, 1, "hash", "42161"))
+
+	// known, but this node has no view on the bridge.
+	e.RegisterEVMBridge("42161", "0xbridge42161", nil)
+	require.Error(t, e.BridgeStopped(ctx, true, "stop", 100, 1, "hash", "42161"))
+}
+
+func testEVMBridgesSnapshotRoundTrip(t *testing.T) {
+	key := (&types.PayloadBankingEVMBridgeStates{}).Key()
+	e := getTestEngine(t)
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.ethSource.EXPECT().UpdateContractBlock(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	e.RegisterEVMBridge("42161", "0xbridge42161", e.secondaryBridgeView)
+	e.RegisterEVMBridge("10", "0xbridge10", e.secondaryBridgeView)
+	stopBridge(t, e, "10")
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+	require.Len(t, pl.GetBankingEvmBridgeStates().BridgeStates, 3)
+
+	// the states are restored before the bridges are registered.
+	snap := getTestEngine(t)
+	_, err = snap.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+	snap.RegisterEVMBridge("42161", "0xbridge42161", snap.secondaryBridgeView)
+	snap.RegisterEVMBridge("10", "0xbridge10", snap.secondaryBridgeView)
+
+	statePostReload, _, err := snap.GetState(key)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state, statePostReload))
+
+	snap.assets.EXPECT().Get("erc20-asset").Times(1).Return(newTestERC20AssetOnChain(t, "10"), nil)
+	require.ErrorIs(t, snap.WithdrawERC20(context.Background(), "w1", "party1", "erc20-asset", num.NewUint(10), &types.Erc20WithdrawExt{}), banking.ErrWithdrawalDisabledWhenBridgeIsStopped)
+}
+
+func testEVMBridgesCheckpointRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.ethSource.EXPECT().UpdateContractBlock(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	e.RegisterEVMBridge("42161", "0xbridge42161", e.secondaryBridgeView)
+	e.RegisterEVMBridge("10", "0xbridge10", e.secondaryBridgeView)
+	stopBridge(t, e, "10")
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	loadEng := getTestEngine(t)
+	loadEng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	loadEng.ethSource.EXPECT().UpdateContractBlock(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	loadEng.RegisterEVMBridge("42161", "0xbridge42161", loadEng.secondaryBridgeView)
+	loadEng.RegisterEVMBridge("10", "0xbridge10", loadEng.secondaryBridgeView)
+	require.NoError(t, loadEng.Load(context.Background(), cp))
+
+	cpPostLoad, err := loadEng.Checkpoint()
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(cp, cpPostLoad))
+
+	loadEng.assets.EXPECT().Get("erc20-asset").Times(1).Return(newTestERC20AssetOnChain(t, "10"), nil)
+	require.ErrorIs(t, loadEng.WithdrawERC20(context.Background(), "w1", "party1", "erc20-asset", num.NewUint(10), &types.Erc20WithdrawExt{}), banking.ErrWithdrawalDisabledWhenBridgeIsStopped)
+}
+
+func testDepositOnAssetChains(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	e.RegisterEVMBridge("42161", "0xbridge42161", e.secondaryBridgeView)
+	e.RegisterEVMBridge("10", "0xbridge10", e.secondaryBridgeView)
+
+	erc20Asset, err := erc20.New("erc20-asset", &types.AssetDetails{
+		Quantum: num.DecimalOne(),
+		Source: &types.AssetDetailsErc20{
+			ERC20: &types.ERC20{
+				ChainID:         "42161",
+				ContractAddress: "0x1FaA74E181092A97Fecc923015293ce57eE1208A",
+				AdditionalChains: []*types.ERC20ChainContract{
+					{ChainID: "10", ContractAddress: "0x2FaA74E181092A97Fecc923015293ce57eE1208A"},
+				},
+			},
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(assets.NewAsset(erc20Asset), nil)
+
+	deposit := &types.ERC20Deposit{
+		VegaAssetID:   "erc20-asset",
+		TargetPartyID: "party1",
+		Amount:        num.NewUint(10),
+	}
+	require.NoError(t, e.DepositERC20(ctx, deposit, "d1", 100, 1, "hash1", "10"))
+	require.ErrorIs(t, e.DepositERC20(ctx, deposit, "d2", 100, 2, "hash2", "1"), banking.ErrAssetNotOnChain)
+}
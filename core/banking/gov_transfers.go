@@ -54,6 +54,7 @@ var (
 		types.AccountTypeValidatorRankingReward: {},
 		types.AccountTypeRealisedReturnReward:   {},
 		types.AccountTypeEligibleEntitiesReward: {},
+		types.AccountTypeMakerFeeRebates:        {},
 	}
 )
 
@@ -186,9 +187,14 @@ func (e *Engine) NewGovernanceTransfer(ctx context.Context, ID, reference string
 		return nil
 	}
 	// recurring governance transfer
+	if err = e.checkVestingSchedule(config.RecurringTransferConfig.DispatchStrategy, config.VestingSchedule); err != nil {
+		gTransfer.Status = types.TransferStatusRejected
+		return err
+	}
 	e.recurringGovernanceTransfers = append(e.recurringGovernanceTransfers, gTransfer)
 	e.recurringGovernanceTransfersMap[ID] = gTransfer
 	e.registerDispatchStrategy(gTransfer.Config.RecurringTransferConfig.DispatchStrategy)
+	e.setVestingSchedule(config.RecurringTransferConfig.DispatchStrategy, config.VestingSchedule)
 	return nil
 }
 
@@ -376,7 +382,8 @@ func (e *Engine) VerifyGovernanceTransfer(transfer *types.NewTransferConfigurati
 	// verify systemn destination account which ought to preexist actually exists
 	if (transfer.RecurringTransferConfig == nil || transfer.RecurringTransferConfig.DispatchStrategy == nil) &&
 		len(transfer.Destination) == 0 &&
-		transfer.DestinationType != types.AccountTypeGeneral {
+		transfer.DestinationType != types.AccountTypeGeneral &&
+		transfer.DestinationType != types.AccountTypeMakerFeeRebates {
 		_, err := e.col.GetSystemAccountBalance(transfer.Asset, transfer.Destination, transfer.DestinationType)
 		if err != nil {
 			return err
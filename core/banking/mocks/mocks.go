@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: code.vegaprotocol.io/vega/core/banking (interfaces: Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,StakeAccounting)
+// Source: code.vegaprotocol.io/vega/core/banking (interfaces: Assets,Notary,Collateral,Witness,TimeService,EpochService,Topology,MarketActivityTracker,ERC20BridgeView,EthereumEventSource,Parties,StakeAccounting,OracleEngine,Markets)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	assets "code.vegaprotocol.io/vega/core/assets"
+	spec "code.vegaprotocol.io/vega/core/datasource/spec"
 	types "code.vegaprotocol.io/vega/core/types"
 	validators "code.vegaprotocol.io/vega/core/validators"
 	num "code.vegaprotocol.io/vega/libs/num"
@@ -800,6 +801,20 @@ func (mr *MockPartiesMockRecorder) CheckDerivedKeyOwnership(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDerivedKeyOwnership", reflect.TypeOf((*MockParties)(nil).CheckDerivedKeyOwnership), arg0, arg1)
 }
 
+// CheckSubAccountOwnership mocks base method.
+func (m *MockParties) CheckSubAccountOwnership(arg0 types.PartyID, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSubAccountOwnership", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CheckSubAccountOwnership indicates an expected call of CheckSubAccountOwnership.
+func (mr *MockPartiesMockRecorder) CheckSubAccountOwnership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSubAccountOwnership", reflect.TypeOf((*MockParties)(nil).CheckSubAccountOwnership), arg0, arg1)
+}
+
 // MockStakeAccounting is a mock of StakeAccounting interface.
 type MockStakeAccounting struct {
 	ctrl     *gomock.Controller
@@ -834,3 +849,80 @@ func (mr *MockStakeAccountingMockRecorder) AddEvent(arg0, arg1 interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEvent", reflect.TypeOf((*MockStakeAccounting)(nil).AddEvent), arg0, arg1)
 }
+
+// MockOracleEngine is a mock of OracleEngine interface.
+type MockOracleEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockOracleEngineMockRecorder
+}
+
+// MockOracleEngineMockRecorder is the mock recorder for MockOracleEngine.
+type MockOracleEngineMockRecorder struct {
+	mock *MockOracleEngine
+}
+
+// NewMockOracleEngine creates a new mock instance.
+func NewMockOracleEngine(ctrl *gomock.Controller) *MockOracleEngine {
+	mock := &MockOracleEngine{ctrl: ctrl}
+	mock.recorder = &MockOracleEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOracleEngine) EXPECT() *MockOracleEngineMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockOracleEngine) Subscribe(arg0 context.Context, arg1 spec.Spec, arg2 spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1, arg2)
+	ret0, _ := ret[0].(spec.SubscriptionID)
+	ret1, _ := ret[1].(spec.Unsubscriber)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockOracleEngineMockRecorder) Subscribe(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockOracleEngine)(nil).Subscribe), arg0, arg1, arg2)
+}
+
+// MockMarkets is a mock of Markets interface.
+type MockMarkets struct {
+	ctrl     *gomock.Controller
+	recorder *MockMarketsMockRecorder
+}
+
+// MockMarketsMockRecorder is the mock recorder for MockMarkets.
+type MockMarketsMockRecorder struct {
+	mock *MockMarkets
+}
+
+// NewMockMarkets creates a new mock instance.
+func NewMockMarkets(ctrl *gomock.Controller) *MockMarkets {
+	mock := &MockMarkets{ctrl: ctrl}
+	mock.recorder = &MockMarketsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMarkets) EXPECT() *MockMarketsMockRecorder {
+	return m.recorder
+}
+
+// GetMarket mocks base method.
+func (m *MockMarkets) GetMarket(arg0 string, arg1 bool) (types.Market, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMarket", arg0, arg1)
+	ret0, _ := ret[0].(types.Market)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetMarket indicates an expected call of GetMarket.
+func (mr *MockMarketsMockRecorder) GetMarket(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarket", reflect.TypeOf((*MockMarkets)(nil).GetMarket), arg0, arg1)
+}
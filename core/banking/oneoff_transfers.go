@@ -153,9 +153,15 @@ func (e *Engine) oneOffTransfer(
 			transfer.Status = types.TransferStatusRejected
 			return fmt.Errorf("party %s does not own derived key %s", transfer.From, *transfer.FromDerivedKey)
 		}
+		// only the general account of a sub-account can be emptied by its owner,
+		// not the one of any other derived key, e.g. an AMM.
+		if transfer.FromAccountType == types.AccountTypeGeneral && !e.parties.CheckSubAccountOwnership(types.PartyID(transfer.From), *transfer.FromDerivedKey) {
+			transfer.Status = types.TransferStatusRejected
+			return fmt.Errorf("party %s does not own sub-account %s", transfer.From, *transfer.FromDerivedKey)
+		}
 	}
 
-	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey); err != nil {
+	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey, transfer.To, transfer.ToAccountType); err != nil {
 		transfer.Status = types.TransferStatusRejected
 		return err
 	}
@@ -172,6 +178,10 @@ func (e *Engine) oneOffTransfer(
 
 	// all was OK
 	transfer.Status = types.TransferStatusDone
+	if transfer.Condition != nil {
+		// the funds are escrowed until the condition is met
+		transfer.Status = types.TransferStatusPending
+	}
 	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
 
 	return nil
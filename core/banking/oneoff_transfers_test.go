@@ -42,6 +42,8 @@ func TestTransfers(t *testing.T) {
 	t.Run("valid oneoff transfer from derived key", testValidOneOffTransferWithFromDerivedKey)
 	t.Run("onefoff invalid transfers from derived key", testOneOffTransferInvalidTransfersWithFromDerivedKey)
 	t.Run("onefoff invalid owner transfers from derived key", testOneOffTransferInvalidOwnerTransfersWithFromDerivedKey)
+	t.Run("transfers between a party and its sub-accounts are free", testOneOffTransferToAndFromSubAccount)
+	t.Run("general account of a derived key that is not a sub-account cannot be emptied", testOneOffTransferFromDerivedKeyGeneralAccount)
 }
 
 func testRejectedIfDoesntReachMinimalAmount(t *testing.T) {
@@ -785,7 +787,7 @@ func testOneOffTransferInvalidTransfersWithFromDerivedKey(t *testing.T) {
 		e.broker.EXPECT().Send(gomock.Any()).Times(1)
 		baseCpy = transferBase
 		transfer.OneOff.TransferBase = &baseCpy
-		transfer.OneOff.FromAccountType = types.AccountTypeGeneral
+		transfer.OneOff.FromAccountType = types.AccountTypeLockedForStaking
 		assert.EqualError(t,
 			e.TransferFunds(ctx, &transfer),
 			types.ErrUnsupportedFromAccountType.Error(),
@@ -851,3 +853,101 @@ func testOneOffTransferInvalidOwnerTransfersWithFromDerivedKey(t *testing.T) {
 	e.broker.EXPECT().Send(gomock.Any()).Times(1)
 	assert.ErrorContains(t, e.TransferFunds(ctx, transfer), "does not own derived key")
 }
+
+func testOneOffTransferToAndFromSubAccount(t *testing.T) {
+	e := getTestEngine(t)
+
+	// let's do a massive fee, and minimal amount, easy to test
+	e.OnTransferFeeFactorUpdate(context.Background(), num.NewDecimalFromFloat(1))
+	e.OnMinTransferQuantumMultiple(context.Background(), num.DecimalFromFloat(1))
+
+	partyKey := "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301"
+	subAccount := "c84fbf3442a2a9f9ca87c9cefe686aed241ff49981dd8ce819dd532cd42a8427"
+	e.subAccounts[subAccount] = partyKey
+	amount := num.NewUint(10)
+
+	ctx := context.Background()
+	e.assets.EXPECT().Get(gomock.Any()).Times(2).Return(
+		assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(100)}), nil)
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	expectFreeTransfer := func(from, to string) {
+		e.col.EXPECT().GetPartyGeneralAccount(from, assetNameETH).Return(&types.Account{Balance: amount.Clone()}, nil).Times(1)
+		e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+			func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, feeTransfers []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+				assert.Len(t, transfers, 2)
+				assert.Equal(t, from, transfers[0].Owner)
+				assert.Equal(t, to, transfers[1].Owner)
+				assert.Equal(t, amount, transfers[0].Amount.Amount)
+				assert.Equal(t, []types.AccountType{types.AccountTypeGeneral, types.AccountTypeGeneral}, accountTypes)
+				assert.Len(t, feeTransfers, 1)
+				assert.True(t, feeTransfers[0].Amount.Amount.IsZero())
+				return nil, nil
+			})
+	}
+
+	// from the party to its sub-account, under the minimal amount and without fee.
+	expectFreeTransfer(partyKey, subAccount)
+	assert.NoError(t, e.TransferFunds(ctx, &types.TransferFunds{
+		Kind: types.TransferCommandKindOneOff,
+		OneOff: &types.OneOffTransfer{
+			TransferBase: &types.TransferBase{
+				From:            partyKey,
+				FromAccountType: types.AccountTypeGeneral,
+				To:              subAccount,
+				ToAccountType:   types.AccountTypeGeneral,
+				Asset:           assetNameETH,
+				Amount:          amount,
+				Reference:       "someref",
+			},
+		},
+	}))
+
+	// and back, signed by the party on behalf of its sub-account.
+	e.parties.EXPECT().CheckDerivedKeyOwnership(types.PartyID(partyKey), subAccount).Return(true).Times(1)
+	expectFreeTransfer(subAccount, partyKey)
+	assert.NoError(t, e.TransferFunds(ctx, &types.TransferFunds{
+		Kind: types.TransferCommandKindOneOff,
+		OneOff: &types.OneOffTransfer{
+			TransferBase: &types.TransferBase{
+				From:            partyKey,
+				FromDerivedKey:  &subAccount,
+				FromAccountType: types.AccountTypeGeneral,
+				To:              partyKey,
+				ToAccountType:   types.AccountTypeGeneral,
+				Asset:           assetNameETH,
+				Amount:          amount,
+				Reference:       "someref",
+			},
+		},
+	}))
+}
+
+func testOneOffTransferFromDerivedKeyGeneralAccount(t *testing.T) {
+	e := getTestEngine(t)
+
+	partyKey := "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301"
+	derivedKey := "c84fbf3442a2a9f9ca87c9cefe686aed241ff49981dd8ce819dd532cd42a8427"
+
+	ctx := context.Background()
+	e.assets.EXPECT().Get(gomock.Any()).Times(1).Return(
+		assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(100)}), nil)
+	e.parties.EXPECT().CheckDerivedKeyOwnership(types.PartyID(partyKey), derivedKey).Return(true).Times(1)
+	e.broker.EXPECT().Send(gomock.Any()).Times(1)
+
+	assert.EqualError(t, e.TransferFunds(ctx, &types.TransferFunds{
+		Kind: types.TransferCommandKindOneOff,
+		OneOff: &types.OneOffTransfer{
+			TransferBase: &types.TransferBase{
+				From:            partyKey,
+				FromDerivedKey:  &derivedKey,
+				FromAccountType: types.AccountTypeGeneral,
+				To:              partyKey,
+				ToAccountType:   types.AccountTypeGeneral,
+				Asset:           assetNameETH,
+				Amount:          num.NewUint(10),
+				Reference:       "someref",
+			},
+		},
+	}), fmt.Sprintf("party %s does not own sub-account %s", partyKey, derivedKey))
+}
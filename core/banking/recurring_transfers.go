@@ -87,7 +87,7 @@ func (e *Engine) recurringTransfer(
 		return err
 	}
 
-	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey); err != nil {
+	if err := e.ensureMinimalTransferAmount(a, transfer.Amount, transfer.FromAccountType, transfer.From, transfer.FromDerivedKey, transfer.To, transfer.ToAccountType); err != nil {
 		transfer.Status = types.TransferStatusRejected
 		return err
 	}
@@ -97,6 +97,11 @@ func (e *Engine) recurringTransfer(
 		return err
 	}
 
+	if err := e.checkVestingSchedule(transfer.DispatchStrategy, transfer.VestingSchedule); err != nil {
+		transfer.Status = types.TransferStatusRejected
+		return err
+	}
+
 	// can't create transfer with start epoch in the past
 	if transfer.StartEpoch < e.currentEpoch {
 		transfer.Status = types.TransferStatusRejected
@@ -108,6 +113,7 @@ func (e *Engine) recurringTransfer(
 	e.recurringTransfers = append(e.recurringTransfers, transfer)
 	e.recurringTransfersMap[transfer.ID] = transfer
 	e.registerDispatchStrategy(transfer.DispatchStrategy)
+	e.setVestingSchedule(transfer.DispatchStrategy, transfer.VestingSchedule)
 
 	return nil
 }
@@ -148,6 +154,47 @@ func (e *Engine) unregisterDispatchStrategy(ds *vegapb.DispatchStrategy) {
 	e.hashToStrategy[hash].refCount--
 }
 
+// checkVestingSchedule ensures the transfers funding the same reward account, i.e. with
+// the same dispatch strategy, apply the same vesting schedule to the rewards.
+func (e *Engine) checkVestingSchedule(ds *vegapb.DispatchStrategy, schedule *types.VestingSchedule) error {
+	if ds == nil {
+		if schedule != nil {
+			return types.ErrVestingScheduleWithoutDispatch
+		}
+		return nil
+	}
+	if schedule != nil {
+		if err := schedule.IsValid(); err != nil {
+			return err
+		}
+	}
+	entry, ok := e.hashToStrategy[e.hashDispatchStrategy(ds)]
+	if !ok || entry.refCount == 0 {
+		return nil
+	}
+	if !entry.vestingSchedule.Equal(schedule) {
+		return types.ErrVestingScheduleMismatch
+	}
+	return nil
+}
+
+func (e *Engine) setVestingSchedule(ds *vegapb.DispatchStrategy, schedule *types.VestingSchedule) {
+	if ds == nil {
+		return
+	}
+	e.hashToStrategy[e.hashDispatchStrategy(ds)].vestingSchedule = schedule
+}
+
+// GetVestingSchedule returns the vesting schedule of the rewards paid from the
+// reward account of the dispatch strategy, if any.
+func (e *Engine) GetVestingSchedule(hash string) *types.VestingSchedule {
+	ds, ok := e.hashToStrategy[hash]
+	if !ok || ds.refCount == 0 {
+		return nil
+	}
+	return ds.vestingSchedule
+}
+
 func (e *Engine) cleanupStaleDispatchStrategies() {
 	for hash, dsc := range e.hashToStrategy {
 		if dsc.refCount == 0 {
@@ -199,7 +246,8 @@ func (e *Engine) dispatchRequired(ctx context.Context, ds *vegapb.DispatchStrate
 		vegapb.DispatchMetric_DISPATCH_METRIC_RELATIVE_RETURN,
 		vegapb.DispatchMetric_DISPATCH_METRIC_RETURN_VOLATILITY,
 		vegapb.DispatchMetric_DISPATCH_METRIC_REALISED_RETURN,
-		vegapb.DispatchMetric_DISPATCH_METRIC_ELIGIBLE_ENTITIES:
+		vegapb.DispatchMetric_DISPATCH_METRIC_ELIGIBLE_ENTITIES,
+		vegapb.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH:
 		if ds.EntityScope == vegapb.EntityScope_ENTITY_SCOPE_INDIVIDUALS {
 			hasNonZeroMetric := false
 			partyMetrics := e.marketActivityTracker.CalculateMetricForIndividuals(ctx, ds)
@@ -302,7 +350,7 @@ func (e *Engine) distributeRecurringTransfers(ctx context.Context, newEpoch uint
 			e.log.Panic("this should never happen", logging.Error(err))
 		}
 
-		if err = e.ensureMinimalTransferAmount(a, amount, v.FromAccountType, v.From, v.FromDerivedKey); err != nil {
+		if err = e.ensureMinimalTransferAmount(a, amount, v.FromAccountType, v.From, v.FromDerivedKey, v.To, v.ToAccountType); err != nil {
 			v.Status = types.TransferStatusStopped
 			transfersDone = append(transfersDone,
 				events.NewRecurringTransferFundsEventWithReason(ctx, v, err.Error(), e.getGameID(v)))
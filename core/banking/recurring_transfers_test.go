@@ -867,3 +867,72 @@ func TestDispatchStrategyRemoval(t *testing.T) {
 	// still not there
 	assert.Nil(t, e.GetDispatchStrategy(dsHash))
 }
+
+func TestVestingScheduleMismatchRejectsTransfer(t *testing.T) {
+	e := getTestEngine(t)
+
+	dispatchStrat := &vega.DispatchStrategy{
+		AssetForMetric:       "zohar",
+		Metric:               vega.DispatchMetric_DISPATCH_METRIC_AVERAGE_NOTIONAL,
+		Markets:              []string{"mmm"},
+		EntityScope:          vega.EntityScope_ENTITY_SCOPE_INDIVIDUALS,
+		IndividualScope:      vega.IndividualScope_INDIVIDUAL_SCOPE_IN_TEAM,
+		WindowLength:         1,
+		LockPeriod:           1,
+		DistributionStrategy: vega.DistributionStrategy_DISTRIBUTION_STRATEGY_RANK,
+	}
+
+	p, err := proto.Marshal(dispatchStrat)
+	require.NoError(t, err)
+	dsHash := hex.EncodeToString(crypto.Hash(p))
+
+	schedule := &types.VestingSchedule{
+		Type:           types.VestingScheduleTypeLinear,
+		CliffEpochs:    2,
+		DurationEpochs: 10,
+	}
+
+	newTransfer := func(id, party string, schedule *types.VestingSchedule) *types.TransferFunds {
+		return &types.TransferFunds{
+			Kind: types.TransferCommandKindRecurring,
+			Recurring: &types.RecurringTransfer{
+				TransferBase: &types.TransferBase{
+					ID:              id,
+					From:            party,
+					FromAccountType: types.AccountTypeGeneral,
+					To:              "0000000000000000000000000000000000000000000000000000000000000000",
+					ToAccountType:   types.AccountTypeGlobalReward,
+					Asset:           assetNameETH,
+					Amount:          num.NewUint(100),
+					Reference:       "someref",
+				},
+				StartEpoch:       8,
+				Factor:           num.MustDecimalFromString("0.9"),
+				DispatchStrategy: dispatchStrat,
+				VestingSchedule:  schedule,
+			},
+		}
+	}
+
+	ctx := context.Background()
+	e.assets.EXPECT().Get(gomock.Any()).AnyTimes().Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(100)}), nil)
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.marketActivityTracker.EXPECT().MarketTrackedForAsset(gomock.Any(), gomock.Any()).AnyTimes().Return(true)
+
+	party1 := "03ae90688632c649c4beab6040ff5bd04dbde8efbf737d8673bbda792a110301"
+	party2 := "2e05fd230f3c9f4eaf0bdc5bfb7ca0c9d00278afc44637aab60da76653d7ccf0"
+
+	require.NoError(t, e.TransferFunds(ctx, newTransfer("TRANSFERID1", party1, schedule)))
+	assert.True(t, schedule.Equal(e.GetVestingSchedule(dsHash)))
+
+	// another transfer funding the same reward account must use the same schedule
+	require.ErrorIs(t, e.TransferFunds(ctx, newTransfer("TRANSFERID2", party2, nil)), types.ErrVestingScheduleMismatch)
+	require.NoError(t, e.TransferFunds(ctx, newTransfer("TRANSFERID3", party2, schedule.DeepClone())))
+
+	// a schedule without dispatch strategy is rejected
+	transfer := newTransfer("TRANSFERID4", party1, schedule)
+	transfer.Recurring.ToAccountType = types.AccountTypeGeneral
+	transfer.Recurring.To = party2
+	transfer.Recurring.DispatchStrategy = nil
+	require.ErrorIs(t, e.TransferFunds(ctx, transfer), types.ErrVestingScheduleWithoutDispatch)
+}
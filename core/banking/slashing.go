@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	vgcontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// SeizeStake takes up to amount of the staking asset a party holds on the network,
+// first from its locked for staking account then from its vested rewards, and moves
+// it into the global reward pool so it is redistributed to the stakers with the next
+// rewards. It returns the amount actually seized, the stake held on the Ethereum
+// bridge cannot be seized and stays slashed.
+func (e *Engine) SeizeStake(ctx context.Context, party string, amount *num.Uint) *num.Uint {
+	seized := num.UintZero()
+	if len(e.stakingAsset) <= 0 || amount.IsZero() {
+		return seized
+	}
+
+	transfers := []*types.Transfer{}
+	accountTypes := []types.AccountType{}
+	references := []string{}
+	for _, accountType := range []types.AccountType{types.AccountTypeLockedForStaking, types.AccountTypeVestedRewards} {
+		remaining := num.UintZero().Sub(amount, seized)
+		if remaining.IsZero() {
+			break
+		}
+
+		var (
+			account *types.Account
+			err     error
+		)
+		if accountType == types.AccountTypeLockedForStaking {
+			account, err = e.col.GetPartyLockedForStaking(party, e.stakingAsset)
+		} else {
+			account, err = e.col.GetPartyVestedRewardAccount(party, e.stakingAsset)
+		}
+		if err != nil || account.Balance.IsZero() {
+			continue
+		}
+
+		take := num.Min(remaining, account.Balance)
+		from, to := e.makeTransfers(party, "*", e.stakingAsset, "", "", take, nil)
+		transfers = append(transfers, from, to)
+		accountTypes = append(accountTypes, accountType, types.AccountTypeGlobalReward)
+		references = append(references, "stake-slashed", "stake-slashed")
+		seized.AddSum(take)
+	}
+
+	if seized.IsZero() {
+		return seized
+	}
+
+	tresps, err := e.col.TransferFunds(ctx, transfers, accountTypes, references, nil, nil)
+	if err != nil {
+		e.log.Error("could not seize the slashed stake",
+			logging.PartyID(party),
+			logging.String("amount", amount.String()),
+			logging.Error(err),
+		)
+		return num.UintZero()
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, tresps))
+
+	var (
+		now       = e.timeService.GetTimeNow().Unix()
+		height, _ = vgcontext.BlockHeightFromContext(ctx)
+		txhash, _ = vgcontext.TxHashFromContext(ctx)
+	)
+	stakeLinking := &types.StakeLinking{
+		ID:              crypto.HashStrToHex(fmt.Sprintf("%v%v%v", party, txhash, height)),
+		Type:            types.StakeLinkingTypeRemoved,
+		TS:              now,
+		Party:           party,
+		Amount:          seized.Clone(),
+		Status:          types.StakeLinkingStatusAccepted,
+		FinalizedAt:     now,
+		TxHash:          txhash,
+		BlockHeight:     height,
+		BlockTime:       now,
+		LogIndex:        1,
+		EthereumAddress: "",
+	}
+	e.stakeAccounting.AddEvent(ctx, stakeLinking)
+	e.broker.Send(events.NewStakeLinking(ctx, *stakeLinking))
+
+	return seized
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeizeStake(t *testing.T) {
+	t.Run("seize from locked for staking then vested rewards", testSeizeStakeFromBothAccounts)
+	t.Run("seize at most the staking asset held on the network", testSeizeStakeCappedToBalances)
+	t.Run("nothing seized without a staking asset", testSeizeStakeNoStakingAsset)
+}
+
+func testSeizeStakeFromBothAccounts(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.OnStakingAsset(ctx, "VEGA")
+
+	e.col.EXPECT().GetPartyLockedForStaking("party1", "VEGA").Times(1).Return(&types.Account{Balance: num.NewUint(30)}, nil)
+	e.col.EXPECT().GetPartyVestedRewardAccount("party1", "VEGA").Times(1).Return(&types.Account{Balance: num.NewUint(100)}, nil)
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string, _ []*types.Transfer, _ []types.AccountType) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 4)
+			assert.Equal(t, []types.AccountType{
+				types.AccountTypeLockedForStaking, types.AccountTypeGlobalReward,
+				types.AccountTypeVestedRewards, types.AccountTypeGlobalReward,
+			}, accountTypes)
+			assert.Equal(t, "30", transfers[0].Amount.Amount.String())
+			assert.Equal(t, "20", transfers[2].Amount.Amount.String())
+			assert.Equal(t, "*", transfers[3].Owner)
+			return nil, nil
+		})
+	e.broker.EXPECT().Send(gomock.Any()).Times(2)
+	e.stakeAccounting.EXPECT().AddEvent(gomock.Any(), gomock.Any()).Times(1).Do(
+		func(_ context.Context, evt *types.StakeLinking) {
+			assert.Equal(t, types.StakeLinkingTypeRemoved, evt.Type)
+			assert.Equal(t, "50", evt.Amount.String())
+		})
+
+	assert.Equal(t, "50", e.SeizeStake(ctx, "party1", num.NewUint(50)).String())
+}
+
+func testSeizeStakeCappedToBalances(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.OnStakingAsset(ctx, "VEGA")
+
+	e.col.EXPECT().GetPartyLockedForStaking("party1", "VEGA").Times(1).Return(&types.Account{Balance: num.NewUint(30)}, nil)
+	e.col.EXPECT().GetPartyVestedRewardAccount("party1", "VEGA").Times(1).Return(&types.Account{Balance: num.UintZero()}, nil)
+	e.col.EXPECT().TransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+	e.broker.EXPECT().Send(gomock.Any()).Times(2)
+	e.stakeAccounting.EXPECT().AddEvent(gomock.Any(), gomock.Any()).Times(1)
+
+	assert.Equal(t, "30", e.SeizeStake(ctx, "party1", num.NewUint(50)).String())
+}
+
+func testSeizeStakeNoStakingAsset(t *testing.T) {
+	e := getTestEngine(t)
+	assert.True(t, e.SeizeStake(context.Background(), "party1", num.NewUint(50)).IsZero())
+}
@@ -45,6 +45,9 @@ var (
 	recurringGovTransfersKey = (&types.PayloadBankingRecurringGovernanceTransfers{}).Key()
 	scheduledGovTransfersKey = (&types.PayloadBankingScheduledGovernanceTransfers{}).Key()
 	transferFeeDiscountsKey  = (&types.PayloadBankingTransferFeeDiscounts{}).Key()
+	teamCompetitionsKey      = (&types.PayloadBankingTeamCompetitions{}).Key()
+	conditionalTransfersKey  = (&types.PayloadBankingConditionalTransfers{}).Key()
+	withdrawalBatchesKey     = (&types.PayloadBankingWithdrawalBatches{}).Key()
 
 	hashKeys = []string{
 		withdrawalsKey,
@@ -58,6 +61,9 @@ var (
 		recurringGovTransfersKey,
 		scheduledGovTransfersKey,
 		transferFeeDiscountsKey,
+		teamCompetitionsKey,
+		conditionalTransfersKey,
+		withdrawalBatchesKey,
 	}
 )
 
@@ -73,6 +79,9 @@ type bankingSnapshotState struct {
 	serialisedGovRecurringTransfers []byte
 	serialisedGovScheduledTransfers []byte
 	serialisedTransferFeeDiscounts  []byte
+	serialisedTeamCompetitions      []byte
+	serialisedConditionalTransfers  []byte
+	serialisedWithdrawalBatches     []byte
 }
 
 func (e *Engine) Namespace() types.SnapshotNamespace {
@@ -104,15 +113,7 @@ func (e *Engine) serialisePrimaryBridgeState() ([]byte, error) {
 func (e *Engine) serialiseSecondaryBridgeState() ([]byte, error) {
 	payload := types.Payload{
 		Data: &types.PayloadBankingEVMBridgeStates{
-			// we only have one bridge state atm, its easy
-			BankingBridgeStates: []*checkpoint.BridgeState{
-				{
-					Active:      e.secondaryBridgeState.active,
-					BlockHeight: e.secondaryBridgeState.block,
-					LogIndex:    e.secondaryBridgeState.logIndex,
-					ChainId:     e.secondaryEthChainID,
-				},
-			},
+			BankingBridgeStates: e.getEVMBridgeStates(),
 		},
 	}
 	return proto.Marshal(payload.IntoProto())
@@ -206,10 +207,19 @@ func (e *Engine) serialiseWithdrawals() ([]byte, error) {
 
 	sort.SliceStable(withdrawals, func(i, j int) bool { return withdrawals[i].Ref < withdrawals[j].Ref })
 
+	delayed := make([]*types.DelayedWithdrawal, 0, len(e.delayedWithdrawals))
+	for _, d := range e.delayedWithdrawals {
+		delayed = append(delayed, &types.DelayedWithdrawal{
+			WithdrawalID: d.Withdrawal.ID,
+			ReleaseAt:    d.ReleaseAt.UnixNano(),
+		})
+	}
+
 	payload := types.Payload{
 		Data: &types.PayloadBankingWithdrawals{
 			BankingWithdrawals: &types.BankingWithdrawals{
-				Withdrawals: withdrawals,
+				Withdrawals:        withdrawals,
+				DelayedWithdrawals: delayed,
 			},
 		},
 	}
@@ -219,8 +229,7 @@ func (e *Engine) serialiseWithdrawals() ([]byte, error) {
 func (e *Engine) serialiseSeen() ([]byte, error) {
 	seen := &types.PayloadBankingSeen{
 		BankingSeen: &types.BankingSeen{
-			LastSeenPrimaryEthBlock:   e.lastSeenPrimaryEthBlock,
-			LastSeenSecondaryEthBlock: e.lastSeenSecondaryEthBlock,
+			LastSeenPrimaryEthBlock: e.lastSeenPrimaryEthBlock,
 		},
 	}
 	seen.BankingSeen.Refs = make([]string, 0, e.seenAssetActions.Size())
@@ -267,6 +276,37 @@ func (e *Engine) serialiseK(serialFunc func() ([]byte, error), dataField *[]byte
 	return data, nil
 }
 
+func (e *Engine) serialiseTeamCompetitions() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadBankingTeamCompetitions{
+			BankingTeamCompetitions: &snapshot.BankingTeamCompetitions{
+				TeamCompetitions: e.getTeamCompetitions(),
+			},
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
+func (e *Engine) serialiseConditionalTransfers() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadBankingConditionalTransfers{
+			BankingConditionalTransfers: &snapshot.BankingConditionalTransfers{
+				ConditionalTransfers: e.getConditionalTransfers(),
+			},
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
+func (e *Engine) serialiseWithdrawalBatches() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadBankingWithdrawalBatches{
+			BankingWithdrawalBatches: e.getWithdrawalBatches(),
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
 // get the serialised form and hash of the given key.
 func (e *Engine) serialise(k string) ([]byte, error) {
 	switch k {
@@ -288,6 +328,12 @@ func (e *Engine) serialise(k string) ([]byte, error) {
 		return e.serialiseK(e.serialiseScheduledGovernanceTransfers, &e.bss.serialisedGovScheduledTransfers)
 	case transferFeeDiscountsKey:
 		return e.serialiseK(e.serialisedTransferFeeDiscounts, &e.bss.serialisedTransferFeeDiscounts)
+	case teamCompetitionsKey:
+		return e.serialiseK(e.serialiseTeamCompetitions, &e.bss.serialisedTeamCompetitions)
+	case conditionalTransfersKey:
+		return e.serialiseK(e.serialiseConditionalTransfers, &e.bss.serialisedConditionalTransfers)
+	case withdrawalBatchesKey:
+		return e.serialiseK(e.serialiseWithdrawalBatches, &e.bss.serialisedWithdrawalBatches)
 	case primaryBridgeStateKey:
 		return e.serialiseK(e.serialisePrimaryBridgeState, &e.bss.serialisedPrimaryBridgeState)
 	case secondaryBridgeStateKey:
@@ -330,6 +376,12 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 		return nil, e.restoreSecondaryBridgeState(pl.BankingBridgeStates, p)
 	case *types.PayloadBankingTransferFeeDiscounts:
 		return nil, e.restoreTransferFeeDiscounts(pl.BankingTransferFeeDiscounts, p)
+	case *types.PayloadBankingTeamCompetitions:
+		return nil, e.restoreTeamCompetitions(ctx, pl.BankingTeamCompetitions, p)
+	case *types.PayloadBankingConditionalTransfers:
+		return nil, e.restoreConditionalTransfers(ctx, pl.BankingConditionalTransfers, p)
+	case *types.PayloadBankingWithdrawalBatches:
+		return nil, e.restoreWithdrawalBatches(pl.BankingWithdrawalBatches, p)
 	default:
 		return nil, types.ErrUnknownSnapshotType
 	}
@@ -384,12 +436,8 @@ func (e *Engine) restorePrimaryBridgeState(state *types.BankingBridgeState, p *t
 }
 
 func (e *Engine) restoreSecondaryBridgeState(state []*checkpoint.BridgeState, p *types.Payload) (err error) {
-	if state != nil {
-		e.secondaryBridgeState = &bridgeState{
-			active:   state[0].Active,
-			block:    state[0].BlockHeight,
-			logIndex: state[0].LogIndex,
-		}
+	for _, s := range state {
+		e.loadEVMBridgeState(s)
 	}
 
 	e.bss.serialisedSecondaryBridgeState, err = proto.Marshal(p.IntoProto())
@@ -418,6 +466,12 @@ func (e *Engine) restoreWithdrawals(withdrawals *types.BankingWithdrawals, p *ty
 			ref: ref,
 		}
 	}
+	for _, d := range withdrawals.DelayedWithdrawals {
+		e.delayedWithdrawals = append(e.delayedWithdrawals, &DelayedWithdrawal{
+			Withdrawal: e.withdrawals[d.WithdrawalID].w,
+			ReleaseAt:  time.Unix(0, d.ReleaseAt),
+		})
+	}
 
 	e.bss.serialisedWithdrawals, err = proto.Marshal(p.IntoProto())
 
@@ -442,19 +496,24 @@ func (e *Engine) restoreSeen(ctx context.Context, seen *types.BankingSeen, p *ty
 			e.primaryEthChainID,
 			seen.LastSeenPrimaryEthBlock,
 		)
+		secondary := e.secondaryEVMBridge()
 		e.log.Info("migration code updating primary bridge last seen",
-			logging.String("address", e.bridgeAddresses[e.secondaryEthChainID]),
+			logging.String("address", secondary.collateralAddress),
 			logging.Uint64("last-seen", seen.LastSeenSecondaryEthBlock),
 		)
 		e.ethEventSource.UpdateContractBlock(
-			e.bridgeAddresses[e.secondaryEthChainID],
-			e.secondaryEthChainID,
+			secondary.collateralAddress,
+			secondary.chainID,
 			seen.LastSeenSecondaryEthBlock,
 		)
 	}
 
 	e.lastSeenPrimaryEthBlock = seen.LastSeenPrimaryEthBlock
-	e.lastSeenSecondaryEthBlock = seen.LastSeenSecondaryEthBlock
+	// the last seen blocks of the EVM bridges are now part of their states,
+	// only snapshots produced before the bridge registry carry it here.
+	if seen.LastSeenSecondaryEthBlock != 0 {
+		e.secondaryEVMBridge().lastSeenBlock = seen.LastSeenSecondaryEthBlock
+	}
 	e.bss.serialisedSeen, err = proto.Marshal(p.IntoProto())
 	return err
 }
@@ -494,6 +553,32 @@ func (e *Engine) restoreTransferFeeDiscounts(
 	return
 }
 
+func (e *Engine) restoreTeamCompetitions(ctx context.Context, state *snapshot.BankingTeamCompetitions, p *types.Payload) error {
+	var err error
+	// ignore events here as we don't need to send them
+	_ = e.loadTeamCompetitions(ctx, state.TeamCompetitions)
+	e.bss.serialisedTeamCompetitions, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreConditionalTransfers(ctx context.Context, state *snapshot.BankingConditionalTransfers, p *types.Payload) error {
+	if err := e.loadConditionalTransfers(ctx, state.ConditionalTransfers); err != nil {
+		return err
+	}
+	var err error
+	e.bss.serialisedConditionalTransfers, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreWithdrawalBatches(state *snapshot.BankingWithdrawalBatches, p *types.Payload) error {
+	if err := e.loadWithdrawalBatches(state); err != nil {
+		return err
+	}
+	var err error
+	e.bss.serialisedWithdrawalBatches, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
 func (e *Engine) OnEpochRestore(_ context.Context, ep types.Epoch) {
 	e.log.Debug("epoch restoration notification received", logging.String("epoch", ep.String()))
 	e.currentEpoch = ep.Seq
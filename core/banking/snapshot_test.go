@@ -524,6 +524,12 @@ func TestRecurringTransfersSnapshotRoundTrip(t *testing.T) {
 				LockPeriod:           1,
 				DistributionStrategy: vega.DistributionStrategy_DISTRIBUTION_STRATEGY_RANK,
 			},
+			VestingSchedule: &types.VestingSchedule{
+				Type:           types.VestingScheduleTypeExponential,
+				CliffEpochs:    1,
+				DurationEpochs: 5,
+				DecayRate:      num.MustDecimalFromString("0.2"),
+			},
 		},
 	}
 
@@ -551,6 +557,7 @@ func TestRecurringTransfersSnapshotRoundTrip(t *testing.T) {
 	require.NotNil(t, eng.GetDispatchStrategy(dsHash))
 	require.NotNil(t, snap.GetDispatchStrategy(dsHash))
 	require.Equal(t, eng.GetDispatchStrategy(dsHash), snap.GetDispatchStrategy(dsHash))
+	require.True(t, recurring.Recurring.VestingSchedule.Equal(snap.GetVestingSchedule(dsHash)))
 }
 
 func TestRecurringGovTransfersSnapshotRoundTrip(t *testing.T) {
@@ -0,0 +1,361 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"golang.org/x/exp/maps"
+)
+
+const teamCompetitionReference = "team-competition"
+
+var (
+	ErrTeamCompetitionAlreadyExists          = errors.New("a team competition with the same ID already exists")
+	ErrTeamCompetitionNotFound               = errors.New("team competition not found")
+	ErrTeamCompetitionMustStartInFuture      = errors.New("team competition must start in a future epoch")
+	ErrTeamCompetitionAlreadyStarted         = errors.New("team competition has already started")
+	ErrNotTeamCompetitionCreator             = errors.New("only the creator of a team competition can cancel it")
+	ErrTeamCompetitionMarketNotTracked       = errors.New("market is not tracked for the asset of the team competition")
+	ErrNotEnoughFundsForTeamCompetitionPrize = errors.New("not enough funds to escrow the team competition prize pool")
+)
+
+// NewTeamCompetition escrows the prize pool of the competition, and registers
+// its dispatch strategy so the scores of the teams are published while it runs.
+// The prize pool is taken from the general account of the creator, or from the
+// network treasury if the competition is created by the network.
+func (e *Engine) NewTeamCompetition(ctx context.Context, competition *types.TeamCompetition) error {
+	if err := competition.IsValid(); err != nil {
+		return err
+	}
+	if _, ok := e.teamCompetitions[competition.ID]; ok {
+		return ErrTeamCompetitionAlreadyExists
+	}
+	if competition.StartEpoch <= e.currentEpoch {
+		return ErrTeamCompetitionMustStartInFuture
+	}
+	asset, err := e.assets.Get(competition.Asset)
+	if err != nil {
+		return fmt.Errorf("could not get asset %s: %w", competition.Asset, err)
+	}
+	for _, mkt := range competition.Markets {
+		if !e.marketActivityTracker.MarketTrackedForAsset(mkt, competition.Asset) {
+			return fmt.Errorf("%w: %s", ErrTeamCompetitionMarketNotTracked, mkt)
+		}
+	}
+
+	from, fromAcc := teamCompetitionAccount(competition.Creator)
+	if fromAcc == types.AccountTypeGeneral {
+		if err := e.ensureEnoughFundsForTransfer(asset.ToAssetType(), competition.PrizePool, from, fromAcc, nil, num.UintZero()); err != nil {
+			return err
+		}
+	} else {
+		balance, err := e.col.GetSystemAccountBalance(competition.Asset, "", fromAcc)
+		if err != nil {
+			return err
+		}
+		if balance.LT(competition.PrizePool) {
+			return ErrNotEnoughFundsForTeamCompetitionPrize
+		}
+	}
+
+	// the prize pool stays in the pending transfers account until the end of the competition.
+	fromTransfer, _ := e.makeTransfers(from, "", competition.Asset, "", "", competition.PrizePool, &competition.ID)
+	resps, err := e.col.GovernanceTransferFunds(ctx, []*types.Transfer{fromTransfer}, []types.AccountType{fromAcc}, []string{teamCompetitionReference})
+	if err != nil {
+		return err
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, resps))
+
+	ds := competition.DispatchStrategy()
+	competition.Status = types.TeamCompetitionStatusPending
+	competition.GameID = e.hashDispatchStrategy(ds)
+	e.teamCompetitions[competition.ID] = competition
+	e.registerDispatchStrategy(ds)
+	e.broker.Send(events.NewTeamCompetitionEvent(ctx, competition))
+	return nil
+}
+
+// CancelTeamCompetition refunds the prize pool of a competition that hasn't started yet.
+func (e *Engine) CancelTeamCompetition(ctx context.Context, party, competitionID string) error {
+	competition, ok := e.teamCompetitions[competitionID]
+	if !ok {
+		return ErrTeamCompetitionNotFound
+	}
+	if competition.Creator != party {
+		return ErrNotTeamCompetitionCreator
+	}
+	if competition.Status != types.TeamCompetitionStatusPending {
+		return ErrTeamCompetitionAlreadyStarted
+	}
+
+	if err := e.refundTeamCompetition(ctx, competition, competition.PrizePool); err != nil {
+		return err
+	}
+	competition.Status = types.TeamCompetitionStatusCancelled
+	e.unregisterDispatchStrategy(competition.DispatchStrategy())
+	e.broker.Send(events.NewTeamCompetitionEvent(ctx, competition))
+	delete(e.teamCompetitions, competitionID)
+	return nil
+}
+
+// GetTeamCompetition returns the ongoing competition with the given ID. The
+// competitions are dropped once settled or cancelled.
+func (e *Engine) GetTeamCompetition(competitionID string) (*types.TeamCompetition, error) {
+	competition, ok := e.teamCompetitions[competitionID]
+	if !ok {
+		return nil, ErrTeamCompetitionNotFound
+	}
+	return competition, nil
+}
+
+func (e *Engine) startTeamCompetitions(ctx context.Context, epoch uint64) {
+	ids := maps.Keys(e.teamCompetitions)
+	sort.Strings(ids)
+	for _, id := range ids {
+		competition := e.teamCompetitions[id]
+		if competition.Status == types.TeamCompetitionStatusPending && competition.StartEpoch <= epoch {
+			competition.Status = types.TeamCompetitionStatusActive
+			e.broker.Send(events.NewTeamCompetitionEvent(ctx, competition))
+		}
+	}
+}
+
+// settleTeamCompetitions pays out the prize pool of the competitions ending
+// with the given epoch. A competition that couldn't be settled is left active,
+// and its settlement is attempted again at the end of the next epoch.
+func (e *Engine) settleTeamCompetitions(ctx context.Context, epoch uint64) {
+	ids := maps.Keys(e.teamCompetitions)
+	sort.Strings(ids)
+	for _, id := range ids {
+		competition := e.teamCompetitions[id]
+		if competition.Status != types.TeamCompetitionStatusActive || competition.EndEpoch > epoch {
+			continue
+		}
+		if err := e.settleTeamCompetition(ctx, competition); err != nil {
+			e.log.Error("could not settle team competition",
+				logging.String("competition-id", competition.ID),
+				logging.Error(err),
+			)
+			continue
+		}
+		e.unregisterDispatchStrategy(competition.DispatchStrategy())
+		e.broker.Send(events.NewTeamCompetitionEvent(ctx, competition))
+		delete(e.teamCompetitions, id)
+	}
+}
+
+// settleTeamCompetition pays out the prize pool to the members of the winning
+// teams, and refunds whatever couldn't be allocated, because of rounding or a
+// lack of winners, to the creator. The competition is only marked as ended
+// once all the transfers went through.
+func (e *Engine) settleTeamCompetition(ctx context.Context, competition *types.TeamCompetition) error {
+	escrowed, err := e.col.GetSystemAccountBalance(competition.Asset, "", types.AccountTypePendingTransfers)
+	if err != nil {
+		return err
+	}
+	if escrowed.LT(competition.PrizePool) {
+		return fmt.Errorf("not enough funds escrowed for the prize pool, expected %s, got %s", competition.PrizePool, escrowed)
+	}
+
+	teamScores, membersScores := e.marketActivityTracker.CalculateMetricForTeams(ctx, competition.DispatchStrategy())
+	results := rankTeamCompetitionResults(competition, teamScores, membersScores)
+
+	transfers := []*types.Transfer{}
+	accountTypes := []types.AccountType{}
+	references := []string{}
+	paid := num.UintZero()
+	for _, result := range results {
+		for _, member := range result.Members {
+			_, toTransfer := e.makeTransfers("", string(member.Party), competition.Asset, "", "", member.Amount, &competition.ID)
+			transfers = append(transfers, toTransfer)
+			accountTypes = append(accountTypes, types.AccountTypeGeneral)
+			references = append(references, teamCompetitionReference)
+			paid.AddSum(member.Amount)
+		}
+	}
+	if remaining := num.UintZero().Sub(competition.PrizePool, paid); !remaining.IsZero() {
+		to, toAcc := teamCompetitionAccount(competition.Creator)
+		_, toTransfer := e.makeTransfers("", to, competition.Asset, "", "", remaining, &competition.ID)
+		transfers = append(transfers, toTransfer)
+		accountTypes = append(accountTypes, toAcc)
+		references = append(references, teamCompetitionReference)
+	}
+
+	resps, err := e.col.GovernanceTransferFunds(ctx, transfers, accountTypes, references)
+	if err != nil {
+		return err
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, resps))
+
+	competition.Results = results
+	competition.Status = types.TeamCompetitionStatusEnded
+	return nil
+}
+
+func (e *Engine) refundTeamCompetition(ctx context.Context, competition *types.TeamCompetition, amount *num.Uint) error {
+	to, toAcc := teamCompetitionAccount(competition.Creator)
+	_, toTransfer := e.makeTransfers("", to, competition.Asset, "", "", amount, &competition.ID)
+	resps, err := e.col.GovernanceTransferFunds(ctx, []*types.Transfer{toTransfer}, []types.AccountType{toAcc}, []string{teamCompetitionReference})
+	if err != nil {
+		return err
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, resps))
+	return nil
+}
+
+// teamCompetitionAccount returns the owner and the type of the account funding
+// a competition created by the given creator.
+func teamCompetitionAccount(creator string) (string, types.AccountType) {
+	if creator == types.NetworkParty {
+		return "*", types.AccountTypeNetworkTreasury
+	}
+	return creator, types.AccountTypeGeneral
+}
+
+// rankTeamCompetitionResults ranks the teams with a positive score, gives each
+// of them the share of the prize pool of their rank in the rank table, and
+// splits the payout of each team between its members proportionally to their
+// own score. Teams with the same score share the same rank.
+func rankTeamCompetitionResults(competition *types.TeamCompetition, teamScores []*types.PartyContributionScore, membersScores map[string][]*types.PartyContributionScore) []*types.TeamCompetitionResult {
+	scores := make([]*types.PartyContributionScore, 0, len(teamScores))
+	for _, ts := range teamScores {
+		if ts.Score.IsPositive() {
+			scores = append(scores, ts)
+		}
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score.Equal(scores[j].Score) {
+			return scores[i].Party < scores[j].Party
+		}
+		return scores[i].Score.GreaterThan(scores[j].Score)
+	})
+
+	results := make([]*types.TeamCompetitionResult, 0, len(scores))
+	shareRatios := make([]num.Decimal, 0, len(scores))
+	totalShareRatio := num.DecimalZero()
+	var rank uint64
+	for i, ts := range scores {
+		if i == 0 || !ts.Score.Equal(scores[i-1].Score) {
+			rank = uint64(i + 1)
+		}
+		shareRatio := num.DecimalFromInt64(int64(teamCompetitionShareRatio(competition.RankTable, rank)))
+		results = append(results, &types.TeamCompetitionResult{
+			Team:   types.TeamID(ts.Party),
+			Rank:   rank,
+			Score:  ts.Score,
+			Payout: num.UintZero(),
+		})
+		shareRatios = append(shareRatios, shareRatio)
+		totalShareRatio = totalShareRatio.Add(shareRatio)
+	}
+	if totalShareRatio.IsZero() {
+		return results
+	}
+
+	prizePool := competition.PrizePool.ToDecimal()
+	for i, result := range results {
+		payout, _ := num.UintFromDecimal(prizePool.Mul(shareRatios[i]).Div(totalShareRatio).Floor())
+		if payout.IsZero() {
+			continue
+		}
+		result.Members = splitTeamPayout(payout, membersScores[string(result.Team)])
+		for _, member := range result.Members {
+			result.Payout.AddSum(member.Amount)
+		}
+	}
+	return results
+}
+
+// splitTeamPayout splits the payout of a team between its members with a positive
+// score, proportionally to their score.
+func splitTeamPayout(payout *num.Uint, membersScores []*types.PartyContributionScore) []*types.TeamCompetitionPayout {
+	totalScore := num.DecimalZero()
+	for _, ms := range membersScores {
+		if ms.Score.IsPositive() {
+			totalScore = totalScore.Add(ms.Score)
+		}
+	}
+	if totalScore.IsZero() {
+		return nil
+	}
+
+	payouts := []*types.TeamCompetitionPayout{}
+	for _, ms := range membersScores {
+		if !ms.Score.IsPositive() {
+			continue
+		}
+		amount, _ := num.UintFromDecimal(payout.ToDecimal().Mul(ms.Score).Div(totalScore).Floor())
+		if amount.IsZero() {
+			continue
+		}
+		payouts = append(payouts, &types.TeamCompetitionPayout{
+			Party:  types.PartyID(ms.Party),
+			Amount: amount,
+		})
+	}
+	sort.Slice(payouts, func(i, j int) bool { return payouts[i].Party < payouts[j].Party })
+	return payouts
+}
+
+func teamCompetitionShareRatio(rankTable []*vegapb.Rank, rank uint64) uint32 {
+	var lastSeen *vegapb.Rank
+	for _, r := range rankTable {
+		if uint64(r.StartRank) > rank {
+			break
+		}
+		lastSeen = r
+	}
+	if lastSeen == nil {
+		return 0
+	}
+	return lastSeen.ShareRatio
+}
+
+// getTeamCompetitions returns the competitions ordered by ID.
+func (e *Engine) getTeamCompetitions() []*vegapb.TeamCompetition {
+	ids := maps.Keys(e.teamCompetitions)
+	sort.Strings(ids)
+	competitions := make([]*vegapb.TeamCompetition, 0, len(ids))
+	for _, id := range ids {
+		competitions = append(competitions, e.teamCompetitions[id].IntoProto())
+	}
+	return competitions
+}
+
+func (e *Engine) loadTeamCompetitions(ctx context.Context, competitions []*vegapb.TeamCompetition) []events.Event {
+	evts := make([]events.Event, 0, len(competitions))
+	for _, c := range competitions {
+		competition := types.TeamCompetitionFromProto(c)
+		// the competitions settled or cancelled are no longer kept
+		if !competition.IsOngoing() {
+			continue
+		}
+		e.teamCompetitions[competition.ID] = competition
+		e.registerDispatchStrategy(competition.DispatchStrategy())
+		evts = append(evts, events.NewTeamCompetitionEvent(ctx, competition))
+	}
+	return evts
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	"code.vegaprotocol.io/vega/protos/vega"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamCompetitions(t *testing.T) {
+	t.Run("prize pool is escrowed and paid out to the winning teams", testTeamCompetitionPayout)
+	t.Run("cancelling a competition refunds the prize pool", testTeamCompetitionCancel)
+	t.Run("competition must start in the future", testTeamCompetitionStartInThePast)
+	t.Run("failed payout leaves the competition active until it succeeds", testTeamCompetitionFailedPayoutIsRetried)
+	t.Run("competitions are restored from a snapshot", testTeamCompetitionSnapshotRoundTrip)
+}
+
+func newTestTeamCompetition() *types.TeamCompetition {
+	return &types.TeamCompetition{
+		ID:         "competition1",
+		Creator:    "creator",
+		Asset:      assetNameETH,
+		PrizePool:  num.NewUint(1000),
+		StartEpoch: 2,
+		EndEpoch:   3,
+		Metric:     vega.DispatchMetric_DISPATCH_METRIC_MAKER_FEES_PAID,
+		RankTable: []*vega.Rank{
+			{StartRank: 1, ShareRatio: 2},
+			{StartRank: 2, ShareRatio: 1},
+			{StartRank: 3, ShareRatio: 0},
+		},
+	}
+}
+
+func expectTeamCompetitionEscrow(t *testing.T, e *testEngine) {
+	t.Helper()
+	e.assets.EXPECT().Get(gomock.Any()).Times(1).Return(assets.NewAsset(&mockAsset{name: assetNameETH, quantum: num.DecimalFromFloat(100)}), nil)
+	e.col.EXPECT().GetPartyGeneralAccount("creator", assetNameETH).Times(1).Return(&types.Account{Balance: num.NewUint(5000)}, nil)
+	e.col.EXPECT().GovernanceTransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, "creator", transfers[0].Owner)
+			assert.Equal(t, types.TransferTypeTransferFundsSend, transfers[0].Type)
+			assert.Equal(t, "1000", transfers[0].Amount.Amount.String())
+			assert.Equal(t, types.AccountTypeGeneral, accountTypes[0])
+			return nil, nil
+		})
+}
+
+func testTeamCompetitionPayout(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.marketActivityTracker.EXPECT().TeamStatsForMarkets(gomock.Any(), gomock.Any()).AnyTimes()
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_START})
+	expectTeamCompetitionEscrow(t, e)
+	require.NoError(t, e.NewTeamCompetition(ctx, newTestTeamCompetition()))
+
+	competition, err := e.GetTeamCompetition("competition1")
+	require.NoError(t, err)
+	require.Equal(t, types.TeamCompetitionStatusPending, competition.Status)
+	require.NotEmpty(t, competition.GameID)
+	require.NotNil(t, e.GetDispatchStrategy(competition.GameID))
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_END})
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_START})
+	require.Equal(t, types.TeamCompetitionStatusActive, competition.Status)
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_END})
+	e.OnEpoch(ctx, types.Epoch{Seq: 3, Action: vega.EpochAction_EPOCH_ACTION_START})
+
+	e.marketActivityTracker.EXPECT().CalculateMetricForTeams(gomock.Any(), gomock.Any()).Times(1).Return(
+		[]*types.PartyContributionScore{
+			{Party: "team2", Score: num.DecimalFromFloat(0.4)},
+			{Party: "team1", Score: num.DecimalFromFloat(0.6)},
+			{Party: "team3", Score: num.DecimalZero()},
+		},
+		map[string][]*types.PartyContributionScore{
+			"team1": {
+				{Party: "p1", Score: num.DecimalFromFloat(0.3)},
+				{Party: "p2", Score: num.DecimalFromFloat(0.1)},
+			},
+			"team2": {
+				{Party: "p3", Score: num.DecimalFromFloat(0.5)},
+			},
+		},
+	)
+
+	paid := map[string]string{}
+	e.col.EXPECT().GetSystemAccountBalance(assetNameETH, "", types.AccountTypePendingTransfers).Times(1).Return(num.NewUint(1000), nil)
+	e.col.EXPECT().GovernanceTransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string) ([]*types.LedgerMovement, error) {
+			for i, tr := range transfers {
+				assert.Equal(t, types.TransferTypeTransferFundsDistribute, tr.Type)
+				assert.Equal(t, types.AccountTypeGeneral, accountTypes[i])
+				paid[tr.Owner] = tr.Amount.Amount.String()
+			}
+			return nil, nil
+		})
+	e.OnEpoch(ctx, types.Epoch{Seq: 3, Action: vega.EpochAction_EPOCH_ACTION_END})
+
+	// team1 gets 2/3 of the prize pool = 666, split 3:1 between its members,
+	// team2 gets 1/3 = 333, and the 2 left from rounding go back to the creator.
+	require.Equal(t, map[string]string{
+		"p1":      "499",
+		"p2":      "166",
+		"p3":      "333",
+		"creator": "2",
+	}, paid)
+
+	require.Equal(t, types.TeamCompetitionStatusEnded, competition.Status)
+	require.Len(t, competition.Results, 2)
+	require.Equal(t, types.TeamID("team1"), competition.Results[0].Team)
+	require.Equal(t, uint64(1), competition.Results[0].Rank)
+	require.Equal(t, "665", competition.Results[0].Payout.String())
+	require.Equal(t, types.TeamID("team2"), competition.Results[1].Team)
+	require.Equal(t, uint64(2), competition.Results[1].Rank)
+	require.Equal(t, "333", competition.Results[1].Payout.String())
+
+	// the settled competition is no longer kept
+	_, err = e.GetTeamCompetition("competition1")
+	require.ErrorIs(t, err, banking.ErrTeamCompetitionNotFound)
+}
+
+func testTeamCompetitionCancel(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_START})
+	expectTeamCompetitionEscrow(t, e)
+	competition := newTestTeamCompetition()
+	require.NoError(t, e.NewTeamCompetition(ctx, competition))
+
+	require.ErrorIs(t, e.CancelTeamCompetition(ctx, "someone-else", "competition1"), banking.ErrNotTeamCompetitionCreator)
+	require.ErrorIs(t, e.CancelTeamCompetition(ctx, "creator", "unknown"), banking.ErrTeamCompetitionNotFound)
+
+	e.col.EXPECT().GovernanceTransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+		func(_ context.Context, transfers []*types.Transfer, accountTypes []types.AccountType, _ []string) ([]*types.LedgerMovement, error) {
+			require.Len(t, transfers, 1)
+			assert.Equal(t, "creator", transfers[0].Owner)
+			assert.Equal(t, types.TransferTypeTransferFundsDistribute, transfers[0].Type)
+			assert.Equal(t, "1000", transfers[0].Amount.Amount.String())
+			return nil, nil
+		})
+	require.NoError(t, e.CancelTeamCompetition(ctx, "creator", "competition1"))
+
+	require.Equal(t, types.TeamCompetitionStatusCancelled, competition.Status)
+	require.Nil(t, e.GetDispatchStrategy(competition.GameID))
+
+	// the cancelled competition is no longer kept
+	_, err := e.GetTeamCompetition("competition1")
+	require.ErrorIs(t, err, banking.ErrTeamCompetitionNotFound)
+	require.ErrorIs(t, e.CancelTeamCompetition(ctx, "creator", "competition1"), banking.ErrTeamCompetitionNotFound)
+}
+
+func testTeamCompetitionStartInThePast(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_START})
+	require.ErrorIs(t, e.NewTeamCompetition(ctx, newTestTeamCompetition()), banking.ErrTeamCompetitionMustStartInFuture)
+}
+
+func testTeamCompetitionFailedPayoutIsRetried(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_START})
+	expectTeamCompetitionEscrow(t, e)
+	competition := newTestTeamCompetition()
+	competition.StartEpoch, competition.EndEpoch = 2, 2
+	require.NoError(t, e.NewTeamCompetition(ctx, competition))
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_START})
+
+	scores := []*types.PartyContributionScore{{Party: "team1", Score: num.DecimalFromFloat(1)}}
+	membersScores := map[string][]*types.PartyContributionScore{"team1": {{Party: "p1", Score: num.DecimalFromFloat(1)}}}
+	e.marketActivityTracker.EXPECT().CalculateMetricForTeams(gomock.Any(), gomock.Any()).Times(2).Return(scores, membersScores)
+	e.col.EXPECT().GetSystemAccountBalance(assetNameETH, "", types.AccountTypePendingTransfers).Times(2).Return(num.NewUint(1000), nil)
+
+	gomock.InOrder(
+		e.col.EXPECT().GovernanceTransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("transfer failed")),
+		e.col.EXPECT().GovernanceTransferFunds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil, nil),
+	)
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_END})
+	require.Equal(t, types.TeamCompetitionStatusActive, competition.Status)
+	require.Empty(t, competition.Results)
+	require.NotNil(t, e.GetDispatchStrategy(competition.GameID))
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 3, Action: vega.EpochAction_EPOCH_ACTION_START})
+	e.OnEpoch(ctx, types.Epoch{Seq: 3, Action: vega.EpochAction_EPOCH_ACTION_END})
+	require.Equal(t, types.TeamCompetitionStatusEnded, competition.Status)
+	require.Len(t, competition.Results, 1)
+	require.Nil(t, e.GetDispatchStrategy(competition.GameID))
+}
+
+func testTeamCompetitionSnapshotRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	key := (&types.PayloadBankingTeamCompetitions{}).Key()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_START})
+	expectTeamCompetitionEscrow(t, e)
+	require.NoError(t, e.NewTeamCompetition(ctx, newTestTeamCompetition()))
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+	snap := getTestEngine(t)
+	_, err = snap.LoadState(ctx, types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	statePostReload, _, err := snap.GetState(key)
+	require.NoError(t, err)
+	require.Equal(t, state, statePostReload)
+
+	restored, err := snap.GetTeamCompetition("competition1")
+	require.NoError(t, err)
+	competition, _ := e.GetTeamCompetition("competition1")
+	require.Equal(t, competition, restored)
+	require.NotNil(t, snap.GetDispatchStrategy(restored.GameID))
+}
@@ -91,7 +91,7 @@ func (e *Engine) CheckTransfer(t *types.TransferBase) error {
 		return fmt.Errorf("could not transfer funds, %w", err)
 	}
 
-	if err := e.ensureMinimalTransferAmount(a, t.Amount, t.FromAccountType, t.From, t.FromDerivedKey); err != nil {
+	if err := e.ensureMinimalTransferAmount(a, t.Amount, t.FromAccountType, t.From, t.FromDerivedKey, t.To, t.ToAccountType); err != nil {
 		return err
 	}
 
@@ -107,7 +107,14 @@ func (e *Engine) ensureMinimalTransferAmount(
 	fromAccType types.AccountType,
 	from string,
 	fromSubAccount *string,
+	to string,
+	toAccType types.AccountType,
 ) error {
+	// rebalancing between a party and its sub-accounts has no minimum.
+	if e.isSubAccountTransfer(from, fromAccType, fromSubAccount, to, toAccType) {
+		return nil
+	}
+
 	quantum := a.Type().Details.Quantum
 	// no reason this would produce an error
 	minAmount, _ := num.UintFromDecimal(quantum.Mul(e.minTransferQuantumMultiple))
@@ -184,7 +191,12 @@ func (e *Engine) processTransfer(
 
 	// does the transfer needs to be finalized now?
 	now := e.timeService.GetTimeNow()
-	if oneoff == nil || (oneoff.DeliverOn == nil || oneoff.DeliverOn.Before(now)) {
+	if oneoff != nil && oneoff.Condition != nil {
+		// hold the funds until the condition is met
+		if err := e.addConditionalTransfer(ctx, oneoff, toTransfer, toAcc, reference); err != nil {
+			return nil, err
+		}
+	} else if oneoff == nil || (oneoff.DeliverOn == nil || oneoff.DeliverOn.Before(now)) {
 		transfers = append(transfers, toTransfer)
 		accountTypes = append(accountTypes, toAcc)
 		references = append(references, reference)
@@ -206,6 +218,9 @@ func (e *Engine) processTransfer(
 		ctx, transfers, accountTypes, references, []*types.Transfer{feeTransfer}, feeTransferAccountType,
 	)
 	if err != nil {
+		if oneoff != nil && oneoff.Condition != nil {
+			e.removeConditionalTransfer(ctx, oneoff.ID)
+		}
 		return nil, err
 	}
 
@@ -251,6 +266,9 @@ func (e *Engine) calculateFeeTransferForTransfer(
 	to string,
 	toAccountType types.AccountType,
 ) *num.Uint {
+	if e.isSubAccountTransfer(from, fromAccountType, fromDerivedKey, to, toAccountType) {
+		return num.UintZero()
+	}
 	return calculateFeeForTransfer(
 		asset.Details.Quantum,
 		e.maxQuantumAmount,
@@ -332,6 +350,11 @@ func (e *Engine) ensureEnoughFundsForTransfer(
 	)
 	switch fromAccountType {
 	case types.AccountTypeGeneral:
+		// sending from sub account to owners general account
+		if fromDerivedKey != nil {
+			from = *fromDerivedKey
+		}
+
 		account, err = e.col.GetPartyGeneralAccount(from, asset.ID)
 		if err != nil {
 			return err
@@ -374,3 +397,22 @@ func (e *Engine) ensureEnoughFundsForTransfer(
 	}
 	return nil
 }
+
+// isSubAccountTransfer returns true if the transfer moves funds between the general
+// accounts of a party and its sub-accounts, which is free of fee and minimum amount.
+func (e *Engine) isSubAccountTransfer(
+	from string,
+	fromAccountType types.AccountType,
+	fromDerivedKey *string,
+	to string,
+	toAccountType types.AccountType,
+) bool {
+	if fromAccountType != types.AccountTypeGeneral || toAccountType != types.AccountTypeGeneral {
+		return false
+	}
+	if fromDerivedKey != nil && !e.parties.CheckSubAccountOwnership(types.PartyID(from), *fromDerivedKey) {
+		return false
+	}
+	// either back to the party itself from one of its sub-account, or to one of its sub-accounts.
+	return (to == from && fromDerivedKey != nil) || e.parties.CheckSubAccountOwnership(types.PartyID(from), to)
+}
@@ -0,0 +1,335 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/assets/erc20"
+	"code.vegaprotocol.io/vega/core/bridges"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/logging"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"golang.org/x/exp/maps"
+)
+
+var (
+	ErrWithdrawalNotBatched        = errors.New("withdrawal is not part of a withdrawal batch")
+	ErrWithdrawalBatchNotSignedYet = errors.New("withdrawal batch is not signed yet")
+	ErrInvalidWithdrawalBatchRef   = errors.New("invalid withdrawal batch reference")
+	ErrInvalidWithdrawalRef        = errors.New("invalid withdrawal reference")
+)
+
+// withdrawalBatch aggregates the withdrawals made on the same bridge during an
+// epoch, so a single bundle, signing the root of the Merkle tree of the
+// withdrawals, is required for all of them.
+type withdrawalBatch struct {
+	id      string
+	chainID string
+	ref     *big.Int
+	// signingAsset is the asset used to sign the batch, all the assets of a batch
+	// are on the same bridge.
+	signingAsset string
+	root         []byte
+	// withdrawals and leaves are in the same order.
+	withdrawals []string
+	leaves      [][]byte
+}
+
+// WithdrawalBatchProof holds what the receiver of a batched withdrawal needs to
+// claim it on the bridge.
+type WithdrawalBatchProof struct {
+	BatchID    string
+	Nonce      *big.Int
+	Root       []byte
+	Leaf       []byte
+	Proof      [][]byte
+	Signatures []types.NodeSignature
+}
+
+func (e *Engine) OnWithdrawalBatchingEnabledUpdate(_ context.Context, enabled int64) error {
+	e.withdrawalBatching = enabled == 1
+	return nil
+}
+
+// addToWithdrawalBatch queues a finalized withdrawal, it is bundled with the
+// other withdrawals of the same bridge at the end of the epoch.
+func (e *Engine) addToWithdrawalBatch(w *types.Withdrawal, asset *erc20.ERC20) {
+	chainID := asset.ChainID()
+	e.pendingWithdrawalBatches[chainID] = append(e.pendingWithdrawalBatches[chainID], w.ID)
+}
+
+// startWithdrawalBatches creates a batch per bridge with the withdrawals queued
+// during the epoch, and starts aggregating the signatures of their bundle.
+func (e *Engine) startWithdrawalBatches() {
+	chainIDs := maps.Keys(e.pendingWithdrawalBatches)
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		batch := e.newWithdrawalBatch(chainID, e.pendingWithdrawalBatches[chainID])
+		e.withdrawalBatches[batch.id] = batch
+		for _, id := range batch.withdrawals {
+			e.withdrawalToBatch[id] = batch.id
+		}
+
+		var signature []byte
+		if e.top.IsValidator() {
+			signature = e.signWithdrawalBatch(batch)
+		}
+		e.notary.StartAggregate(batch.id, types.NodeSignatureKindAssetWithdrawal, signature)
+	}
+	e.pendingWithdrawalBatches = map[string][]string{}
+}
+
+func (e *Engine) newWithdrawalBatch(chainID string, ids []string) *withdrawalBatch {
+	now := e.timeService.GetTimeNow()
+	// same as the withdrawals, the reference needs to be an int, deterministic for the contracts.
+	ref := big.NewInt(0).Add(e.withdrawalCnt, big.NewInt(now.Unix()))
+	e.withdrawalCnt.Add(e.withdrawalCnt, big.NewInt(1))
+
+	batch := &withdrawalBatch{
+		id:          crypto.HashStrToHex("withdrawal-batch" + chainID + strings.Join(ids, "")),
+		chainID:     chainID,
+		ref:         ref,
+		withdrawals: ids,
+		leaves:      make([][]byte, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		wref := e.withdrawals[id]
+		asset := e.erc20AssetForWithdrawal(wref.w)
+		if len(batch.signingAsset) == 0 {
+			batch.signingAsset = wref.w.Asset
+		}
+		leaf, err := asset.WithdrawalLeaf(
+			wref.w.Amount, wref.w.Ext.GetErc20().GetReceiverAddress(), wref.ref, time.Unix(0, wref.w.CreationDate))
+		if err != nil {
+			// the withdrawal was validated when it was made,
+			// there's no reason we cannot build its leaf.
+			e.log.Panic("unable to build withdrawal leaf",
+				logging.WithdrawalID(id),
+				logging.Error(err))
+		}
+		batch.leaves = append(batch.leaves, leaf)
+	}
+	batch.root = bridges.MerkleRoot(batch.leaves)
+	return batch
+}
+
+func (e *Engine) signWithdrawalBatch(batch *withdrawalBatch) []byte {
+	asset, err := e.assets.Get(batch.signingAsset)
+	if err != nil {
+		e.log.Panic("unable to get asset when signing withdrawal batch",
+			logging.String("batch-id", batch.id),
+			logging.AssetID(batch.signingAsset),
+			logging.Error(err))
+	}
+	erc20asset, _ := asset.ERC20()
+	_, signature, err := erc20asset.SignWithdrawalBatch(batch.root, batch.ref)
+	if err != nil {
+		// there's no reason we cannot build the signature here
+		// apart if the node isn't configure properly
+		e.log.Panic("unable to sign withdrawal batch",
+			logging.String("batch-id", batch.id),
+			logging.Error(err))
+	}
+	return signature
+}
+
+func (e *Engine) erc20AssetForWithdrawal(w *types.Withdrawal) *erc20.ERC20 {
+	asset, err := e.assets.Get(w.Asset)
+	if err != nil {
+		e.log.Panic("unable to get asset of withdrawal",
+			logging.WithdrawalID(w.ID),
+			logging.AssetID(w.Asset),
+			logging.Error(err))
+	}
+	erc20asset, _ := asset.ERC20()
+	return erc20asset
+}
+
+// isWithdrawalSigned returns true if the bundle allowing to claim the
+// withdrawal, on its own or as part of a batch, is signed.
+func (e *Engine) isWithdrawalSigned(ctx context.Context, id string) bool {
+	resource := id
+	if batchID, ok := e.withdrawalToBatch[id]; ok {
+		resource = batchID
+	}
+	_, ok := e.notary.IsSigned(ctx, resource, types.NodeSignatureKindAssetWithdrawal)
+	return ok
+}
+
+// GetWithdrawalBatchProof returns the Merkle proof, and the signatures of the
+// batch, allowing to claim the given batched withdrawal.
+func (e *Engine) GetWithdrawalBatchProof(ctx context.Context, withdrawalID string) (*WithdrawalBatchProof, error) {
+	batchID, ok := e.withdrawalToBatch[withdrawalID]
+	if !ok {
+		return nil, ErrWithdrawalNotBatched
+	}
+	signatures, ok := e.notary.IsSigned(ctx, batchID, types.NodeSignatureKindAssetWithdrawal)
+	if !ok {
+		return nil, ErrWithdrawalBatchNotSignedYet
+	}
+
+	batch := e.withdrawalBatches[batchID]
+	index := 0
+	for i, id := range batch.withdrawals {
+		if id == withdrawalID {
+			index = i
+			break
+		}
+	}
+	return &WithdrawalBatchProof{
+		BatchID:    batch.id,
+		Nonce:      batch.ref,
+		Root:       batch.root,
+		Leaf:       batch.leaves[index],
+		Proof:      bridges.MerkleProof(batch.leaves, index),
+		Signatures: signatures,
+	}, nil
+}
+
+func (e *Engine) getWithdrawalBatches() *snapshot.BankingWithdrawalBatches {
+	out := &snapshot.BankingWithdrawalBatches{
+		PendingWithdrawalBatches: make([]*snapshot.PendingWithdrawalBatch, 0, len(e.pendingWithdrawalBatches)),
+		WithdrawalBatches:        make([]*snapshot.WithdrawalBatch, 0, len(e.withdrawalBatches)),
+	}
+
+	for chainID, ids := range e.pendingWithdrawalBatches {
+		out.PendingWithdrawalBatches = append(out.PendingWithdrawalBatches, &snapshot.PendingWithdrawalBatch{
+			ChainId:     chainID,
+			Withdrawals: ids,
+		})
+	}
+	sort.Slice(out.PendingWithdrawalBatches, func(i, j int) bool {
+		return out.PendingWithdrawalBatches[i].ChainId < out.PendingWithdrawalBatches[j].ChainId
+	})
+
+	for _, batch := range e.withdrawalBatches {
+		out.WithdrawalBatches = append(out.WithdrawalBatches, &snapshot.WithdrawalBatch{
+			Id:           batch.id,
+			ChainId:      batch.chainID,
+			Ref:          batch.ref.String(),
+			SigningAsset: batch.signingAsset,
+			Root:         batch.root,
+			Withdrawals:  batch.withdrawals,
+			Leaves:       batch.leaves,
+		})
+	}
+	sort.Slice(out.WithdrawalBatches, func(i, j int) bool {
+		return out.WithdrawalBatches[i].Id < out.WithdrawalBatches[j].Id
+	})
+
+	return out
+}
+
+func (e *Engine) loadWithdrawalBatches(state *snapshot.BankingWithdrawalBatches) error {
+	e.pendingWithdrawalBatches = make(map[string][]string, len(state.PendingWithdrawalBatches))
+	for _, v := range state.PendingWithdrawalBatches {
+		e.pendingWithdrawalBatches[v.ChainId] = v.Withdrawals
+	}
+
+	e.withdrawalBatches = make(map[string]*withdrawalBatch, len(state.WithdrawalBatches))
+	e.withdrawalToBatch = map[string]string{}
+	for _, v := range state.WithdrawalBatches {
+		ref, ok := big.NewInt(0).SetString(v.Ref, 10)
+		if !ok {
+			return ErrInvalidWithdrawalBatchRef
+		}
+		e.withdrawalBatches[v.Id] = &withdrawalBatch{
+			id:           v.Id,
+			chainID:      v.ChainId,
+			ref:          ref,
+			signingAsset: v.SigningAsset,
+			root:         v.Root,
+			withdrawals:  v.Withdrawals,
+			leaves:       v.Leaves,
+		}
+		for _, id := range v.Withdrawals {
+			e.withdrawalToBatch[id] = v.Id
+		}
+	}
+	return nil
+}
+
+// getCheckpointWithdrawalBatches returns the withdrawals queued for the next
+// batches. Their funds were already taken from the parties, so they are
+// checkpointed with the withdrawals themselves to be batched after the restore.
+func (e *Engine) getCheckpointWithdrawalBatches() []*checkpoint.PendingWithdrawalBatch {
+	chainIDs := maps.Keys(e.pendingWithdrawalBatches)
+	sort.Strings(chainIDs)
+
+	out := make([]*checkpoint.PendingWithdrawalBatch, 0, len(chainIDs))
+	for _, chainID := range chainIDs {
+		ids := e.pendingWithdrawalBatches[chainID]
+		batch := &checkpoint.PendingWithdrawalBatch{
+			ChainId:     chainID,
+			Withdrawals: make([]*checkpoint.Withdrawal, 0, len(ids)),
+		}
+		for _, id := range ids {
+			batch.Withdrawals = append(batch.Withdrawals, e.getCheckpointWithdrawal(id))
+		}
+		out = append(out, batch)
+	}
+	return out
+}
+
+func (e *Engine) loadCheckpointWithdrawalBatches(ctx context.Context, batches []*checkpoint.PendingWithdrawalBatch) ([]events.Event, error) {
+	evts := []events.Event{}
+	for _, v := range batches {
+		ids := make([]string, 0, len(v.Withdrawals))
+		for _, pw := range v.Withdrawals {
+			w, err := e.loadCheckpointWithdrawal(pw)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, w.ID)
+			evts = append(evts, events.NewWithdrawalEvent(ctx, *w))
+		}
+		e.pendingWithdrawalBatches[v.ChainId] = ids
+	}
+	return evts, nil
+}
+
+func (e *Engine) getCheckpointWithdrawal(id string) *checkpoint.Withdrawal {
+	wref := e.withdrawals[id]
+	return &checkpoint.Withdrawal{
+		Ref:        wref.ref.String(),
+		Withdrawal: wref.w.IntoProto(),
+	}
+}
+
+func (e *Engine) loadCheckpointWithdrawal(pw *checkpoint.Withdrawal) (*types.Withdrawal, error) {
+	ref, ok := big.NewInt(0).SetString(pw.Ref, 10)
+	if !ok {
+		return nil, ErrInvalidWithdrawalRef
+	}
+	w := types.WithdrawalFromProto(pw.Withdrawal)
+	e.withdrawalCnt.Add(e.withdrawalCnt, big.NewInt(1))
+	e.withdrawals[w.ID] = withdrawalRef{
+		w:   w,
+		ref: ref,
+	}
+	return w, nil
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/assets"
+	"code.vegaprotocol.io/vega/core/assets/erc20"
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/bridges"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	"code.vegaprotocol.io/vega/protos/vega"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawalBatches(t *testing.T) {
+	t.Run("withdrawals of an epoch are signed in a single batch", testWithdrawalBatch)
+	t.Run("withdrawals are signed individually when batching is disabled", testWithdrawalWithoutBatching)
+	t.Run("withdrawal batches are restored from a snapshot", testWithdrawalBatchesSnapshotRoundTrip)
+	t.Run("pending withdrawal batches are restored from a checkpoint", testPendingWithdrawalBatchesCheckpointRoundTrip)
+}
+
+func newTestERC20Asset(t *testing.T) *assets.Asset {
+	t.Helper()
+	erc20Asset, err := erc20.New("erc20-asset", &types.AssetDetails{
+		Quantum: num.DecimalOne(),
+		Source: &types.AssetDetailsErc20{
+			ERC20: &types.ERC20{
+				ChainID:         "1",
+				ContractAddress: "0x1FaA74E181092A97Fecc923015293ce57eE1208A",
+			},
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+	return assets.NewAsset(erc20Asset)
+}
+
+func withdrawForBatch(t *testing.T, e *testEngine, id string, amount uint64) {
+	t.Helper()
+	require.NoError(t, e.WithdrawERC20(context.Background(), id, "party1", "erc20-asset", num.NewUint(amount), &types.Erc20WithdrawExt{
+		ReceiverAddress: "0x1Ebe188952aB6035ADaD21eA1C4F64Fd2EAC60E1",
+	}))
+}
+
+func testWithdrawalBatch(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	require.NoError(t, e.OnWithdrawalBatchingEnabledUpdate(ctx, 1))
+
+	refs := map[string]string{}
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes().Do(func(evt events.Event) {
+		if w, ok := evt.(*events.Withdrawal); ok {
+			proto := w.Withdrawal()
+			refs[proto.Id] = proto.Ref
+		}
+	})
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(3).Return(&types.LedgerMovement{}, nil)
+	e.marketActivityTracker.EXPECT().TeamStatsForMarkets(gomock.Any(), gomock.Any()).AnyTimes()
+
+	// no bundle is created until the end of the epoch.
+	withdrawForBatch(t, e, "w1", 10)
+	withdrawForBatch(t, e, "w2", 20)
+	withdrawForBatch(t, e, "w3", 30)
+
+	var batchID string
+	e.notary.EXPECT().StartAggregate(gomock.Any(), types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1).Do(
+		func(id string, _ types.NodeSignatureKind, _ []byte) {
+			batchID = id
+		})
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_END})
+	require.NotEmpty(t, batchID)
+
+	// the batch isn't signed yet.
+	e.notary.EXPECT().IsSigned(gomock.Any(), batchID, types.NodeSignatureKindAssetWithdrawal).Times(2).Return(nil, false)
+	_, err := e.GetWithdrawalBatchProof(ctx, "w1")
+	require.ErrorIs(t, err, banking.ErrWithdrawalBatchNotSignedYet)
+	require.ErrorIs(t, e.ERC20WithdrawalEvent(ctx, &types.ERC20Withdrawal{ReferenceNonce: refs["w1"]}, 100, "hash", "1"), banking.ErrWithdrawalNotReady)
+
+	signatures := []types.NodeSignature{{Id: batchID, Sig: []byte("sig")}}
+	e.notary.EXPECT().IsSigned(gomock.Any(), batchID, types.NodeSignatureKindAssetWithdrawal).AnyTimes().Return(signatures, true)
+
+	var root []byte
+	for _, id := range []string{"w1", "w2", "w3"} {
+		proof, err := e.GetWithdrawalBatchProof(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, batchID, proof.BatchID)
+		assert.Equal(t, signatures, proof.Signatures)
+		assert.True(t, bridges.VerifyMerkleProof(proof.Root, proof.Leaf, proof.Proof))
+		if root != nil {
+			assert.Equal(t, root, proof.Root)
+		}
+		root = proof.Root
+	}
+
+	// the withdrawals can now be claimed.
+	require.NoError(t, e.ERC20WithdrawalEvent(ctx, &types.ERC20Withdrawal{ReferenceNonce: refs["w2"]}, 100, "hash", "1"))
+
+	// nothing left to batch.
+	e.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_END})
+}
+
+func testWithdrawalWithoutBatching(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(1).Return(&types.LedgerMovement{}, nil)
+	e.notary.EXPECT().StartAggregate("w1", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+
+	withdrawForBatch(t, e, "w1", 10)
+
+	_, err := e.GetWithdrawalBatchProof(ctx, "w1")
+	require.ErrorIs(t, err, banking.ErrWithdrawalNotBatched)
+}
+
+func testWithdrawalBatchesSnapshotRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	key := (&types.PayloadBankingWithdrawalBatches{}).Key()
+	require.NoError(t, e.OnWithdrawalBatchingEnabledUpdate(ctx, 1))
+
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(3).Return(&types.LedgerMovement{}, nil)
+	e.marketActivityTracker.EXPECT().TeamStatsForMarkets(gomock.Any(), gomock.Any()).AnyTimes()
+	e.notary.EXPECT().StartAggregate(gomock.Any(), types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+
+	// one batch is signed, and one withdrawal waits for the end of the next epoch.
+	withdrawForBatch(t, e, "w1", 10)
+	withdrawForBatch(t, e, "w2", 20)
+	e.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_END})
+	withdrawForBatch(t, e, "w3", 30)
+
+	snap := getTestEngine(t)
+	// the withdrawals are needed to batch the pending ones.
+	for _, k := range []string{(&types.PayloadBankingWithdrawals{}).Key(), key} {
+		state, _, err := e.GetState(k)
+		require.NoError(t, err)
+
+		var pl snapshotpb.Payload
+		require.NoError(t, proto.Unmarshal(state, &pl))
+		_, err = snap.LoadState(ctx, types.PayloadFromProto(&pl))
+		require.NoError(t, err)
+
+	}
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+	statePostReload, _, err := snap.GetState(key)
+	require.NoError(t, err)
+	require.Equal(t, state, statePostReload)
+
+	signatures := []types.NodeSignature{{Sig: []byte("sig")}}
+	e.notary.EXPECT().IsSigned(gomock.Any(), gomock.Any(), types.NodeSignatureKindAssetWithdrawal).AnyTimes().Return(signatures, true)
+	snap.notary.EXPECT().IsSigned(gomock.Any(), gomock.Any(), types.NodeSignatureKindAssetWithdrawal).AnyTimes().Return(signatures, true)
+	for _, id := range []string{"w1", "w2"} {
+		expected, err := e.GetWithdrawalBatchProof(ctx, id)
+		require.NoError(t, err)
+		proof, err := snap.GetWithdrawalBatchProof(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, expected, proof)
+	}
+	_, err = snap.GetWithdrawalBatchProof(ctx, "w3")
+	require.ErrorIs(t, err, banking.ErrWithdrawalNotBatched)
+
+	// the pending withdrawal is batched at the end of the epoch.
+	snap.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	snap.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	snap.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	snap.marketActivityTracker.EXPECT().TeamStatsForMarkets(gomock.Any(), gomock.Any()).AnyTimes()
+	snap.notary.EXPECT().StartAggregate(gomock.Any(), types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+	snap.OnEpoch(ctx, types.Epoch{Seq: 2, Action: vega.EpochAction_EPOCH_ACTION_END})
+}
+
+func testPendingWithdrawalBatchesCheckpointRoundTrip(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+	require.NoError(t, e.OnWithdrawalBatchingEnabledUpdate(ctx, 1))
+
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(2).Return(&types.LedgerMovement{}, nil)
+
+	// the funds are taken, but the withdrawals wait for the end of the epoch.
+	withdrawForBatch(t, e, "w1", 10)
+	withdrawForBatch(t, e, "w2", 20)
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	loaded := getTestEngine(t)
+	require.NoError(t, loaded.OnWithdrawalBatchingEnabledUpdate(ctx, 1))
+	loaded.broker.EXPECT().SendBatch(gomock.Any()).Times(1).Do(func(evts []events.Event) {
+		ids := []string{}
+		for _, evt := range evts {
+			if w, ok := evt.(*events.Withdrawal); ok {
+				ids = append(ids, w.Withdrawal().Id)
+			}
+		}
+		assert.Equal(t, []string{"w1", "w2"}, ids)
+	})
+	require.NoError(t, loaded.Load(ctx, cp))
+
+	cpPostLoad, err := loaded.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, cp, cpPostLoad)
+
+	// the restored withdrawals are batched at the end of the epoch.
+	var batchID string
+	loaded.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	loaded.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	loaded.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+	loaded.marketActivityTracker.EXPECT().TeamStatsForMarkets(gomock.Any(), gomock.Any()).AnyTimes()
+	loaded.notary.EXPECT().StartAggregate(gomock.Any(), types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1).Do(
+		func(id string, _ types.NodeSignatureKind, _ []byte) {
+			batchID = id
+		})
+	loaded.OnEpoch(ctx, types.Epoch{Seq: 1, Action: vega.EpochAction_EPOCH_ACTION_END})
+	require.NotEmpty(t, batchID)
+
+	signatures := []types.NodeSignature{{Id: batchID, Sig: []byte("sig")}}
+	loaded.notary.EXPECT().IsSigned(gomock.Any(), batchID, types.NodeSignatureKindAssetWithdrawal).AnyTimes().Return(signatures, true)
+	for _, id := range []string{"w1", "w2"} {
+		proof, err := loaded.GetWithdrawalBatchProof(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, batchID, proof.BatchID)
+		assert.True(t, bridges.VerifyMerkleProof(proof.Root, proof.Leaf, proof.Proof))
+	}
+}
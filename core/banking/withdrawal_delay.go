@@ -0,0 +1,245 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/logging"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+)
+
+var (
+	ErrNotWithdrawalGuardian = errors.New("party is not a withdrawal guardian")
+	ErrWithdrawalNotDelayed  = errors.New("withdrawal is not delayed")
+)
+
+// DelayedWithdrawal is a withdrawal held in the delay queue, which can still be
+// cancelled by a withdrawal guardian until it is released.
+type DelayedWithdrawal struct {
+	Withdrawal *types.Withdrawal
+	ReleaseAt  time.Time
+}
+
+func (e *Engine) OnWithdrawalDelayThresholdsUpdate(_ context.Context, v string) error {
+	thresholds, err := types.ParseWithdrawalDelayThresholds(v)
+	if err != nil {
+		return err
+	}
+	e.withdrawalDelayThresholds = thresholds
+	return nil
+}
+
+func (e *Engine) OnWithdrawalDelayPeriodUpdate(_ context.Context, d time.Duration) error {
+	e.withdrawalDelayPeriod = d
+	return nil
+}
+
+func (e *Engine) OnWithdrawalGuardiansUpdate(_ context.Context, v string) error {
+	guardians, err := types.ParseWithdrawalGuardians(v)
+	if err != nil {
+		return err
+	}
+	e.withdrawalGuardians = guardians
+	return nil
+}
+
+// isWithdrawalDelayed returns true if the withdrawal amount is above the delay
+// threshold of its asset.
+func (e *Engine) isWithdrawalDelayed(w *types.Withdrawal) bool {
+	threshold, ok := e.withdrawalDelayThresholds[w.Asset]
+	return ok && e.withdrawalDelayPeriod > 0 && w.Amount.GT(threshold)
+}
+
+// delayWithdrawal takes the funds of the withdrawal from the party and queues it.
+// The withdrawal stays open until it is released at the end of the delay period,
+// at which point its bundle is signed, or cancelled by a guardian.
+func (e *Engine) delayWithdrawal(ctx context.Context, w *types.Withdrawal) error {
+	defer func() {
+		e.broker.Send(events.NewWithdrawalEvent(ctx, *w))
+	}()
+
+	res, err := e.col.Withdraw(ctx, w.PartyID, w.Asset, w.Amount.Clone())
+	if err != nil {
+		w.Status = types.WithdrawalStatusRejected
+		return err
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{res}))
+
+	e.log.Info("withdrawal above the delay threshold, added to the delay queue",
+		logging.WithdrawalID(w.ID),
+		logging.PartyID(w.PartyID),
+		logging.AssetID(w.Asset),
+		logging.BigUint("amount", w.Amount))
+	e.delayedWithdrawals = append(e.delayedWithdrawals, &DelayedWithdrawal{
+		Withdrawal: w,
+		ReleaseAt:  e.timeService.GetTimeNow().Add(e.withdrawalDelayPeriod),
+	})
+	return nil
+}
+
+// releaseDelayedWithdrawals finalizes the withdrawals whose delay period is over,
+// and starts signing them. The withdrawals of a stopped bridge are held until
+// the bridge is resumed.
+func (e *Engine) releaseDelayedWithdrawals(ctx context.Context, now time.Time) {
+	if len(e.delayedWithdrawals) == 0 {
+		return
+	}
+
+	stillDelayed := make([]*DelayedWithdrawal, 0, len(e.delayedWithdrawals))
+	for _, delayed := range e.delayedWithdrawals {
+		if now.Before(delayed.ReleaseAt) {
+			stillDelayed = append(stillDelayed, delayed)
+			continue
+		}
+
+		id := delayed.Withdrawal.ID
+		wref := e.withdrawals[id]
+		asset := e.erc20AssetForWithdrawal(wref.w)
+		bridgeState, err := e.bridgeStateForChainID(asset.ChainID())
+		if err != nil || bridgeState.IsStopped() {
+			stillDelayed = append(stillDelayed, delayed)
+			continue
+		}
+
+		wref.w.Status = types.WithdrawalStatusFinalized
+		e.broker.Send(events.NewWithdrawalEvent(ctx, *wref.w))
+		if e.withdrawalBatching {
+			e.addToWithdrawalBatch(wref.w, asset)
+			continue
+		}
+		if err := e.startERC20Signatures(wref.w, asset, wref.ref); err != nil {
+			e.log.Error("could not start signing delayed withdrawal",
+				logging.WithdrawalID(id),
+				logging.Error(err))
+		}
+	}
+	e.delayedWithdrawals = stillDelayed
+}
+
+// CancelDelayedWithdrawal cancels a withdrawal still in the delay queue, on behalf
+// of a withdrawal guardian, and gives the funds back to the party.
+func (e *Engine) CancelDelayedWithdrawal(ctx context.Context, guardian, id string) error {
+	if _, ok := e.withdrawalGuardians[guardian]; !ok {
+		return ErrNotWithdrawalGuardian
+	}
+	w, err := e.cancelDelayedWithdrawal(ctx, id)
+	if err != nil {
+		return err
+	}
+	e.log.Info("delayed withdrawal cancelled by a guardian",
+		logging.WithdrawalID(id),
+		logging.PartyID(w.PartyID),
+		logging.String("guardian", guardian))
+	return nil
+}
+
+// VerifyCancelDelayedWithdrawal checks the withdrawal is still in the delay queue,
+// so a governance proposal can cancel it.
+func (e *Engine) VerifyCancelDelayedWithdrawal(id string) error {
+	if e.delayedWithdrawalIndex(id) < 0 {
+		return ErrWithdrawalNotDelayed
+	}
+	return nil
+}
+
+// CancelGovDelayedWithdrawal cancels a withdrawal still in the delay queue when the
+// governance proposal cancelling it is enacted.
+func (e *Engine) CancelGovDelayedWithdrawal(ctx context.Context, proposalID, id string) error {
+	w, err := e.cancelDelayedWithdrawal(ctx, id)
+	if err != nil {
+		return err
+	}
+	e.log.Info("delayed withdrawal cancelled by governance",
+		logging.WithdrawalID(id),
+		logging.PartyID(w.PartyID),
+		logging.ProposalID(proposalID))
+	return nil
+}
+
+// cancelDelayedWithdrawal takes the withdrawal out of the delay queue, rejects it
+// and gives the funds back to the party.
+func (e *Engine) cancelDelayedWithdrawal(ctx context.Context, id string) (*types.Withdrawal, error) {
+	idx := e.delayedWithdrawalIndex(id)
+	if idx < 0 {
+		return nil, ErrWithdrawalNotDelayed
+	}
+
+	w := e.delayedWithdrawals[idx].Withdrawal
+	res, err := e.col.Deposit(ctx, w.PartyID, w.Asset, w.Amount.Clone())
+	if err != nil {
+		return nil, err
+	}
+	e.broker.Send(events.NewLedgerMovements(ctx, []*types.LedgerMovement{res}))
+
+	w.Status = types.WithdrawalStatusRejected
+	e.broker.Send(events.NewWithdrawalEvent(ctx, *w))
+	e.delayedWithdrawals = append(e.delayedWithdrawals[:idx], e.delayedWithdrawals[idx+1:]...)
+	return w, nil
+}
+
+func (e *Engine) delayedWithdrawalIndex(id string) int {
+	for i, delayed := range e.delayedWithdrawals {
+		if delayed.Withdrawal.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetDelayedWithdrawals returns the withdrawals in the delay queue, in the order
+// they were made.
+func (e *Engine) GetDelayedWithdrawals() []DelayedWithdrawal {
+	out := make([]DelayedWithdrawal, 0, len(e.delayedWithdrawals))
+	for _, delayed := range e.delayedWithdrawals {
+		out = append(out, *delayed)
+	}
+	return out
+}
+
+// getCheckpointDelayedWithdrawals returns the withdrawals in the delay queue. Their
+// funds were already taken from the parties, so they are checkpointed with the
+// withdrawals themselves to be released after the restore.
+func (e *Engine) getCheckpointDelayedWithdrawals() []*checkpoint.DelayedWithdrawal {
+	out := make([]*checkpoint.DelayedWithdrawal, 0, len(e.delayedWithdrawals))
+	for _, delayed := range e.delayedWithdrawals {
+		out = append(out, &checkpoint.DelayedWithdrawal{
+			Withdrawal: e.getCheckpointWithdrawal(delayed.Withdrawal.ID),
+			ReleaseAt:  delayed.ReleaseAt.UnixNano(),
+		})
+	}
+	return out
+}
+
+func (e *Engine) loadCheckpointDelayedWithdrawals(ctx context.Context, delayed []*checkpoint.DelayedWithdrawal) ([]events.Event, error) {
+	evts := make([]events.Event, 0, len(delayed))
+	for _, v := range delayed {
+		w, err := e.loadCheckpointWithdrawal(v.Withdrawal)
+		if err != nil {
+			return nil, err
+		}
+		e.delayedWithdrawals = append(e.delayedWithdrawals, &DelayedWithdrawal{
+			Withdrawal: w,
+			ReleaseAt:  time.Unix(0, v.ReleaseAt),
+		})
+		evts = append(evts, events.NewWithdrawalEvent(ctx, *w))
+	}
+	return evts, nil
+}
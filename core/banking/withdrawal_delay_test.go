@@ -0,0 +1,205 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package banking_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/banking"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const withdrawalGuardian = "69464e35bcb8e8a2900ca0f87acaf252d50cf2ab2fc73694845a16b7c8a0dc6f"
+
+func TestWithdrawalDelay(t *testing.T) {
+	t.Run("withdrawals above the threshold are released after the delay", testDelayedWithdrawalReleased)
+	t.Run("a guardian can cancel a delayed withdrawal", testDelayedWithdrawalCancelled)
+	t.Run("a governance proposal can cancel a delayed withdrawal", testDelayedWithdrawalCancelledByGovernance)
+	t.Run("the delay queue is restored from the snapshot", testDelayedWithdrawalSnapshot)
+	t.Run("the delay queue is restored from a checkpoint", testDelayedWithdrawalCheckpoint)
+	t.Run("invalid thresholds and guardians are rejected", testWithdrawalDelayInvalidConfig)
+}
+
+func getWithdrawalDelayTestEngine(t *testing.T) *testEngine {
+	t.Helper()
+	e := getTestEngine(t)
+	ctx := context.Background()
+	e.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	e.top.EXPECT().IsValidator().AnyTimes().Return(false)
+	e.assets.EXPECT().Get("erc20-asset").AnyTimes().Return(newTestERC20Asset(t), nil)
+
+	require.NoError(t, e.OnWithdrawalDelayThresholdsUpdate(ctx, `[{"asset": "erc20-asset", "threshold": "100"}]`))
+	require.NoError(t, e.OnWithdrawalDelayPeriodUpdate(ctx, time.Hour))
+	require.NoError(t, e.OnWithdrawalGuardiansUpdate(ctx, fmt.Sprintf("[%q]", withdrawalGuardian)))
+	return e
+}
+
+func testDelayedWithdrawalReleased(t *testing.T) {
+	e := getWithdrawalDelayTestEngine(t)
+	ctx := context.Background()
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(2).Return(&types.LedgerMovement{}, nil)
+
+	// under the threshold, signed straight away.
+	e.notary.EXPECT().StartAggregate("w1", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+	withdrawForBatch(t, e, "w1", 100)
+
+	withdrawForBatch(t, e, "w2", 101)
+	delayed := e.GetDelayedWithdrawals()
+	require.Len(t, delayed, 1)
+	assert.Equal(t, "w2", delayed[0].Withdrawal.ID)
+	assert.Equal(t, types.WithdrawalStatusOpen, delayed[0].Withdrawal.Status)
+	assert.Equal(t, time.Unix(10, 0).Add(time.Hour), delayed[0].ReleaseAt)
+
+	// the release time is set when the withdrawal is made.
+	require.NoError(t, e.OnWithdrawalDelayPeriodUpdate(ctx, 2*time.Hour))
+	assert.Equal(t, time.Unix(10, 0).Add(time.Hour), e.GetDelayedWithdrawals()[0].ReleaseAt)
+
+	// still delayed.
+	e.OnTick(ctx, time.Unix(10, 0).Add(30*time.Minute))
+	require.Len(t, e.GetDelayedWithdrawals(), 1)
+
+	e.notary.EXPECT().StartAggregate("w2", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+	e.OnTick(ctx, time.Unix(10, 0).Add(time.Hour))
+	require.Empty(t, e.GetDelayedWithdrawals())
+	assert.Equal(t, types.WithdrawalStatusFinalized, delayed[0].Withdrawal.Status)
+}
+
+func testDelayedWithdrawalCancelled(t *testing.T) {
+	e := getWithdrawalDelayTestEngine(t)
+	ctx := context.Background()
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(1).Return(&types.LedgerMovement{}, nil)
+
+	withdrawForBatch(t, e, "w1", 500)
+	w := e.GetDelayedWithdrawals()[0].Withdrawal
+
+	require.ErrorIs(t, e.CancelDelayedWithdrawal(ctx, "party1", "w1"), banking.ErrNotWithdrawalGuardian)
+	require.ErrorIs(t, e.CancelDelayedWithdrawal(ctx, withdrawalGuardian, "w2"), banking.ErrWithdrawalNotDelayed)
+
+	// the funds go back to the party.
+	e.col.EXPECT().Deposit(gomock.Any(), "party1", "erc20-asset", num.NewUint(500)).Times(1).Return(&types.LedgerMovement{}, nil)
+	require.NoError(t, e.CancelDelayedWithdrawal(ctx, withdrawalGuardian, "w1"))
+	assert.Equal(t, types.WithdrawalStatusRejected, w.Status)
+	require.Empty(t, e.GetDelayedWithdrawals())
+
+	// never signed.
+	e.OnTick(ctx, time.Unix(10, 0).Add(2*time.Hour))
+	require.ErrorIs(t, e.CancelDelayedWithdrawal(ctx, withdrawalGuardian, "w1"), banking.ErrWithdrawalNotDelayed)
+}
+
+func testDelayedWithdrawalCancelledByGovernance(t *testing.T) {
+	e := getWithdrawalDelayTestEngine(t)
+	ctx := context.Background()
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(1).Return(&types.LedgerMovement{}, nil)
+
+	withdrawForBatch(t, e, "w1", 500)
+	w := e.GetDelayedWithdrawals()[0].Withdrawal
+
+	require.ErrorIs(t, e.VerifyCancelDelayedWithdrawal("w2"), banking.ErrWithdrawalNotDelayed)
+	require.NoError(t, e.VerifyCancelDelayedWithdrawal("w1"))
+
+	// no guardian needed, the funds go back to the party.
+	e.col.EXPECT().Deposit(gomock.Any(), "party1", "erc20-asset", num.NewUint(500)).Times(1).Return(&types.LedgerMovement{}, nil)
+	require.NoError(t, e.CancelGovDelayedWithdrawal(ctx, "proposal1", "w1"))
+	assert.Equal(t, types.WithdrawalStatusRejected, w.Status)
+	require.Empty(t, e.GetDelayedWithdrawals())
+	require.ErrorIs(t, e.VerifyCancelDelayedWithdrawal("w1"), banking.ErrWithdrawalNotDelayed)
+}
+
+func testDelayedWithdrawalSnapshot(t *testing.T) {
+	key := (&types.PayloadBankingWithdrawals{}).Key()
+	e := getWithdrawalDelayTestEngine(t)
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(3).Return(&types.LedgerMovement{}, nil)
+	e.notary.EXPECT().StartAggregate("w2", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+
+	withdrawForBatch(t, e, "w1", 300)
+	withdrawForBatch(t, e, "w2", 30)
+	require.NoError(t, e.OnWithdrawalDelayPeriodUpdate(context.Background(), 2*time.Hour))
+	withdrawForBatch(t, e, "w3", 200)
+
+	state, _, err := e.GetState(key)
+	require.NoError(t, err)
+	var pl snapshotpb.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+
+	snap := getWithdrawalDelayTestEngine(t)
+	_, err = snap.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	delayed := snap.GetDelayedWithdrawals()
+	require.Len(t, delayed, 2)
+	assert.Equal(t, "w1", delayed[0].Withdrawal.ID)
+	assert.Equal(t, time.Unix(10, 0).Add(time.Hour), delayed[0].ReleaseAt)
+	assert.Equal(t, "w3", delayed[1].Withdrawal.ID)
+	assert.Equal(t, time.Unix(10, 0).Add(2*time.Hour), delayed[1].ReleaseAt)
+}
+
+func testDelayedWithdrawalCheckpoint(t *testing.T) {
+	e := getWithdrawalDelayTestEngine(t)
+	ctx := context.Background()
+	e.col.EXPECT().Withdraw(gomock.Any(), "party1", "erc20-asset", gomock.Any()).Times(2).Return(&types.LedgerMovement{}, nil)
+
+	withdrawForBatch(t, e, "w1", 300)
+	require.NoError(t, e.OnWithdrawalDelayPeriodUpdate(ctx, 2*time.Hour))
+	withdrawForBatch(t, e, "w2", 200)
+
+	cp, err := e.Checkpoint()
+	require.NoError(t, err)
+
+	loaded := getWithdrawalDelayTestEngine(t)
+	loaded.broker.EXPECT().SendBatch(gomock.Any()).Times(1)
+	require.NoError(t, loaded.Load(ctx, cp))
+
+	delayed := loaded.GetDelayedWithdrawals()
+	require.Len(t, delayed, 2)
+	assert.Equal(t, "w1", delayed[0].Withdrawal.ID)
+	assert.Equal(t, time.Unix(10, 0).Add(time.Hour), delayed[0].ReleaseAt)
+	assert.Equal(t, "w2", delayed[1].Withdrawal.ID)
+	assert.Equal(t, time.Unix(10, 0).Add(2*time.Hour), delayed[1].ReleaseAt)
+
+	cpPostLoad, err := loaded.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, cp, cpPostLoad)
+
+	// the withdrawals are still released once their delay is over.
+	loaded.notary.EXPECT().StartAggregate("w1", types.NodeSignatureKindAssetWithdrawal, gomock.Any()).Times(1)
+	loaded.OnTick(ctx, time.Unix(10, 0).Add(time.Hour))
+	require.Len(t, loaded.GetDelayedWithdrawals(), 1)
+
+	// or cancelled, giving the funds back to the party.
+	loaded.col.EXPECT().Deposit(gomock.Any(), "party1", "erc20-asset", num.NewUint(200)).Times(1).Return(&types.LedgerMovement{}, nil)
+	require.NoError(t, loaded.CancelDelayedWithdrawal(ctx, withdrawalGuardian, "w2"))
+	require.Empty(t, loaded.GetDelayedWithdrawals())
+}
+
+func testWithdrawalDelayInvalidConfig(t *testing.T) {
+	e := getTestEngine(t)
+	ctx := context.Background()
+
+	require.ErrorIs(t, e.OnWithdrawalDelayThresholdsUpdate(ctx, `[{"threshold": "100"}]`), types.ErrWithdrawalDelayThresholdMissingAsset)
+	require.ErrorIs(t, e.OnWithdrawalDelayThresholdsUpdate(ctx, `[{"asset": "erc20-asset", "threshold": "0"}]`), types.ErrWithdrawalDelayThresholdInvalid)
+	require.ErrorIs(t, e.OnWithdrawalDelayThresholdsUpdate(ctx, `[{"asset": "erc20-asset", "threshold": "1"}, {"asset": "erc20-asset", "threshold": "2"}]`), types.ErrWithdrawalDelayThresholdDuplicate)
+	require.ErrorIs(t, e.OnWithdrawalGuardiansUpdate(ctx, `["not-a-key"]`), types.ErrInvalidWithdrawalGuardian)
+}
@@ -166,6 +166,9 @@ func (app *App) FinalizeBlock(_ context.Context, req *types.RequestFinalizeBlock
 	}
 
 	app.ctx = app.OnBeginBlock(blockHeight, hex.EncodeToString(req.Hash), blockTime, hex.EncodeToString(req.ProposerAddress), txs)
+	if app.OnMisbehaviour != nil {
+		app.OnMisbehaviour(app.ctx, req.Misbehavior)
+	}
 	results := make([]*types.ExecTxResult, 0, len(req.Txs))
 	events := []types.Event{}
 
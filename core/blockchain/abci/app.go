@@ -46,6 +46,7 @@ type App struct {
 	OnCheckTx         OnCheckTxHandler
 	OnCommit          OnCommitHandler
 	OnBeginBlock      OnBeginBlockHandler
+	OnMisbehaviour    OnMisbehaviourHandler
 	OnEndBlock        OnEndBlockHandler
 	OnFinalize        FinalizeHandler
 
@@ -122,6 +122,15 @@ func (c *Client) Health(ctx context.Context) (*tmctypes.ResultHealth, error) {
 	return c.tmclt.Health(ctx)
 }
 
+// Block returns the block at the given height.
+func (c *Client) Block(ctx context.Context, height int64) (*tmtypes.Block, error) {
+	res, err := c.tmclt.Block(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	return res.Block, nil
+}
+
 func (c *Client) Validators(ctx context.Context, height *int64) ([]*tmtypes.Validator, error) {
 	res, err := c.tmclt.Validators(ctx, height, nil, nil)
 	if err != nil {
@@ -53,6 +53,7 @@ type (
 	ProcessProposalHandler    func(height uint64, txs []Tx) bool
 	OnInitChainHandler        func(*types.RequestInitChain) (*types.ResponseInitChain, error)
 	OnBeginBlockHandler       func(uint64, string, time.Time, string, []Tx) context.Context
+	OnMisbehaviourHandler     func(context.Context, []types.Misbehavior)
 	OnEndBlockHandler         func(blockHeight uint64) (types.ValidatorUpdates, types1.ConsensusParams)
 	OnCheckTxHandler          func(context.Context, *types.RequestCheckTx, Tx) (context.Context, *types.ResponseCheckTx)
 	OnDeliverTxHandler        func(context.Context, Tx)
@@ -67,9 +67,10 @@ func NewDefaultTendermintConfig() TendermintConfig {
 }
 
 type ReplayConfig struct {
-	Record     bool   `description:"whether to record block data to a file to allow replaying" long:"record"`
-	Replay     bool   `description:"whether to replay any blockdata found in replay-file"      long:"replay"`
-	ReplayFile string `description:"path to file of which to write/read replay data"           long:"replay-file"`
+	Record          bool   `description:"whether to record block data to a file to allow replaying"    long:"record"`
+	Replay          bool   `description:"whether to replay any blockdata found in replay-file"         long:"replay"`
+	ReplayFile      string `description:"path to file of which to write/read replay data"              long:"replay-file"`
+	ExitAfterReplay bool   `description:"whether to stop the node once the blockdata has been replayed" long:"exit-after-replay"`
 }
 
 type NullChainConfig struct {
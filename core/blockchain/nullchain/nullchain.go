@@ -169,6 +169,9 @@ func (n *NullBlockchain) StartChain() error {
 		n.replaying.Store(false)
 
 		n.log.Info("nullchain finished replaying chain", logging.Int64("block-height", blockHeight))
+		if n.cfg.Replay.ExitAfterReplay {
+			return ErrReplayCompleted
+		}
 		if blockHeight != 0 {
 			// set the next height to where we replayed to
 			n.blockHeight = blockHeight + 1
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -51,6 +52,7 @@ func TestNullChain(t *testing.T) {
 	t.Run("test replay from genesis", testReplayFromGenesis)
 	t.Run("test replay with snapshot restore", testReplayWithSnapshotRestore)
 	t.Run("test replay with a block that panics", testReplayPanicBlock)
+	t.Run("test replay of recorded blocks then exit", testReplayRecordedBlocksAndExit)
 }
 
 func testBasics(t *testing.T) {
@@ -249,6 +251,32 @@ func testReplayFromGenesis(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func testReplayRecordedBlocksAndExit(t *testing.T) {
+	// blocks taken from a real chain come with their hash and proposer
+	rplFile := path.Join(t.TempDir(), "rfile")
+	blocks := []string{
+		`{"height":11,"time":11000,"hash":"aGFzaDEx","proposer":"cHJvcDE=","txns":[],"appHash":"YXBwMTE="}`,
+		`{"height":12,"time":12000,"hash":"aGFzaDEy","proposer":"cHJvcDI=","txns":[],"appHash":"YXBwMTI="}`,
+	}
+	require.NoError(t, vgfs.WriteFile(rplFile, []byte(strings.Join(blocks, "\n")+"\n")))
+
+	testChain := getTestUnstartedNullChain(t, 2, time.Second, &blockchain.ReplayConfig{Replay: true, ReplayFile: rplFile, ExitAfterReplay: true})
+	defer testChain.ctrl.Finish()
+
+	testChain.app.EXPECT().Info(gomock.Any(), gomock.Any()).Times(1).Return(&abci.ResponseInfo{LastBlockHeight: 10}, nil)
+	testChain.ts.EXPECT().GetTimeNow().Times(1).Return(time.Unix(10, 0))
+	testChain.app.EXPECT().FinalizeBlock(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(_ context.Context, r *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+			require.Equal(t, fmt.Sprintf("hash%d", r.Height), string(r.Hash))
+			require.Equal(t, fmt.Sprintf("prop%d", r.Height-10), string(r.ProposerAddress))
+			return &abci.ResponseFinalizeBlock{AppHash: []byte(fmt.Sprintf("app%d", r.Height))}, nil
+		})
+	testChain.app.EXPECT().Commit(gomock.Any(), gomock.Any()).Times(2)
+
+	// the app hashes match, the chain stops once the blocks are replayed
+	require.ErrorIs(t, testChain.chain.StartChain(), nullchain.ErrReplayCompleted)
+}
+
 func testReplayPanicBlock(t *testing.T) {
 	ctx := context.Background()
 	// replay file
@@ -35,13 +35,19 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 )
 
-var ErrReplayFileIsRequired = errors.New("replay-file is required when replay/record is enabled")
+var (
+	ErrReplayFileIsRequired = errors.New("replay-file is required when replay/record is enabled")
+	ErrReplayCompleted      = errors.New("replay completed")
+)
 
 type blockData struct {
-	Height  int64    `json:"height"`
-	Time    int64    `json:"time"`
-	Txs     [][]byte `json:"txns"`
-	AppHash []byte   `json:"appHash"`
+	Height int64 `json:"height"`
+	Time   int64 `json:"time"`
+	// Hash and Proposer are only set for blocks taken from a real chain
+	Hash     []byte   `json:"hash,omitempty"`
+	Proposer []byte   `json:"proposer,omitempty"`
+	Txs      [][]byte `json:"txns"`
+	AppHash  []byte   `json:"appHash"`
 }
 
 type Replayer struct {
@@ -156,12 +162,18 @@ func (r *Replayer) replayChain(appHeight int64) (int64, time.Time, error) {
 			continue
 		}
 
+		hash := data.Hash
+		if len(hash) == 0 {
+			hash = vgcrypto.Hash([]byte(strconv.FormatInt(data.Height+data.Time, 10)))
+		}
+
 		r.log.Info("replaying block", logging.Int64("height", data.Height), logging.Int("ntxns", len(data.Txs)))
 		resp, _ := r.app.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{
-			Height: data.Height,
-			Time:   time.Unix(0, data.Time),
-			Hash:   vgcrypto.Hash([]byte(strconv.FormatInt(data.Height+data.Time, 10))),
-			Txs:    data.Txs,
+			Height:          data.Height,
+			Time:            time.Unix(0, data.Time),
+			Hash:            hash,
+			ProposerAddress: data.Proposer,
+			Txs:             data.Txs,
 		})
 
 		r.app.Commit(context.Background(), &abci.RequestCommit{})
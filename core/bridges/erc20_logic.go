@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
 // ERC20Logic yea that's a weird name but
@@ -399,6 +400,123 @@ func (e ERC20Logic) VerifyWithdrawAsset(
 	return addresses, nil
 }
 
+// WithdrawalLeaf returns the leaf representing a withdrawal in the Merkle tree
+// of a withdrawal batch, where
+// leaf = keccak256(abi.encode(token, amount, receiver, creation, nonce)).
+func WithdrawalLeaf(
+	tokenAddress string,
+	amount *num.Uint,
+	ethPartyAddress string,
+	creation time.Time,
+	nonce *num.Uint,
+) ([]byte, error) {
+	typAddr, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	typU256, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	args := abi.Arguments([]abi.Argument{
+		{
+			Name: "address",
+			Type: typAddr,
+		},
+		{
+			Name: "uint256",
+			Type: typU256,
+		},
+		{
+			Name: "address",
+			Type: typAddr,
+		},
+		{
+			Name: "uint256",
+			Type: typU256,
+		},
+		{
+			Name: "nonce",
+			Type: typU256,
+		},
+	})
+
+	buf, err := args.Pack([]interface{}{
+		ethcmn.HexToAddress(tokenAddress),
+		amount.BigInt(),
+		ethcmn.HexToAddress(ethPartyAddress),
+		big.NewInt(creation.Unix()),
+		nonce.BigInt(),
+	}...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't pack abi message: %w", err)
+	}
+
+	return ethcrypto.Keccak256(buf), nil
+}
+
+// WithdrawAssetBatch signs the root of the Merkle tree of a batch of withdrawals,
+// each withdrawal of the batch can then be claimed on the bridge with its own
+// Merkle proof.
+func (e ERC20Logic) WithdrawAssetBatch(
+	root []byte,
+	nonce *num.Uint,
+) (*SignaturePayload, error) {
+	msg, err := e.buildWithdrawAssetBatchMessage(root, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return sign(e.signer, msg)
+}
+
+func (e ERC20Logic) buildWithdrawAssetBatchMessage(
+	root []byte,
+	nonce *num.Uint,
+) ([]byte, error) {
+	typBytes32, err := abi.NewType("bytes32", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	typString, err := abi.NewType("string", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	typU256, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	args := abi.Arguments([]abi.Argument{
+		{
+			Name: "root",
+			Type: typBytes32,
+		},
+		{
+			Name: "nonce",
+			Type: typU256,
+		},
+		{
+			Name: "func_name",
+			Type: typString,
+		},
+	})
+
+	var rootArray [32]byte
+	copy(rootArray[:], root)
+	buf, err := args.Pack([]interface{}{
+		rootArray,
+		nonce.BigInt(),
+		"withdrawAssetBatch",
+	}...)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't pack abi message: %w", err)
+	}
+
+	return packScheme(buf, e.bridgeAddr, e.chainID, e.v1)
+}
+
 func (e ERC20Logic) SetAssetLimits(
 	tokenAddress string,
 	lifetimeLimit *num.Uint,
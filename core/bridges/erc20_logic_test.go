@@ -23,6 +23,7 @@ import (
 	"code.vegaprotocol.io/vega/libs/num"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -36,6 +37,7 @@ func TestERC20Logic(t *testing.T) {
 	t.Run("list asset", testListAsset)
 	t.Run("remove asset", testRemoveAsset)
 	t.Run("withdraw asset", testWithdrawAsset)
+	t.Run("withdraw asset batch", testWithdrawAssetBatch)
 }
 
 func testListAsset(t *testing.T) {
@@ -151,3 +153,33 @@ func testWithdrawAsset(t *testing.T) {
 		})
 	}
 }
+
+func testWithdrawAssetBatch(t *testing.T) {
+	signer := testSigner{}
+	bridge := bridges.NewERC20Logic(signer, erc20BridgeAddr, chainID, false)
+
+	leaves := make([][]byte, 0, 3)
+	for i := uint64(1); i <= 3; i++ {
+		leaf, err := bridges.WithdrawalLeaf(
+			erc20AssetAddr,
+			num.NewUint(42*i), // amount
+			ethPartyAddr,
+			time.Unix(1000, 0),
+			num.NewUint(1000+i), // nonce
+		)
+		require.NoError(t, err)
+		require.Len(t, leaf, 32)
+		leaves = append(leaves, leaf)
+	}
+
+	root := bridges.MerkleRoot(leaves)
+	sig, err := bridge.WithdrawAssetBatch(root, num.NewUint(1000))
+	require.NoError(t, err)
+	assert.NotNil(t, sig.Message)
+	assert.True(t, signer.Verify(sig.Message, sig.Signature))
+
+	// every withdrawal can be claimed on its own.
+	for i, leaf := range leaves {
+		assert.True(t, bridges.VerifyMerkleProof(root, leaf, bridges.MerkleProof(leaves, i)))
+	}
+}
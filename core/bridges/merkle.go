@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bridges
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The Merkle trees built here hash the pairs of nodes in sorted order, so a
+// proof doesn't need to carry the position of the nodes, which matches the
+// verification done by the OpenZeppelin MerkleProof library used by the bridge.
+// A node without a sibling is promoted as-is to the next level.
+
+// MerkleRoot returns the root of the Merkle tree built from the given leaves.
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextMerkleLevel(level)
+	}
+	return level[0]
+}
+
+// MerkleProof returns the siblings needed to verify that the leaf at the given
+// index is part of the tree built from the given leaves.
+func MerkleProof(leaves [][]byte, index int) [][]byte {
+	if index < 0 || index >= len(leaves) {
+		return nil
+	}
+	proof := [][]byte{}
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			proof = append(proof, level[sibling])
+		}
+		level = nextMerkleLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyMerkleProof returns true if the proof links the leaf to the root.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+	hash := leaf
+	for _, sibling := range proof {
+		hash = hashMerklePair(hash, sibling)
+	}
+	return bytes.Equal(hash, root)
+}
+
+func nextMerkleLevel(level [][]byte) [][]byte {
+	next := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			continue
+		}
+		next = append(next, hashMerklePair(level[i], level[i+1]))
+	}
+	return next
+}
+
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256(a, b)
+}
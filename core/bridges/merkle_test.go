@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bridges_test
+
+import (
+	"fmt"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/bridges"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkleTree(t *testing.T) {
+	t.Run("single leaf is the root", testMerkleSingleLeaf)
+	t.Run("proofs are valid for every leaf", testMerkleProofs)
+	t.Run("proof is invalid for another leaf", testMerkleInvalidProof)
+}
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		leaves = append(leaves, crypto.Keccak256([]byte(fmt.Sprintf("leaf-%d", i))))
+	}
+	return leaves
+}
+
+func testMerkleSingleLeaf(t *testing.T) {
+	leaves := testLeaves(1)
+	assert.Equal(t, leaves[0], bridges.MerkleRoot(leaves))
+	assert.Empty(t, bridges.MerkleProof(leaves, 0))
+	assert.True(t, bridges.VerifyMerkleProof(leaves[0], leaves[0], nil))
+	assert.Nil(t, bridges.MerkleRoot(nil))
+}
+
+func testMerkleProofs(t *testing.T) {
+	// odd and even sizes, so some nodes are promoted without a sibling.
+	for _, n := range []int{2, 3, 5, 8, 13} {
+		leaves := testLeaves(n)
+		root := bridges.MerkleRoot(leaves)
+		for i, leaf := range leaves {
+			assert.True(t, bridges.VerifyMerkleProof(root, leaf, bridges.MerkleProof(leaves, i)), "leaf %d of %d", i, n)
+		}
+	}
+}
+
+func testMerkleInvalidProof(t *testing.T) {
+	leaves := testLeaves(5)
+	root := bridges.MerkleRoot(leaves)
+	assert.False(t, bridges.VerifyMerkleProof(root, leaves[1], bridges.MerkleProof(leaves, 2)))
+	assert.False(t, bridges.VerifyMerkleProof(root, crypto.Keccak256([]byte("unknown")), bridges.MerkleProof(leaves, 0)))
+	assert.Nil(t, bridges.MerkleProof(leaves, 5))
+}
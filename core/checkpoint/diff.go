@@ -0,0 +1,174 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package checkpoint
+
+import (
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// Diff holds the differences between two checkpoints for the sections
+// that matter when preparing a network restart. A missing value is
+// represented by an empty string.
+type Diff struct {
+	Balances          []*BalanceDiff `json:"balances"`
+	CollateralTotals  []*ValueDiff   `json:"collateral_totals"`
+	Stakes            []*ValueDiff   `json:"stakes"`
+	StakingTotal      *ValueDiff     `json:"staking_total,omitempty"`
+	Proposals         []*ValueDiff   `json:"proposals"`
+	NetworkParameters []*ValueDiff   `json:"network_parameters"`
+}
+
+// BalanceDiff is a change of balance for a party in a given asset.
+type BalanceDiff struct {
+	Party string `json:"party"`
+	Asset string `json:"asset"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// ValueDiff is a change of value for a given key, which is a party for stakes,
+// an asset for collateral totals, a proposal ID for proposals, or the network
+// parameter key.
+type ValueDiff struct {
+	Key string `json:"key"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// Empty returns true if there is no difference between the checkpoints.
+func (d *Diff) Empty() bool {
+	return len(d.Balances) == 0 && len(d.CollateralTotals) == 0 &&
+		len(d.Stakes) == 0 && d.StakingTotal == nil &&
+		len(d.Proposals) == 0 && len(d.NetworkParameters) == 0
+}
+
+// DiffCheckpoints compares the balances, stakes, governance proposals and network
+// parameters of two checkpoints.
+func DiffCheckpoints(oldCP, newCP *types.CheckpointState) (*Diff, error) {
+	oc, err := oldCP.GetCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+	nc, err := newCP.GetCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Diff{}
+
+	oldBalances, err := readBalances(oc.Collateral)
+	if err != nil {
+		return nil, err
+	}
+	newBalances, err := readBalances(nc.Collateral)
+	if err != nil {
+		return nil, err
+	}
+	d.Balances = diffBalances(oldBalances, newBalances)
+	d.CollateralTotals = diffUints(assetTotals(oldBalances), assetTotals(newBalances))
+
+	oldStakes, err := readStakes(oc.Staking)
+	if err != nil {
+		return nil, err
+	}
+	newStakes, err := readStakes(nc.Staking)
+	if err != nil {
+		return nil, err
+	}
+	d.Stakes = diffUints(oldStakes, newStakes)
+	if oldTotal, newTotal := stakeTotal(oldStakes), stakeTotal(newStakes); !oldTotal.EQ(newTotal) {
+		d.StakingTotal = &ValueDiff{Key: "total", Old: oldTotal.String(), New: newTotal.String()}
+	}
+
+	oldProposals, err := readProposals(oc.Governance)
+	if err != nil {
+		return nil, err
+	}
+	newProposals, err := readProposals(nc.Governance)
+	if err != nil {
+		return nil, err
+	}
+	d.Proposals = diffStrings(oldProposals, newProposals)
+
+	oldParams, err := readNetParams(oc.NetworkParameters)
+	if err != nil {
+		return nil, err
+	}
+	newParams, err := readNetParams(nc.NetworkParameters)
+	if err != nil {
+		return nil, err
+	}
+	d.NetworkParameters = diffStrings(oldParams, newParams)
+
+	return d, nil
+}
+
+func diffBalances(oldBalances, newBalances map[balanceKey]*num.Uint) []*BalanceDiff {
+	diffs := []*BalanceDiff{}
+	for k, o := range oldBalances {
+		n, ok := newBalances[k]
+		if !ok {
+			diffs = append(diffs, &BalanceDiff{Party: k.party, Asset: k.asset, Old: o.String()})
+			continue
+		}
+		if !o.EQ(n) {
+			diffs = append(diffs, &BalanceDiff{Party: k.party, Asset: k.asset, Old: o.String(), New: n.String()})
+		}
+	}
+	for k, n := range newBalances {
+		if _, ok := oldBalances[k]; !ok {
+			diffs = append(diffs, &BalanceDiff{Party: k.party, Asset: k.asset, New: n.String()})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Party == diffs[j].Party {
+			return diffs[i].Asset < diffs[j].Asset
+		}
+		return diffs[i].Party < diffs[j].Party
+	})
+	return diffs
+}
+
+func diffUints(oldValues, newValues map[string]*num.Uint) []*ValueDiff {
+	oldStr := make(map[string]string, len(oldValues))
+	for k, v := range oldValues {
+		oldStr[k] = v.String()
+	}
+	newStr := make(map[string]string, len(newValues))
+	for k, v := range newValues {
+		newStr[k] = v.String()
+	}
+	return diffStrings(oldStr, newStr)
+}
+
+func diffStrings(oldValues, newValues map[string]string) []*ValueDiff {
+	diffs := []*ValueDiff{}
+	for _, k := range sortedKeys(oldValues) {
+		if n := newValues[k]; n != oldValues[k] {
+			diffs = append(diffs, &ValueDiff{Key: k, Old: oldValues[k], New: n})
+		}
+	}
+	for _, k := range sortedKeys(newValues) {
+		if _, ok := oldValues[k]; !ok {
+			diffs = append(diffs, &ValueDiff{Key: k, New: newValues[k]})
+		}
+	}
+	sort.SliceStable(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+	return diffs
+}
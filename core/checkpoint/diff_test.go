@@ -0,0 +1,142 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package checkpoint_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/checkpoint"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/proto"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	checkpointpb "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+func TestDiffCheckpoints(t *testing.T) {
+	t.Run("identical checkpoints have no difference", testDiffIdentical)
+	t.Run("differences are reported per section", testDiffSections)
+}
+
+func makeCheckpointState(t *testing.T, sections map[types.CheckpointName]protoiface.MessageV1) *types.CheckpointState {
+	t.Helper()
+	cp := &types.Checkpoint{}
+	for name, msg := range sections {
+		data, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		cp.Set(name, data)
+	}
+	require.NoError(t, cp.SetBlockHeight(100))
+	cpt := &types.CheckpointState{}
+	require.NoError(t, cpt.SetCheckpoint(cp))
+	return cpt
+}
+
+func oldSections() map[types.CheckpointName]protoiface.MessageV1 {
+	return map[types.CheckpointName]protoiface.MessageV1{
+		types.CollateralCheckpoint: &checkpointpb.Collateral{
+			Balances: []*checkpointpb.AssetBalance{
+				{Party: "p1", Asset: "a1", Balance: "100"},
+				{Party: "p2", Asset: "a1", Balance: "200"},
+				{Party: "p2", Asset: "a2", Balance: "50"},
+			},
+		},
+		types.StakingCheckpoint: &checkpointpb.Staking{
+			Accepted: []*eventspb.StakeLinking{
+				{Party: "p1", Amount: "1000", Type: eventspb.StakeLinking_TYPE_LINK},
+				{Party: "p1", Amount: "400", Type: eventspb.StakeLinking_TYPE_UNLINK},
+				{Party: "p2", Amount: "500", Type: eventspb.StakeLinking_TYPE_LINK},
+			},
+		},
+		types.GovernanceCheckpoint: &checkpointpb.Proposals{
+			Proposals: []*vegapb.Proposal{
+				{Id: "prop1", State: vegapb.Proposal_STATE_OPEN},
+			},
+		},
+		types.NetParamsCheckpoint: &checkpointpb.NetParams{
+			Params: []*vegapb.NetworkParameter{
+				{Key: "foo", Value: "1"},
+				{Key: "bar", Value: "2"},
+			},
+		},
+	}
+}
+
+func testDiffIdentical(t *testing.T) {
+	diff, err := checkpoint.DiffCheckpoints(makeCheckpointState(t, oldSections()), makeCheckpointState(t, oldSections()))
+	require.NoError(t, err)
+	require.True(t, diff.Empty())
+}
+
+func testDiffSections(t *testing.T) {
+	newSections := map[types.CheckpointName]protoiface.MessageV1{
+		types.CollateralCheckpoint: &checkpointpb.Collateral{
+			Balances: []*checkpointpb.AssetBalance{
+				{Party: "p1", Asset: "a1", Balance: "150"},
+				{Party: "p2", Asset: "a1", Balance: "200"},
+				{Party: "p3", Asset: "a2", Balance: "50"},
+			},
+		},
+		types.StakingCheckpoint: &checkpointpb.Staking{
+			Accepted: []*eventspb.StakeLinking{
+				{Party: "p1", Amount: "1000", Type: eventspb.StakeLinking_TYPE_LINK},
+				{Party: "p2", Amount: "500", Type: eventspb.StakeLinking_TYPE_LINK},
+			},
+		},
+		types.GovernanceCheckpoint: &checkpointpb.Proposals{
+			Proposals: []*vegapb.Proposal{
+				{Id: "prop1", State: vegapb.Proposal_STATE_ENACTED},
+				{Id: "prop2", State: vegapb.Proposal_STATE_OPEN},
+			},
+		},
+		types.NetParamsCheckpoint: &checkpointpb.NetParams{
+			Params: []*vegapb.NetworkParameter{
+				{Key: "foo", Value: "3"},
+			},
+		},
+	}
+
+	diff, err := checkpoint.DiffCheckpoints(makeCheckpointState(t, oldSections()), makeCheckpointState(t, newSections))
+	require.NoError(t, err)
+	require.False(t, diff.Empty())
+
+	require.Equal(t, []*checkpoint.BalanceDiff{
+		{Party: "p1", Asset: "a1", Old: "100", New: "150"},
+		{Party: "p2", Asset: "a2", Old: "50"},
+		{Party: "p3", Asset: "a2", New: "50"},
+	}, diff.Balances)
+	require.Equal(t, []*checkpoint.ValueDiff{
+		{Key: "a1", Old: "300", New: "350"},
+	}, diff.CollateralTotals)
+
+	require.Equal(t, []*checkpoint.ValueDiff{
+		{Key: "p1", Old: "600", New: "1000"},
+	}, diff.Stakes)
+	require.Equal(t, &checkpoint.ValueDiff{Key: "total", Old: "1100", New: "1500"}, diff.StakingTotal)
+
+	require.Equal(t, []*checkpoint.ValueDiff{
+		{Key: "prop1", Old: "STATE_OPEN", New: "STATE_ENACTED"},
+		{Key: "prop2", New: "STATE_OPEN"},
+	}, diff.Proposals)
+
+	require.Equal(t, []*checkpoint.ValueDiff{
+		{Key: "bar", Old: "2"},
+		{Key: "foo", Old: "1", New: "3"},
+	}, diff.NetworkParameters)
+}
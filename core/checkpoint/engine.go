@@ -88,6 +88,7 @@ type Engine struct {
 
 	components map[types.CheckpointName]State
 	loadHash   []byte
+	restore    *restoreOptions
 	nextCP     time.Time
 	delta      time.Duration
 
@@ -162,6 +163,12 @@ func (e *Engine) UponGenesis(ctx context.Context, data []byte) (err error) {
 			e.log.Panic("invalid genesis file, hash specified without state")
 		}
 
+		e.restore, err = newRestoreOptions(state)
+		if err != nil {
+			return fmt.Errorf("invalid genesis file checkpoint restore options: %w", err)
+		}
+		e.restore.logSections(e.log)
+
 		buf, err := base64.StdEncoding.DecodeString(state.CheckpointState)
 		if err != nil {
 			return fmt.Errorf("invalid genesis file checkpoint.state: %w", err)
@@ -300,13 +307,16 @@ func (e *Engine) load(ctx context.Context, cpt *types.CheckpointState) error {
 	if err := cpt.Validate(); err != nil {
 		return err
 	}
+	if err := e.restore.validateTotals(cp); err != nil {
+		return err
+	}
 	var (
 		assets                 []*types.Asset
 		doneAssets, doneCollat bool // just avoids type asserting all components
 	)
 	for _, k := range cpOrder {
 		cpData := cp.Get(k)
-		if len(cpData) == 0 {
+		if len(cpData) == 0 || !e.restore.restore(k) {
 			continue
 		}
 		c, ok := e.components[k]
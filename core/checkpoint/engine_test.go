@@ -29,6 +29,7 @@ import (
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
+	checkpointpb "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -69,6 +70,9 @@ func TestLoadCheckpoints(t *testing.T) {
 	t.Run("load checkpoint with invalid hash", testLoadInvalidHash)
 	t.Run("load sparse checkpoint", testLoadSparse)
 	t.Run("error loading checkpoint", testLoadError)
+	t.Run("load selected sections only", testLoadSelectedSections)
+	t.Run("load checkpoint with expected totals", testLoadExpectedTotals)
+	t.Run("load checkpoint with unknown section", testLoadUnknownSection)
 }
 
 func TestLoadAssets(t *testing.T) {
@@ -597,3 +601,104 @@ func (w *wrappedMock) Load(ctx context.Context, data []byte) error {
 	w.data = data
 	return w.MockState.Load(ctx, data)
 }
+
+func testLoadSelectedSections(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	components := map[types.CheckpointName]*mocks.MockState{
+		types.GovernanceCheckpoint: mocks.NewMockState(ctrl),
+		types.AssetsCheckpoint:     mocks.NewMockState(ctrl),
+		types.CollateralCheckpoint: mocks.NewMockState(ctrl),
+		types.BankingCheckpoint:    mocks.NewMockState(ctrl),
+	}
+	data := map[types.CheckpointName][]byte{
+		types.GovernanceCheckpoint: []byte("foodata"),
+		types.AssetsCheckpoint:     []byte("bardata"),
+		types.CollateralCheckpoint: []byte("bazdata"),
+		types.BankingCheckpoint:    []byte("quxdata"),
+	}
+	for k, c := range components {
+		c.EXPECT().Name().Times(1).Return(k)
+		c.EXPECT().Checkpoint().Times(1).Return(data[k], nil)
+	}
+	eng, err := checkpoint.New(logging.NewTestLogger(), checkpoint.NewDefaultConfig(),
+		components[types.GovernanceCheckpoint], components[types.AssetsCheckpoint], components[types.CollateralCheckpoint], components[types.BankingCheckpoint])
+	require.NoError(t, err)
+	_, _ = eng.Checkpoint(ctx, time.Now().Add(-2*time.Hour))
+	snapshot, err := eng.Checkpoint(ctx, time.Now())
+	require.NoError(t, err)
+
+	// collateral depends on the assets and banking, so all three are restored, governance is not
+	components[types.AssetsCheckpoint].EXPECT().Load(gomock.Any(), data[types.AssetsCheckpoint]).Times(1).Return(nil)
+	components[types.CollateralCheckpoint].EXPECT().Load(gomock.Any(), data[types.CollateralCheckpoint]).Times(1).Return(nil)
+	components[types.BankingCheckpoint].EXPECT().Load(gomock.Any(), data[types.BankingCheckpoint]).Times(1).Return(nil)
+	set := genesis{
+		CP: &checkpoint.GenesisState{
+			CheckpointHash:  hex.EncodeToString(snapshot.Hash),
+			CheckpointState: base64.StdEncoding.EncodeToString(snapshot.State),
+			Sections:        []string{string(types.CollateralCheckpoint)},
+		},
+	}
+	gen, err := json.Marshal(set)
+	require.NoError(t, err)
+	require.NoError(t, eng.UponGenesis(ctx, gen))
+}
+
+func testLoadExpectedTotals(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	collat := mocks.NewMockState(ctrl)
+	collat.EXPECT().Name().AnyTimes().Return(types.CollateralCheckpoint)
+	data, err := proto.Marshal(&checkpointpb.Collateral{
+		Balances: []*checkpointpb.AssetBalance{
+			{Party: "p1", Asset: "a1", Balance: "100"},
+			{Party: "p2", Asset: "a1", Balance: "200"},
+		},
+	})
+	require.NoError(t, err)
+	collat.EXPECT().Checkpoint().AnyTimes().Return(data, nil)
+
+	load := func(totals map[string]string) error {
+		eng, err := checkpoint.New(logging.NewTestLogger(), checkpoint.NewDefaultConfig(), collat)
+		require.NoError(t, err)
+		_, _ = eng.Checkpoint(ctx, time.Now().Add(-2*time.Hour))
+		snapshot, err := eng.Checkpoint(ctx, time.Now())
+		require.NoError(t, err)
+		set := genesis{
+			CP: &checkpoint.GenesisState{
+				CheckpointHash:   hex.EncodeToString(snapshot.Hash),
+				CheckpointState:  base64.StdEncoding.EncodeToString(snapshot.State),
+				Sections:         []string{string(types.CollateralCheckpoint)},
+				CollateralTotals: totals,
+			},
+		}
+		gen, err := json.Marshal(set)
+		require.NoError(t, err)
+		return eng.UponGenesis(ctx, gen)
+	}
+
+	// the totals don't match, nothing is loaded
+	require.ErrorIs(t, load(map[string]string{"a1": "250"}), checkpoint.ErrCheckpointTotalsMismatch)
+	require.ErrorIs(t, load(map[string]string{"a2": "1"}), checkpoint.ErrCheckpointTotalsMismatch)
+	require.ErrorIs(t, load(map[string]string{"a1": "foo"}), checkpoint.ErrInvalidExpectedTotal)
+
+	collat.EXPECT().Load(gomock.Any(), data).Times(1).Return(nil)
+	require.NoError(t, load(map[string]string{"a1": "300"}))
+}
+
+func testLoadUnknownSection(t *testing.T) {
+	t.Parallel()
+	eng := getTestEngine(t)
+	set := genesis{
+		CP: &checkpoint.GenesisState{
+			CheckpointHash:  hex.EncodeToString([]byte("foo")),
+			CheckpointState: base64.StdEncoding.EncodeToString([]byte("bar")),
+			Sections:        []string{"foo"},
+		},
+	}
+	gen, err := json.Marshal(set)
+	require.NoError(t, err)
+	require.ErrorIs(t, eng.UponGenesis(context.Background(), gen), checkpoint.ErrUnknownCheckpointSection)
+}
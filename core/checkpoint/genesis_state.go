@@ -20,6 +20,14 @@ import "encoding/json"
 type GenesisState struct {
 	CheckpointHash  string `json:"load_hash"`
 	CheckpointState string `json:"state"`
+	// Sections restricts the restore to the given checkpoint sections,
+	// all sections are restored if empty.
+	Sections []string `json:"sections,omitempty"`
+	// CollateralTotals are the expected total balances per asset in the
+	// restored collateral section.
+	CollateralTotals map[string]string `json:"collateral_totals,omitempty"`
+	// StakingTotal is the expected total stake in the restored staking section.
+	StakingTotal string `json:"staking_total,omitempty"`
 }
 
 func DefaultGenesisState() GenesisState {
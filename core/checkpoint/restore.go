@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package checkpoint
+
+import (
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+var (
+	ErrUnknownCheckpointSection = errors.New("unknown checkpoint section")
+	ErrInvalidExpectedTotal     = errors.New("invalid expected total")
+	ErrCheckpointTotalsMismatch = errors.New("checkpoint totals do not match the expected totals")
+
+	// sectionDependencies lists the sections which need to be restored
+	// for a section to be restored successfully. Collateral and banking are
+	// restored together: the pending transfers and withdrawals held by banking
+	// have already been taken out of the collateral balances.
+	sectionDependencies = map[types.CheckpointName][]types.CheckpointName{
+		types.CollateralCheckpoint: {types.AssetsCheckpoint, types.BankingCheckpoint},
+		types.BankingCheckpoint:    {types.AssetsCheckpoint, types.CollateralCheckpoint},
+		types.GovernanceCheckpoint: {types.AssetsCheckpoint, types.CollateralCheckpoint, types.NetParamsCheckpoint},
+		types.DelegationCheckpoint: {types.ValidatorsCheckpoint, types.EpochCheckpoint, types.StakingCheckpoint},
+	}
+)
+
+// restoreOptions are the restore settings taken from the genesis state.
+type restoreOptions struct {
+	// sections to restore, nil means all sections are restored
+	sections         map[types.CheckpointName]struct{}
+	collateralTotals map[string]*num.Uint
+	stakingTotal     *num.Uint
+}
+
+func newRestoreOptions(state *GenesisState) (*restoreOptions, error) {
+	opts := &restoreOptions{}
+	if len(state.Sections) > 0 {
+		opts.sections = map[types.CheckpointName]struct{}{}
+		for _, s := range state.Sections {
+			if !isCheckpointSection(types.CheckpointName(s)) {
+				return nil, fmt.Errorf("%s: %w", s, ErrUnknownCheckpointSection)
+			}
+			opts.addSection(types.CheckpointName(s))
+		}
+	}
+	if len(state.CollateralTotals) > 0 {
+		opts.collateralTotals = make(map[string]*num.Uint, len(state.CollateralTotals))
+		for asset, total := range state.CollateralTotals {
+			v, overflow := num.UintFromString(total, 10)
+			if overflow {
+				return nil, fmt.Errorf("collateral total for asset %s (%s): %w", asset, total, ErrInvalidExpectedTotal)
+			}
+			opts.collateralTotals[asset] = v
+		}
+	}
+	if len(state.StakingTotal) > 0 {
+		v, overflow := num.UintFromString(state.StakingTotal, 10)
+		if overflow {
+			return nil, fmt.Errorf("staking total (%s): %w", state.StakingTotal, ErrInvalidExpectedTotal)
+		}
+		opts.stakingTotal = v
+	}
+	return opts, nil
+}
+
+// addSection adds the section and the sections it depends on.
+func (o *restoreOptions) addSection(name types.CheckpointName) {
+	if _, ok := o.sections[name]; ok {
+		return
+	}
+	o.sections[name] = struct{}{}
+	for _, d := range sectionDependencies[name] {
+		o.addSection(d)
+	}
+}
+
+func (o *restoreOptions) restore(name types.CheckpointName) bool {
+	if o == nil || o.sections == nil {
+		return true
+	}
+	_, ok := o.sections[name]
+	return ok
+}
+
+// validateTotals checks the totals of the sections to restore match the expected totals.
+func (o *restoreOptions) validateTotals(cp *types.Checkpoint) error {
+	if o == nil {
+		return nil
+	}
+	if o.collateralTotals != nil && o.restore(types.CollateralCheckpoint) {
+		balances, err := readBalances(cp.Collateral)
+		if err != nil {
+			return err
+		}
+		totals := assetTotals(balances)
+		for _, asset := range sortedKeys(o.collateralTotals) {
+			got, ok := totals[asset]
+			if !ok {
+				got = num.UintZero()
+			}
+			if expected := o.collateralTotals[asset]; !expected.EQ(got) {
+				return fmt.Errorf("collateral total for asset %s, expected(%s), got(%s): %w", asset, expected, got, ErrCheckpointTotalsMismatch)
+			}
+		}
+	}
+	if o.stakingTotal != nil && o.restore(types.StakingCheckpoint) {
+		stakes, err := readStakes(cp.Staking)
+		if err != nil {
+			return err
+		}
+		if got := stakeTotal(stakes); !o.stakingTotal.EQ(got) {
+			return fmt.Errorf("staking total, expected(%s), got(%s): %w", o.stakingTotal, got, ErrCheckpointTotalsMismatch)
+		}
+	}
+	return nil
+}
+
+func (o *restoreOptions) logSections(log *logging.Logger) {
+	if o == nil || o.sections == nil {
+		return
+	}
+	for _, n := range cpOrder {
+		if _, ok := o.sections[n]; ok {
+			log.Warn("Partial checkpoint restore, section will be restored", logging.String("section", string(n)))
+		}
+	}
+}
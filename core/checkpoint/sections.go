@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package checkpoint
+
+import (
+	"fmt"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	checkpointpb "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// balanceKey identifies a balance in the collateral section of a checkpoint.
+type balanceKey struct {
+	party string
+	asset string
+}
+
+// readBalances returns the balances of the collateral section per party and asset.
+func readBalances(data []byte) (map[balanceKey]*num.Uint, error) {
+	cp := &checkpointpb.Collateral{}
+	if err := proto.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("invalid collateral checkpoint: %w", err)
+	}
+	balances := make(map[balanceKey]*num.Uint, len(cp.Balances))
+	for _, b := range cp.Balances {
+		amount, overflow := num.UintFromString(b.Balance, 10)
+		if overflow {
+			return nil, fmt.Errorf("invalid balance for party %s in asset %s: %s", b.Party, b.Asset, b.Balance)
+		}
+		k := balanceKey{party: b.Party, asset: b.Asset}
+		if current, ok := balances[k]; ok {
+			amount.AddSum(current)
+		}
+		balances[k] = amount
+	}
+	return balances, nil
+}
+
+// assetTotals sums the balances per asset.
+func assetTotals(balances map[balanceKey]*num.Uint) map[string]*num.Uint {
+	totals := map[string]*num.Uint{}
+	for k, b := range balances {
+		if _, ok := totals[k.asset]; !ok {
+			totals[k.asset] = num.UintZero()
+		}
+		totals[k.asset].AddSum(b)
+	}
+	return totals
+}
+
+// readStakes returns the net amount staked per party in the staking section
+// of a checkpoint, that is the linked amount minus the unlinked amount.
+func readStakes(data []byte) (map[string]*num.Uint, error) {
+	cp := &checkpointpb.Staking{}
+	if err := proto.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("invalid staking checkpoint: %w", err)
+	}
+	linked, unlinked := map[string]*num.Uint{}, map[string]*num.Uint{}
+	for _, s := range cp.Accepted {
+		amount, overflow := num.UintFromString(s.Amount, 10)
+		if overflow {
+			return nil, fmt.Errorf("invalid stake amount for party %s: %s", s.Party, s.Amount)
+		}
+		totals := linked
+		if s.Type == eventspb.StakeLinking_TYPE_UNLINK {
+			totals = unlinked
+		}
+		if current, ok := totals[s.Party]; ok {
+			amount.AddSum(current)
+		}
+		totals[s.Party] = amount
+	}
+	stakes := make(map[string]*num.Uint, len(linked))
+	for party, amount := range linked {
+		stakes[party] = amount
+		if u, ok := unlinked[party]; ok {
+			if u.GT(amount) {
+				stakes[party] = num.UintZero()
+				continue
+			}
+			stakes[party] = num.UintZero().Sub(amount, u)
+		}
+	}
+	return stakes, nil
+}
+
+// stakeTotal sums the stakes of all parties.
+func stakeTotal(stakes map[string]*num.Uint) *num.Uint {
+	total := num.UintZero()
+	for _, s := range stakes {
+		total.AddSum(s)
+	}
+	return total
+}
+
+// readProposals returns the state of the proposals in the governance section per proposal ID.
+func readProposals(data []byte) (map[string]string, error) {
+	cp := &checkpointpb.Proposals{}
+	if err := proto.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("invalid governance checkpoint: %w", err)
+	}
+	proposals := make(map[string]string, len(cp.Proposals))
+	for _, p := range cp.Proposals {
+		proposals[p.Id] = p.State.String()
+	}
+	return proposals, nil
+}
+
+// readNetParams returns the values of the network parameters section per key.
+func readNetParams(data []byte) (map[string]string, error) {
+	cp := &checkpointpb.NetParams{}
+	if err := proto.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("invalid network parameters checkpoint: %w", err)
+	}
+	params := make(map[string]string, len(cp.Params))
+	for _, p := range cp.Params {
+		params[p.Key] = p.Value
+	}
+	return params, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isCheckpointSection returns true if the name is a section which can be restored from a checkpoint.
+func isCheckpointSection(name types.CheckpointName) bool {
+	for _, n := range cpOrder {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// EVMBridgeClients holds the clients to the EVM chains hosting a collateral
+// bridge, other than the primary Ethereum chain.
+type EVMBridgeClients struct {
+	// map of chainID -> Client
+	clients       map[string]*SecondaryClient
+	confirmations map[string]*EthereumConfirmations
+}
+
+func DialEVMBridges(ctx context.Context, cfg Config) (*EVMBridgeClients, error) {
+	if len(cfg.EVMBridgeConfigs) <= 0 {
+		return nil, errors.New("require at least one EVM bridge configuration")
+	}
+
+	clients := map[string]*SecondaryClient{}
+	confirmations := map[string]*EthereumConfirmations{}
+
+	for _, v := range cfg.EVMBridgeConfigs {
+		if len(v.ChainID) <= 0 {
+			return nil, errors.New("EVM bridge configured with an empty chain id")
+		}
+		if _, ok := clients[v.ChainID]; ok {
+			return nil, fmt.Errorf("EVM bridge configured twice for chain-id: %s", v.ChainID)
+		}
+
+		clt, err := SecondaryDial(ctx, cfg, v)
+		if err != nil {
+			return nil, err
+		}
+
+		chainID, err := clt.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get chain id: %w", err)
+		}
+
+		if chainID.String() != v.ChainID {
+			return nil, fmt.Errorf("client retrieve different chain id: %v vs %v", chainID.String(), v.ChainID)
+		}
+
+		clients[v.ChainID] = clt
+		// for arbitrum the finality state of a block is in now way connected to the Arbitrum network reaching consensus so Vega gains nothing
+		// from waiting for safe/finalized. Instead we just wait for the event to be seen in the latest block and rely on the consensus check
+		// Vega performs itself with node-votes. If each validator is running their own Arbitrum node, or is using a node that they need trustworthy
+		// then this is sufficient. A far as is know, block reorgs do not happen on Arbitrum.
+		confirmations[v.ChainID] = NewEthereumConfirmations(cfg, clt, nil, FinalityStateLatest)
+	}
+
+	return &EVMBridgeClients{
+		clients:       clients,
+		confirmations: confirmations,
+	}, nil
+}
+
+// Get returns the client to the given chain, and its confirmations.
+func (c *EVMBridgeClients) Get(chainID string) (*SecondaryClient, *EthereumConfirmations, bool) {
+	if c == nil {
+		return nil, nil, false
+	}
+	clt, ok1 := c.clients[chainID]
+	confs, ok2 := c.confirmations[chainID]
+	return clt, confs, ok1 && ok2
+}
+
+// ChainIDs returns the IDs of the chains the clients are connected to.
+func (c *EVMBridgeClients) ChainIDs() []string {
+	if c == nil {
+		return nil
+	}
+	chainIDs := make([]string, 0, len(c.clients))
+	for chainID := range c.clients {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	return chainIDs
+}
@@ -18,7 +18,6 @@ package eth
 import (
 	"context"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -44,12 +43,7 @@ type SecondaryClient struct {
 	retryDelay time.Duration
 }
 
-func SecondaryDial(ctx context.Context, cfg Config) (*SecondaryClient, error) {
-	if len(cfg.EVMBridgeConfigs) != 1 {
-		return nil, errors.New("require exactly one EVM bridge configuration")
-	}
-
-	evmCfg := cfg.EVMBridgeConfigs[0]
+func SecondaryDial(ctx context.Context, cfg Config, evmCfg EVMChainConfig) (*SecondaryClient, error) {
 	if len(evmCfg.RPCEndpoint) <= 0 {
 		return nil, fmt.Errorf("no rpc endpoint configured for chain-id: %s", evmCfg.ChainID)
 	}
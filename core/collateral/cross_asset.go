@@ -0,0 +1,432 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collateral
+
+import (
+	"context"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+// CrossAssetCollateralSeller sells, through a spot market, the cross-asset collateral
+// held in the general account of a party for the settlement asset.
+type CrossAssetCollateralSeller interface {
+	SellCrossAssetCollateral(ctx context.Context, party, asset, settlementAsset, market string, amount *num.Uint) error
+}
+
+func (e *Engine) SetCrossAssetCollateralSeller(seller CrossAssetCollateralSeller) {
+	e.crossAssetSeller = seller
+}
+
+// OnCrossAssetCollateralUpdate sets the assets approved as collateral for the
+// markets settled in another asset. The prices of the assets no longer approved
+// are dropped.
+func (e *Engine) OnCrossAssetCollateralUpdate(_ context.Context, v string) error {
+	cfgs, err := types.ParseCrossAssetCollateral(v)
+	if err != nil {
+		return err
+	}
+
+	approved := make(map[string][]*types.CrossAssetCollateral, len(cfgs))
+	prices := make(map[string]map[string]num.Decimal, len(cfgs))
+	for _, c := range cfgs {
+		approved[c.SettlementAsset] = append(approved[c.SettlementAsset], c)
+		if price, ok := e.crossAssetPrices[c.SettlementAsset][c.Asset]; ok {
+			if _, ok := prices[c.SettlementAsset]; !ok {
+				prices[c.SettlementAsset] = map[string]num.Decimal{}
+			}
+			prices[c.SettlementAsset][c.Asset] = price
+		}
+	}
+	e.crossAssetCollateral = approved
+	e.crossAssetPrices = prices
+	e.state.updateCrossAssetPrices(e.crossAssetPrices)
+	return nil
+}
+
+// UpdateCrossAssetCollateralPrice sets the price, given by its oracle, of one unit
+// of the asset in units of the settlement asset.
+func (e *Engine) UpdateCrossAssetCollateralPrice(asset, settlementAsset string, price num.Decimal) {
+	if !price.IsPositive() {
+		return
+	}
+	for _, c := range e.crossAssetCollateral[settlementAsset] {
+		if c.Asset == asset {
+			if _, ok := e.crossAssetPrices[settlementAsset]; !ok {
+				e.crossAssetPrices[settlementAsset] = map[string]num.Decimal{}
+			}
+			e.crossAssetPrices[settlementAsset][asset] = price
+			e.state.updateCrossAssetPrices(e.crossAssetPrices)
+			return
+		}
+	}
+}
+
+// GetCrossAssetCollateralValue returns the value, in the settlement asset and with
+// the haircuts applied, of the party holdings which can still be pledged to cover
+// its margin in the markets settled in this asset.
+func (e *Engine) GetCrossAssetCollateralValue(party, settlementAsset string) *num.Uint {
+	value := num.DecimalZero()
+	for _, c := range e.crossAssetCollateral[settlementAsset] {
+		unitValue, ok := e.crossAssetUnitValue(c)
+		if !ok {
+			continue
+		}
+		if general, err := e.GetPartyGeneralAccount(party, c.Asset); err == nil {
+			value = value.Add(general.Balance.ToDecimal().Mul(unitValue))
+		}
+	}
+	v, _ := num.UintFromDecimal(value.Floor())
+	return v
+}
+
+// GetPledgedCrossAssetCollateralValue returns the value, in the settlement asset and
+// with the haircuts applied, of the cross-asset collateral the party pledged to cover
+// its margin in the market.
+func (e *Engine) GetPledgedCrossAssetCollateralValue(party, market, settlementAsset string) *num.Uint {
+	v, _ := num.UintFromDecimal(e.pledgedCrossAssetCollateralValue(party, market, settlementAsset).Floor())
+	return v
+}
+
+// pledgeCrossAssetCollateral moves, from the general accounts of the party to its
+// margin accounts in the market, the cross-asset collateral needed to cover the
+// missing margin. The collateral already pledged in the market counts towards it.
+// It returns false, and moves nothing, if the collateral isn't enough.
+func (e *Engine) pledgeCrossAssetCollateral(ctx context.Context, party, market, settlementAsset string, missing *num.Uint) (*types.LedgerMovement, bool) {
+	approved := e.crossAssetCollateral[settlementAsset]
+	if len(approved) == 0 {
+		return nil, false
+	}
+
+	remaining := missing.ToDecimal().Sub(e.pledgedCrossAssetCollateralValue(party, market, settlementAsset))
+	if !remaining.IsPositive() {
+		return nil, true
+	}
+
+	type pledge struct {
+		general *types.Account
+		amount  *num.Uint
+	}
+	pledges := make([]pledge, 0, len(approved))
+	for _, c := range approved {
+		if !remaining.IsPositive() {
+			break
+		}
+		unitValue, ok := e.crossAssetUnitValue(c)
+		if !ok {
+			continue
+		}
+		general, err := e.GetPartyGeneralAccount(party, c.Asset)
+		if err != nil || general.Balance.IsZero() {
+			continue
+		}
+
+		amount, _ := num.UintFromDecimal(remaining.Div(unitValue).Ceil())
+		amount = num.Min(amount, general.Balance)
+		remaining = remaining.Sub(amount.ToDecimal().Mul(unitValue))
+		pledges = append(pledges, pledge{general: general, amount: amount})
+	}
+	if remaining.IsPositive() {
+		return nil, false
+	}
+
+	resp := &types.LedgerMovement{
+		Entries: make([]*types.LedgerEntry, 0, len(pledges)),
+	}
+	now := e.timeService.GetTimeNow().UnixNano()
+	for _, p := range pledges {
+		marginID, err := e.CreatePartyMarginAccount(ctx, party, market, p.general.Asset)
+		if err != nil {
+			e.log.Panic("could not create the margin account for cross-asset collateral",
+				logging.PartyID(party),
+				logging.MarketID(market),
+				logging.AssetID(p.general.Asset),
+				logging.Error(err))
+		}
+		margin := e.accs[marginID]
+		resp.Entries = append(resp.Entries, e.moveCrossAssetCollateral(ctx, p.general, margin, p.amount, types.TransferTypeMarginLow, now))
+	}
+
+	if e.log.IsDebug() {
+		e.log.Debug("margin shortfall covered by cross-asset collateral",
+			logging.PartyID(party),
+			logging.MarketID(market),
+			logging.AssetID(settlementAsset),
+			logging.BigUint("missing", missing))
+	}
+	return resp, true
+}
+
+// releaseCrossAssetCollateral moves the cross-asset collateral pledged by the party
+// in the market back to its general accounts.
+func (e *Engine) releaseCrossAssetCollateral(ctx context.Context, party, market, settlementAsset string) []*types.LedgerEntry {
+	pledged := e.pledgedCrossAssetCollateralAccounts(party, market, settlementAsset)
+	if len(pledged) == 0 {
+		return nil
+	}
+
+	entries := make([]*types.LedgerEntry, 0, len(pledged))
+	now := e.timeService.GetTimeNow().UnixNano()
+	for _, margin := range pledged {
+		general, err := e.GetPartyGeneralAccount(party, margin.Asset)
+		if err != nil {
+			e.log.Panic("no general account for pledged cross-asset collateral",
+				logging.PartyID(party),
+				logging.AssetID(margin.Asset),
+				logging.Error(err))
+		}
+		entries = append(entries, e.moveCrossAssetCollateral(ctx, margin, general, margin.Balance.Clone(), types.TransferTypeMarginHigh, now))
+	}
+	return entries
+}
+
+// sellCrossAssetCollateral sells, through their liquidation market, the cross-asset
+// collateral pledged by the party in the market, and moves the proceeds to its
+// margin account in the settlement asset. What can't be sold stays pledged.
+func (e *Engine) sellCrossAssetCollateral(ctx context.Context, party, market, settlementAsset string) []*types.LedgerEntry {
+	pledged := e.pledgedCrossAssetCollateralAccounts(party, market, settlementAsset)
+	if len(pledged) == 0 || e.crossAssetSeller == nil {
+		return nil
+	}
+
+	settlementGeneralID := e.accountID(noMarket, party, settlementAsset, types.AccountTypeGeneral)
+	settlementGeneral, ok := e.accs[settlementGeneralID]
+	if !ok {
+		return nil
+	}
+	settlementMarginID, err := e.CreatePartyMarginAccount(ctx, party, market, settlementAsset)
+	if err != nil {
+		e.log.Panic("could not create the margin account for the cross-asset collateral proceeds",
+			logging.PartyID(party),
+			logging.MarketID(market),
+			logging.AssetID(settlementAsset),
+			logging.Error(err))
+	}
+	settlementMargin := e.accs[settlementMarginID]
+
+	entries := []*types.LedgerEntry{}
+	now := e.timeService.GetTimeNow().UnixNano()
+	for _, margin := range pledged {
+		c, ok := e.getCrossAssetCollateral(margin.Asset, settlementAsset)
+		if !ok {
+			continue
+		}
+		general, ok := e.accs[e.accountID(noMarket, party, margin.Asset, types.AccountTypeGeneral)]
+		if !ok {
+			e.log.Panic("no general account for pledged cross-asset collateral",
+				logging.PartyID(party),
+				logging.AssetID(margin.Asset))
+		}
+
+		// the spot market sells from the general account of the party.
+		held := general.Balance.Clone()
+		amount := margin.Balance.Clone()
+		proceedsFrom := settlementGeneral.Balance.Clone()
+		entries = append(entries, e.moveCrossAssetCollateral(ctx, margin, general, amount, types.TransferTypeMarginHigh, now))
+		if err := e.crossAssetSeller.SellCrossAssetCollateral(ctx, party, margin.Asset, settlementAsset, c.LiquidationMarket, amount); err != nil {
+			e.log.Error("could not sell cross-asset collateral",
+				logging.PartyID(party),
+				logging.AssetID(margin.Asset),
+				logging.MarketID(c.LiquidationMarket),
+				logging.Error(err))
+		}
+
+		if settlementGeneral.Balance.GT(proceedsFrom) {
+			proceeds := num.UintZero().Sub(settlementGeneral.Balance, proceedsFrom)
+			entries = append(entries, e.moveCrossAssetCollateral(ctx, settlementGeneral, settlementMargin, proceeds, types.TransferTypeMarginLow, now))
+		}
+		if general.Balance.GT(held) {
+			unsold := num.UintZero().Sub(general.Balance, held)
+			entries = append(entries, e.moveCrossAssetCollateral(ctx, general, margin, unsold, types.TransferTypeMarginLow, now))
+		}
+	}
+
+	if e.log.IsDebug() {
+		e.log.Debug("cross-asset collateral sold",
+			logging.PartyID(party),
+			logging.MarketID(market),
+			logging.AssetID(settlementAsset),
+			logging.BigUint("margin", settlementMargin.Balance))
+	}
+	return entries
+}
+
+// coverLossWithCrossAssetCollateral sells the cross-asset collateral pledged by the
+// party if its margin, general and bond accounts don't cover the loss, so the
+// proceeds are used before the insurance pool.
+func (e *Engine) coverLossWithCrossAssetCollateral(ctx context.Context, party, market, settlementAsset string, loss *num.Uint, useGeneralAccount bool) *types.LedgerMovement {
+	if len(e.crossAssetCollateral[settlementAsset]) == 0 {
+		return nil
+	}
+	general, margin, bond, err := e.getMTMPartyAccounts(party, market, settlementAsset)
+	if err != nil {
+		return nil
+	}
+	available := margin.Balance.Clone()
+	if useGeneralAccount {
+		available.AddSum(general.Balance)
+	}
+	if bond != nil {
+		available.AddSum(bond.Balance)
+	}
+	if available.GTE(loss) {
+		return nil
+	}
+	return crossAssetLedgerMovement(e.sellCrossAssetCollateral(ctx, party, market, settlementAsset))
+}
+
+// confiscateCrossAssetCollateral moves the cross-asset collateral pledged by a closed
+// out party in the market, which couldn't be sold, to the global insurance pool of
+// each asset.
+func (e *Engine) confiscateCrossAssetCollateral(ctx context.Context, party, market, settlementAsset string) []*types.LedgerEntry {
+	pledged := e.pledgedCrossAssetCollateralAccounts(party, market, settlementAsset)
+	if len(pledged) == 0 {
+		return nil
+	}
+
+	entries := make([]*types.LedgerEntry, 0, len(pledged))
+	now := e.timeService.GetTimeNow().UnixNano()
+	for _, margin := range pledged {
+		insurance := e.GetOrCreateGlobalInsuranceAccount(ctx, margin.Asset)
+		entries = append(entries, e.moveCrossAssetCollateral(ctx, margin, insurance, margin.Balance.Clone(), types.TransferTypeMarginConfiscated, now))
+	}
+	return entries
+}
+
+func (e *Engine) moveCrossAssetCollateral(ctx context.Context, from, to *types.Account, amount *num.Uint, transferType types.TransferType, now int64) *types.LedgerEntry {
+	// the accounts may be copies, the balances reported are the ones after the move.
+	from, to = e.accs[from.ID], e.accs[to.ID]
+	if err := e.UpdateBalance(ctx, from.ID, num.UintZero().Sub(from.Balance, amount)); err != nil {
+		e.log.Panic("could not update the balance of the account", logging.String("account-id", from.ID), logging.Error(err))
+	}
+	if err := e.IncrementBalance(ctx, to.ID, amount); err != nil {
+		e.log.Panic("could not update the balance of the account", logging.String("account-id", to.ID), logging.Error(err))
+	}
+	return &types.LedgerEntry{
+		FromAccount:        from.ToDetails(),
+		ToAccount:          to.ToDetails(),
+		Amount:             amount,
+		Type:               transferType,
+		Timestamp:          now,
+		FromAccountBalance: from.Balance.Clone(),
+		ToAccountBalance:   to.Balance.Clone(),
+	}
+}
+
+// pledgedCrossAssetCollateralAccounts returns the non-empty margin accounts of the
+// party in the market held in another asset than the settlement asset, sorted by
+// ID. Assets no longer approved are included, so they can be released.
+func (e *Engine) pledgedCrossAssetCollateralAccounts(party, market, settlementAsset string) []*types.Account {
+	accs := []*types.Account{}
+	for _, acc := range e.partiesAccs[party] {
+		if acc.Type == types.AccountTypeMargin && acc.MarketID == market && acc.Asset != settlementAsset && !acc.Balance.IsZero() {
+			accs = append(accs, acc)
+		}
+	}
+	sort.Slice(accs, func(i, j int) bool { return accs[i].ID < accs[j].ID })
+	return accs
+}
+
+// pledgedCrossAssetCollateralValue returns the value, in the smallest unit of the
+// settlement asset and with the haircuts applied, of the approved and priced
+// collateral pledged by the party in the market.
+func (e *Engine) pledgedCrossAssetCollateralValue(party, market, settlementAsset string) num.Decimal {
+	value := num.DecimalZero()
+	for _, c := range e.crossAssetCollateral[settlementAsset] {
+		unitValue, ok := e.crossAssetUnitValue(c)
+		if !ok {
+			continue
+		}
+		if margin, ok := e.accs[e.accountID(market, party, c.Asset, types.AccountTypeMargin)]; ok {
+			value = value.Add(margin.Balance.ToDecimal().Mul(unitValue))
+		}
+	}
+	return value
+}
+
+func (e *Engine) getCrossAssetCollateral(asset, settlementAsset string) (*types.CrossAssetCollateral, bool) {
+	for _, c := range e.crossAssetCollateral[settlementAsset] {
+		if c.Asset == asset {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// crossAssetUnitValue returns the value of the smallest unit of the asset in the
+// smallest unit of the settlement asset, with the haircut applied.
+func (e *Engine) crossAssetUnitValue(c *types.CrossAssetCollateral) (num.Decimal, bool) {
+	price, ok := e.crossAssetPrices[c.SettlementAsset][c.Asset]
+	if !ok {
+		return num.DecimalZero(), false
+	}
+	asset, ok := e.enabledAssets[c.Asset]
+	if !ok {
+		return num.DecimalZero(), false
+	}
+	settlementAsset, ok := e.enabledAssets[c.SettlementAsset]
+	if !ok {
+		return num.DecimalZero(), false
+	}
+
+	decimals := int64(settlementAsset.Details.Decimals) - int64(asset.Details.Decimals)
+	unitValue := price.Mul(num.DecimalOne().Sub(c.Haircut)).
+		Mul(num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(decimals)))
+	return unitValue, unitValue.IsPositive()
+}
+
+func crossAssetPricesList(prices map[string]map[string]num.Decimal) []*types.CrossAssetCollateralPrice {
+	out := []*types.CrossAssetCollateralPrice{}
+	for settlementAsset, assetPrices := range prices {
+		for asset, price := range assetPrices {
+			out = append(out, &types.CrossAssetCollateralPrice{
+				Asset:           asset,
+				SettlementAsset: settlementAsset,
+				Price:           price,
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].SettlementAsset == out[j].SettlementAsset {
+			return out[i].Asset < out[j].Asset
+		}
+		return out[i].SettlementAsset < out[j].SettlementAsset
+	})
+	return out
+}
+
+func (e *Engine) restoreCrossAssetPrices(prices []*types.CrossAssetCollateralPrice) {
+	e.crossAssetPrices = map[string]map[string]num.Decimal{}
+	for _, p := range prices {
+		if _, ok := e.crossAssetPrices[p.SettlementAsset]; !ok {
+			e.crossAssetPrices[p.SettlementAsset] = map[string]num.Decimal{}
+		}
+		e.crossAssetPrices[p.SettlementAsset][p.Asset] = p.Price
+	}
+	e.state.updateCrossAssetPrices(e.crossAssetPrices)
+}
+
+// crossAssetLedgerMovement wraps the entries moving cross-asset collateral, nil
+// if there are none.
+func crossAssetLedgerMovement(entries []*types.LedgerEntry) *types.LedgerMovement {
+	if len(entries) == 0 {
+		return nil
+	}
+	return &types.LedgerMovement{Entries: entries}
+}
@@ -0,0 +1,437 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collateral_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/collateral"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	crossAssetCollateralAsset = "WETH"
+	crossAssetPriceOracle     = `{"external": {"oracle": {"signers": [{"pubKey": {"key": "0xCAFED00D"}}], "filters": [{"key": {"name": "prices.ETH.value", "type": "TYPE_INTEGER", "numberDecimalPlaces": "5"}}]}}}`
+)
+
+func TestCrossAssetCollateral(t *testing.T) {
+	t.Run("a party isn't closed out when its collateral covers the shortfall", testCrossAssetCollateralCoversShortfall)
+	t.Run("the collateral already pledged counts towards the shortfall", testCrossAssetCollateralAlreadyPledged)
+	t.Run("the collateral is released when the margin is high", testCrossAssetCollateralReleased)
+	t.Run("the collateral covers the margin of an order", testCrossAssetCollateralOnOrder)
+	t.Run("the collateral of a closed out party is sold, and the proceeds confiscated", testCrossAssetCollateralSoldOnCloseOut)
+	t.Run("the collateral of a closed out party which can't be sold is confiscated", testCrossAssetCollateralConfiscated)
+	t.Run("the collateral is sold to cover a loss before the insurance pool", testCrossAssetCollateralSoldToCoverLoss)
+	t.Run("a party is closed out when its collateral isn't priced", testCrossAssetCollateralWithoutPrice)
+	t.Run("a party is closed out when its collateral doesn't cover the shortfall", testCrossAssetCollateralTooSmall)
+	t.Run("the prices are restored from a snapshot", testCrossAssetCollateralSnapshot)
+	t.Run("invalid configurations are rejected", testCrossAssetCollateralInvalidConfig)
+}
+
+// getCrossAssetTestEngine sets up a party with 50 of the settlement asset, which
+// has no decimals, and 10 WETH, with 18 decimals, approved with a haircut of 20%.
+func getCrossAssetTestEngine(t *testing.T, party string) *testEngine {
+	t.Helper()
+	eng := getTestEngine(t)
+	ctx := context.Background()
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	require.NoError(t, eng.EnableAsset(ctx, types.Asset{
+		ID: crossAssetCollateralAsset,
+		Details: &types.AssetDetails{
+			Symbol:   crossAssetCollateralAsset,
+			Decimals: 18,
+			Quantum:  num.DecimalOne(),
+		},
+	}))
+	require.NoError(t, eng.OnCrossAssetCollateralUpdate(ctx, crossAssetCollateralConfig(crossAssetCollateralAsset, testMarketAsset, "0.2")))
+
+	acc, err := eng.CreatePartyGeneralAccount(ctx, party, testMarketAsset)
+	require.NoError(t, err)
+	require.NoError(t, eng.IncrementBalance(ctx, acc, num.NewUint(50)))
+	acc, err = eng.CreatePartyGeneralAccount(ctx, party, crossAssetCollateralAsset)
+	require.NoError(t, err)
+	require.NoError(t, eng.IncrementBalance(ctx, acc, num.MustUintFromString("10000000000000000000", 10)))
+	_, err = eng.CreatePartyMarginAccount(ctx, party, testMarketID, testMarketAsset)
+	require.NoError(t, err)
+	return eng
+}
+
+func crossAssetCollateralConfig(asset, settlementAsset, haircut string) string {
+	return fmt.Sprintf(
+		`[{"asset": %q, "settlement_asset": %q, "haircut": %q, "price_oracle": %s, "price_oracle_spec_binding": {"price_source_property": "prices.ETH.value"}, "liquidation_market": "spot"}]`,
+		asset, settlementAsset, haircut, crossAssetPriceOracle)
+}
+
+// crossAssetSeller sells the collateral at a fixed price, in units of the settlement
+// asset, for up to the given amount of the asset.
+type crossAssetSeller struct {
+	eng      *testEngine
+	price    num.Decimal
+	maxSold  *num.Uint
+	market   string
+	proceeds *num.Uint
+}
+
+func (s *crossAssetSeller) SellCrossAssetCollateral(ctx context.Context, party, asset, settlementAsset, market string, amount *num.Uint) error {
+	sold := amount
+	if s.maxSold != nil {
+		sold = num.Min(sold, s.maxSold)
+	}
+	general, err := s.eng.GetPartyGeneralAccount(party, asset)
+	if err != nil {
+		return err
+	}
+	if err := s.eng.UpdateBalance(ctx, general.ID, num.UintZero().Sub(general.Balance, sold)); err != nil {
+		return err
+	}
+	settlement, err := s.eng.GetPartyGeneralAccount(party, settlementAsset)
+	if err != nil {
+		return err
+	}
+	// the asset has 18 decimals, the settlement asset none.
+	s.proceeds, _ = num.UintFromDecimal(sold.ToDecimal().Mul(s.price).Div(num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(18))).Floor())
+	s.market = market
+	return s.eng.IncrementBalance(ctx, settlement.ID, s.proceeds)
+}
+
+func crossAssetMarginUpdate(party string, amount uint64, transferType types.TransferType) []events.Risk {
+	return []events.Risk{
+		riskFake{
+			party:  party,
+			asset:  testMarketAsset,
+			amount: num.NewUint(amount),
+			transfer: &types.Transfer{
+				Owner: party,
+				Amount: &types.FinancialAmount{
+					Amount: num.NewUint(amount),
+					Asset:  testMarketAsset,
+				},
+				MinAmount: num.NewUint(amount),
+				Type:      transferType,
+			},
+		},
+	}
+}
+
+func crossAssetBalances(t *testing.T, eng *testEngine, party string) (general, pledged string) {
+	t.Helper()
+	acc, err := eng.GetPartyGeneralAccount(party, crossAssetCollateralAsset)
+	require.NoError(t, err)
+	general = acc.Balance.String()
+	pledged = "0"
+	if acc, err := eng.GetPartyMarginAccount(testMarketID, party, crossAssetCollateralAsset); err == nil {
+		pledged = acc.Balance.String()
+	}
+	return general, pledged
+}
+
+func testCrossAssetCollateralCoversShortfall(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+
+	// 1 WETH is worth 10.5, 8.4 with the haircut.
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10.5"))
+	assert.Equal(t, "84", eng.GetCrossAssetCollateralValue(party, testMarketAsset).String())
+
+	resp, closed, _, err := eng.MarginUpdate(context.Background(), testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	assert.Empty(t, closed)
+
+	// 50 are missing, so 50 / 8.4 WETH are pledged, rounded up.
+	require.Len(t, resp, 2)
+	require.Len(t, resp[1].Entries, 1)
+	assert.Equal(t, types.TransferTypeMarginLow, resp[1].Entries[0].Type)
+	assert.Equal(t, "5952380952380952381", resp[1].Entries[0].Amount.String())
+	general, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "4047619047619047619", general)
+	assert.Equal(t, "5952380952380952381", pledged)
+	assert.Equal(t, "50", eng.GetPledgedCrossAssetCollateralValue(party, testMarketID, testMarketAsset).String())
+}
+
+func testCrossAssetCollateralAlreadyPledged(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	_, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	require.Empty(t, closed)
+	_, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "6250000000000000000", pledged)
+
+	// the pledged collateral still covers the 50 missing.
+	resp, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	assert.Empty(t, closed)
+	assert.Len(t, resp, 1)
+	_, pledged = crossAssetBalances(t, eng, party)
+	assert.Equal(t, "6250000000000000000", pledged)
+
+	// the price dropped, more is pledged.
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("6.25"))
+	_, closed, _, err = eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	assert.Empty(t, closed)
+	general, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "0", general)
+	assert.Equal(t, "10000000000000000000", pledged)
+}
+
+func testCrossAssetCollateralReleased(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	_, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	require.Empty(t, closed)
+
+	resp, _, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 10, types.TransferTypeMarginHigh))
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	require.Len(t, resp[1].Entries, 1)
+	assert.Equal(t, types.TransferTypeMarginHigh, resp[1].Entries[0].Type)
+	general, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "10000000000000000000", general)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralOnOrder(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+
+	update := crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow)[0]
+	_, _, err := eng.MarginUpdateOnOrder(context.Background(), testMarketID, update)
+	require.ErrorIs(t, err, collateral.ErrMinAmountNotReached)
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	resp, _, err := eng.MarginUpdateOnOrder(context.Background(), testMarketID, update)
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 2)
+	assert.Equal(t, crossAssetCollateralAsset, resp.Entries[1].ToAccount.AssetID)
+	_, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "6250000000000000000", pledged)
+}
+
+func testCrossAssetCollateralConfiscated(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	_, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	require.Empty(t, closed)
+
+	resp, err := eng.RemoveDistressed(ctx, []events.MarketPosition{riskFake{party: party}}, testMarketID, testMarketAsset, func(string) bool { return true })
+	require.NoError(t, err)
+	last := resp.Entries[len(resp.Entries)-1]
+	assert.Equal(t, types.TransferTypeMarginConfiscated, last.Type)
+	assert.Equal(t, types.AccountTypeGlobalInsurance, last.ToAccount.Type)
+	assert.Equal(t, "6250000000000000000", last.Amount.String())
+
+	insurance, err := eng.GetGlobalInsuranceAccount(crossAssetCollateralAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "6250000000000000000", insurance.Balance.String())
+	_, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralSoldOnCloseOut(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	_, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	require.Empty(t, closed)
+
+	// only 5 of the 6.25 WETH pledged can be sold, at 10.
+	seller := &crossAssetSeller{eng: eng, price: num.DecimalFromInt64(10), maxSold: num.MustUintFromString("5000000000000000000", 10)}
+	eng.SetCrossAssetCollateralSeller(seller)
+	_, err = eng.RemoveDistressed(ctx, []events.MarketPosition{riskFake{party: party}}, testMarketID, testMarketAsset, func(string) bool { return true })
+	require.NoError(t, err)
+	assert.Equal(t, "spot", seller.market)
+	assert.Equal(t, "50", seller.proceeds.String())
+
+	// the 50 of margin and the 50 of proceeds are confiscated.
+	insurance, err := eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "100", insurance.Balance.String())
+
+	// the 1.25 WETH which couldn't be sold go to the global insurance pool.
+	globalInsurance, err := eng.GetGlobalInsuranceAccount(crossAssetCollateralAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "1250000000000000000", globalInsurance.Balance.String())
+	general, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "3750000000000000000", general)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralSoldToCoverLoss(t *testing.T) {
+	party, winner := "party", "winner"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10"))
+	_, closed, _, err := eng.MarginUpdate(ctx, testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	require.Empty(t, closed)
+
+	_, err = eng.CreatePartyGeneralAccount(ctx, winner, testMarketAsset)
+	require.NoError(t, err)
+	_, err = eng.CreatePartyMarginAccount(ctx, winner, testMarketID, testMarketAsset)
+	require.NoError(t, err)
+
+	seller := &crossAssetSeller{eng: eng, price: num.DecimalFromInt64(10)}
+	eng.SetCrossAssetCollateralSeller(seller)
+
+	// the party has 50 of margin, and loses 100.
+	transfers := []*types.Transfer{
+		{
+			Owner:  party,
+			Amount: &types.FinancialAmount{Amount: num.NewUint(100), Asset: testMarketAsset},
+			Type:   types.TransferTypeMTMLoss,
+		},
+		{
+			Owner:  winner,
+			Amount: &types.FinancialAmount{Amount: num.NewUint(100), Asset: testMarketAsset},
+			Type:   types.TransferTypeMTMWin,
+		},
+	}
+	_, _, err = eng.MarkToMarket(ctx, testMarketID, getMTMTransfer(transfers), testMarketAsset, func(string) bool { return true })
+	require.NoError(t, err)
+
+	// the 6.25 WETH pledged are sold for 62, so the loss is covered without the insurance pool.
+	assert.Equal(t, "62", seller.proceeds.String())
+	margin, err := eng.GetPartyMarginAccount(testMarketID, party, testMarketAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "12", margin.Balance.String())
+	winnerMargin, err := eng.GetPartyMarginAccount(testMarketID, winner, testMarketAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "100", winnerMargin.Balance.String())
+	insurance, err := eng.GetMarketInsurancePoolAccount(testMarketID, testMarketAsset)
+	require.NoError(t, err)
+	assert.Equal(t, "0", insurance.Balance.String())
+	_, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralWithoutPrice(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+
+	assert.Equal(t, "0", eng.GetCrossAssetCollateralValue(party, testMarketAsset).String())
+
+	_, closed, _, err := eng.MarginUpdate(context.Background(), testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	assert.Len(t, closed, 1)
+	_, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralTooSmall(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+
+	// 10 WETH are worth 8 with the haircut.
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("1"))
+
+	_, closed, _, err := eng.MarginUpdate(context.Background(), testMarketID, crossAssetMarginUpdate(party, 100, types.TransferTypeMarginLow))
+	require.NoError(t, err)
+	assert.Len(t, closed, 1)
+	general, pledged := crossAssetBalances(t, eng, party)
+	assert.Equal(t, "10000000000000000000", general)
+	assert.Equal(t, "0", pledged)
+}
+
+func testCrossAssetCollateralSnapshot(t *testing.T) {
+	party := "party"
+	eng := getCrossAssetTestEngine(t, party)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	eng.UpdateCrossAssetCollateralPrice(crossAssetCollateralAsset, testMarketAsset, num.MustDecimalFromString("10.5"))
+	key := (&types.PayloadCollateralAccounts{}).Key()
+	state, _, err := eng.GetState(key)
+	require.NoError(t, err)
+
+	ptype := (&types.Payload{
+		Data: &types.PayloadCollateralAccounts{CollateralAccounts: &types.CollateralAccounts{}},
+	}).IntoProto()
+	require.NoError(t, proto.Unmarshal(state, ptype))
+
+	newEng := getCrossAssetTestEngine(t, party)
+	defer newEng.Finish()
+	newEng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	_, err = newEng.LoadState(ctx, types.PayloadFromProto(ptype))
+	require.NoError(t, err)
+
+	assert.Equal(t, "84", newEng.GetCrossAssetCollateralValue(party, testMarketAsset).String())
+	restored, _, err := newEng.GetState(key)
+	require.NoError(t, err)
+	assert.Equal(t, state, restored)
+}
+
+func testCrossAssetCollateralInvalidConfig(t *testing.T) {
+	eng := getTestEngine(t)
+	defer eng.Finish()
+	ctx := context.Background()
+
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, crossAssetCollateralConfig("ETH", "BTC", "1")),
+		types.ErrCrossAssetCollateralInvalidHaircut)
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, crossAssetCollateralConfig("ETH", "ETH", "0.1")),
+		types.ErrCrossAssetCollateralSameAsset)
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx,
+		`[{"asset": "ETH", "settlement_asset": "BTC", "haircut": "0.1"}]`),
+		types.ErrCrossAssetCollateralMissingPriceOracle)
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, fmt.Sprintf(
+		`[{"asset": "ETH", "settlement_asset": "BTC", "haircut": "0.1", "price_oracle": %s}]`, crossAssetPriceOracle)),
+		types.ErrCrossAssetCollateralMissingPriceBinding)
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, fmt.Sprintf(
+		`[{"asset": "ETH", "settlement_asset": "BTC", "haircut": "0.1", "price_oracle": %s, "price_oracle_spec_binding": {"price_source_property": "p"}}]`, crossAssetPriceOracle)),
+		types.ErrCrossAssetCollateralMissingLiquidation)
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, fmt.Sprintf(
+		`[{"asset": "ETH", "settlement_asset": "BTC", "haircut": "0.1", "price_oracle": %s, "price_oracle_spec_binding": {"price_source_property": "p"}}]`,
+		`{"internal": {"timeTrigger": {"triggers": [{"every": "10"}]}}}`)),
+		types.ErrCrossAssetCollateralInternalPriceOracle)
+	cfg := crossAssetCollateralConfig("ETH", "BTC", "0.1")
+	require.ErrorIs(t, eng.OnCrossAssetCollateralUpdate(ctx, cfg[:len(cfg)-1]+","+cfg[1:]),
+		types.ErrCrossAssetCollateralDuplicate)
+}
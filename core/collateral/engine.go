@@ -126,6 +126,16 @@ type Engine struct {
 	nextBalancesSnapshot     time.Time
 	balanceSnapshotFrequency time.Duration
 
+	// fraction of the insurance pool of a closed market going to the network treasury.
+	insurancePoolTreasurySweepFraction num.Decimal
+
+	// settlement asset -> assets approved as collateral for the markets settled in it.
+	crossAssetCollateral map[string][]*types.CrossAssetCollateral
+	// settlement asset -> asset -> price of one unit of the asset in units of the settlement asset.
+	crossAssetPrices map[string]map[string]num.Decimal
+	// sells the pledged cross-asset collateral through its liquidation market.
+	crossAssetSeller CrossAssetCollateralSeller
+
 	// set to false when started
 	// we'll use it only once after an upgrade
 	// to make sure asset are being created
@@ -154,6 +164,8 @@ func New(log *logging.Logger, conf Config, ts TimeService, broker Broker) *Engin
 		partyAssetCache:         map[string]map[string]*num.Uint{},
 		partyMarketCache:        map[string]map[string]*num.Uint{},
 		earmarkedBalance:        map[string]*num.Uint{},
+		crossAssetCollateral:    map[string][]*types.CrossAssetCollateral{},
+		crossAssetPrices:        map[string]map[string]num.Decimal{},
 	}
 }
 
@@ -322,6 +334,14 @@ func (e *Engine) OnBalanceSnapshotFrequencyUpdated(ctx context.Context, d time.D
 	return nil
 }
 
+// OnInsurancePoolTreasurySweepFractionUpdate sets the fraction of the insurance
+// pool of a closed market swept to the network treasury rather than to the
+// global insurance pool.
+func (e *Engine) OnInsurancePoolTreasurySweepFractionUpdate(_ context.Context, f num.Decimal) error {
+	e.insurancePoolTreasurySweepFraction = f
+	return nil
+}
+
 func (e *Engine) GetPartyBalance(party string) *num.Uint {
 	e.partiesAccsBalanceCacheLock.RLock()
 	defer e.partiesAccsBalanceCacheLock.RUnlock()
@@ -901,6 +921,8 @@ func (e *Engine) getSpotFeeTransferRequest(
 		treq.FromAccount = []*types.Account{makerFee}
 		treq.ToAccount = []*types.Account{general}
 		return treq, nil
+	case types.TransferTypeMakerFeeTierRebate:
+		return e.makerFeeTierRebateRequest(ctx, treq, general), nil
 	case types.TransferTypeHighMakerRebatePay:
 		amt := num.Min(treq.Amount, general.Balance.Clone())
 		treq.Amount = amt
@@ -1149,6 +1171,10 @@ func (e *Engine) transferFees(ctx context.Context, marketID string, assetID stri
 			return nil, err
 		}
 
+		if req == nil {
+			continue
+		}
+
 		res, err := e.getLedgerEntries(ctx, req)
 		if err != nil {
 			e.log.Error("Failed to transfer funds", logging.Error(err))
@@ -1573,6 +1599,14 @@ func (e *Engine) mtmOrFundingSettlement(ctx context.Context, marketID string, tr
 			break
 		}
 
+		// the party cross-asset collateral is sold before its loss is taken from the insurance pool.
+		if party != types.NetworkParty {
+			if sold := e.coverLossWithCrossAssetCollateral(ctx, party, settle.MarketID, asset, transfer.Amount.Amount, useGeneralAccountForMarginSearch(party)); sold != nil {
+				responses = append(responses, sold)
+				marginEvt.general, marginEvt.margin, marginEvt.bond, _ = e.getMTMPartyAccounts(party, settle.MarketID, asset)
+			}
+		}
+
 		req, err := e.getTransferRequest(transfer, settle, insurance, marginEvt, useGeneralAccountForMarginSearch(transfer.Owner))
 		if err != nil {
 			e.log.Error(
@@ -1907,6 +1941,7 @@ func (e *Engine) MarginUpdate(ctx context.Context, marketID string, updates []ev
 		if err != nil {
 			return response, closed, toPenalise, err
 		}
+		var crossAsset *types.LedgerMovement
 		// we didn't manage to top up to even the minimum required system margin, close out party
 		// we need to be careful with this, only apply this to transfer for low margin
 		// the MinAmount in the transfer is always set to 0 but in 2 case:
@@ -1918,7 +1953,16 @@ func (e *Engine) MarginUpdate(ctx context.Context, marketID string, updates []ev
 		// In both case either the order will not be accepted, or the party will be closed
 		if transfer.Type == types.TransferTypeMarginLow &&
 			res.Balances[0].Account.Balance.LT(num.Sum(update.MarginBalance(), transfer.MinAmount)) {
-			closed = append(closed, mevt)
+			// the party isn't closed out if its cross-asset collateral covers what's missing.
+			missing := num.UintZero().Sub(num.Sum(update.MarginBalance(), transfer.MinAmount), res.Balances[0].Account.Balance)
+			var ok bool
+			if crossAsset, ok = e.pledgeCrossAssetCollateral(ctx, update.Party(), marketID, update.Asset(), missing); !ok {
+				closed = append(closed, mevt)
+			}
+		}
+		// the margin is back above the release level, the cross-asset collateral isn't needed anymore.
+		if transfer.Type == types.TransferTypeMarginHigh {
+			crossAsset = crossAssetLedgerMovement(e.releaseCrossAssetCollateral(ctx, update.Party(), marketID, update.Asset()))
 		}
 		// always add the event as well
 		if !mevt.marginShortFall.IsZero() {
@@ -1942,6 +1986,9 @@ func (e *Engine) MarginUpdate(ctx context.Context, marketID string, updates []ev
 				)
 			}
 		}
+		if crossAsset != nil {
+			response = append(response, crossAsset)
+		}
 	}
 
 	return response, closed, toPenalise, nil
@@ -2214,9 +2261,17 @@ func (e *Engine) MarginUpdateOnOrder(ctx context.Context, marketID string, updat
 	}
 
 	// we do not have enough money to get to the minimum amount,
+	// and the cross-asset collateral of the party doesn't cover what's missing,
 	// we return an error.
-	if transfer.Type == types.TransferTypeMarginLow && num.Sum(mevt.GeneralBalance(), mevt.MarginBalance()).LT(transfer.MinAmount) {
-		return nil, mevt, ErrMinAmountNotReached
+	var crossAsset *types.LedgerMovement
+	if transfer.Type == types.TransferTypeMarginLow {
+		if available := num.Sum(mevt.GeneralBalance(), mevt.MarginBalance()); available.LT(transfer.MinAmount) {
+			var ok bool
+			missing := num.UintZero().Sub(transfer.MinAmount, available)
+			if crossAsset, ok = e.pledgeCrossAssetCollateral(ctx, update.Party(), marketID, update.Asset(), missing); !ok {
+				return nil, mevt, ErrMinAmountNotReached
+			}
+		}
 	}
 	if transfer.Type == types.TransferTypeOrderMarginLow && num.Sum(mevt.GeneralBalance(), mevt.OrderMarginBalance()).LT(transfer.MinAmount) {
 		return nil, mevt, ErrMinAmountNotReached
@@ -2248,6 +2303,9 @@ func (e *Engine) MarginUpdateOnOrder(ctx context.Context, marketID string, updat
 			)
 		}
 	}
+	if crossAsset != nil {
+		res.Entries = append(res.Entries, crossAsset.Entries...)
+	}
 
 	if !mevt.marginShortFall.IsZero() {
 		return res, mevt, nil
@@ -2442,6 +2500,8 @@ func (e *Engine) getFeeTransferRequest(
 		treq.FromAccount = []*types.Account{makerFee}
 		treq.ToAccount = []*types.Account{general}
 		return treq, nil
+	case types.TransferTypeMakerFeeTierRebate:
+		return e.makerFeeTierRebateRequest(ctx, treq, general), nil
 	case types.TransferTypeLiquidityFeeAllocate:
 		partyLiquidityFee, err := partyLiquidityFeeAccount()
 		if err != nil {
@@ -2758,7 +2818,8 @@ func (e *Engine) getGovernanceTransferFundsTransferRequest(ctx context.Context,
 		case types.AccountTypeGlobalReward, types.AccountTypeLPFeeReward, types.AccountTypeMakerReceivedFeeReward,
 			types.AccountTypeMakerPaidFeeReward, types.AccountTypeMarketProposerReward, types.AccountTypeAverageNotionalReward,
 			types.AccountTypeRelativeReturnReward, types.AccountTypeReturnVolatilityReward, types.AccountTypeRealisedReturnReward,
-			types.AccountTypeValidatorRankingReward, types.AccountTypeEligibleEntitiesReward, types.AccountTypeBuyBackFees:
+			types.AccountTypeValidatorRankingReward, types.AccountTypeEligibleEntitiesReward, types.AccountTypeBuyBackFees,
+			types.AccountTypeMakerFeeRebates:
 			market := noMarket
 			if len(t.Market) > 0 {
 				market = t.Market
@@ -2891,7 +2952,8 @@ func (e *Engine) getTransferFundsTransferRequest(ctx context.Context, t *types.T
 		case types.AccountTypeGlobalReward, types.AccountTypeLPFeeReward, types.AccountTypeMakerReceivedFeeReward, types.AccountTypeNetworkTreasury,
 			types.AccountTypeMakerPaidFeeReward, types.AccountTypeMarketProposerReward, types.AccountTypeAverageNotionalReward,
 			types.AccountTypeRelativeReturnReward, types.AccountTypeReturnVolatilityReward, types.AccountTypeRealisedReturnReward,
-			types.AccountTypeValidatorRankingReward, types.AccountTypeEligibleEntitiesReward, types.AccountTypeBuyBackFees:
+			types.AccountTypeValidatorRankingReward, types.AccountTypeEligibleEntitiesReward, types.AccountTypeBuyBackFees,
+			types.AccountTypeMakerFeeRebates:
 			market := noMarket
 			if len(t.Market) > 0 {
 				market = t.Market
@@ -3442,6 +3504,11 @@ func (e *Engine) ClearMarket(ctx context.Context, mktID, asset string, parties [
 			resps = append(resps, ledgerEntries)
 		}
 
+		// release the cross-asset collateral pledged in the market
+		if released := crossAssetLedgerMovement(e.releaseCrossAssetCollateral(ctx, v, mktID, asset)); released != nil {
+			resps = append(resps, released)
+		}
+
 		// Then we do bond account
 		bondAcc, err := e.GetAccountByID(e.accountID(mktID, v, asset, types.AccountTypeBond))
 		if err != nil {
@@ -3594,11 +3661,36 @@ func (e *Engine) ClearInsurancepool(ctx context.Context, mktID, asset string, cl
 		return nil, nil
 	}
 
-	globalIns, _ := e.GetGlobalInsuranceAccount(asset)
-	// redistribute market insurance funds between the global and other markets equally
-	req.FromAccount[0] = marketInsuranceAcc
-	req.ToAccount[0] = globalIns
-	req.Amount = marketInsuranceAcc.Balance.Clone()
+	// the share of the network treasury is swept first, the global insurance pool
+	// gets the remainder.
+	treasuryAmount, _ := num.UintFromDecimal(marketInsuranceAcc.Balance.ToDecimal().Mul(e.insurancePoolTreasurySweepFraction).Floor())
+	if !treasuryAmount.IsZero() {
+		req.FromAccount[0] = marketInsuranceAcc
+		req.ToAccount[0] = e.GetOrCreateNetworkTreasuryAccount(ctx, asset)
+		req.Amount = treasuryAmount
+		treasuryLedgerEntries, err := e.sweepInsurancePool(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, treasuryLedgerEntries)
+	}
+
+	if !marketInsuranceAcc.Balance.IsZero() {
+		globalIns, _ := e.GetGlobalInsuranceAccount(asset)
+		req.FromAccount[0] = marketInsuranceAcc
+		req.ToAccount[0] = globalIns
+		req.Amount = marketInsuranceAcc.Balance.Clone()
+		insuranceLedgerEntries, err := e.sweepInsurancePool(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, insuranceLedgerEntries)
+	}
+	e.removeAccount(marketInsuranceID)
+	return resp, nil
+}
+
+func (e *Engine) sweepInsurancePool(ctx context.Context, req *types.TransferRequest) (*types.LedgerMovement, error) {
 	insuranceLedgerEntries, err := e.getLedgerEntries(ctx, req)
 	if err != nil {
 		e.log.Panic("unable to redistribute market insurance funds", logging.Error(err))
@@ -3611,9 +3703,7 @@ func (e *Engine) ClearInsurancepool(ctx context.Context, mktID, asset string, cl
 			return nil, err
 		}
 	}
-	resp = append(resp, insuranceLedgerEntries)
-	e.removeAccount(marketInsuranceID)
-	return resp, nil
+	return insuranceLedgerEntries, nil
 }
 
 func (e *Engine) CanCoverBond(market, party, asset string, amount *num.Uint) bool {
@@ -4274,6 +4364,9 @@ func (e *Engine) RemoveDistressed(ctx context.Context, parties []events.MarketPo
 	}
 	now := e.timeService.GetTimeNow().UnixNano()
 	for _, party := range parties {
+		// the cross-asset collateral pledged in the market is sold first, so the proceeds
+		// are confiscated with the rest of the margin.
+		resp.Entries = append(resp.Entries, e.sellCrossAssetCollateral(ctx, party.Party(), marketID, asset)...)
 		bondAcc, err := e.GetAccountByID(e.accountID(marketID, party.Party(), asset, types.AccountTypeBond))
 		if err != nil {
 			bondAcc = &types.Account{}
@@ -4347,6 +4440,9 @@ func (e *Engine) RemoveDistressed(ctx context.Context, parties []events.MarketPo
 			}
 		}
 
+		// the cross-asset collateral which couldn't be sold goes to the global insurance pool of its asset.
+		resp.Entries = append(resp.Entries, e.confiscateCrossAssetCollateral(ctx, party.Party(), marketID, asset)...)
+
 		// we remove the margin account
 		if useGeneralAccount(party.Party()) {
 			e.removeAccount(marginAcc.ID)
@@ -4370,7 +4466,18 @@ func (e *Engine) ClearPartyMarginAccount(ctx context.Context, party, market, ass
 	if err != nil {
 		return nil, err
 	}
-	return e.clearPartyMarginAccount(ctx, party, asset, acc, types.TransferTypeMarginHigh)
+	resp, err := e.clearPartyMarginAccount(ctx, party, asset, acc, types.TransferTypeMarginHigh)
+	if err != nil {
+		return nil, err
+	}
+	// the cross-asset collateral pledged in the market is released as well.
+	if released := e.releaseCrossAssetCollateral(ctx, party, market, asset); len(released) > 0 {
+		if resp == nil {
+			return crossAssetLedgerMovement(released), nil
+		}
+		resp.Entries = append(resp.Entries, released...)
+	}
+	return resp, nil
 }
 
 func (e *Engine) clearPartyMarginAccount(ctx context.Context, party, asset string, acc *types.Account, transferType types.TransferType) (*types.LedgerMovement, error) {
@@ -4799,6 +4906,44 @@ func (e *Engine) getOrCreateBuyBackFeesAccount(ctx context.Context, asset string
 	return ntAcc
 }
 
+// makerFeeTierRebateRequest completes the request paying the negative maker fee of a maker fee tier
+// from the network maker fee rebates account. The rebate is capped by the balance of the account,
+// if it is empty no rebate is paid.
+func (e *Engine) makerFeeTierRebateRequest(ctx context.Context, treq *types.TransferRequest, general *types.Account) *types.TransferRequest {
+	rebates := e.GetOrCreateMakerFeeRebatesAccount(ctx, treq.Asset)
+	amt := num.Min(treq.Amount, rebates.Balance.Clone())
+	if amt.IsZero() {
+		return nil
+	}
+	treq.Amount = amt
+	treq.MinAmount = amt.Clone()
+	treq.FromAccount = []*types.Account{rebates}
+	treq.ToAccount = []*types.Account{general}
+	return treq
+}
+
+// GetOrCreateMakerFeeRebatesAccount returns the network account funding the negative
+// maker fees of the market maker fee tiers for the given asset.
+func (e *Engine) GetOrCreateMakerFeeRebatesAccount(ctx context.Context, asset string) *types.Account {
+	accID := e.accountID(noMarket, systemOwner, asset, types.AccountTypeMakerFeeRebates)
+	acc, err := e.GetAccountByID(accID)
+	if err == nil {
+		return acc
+	}
+	rebatesAcc := &types.Account{
+		ID:       accID,
+		Asset:    asset,
+		Owner:    systemOwner,
+		Balance:  num.UintZero(),
+		MarketID: noMarket,
+		Type:     types.AccountTypeMakerFeeRebates,
+	}
+	e.accs[accID] = rebatesAcc
+	e.addAccountToHashableSlice(rebatesAcc)
+	e.broker.Send(events.NewAccountEvent(ctx, *rebatesAcc))
+	return rebatesAcc
+}
+
 func (e *Engine) GetOrCreateNetworkTreasuryAccount(ctx context.Context, asset string) *types.Account {
 	accID := e.accountID(noMarket, systemOwner, asset, types.AccountTypeNetworkTreasury)
 	acc, err := e.GetAccountByID(accID)
@@ -118,6 +118,7 @@ func TestCollateralContinuousTradingFeeTransfer(t *testing.T) {
 	t.Run("fees transfer continuous - OK with enough in margin", testFeeTransferContinuousOKWithEnoughInMargin)
 	t.Run("fees transfer continuous - OK with enough in general", testFeeTransferContinuousOKWithEnoughInGenral)
 	t.Run("fees transfer continuous - OK with enough in margin + general", testFeeTransferContinuousOKWithEnoughInGeneralAndMargin)
+	t.Run("fees transfer continuous - maker fee tier rebate capped at the rebates pool", testFeeTransferMakerFeeTierRebateCappedAtPool)
 	t.Run("fees transfer continuous - transfer with 0 amount", testFeeTransferContinuousOKWith0Amount)
 	t.Run("fees transfer check account events", testFeeTransferContinuousOKCheckAccountEvents)
 }
@@ -135,6 +136,7 @@ func TestTransferRewards(t *testing.T) {
 
 func TestClearAccounts(t *testing.T) {
 	t.Run("clear fee accounts", testClearFeeAccounts)
+	t.Run("clear insurance pool with a treasury share", testClearInsurancePoolTreasurySweep)
 }
 
 func TestGetAllVestingQuantumBalance(t *testing.T) {
@@ -247,6 +249,40 @@ func testClearFeeAccounts(t *testing.T) {
 	eng.ClearInsurancepool(ctx, mktID, asset, true)
 }
 
+func testClearInsurancePoolTreasurySweep(t *testing.T) {
+	eng := getTestEngine(t)
+	defer eng.Finish()
+	ctx := context.Background()
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	mktID := "market"
+	asset := "ETH"
+
+	eng.EnableAsset(ctx, types.Asset{
+		ID: asset,
+		Details: &types.AssetDetails{
+			Symbol: asset,
+		},
+	})
+	insurance, _, err := eng.CreateMarketAccounts(ctx, mktID, asset)
+	require.NoError(t, err)
+	require.NoError(t, eng.UpdateBalance(ctx, insurance, num.NewUint(1001)))
+	require.NoError(t, eng.OnInsurancePoolTreasurySweepFractionUpdate(ctx, num.MustDecimalFromString("0.25")))
+
+	lms, err := eng.ClearInsurancepool(ctx, mktID, asset, false)
+	require.NoError(t, err)
+	require.Len(t, lms, 2)
+
+	treasury, err := eng.GetNetworkTreasuryAccount(asset)
+	require.NoError(t, err)
+	assert.Equal(t, num.NewUint(250), treasury.Balance)
+	globalInsurance, err := eng.GetGlobalInsuranceAccount(asset)
+	require.NoError(t, err)
+	assert.Equal(t, num.NewUint(751), globalInsurance.Balance)
+
+	_, err = eng.GetMarketInsurancePoolAccount(mktID, asset)
+	require.Error(t, err)
+}
+
 func testTransferRewardsEmptySlice(t *testing.T) {
 	eng := getTestEngine(t)
 	defer eng.Finish()
@@ -3899,3 +3935,52 @@ func TestEarmarking(t *testing.T) {
 	// finally unearmark the last 400 successfully
 	require.NoError(t, eng.UnearmarkForAutomatedPurchase("ETH", types.AccountTypeGlobalReward, num.NewUint(400)))
 }
+
+func testFeeTransferMakerFeeTierRebateCappedAtPool(t *testing.T) {
+	eng := getTestEngine(t)
+	defer eng.Finish()
+	party := "myparty"
+	ctx := context.Background()
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	general, err := eng.CreatePartyGeneralAccount(ctx, party, testMarketAsset)
+	require.NoError(t, err)
+	_, err = eng.CreatePartyMarginAccount(ctx, party, testMarketID, testMarketAsset)
+	require.NoError(t, err)
+
+	rebates := eng.GetOrCreateMakerFeeRebatesAccount(ctx, testMarketAsset)
+	require.NoError(t, eng.UpdateBalance(ctx, rebates.ID, num.NewUint(100)))
+
+	rebateReq := func() transferFees {
+		return transferFees{
+			tfs: []*types.Transfer{
+				{
+					Owner: party,
+					Amount: &types.FinancialAmount{
+						Asset:  testMarketAsset,
+						Amount: num.NewUint(250),
+					},
+					Type: types.TransferTypeMakerFeeTierRebate,
+				},
+			},
+			tfa: map[string]uint64{},
+		}
+	}
+
+	// the pool only holds 100, so only 100 is paid out
+	transfers, err := eng.TransferFeesContinuousTrading(ctx, testMarketID, testMarketAsset, rebateReq())
+	require.NoError(t, err)
+	require.Len(t, transfers, 1)
+	require.Len(t, transfers[0].Entries, 1)
+	assert.Equal(t, num.NewUint(100), transfers[0].Entries[0].Amount)
+
+	acc, err := eng.GetAccountByID(general)
+	require.NoError(t, err)
+	assert.Equal(t, num.NewUint(100), acc.Balance)
+	rebates = eng.GetOrCreateMakerFeeRebatesAccount(ctx, testMarketAsset)
+	assert.True(t, rebates.Balance.IsZero())
+
+	// the pool is now empty, nothing is paid out
+	transfers, err = eng.TransferFeesContinuousTrading(ctx, testMarketID, testMarketAsset, rebateReq())
+	require.NoError(t, err)
+	assert.Empty(t, transfers)
+}
@@ -114,6 +114,7 @@ func (e *Engine) restoreAccounts(ctx context.Context, accs *types.CollateralAcco
 	e.snapshotBalances()
 	e.earmarkedBalance = accs.Earmarked
 	e.state.updateEarmarked(e.earmarkedBalance)
+	e.restoreCrossAssetPrices(accs.CrossAssetPrices)
 	return err
 }
 
@@ -180,6 +181,10 @@ func (a *accState) updateEarmarked(earmarked map[string]*num.Uint) {
 	a.accPL.CollateralAccounts.Earmarked = earmarked
 }
 
+func (a *accState) updateCrossAssetPrices(prices map[string]map[string]num.Decimal) {
+	a.accPL.CollateralAccounts.CrossAssetPrices = crossAssetPricesList(prices)
+}
+
 func (a *accState) updateBalanceSnapshotTime(t time.Time) {
 	a.accPL.CollateralAccounts.NextBalanceSnapshot = t
 }
@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"context"
+	"sort"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+// The rewards paid in the staking asset are credited to the staking account of the
+// party as they are distributed, see the vesting engine. Auto-compounding delegates
+// them at the end of each epoch, proportionally to the party's existing delegations,
+// without a round trip through the staking bridge.
+
+// SetAutoCompound opts the party in or out of auto-compounding: at the end of each
+// epoch, the staking rewards received since the last compounding are delegated to the
+// nodes they already delegate to. Any explicit undelegation opts the party out.
+func (e *Engine) SetAutoCompound(party string, enabled bool) {
+	if !enabled {
+		delete(e.autoCompound, party)
+		return
+	}
+	if _, ok := e.autoCompound[party]; !ok {
+		e.autoCompound[party] = num.UintZero()
+	}
+}
+
+// IsAutoCompounding returns true if the party opted in for auto-compounding.
+func (e *Engine) IsAutoCompounding(party string) bool {
+	_, ok := e.autoCompound[party]
+	return ok
+}
+
+// OnStakingReward is called by the vesting engine when a reward in the staking asset
+// is credited to the staking account of the party.
+func (e *Engine) OnStakingReward(_ context.Context, party string, amount *num.Uint) {
+	if rewards, ok := e.autoCompound[party]; ok {
+		rewards.AddSum(amount)
+	}
+}
+
+// resetRewardsToCompound clears the staking rewards once they have been compounded.
+func (e *Engine) resetRewardsToCompound() {
+	for party := range e.autoCompound {
+		e.autoCompound[party] = num.UintZero()
+	}
+}
+
+func (e *Engine) getAutoCompound() []*snapshotpb.PartyRewardsToCompound {
+	parties := make([]*snapshotpb.PartyRewardsToCompound, 0, len(e.autoCompound))
+	for party, rewards := range e.autoCompound {
+		parties = append(parties, &snapshotpb.PartyRewardsToCompound{
+			Party:   party,
+			Rewards: rewards.String(),
+		})
+	}
+	sort.Slice(parties, func(i, j int) bool {
+		return parties[i].Party < parties[j].Party
+	})
+	return parties
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCompound(t *testing.T) {
+	t.Run("rewarded stake is delegated at the end of the epoch", testAutoCompoundDelegatesRewardedStake)
+	t.Run("rewarded stake is not delegated without opting in", testNoAutoCompoundWithoutOptIn)
+	t.Run("explicit undelegation opts out of auto-compounding", testUndelegateStopsAutoCompound)
+	t.Run("only the staking rewards are compounded", testAutoCompoundOnlyRewards)
+	t.Run("auto-compounding snapshot round trip", testAutoCompoundSnapshotRoundTrip)
+}
+
+func processEpochForAutoCompound(testEngine *testEngine) {
+	now := time.Now()
+	testEngine.engine.ProcessEpochDelegations(context.Background(), types.Epoch{Seq: 1, StartTime: now, EndTime: now.Add(time.Hour)})
+}
+
+func testAutoCompoundDelegatesRewardedStake(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.SetAutoCompound("party1", true)
+	require.True(t, testEngine.engine.IsAutoCompounding("party1"))
+
+	// the rewards paid in the staking asset are credited to the staking account.
+	testEngine.stakingAccounts.partyToStake["party1"] = num.NewUint(16)
+	testEngine.engine.OnStakingReward(context.Background(), "party1", num.NewUint(6))
+	// a manual action during the epoch doesn't prevent compounding.
+	require.NoError(t, testEngine.engine.Delegate(context.Background(), "party1", "node2", num.NewUint(2)))
+
+	processEpochForAutoCompound(testEngine)
+	state := testEngine.engine.partyDelegationState["party1"]
+	require.Equal(t, num.NewUint(8), state.nodeToAmount["node1"])
+	require.Equal(t, num.NewUint(8), state.nodeToAmount["node2"])
+	require.Equal(t, num.NewUint(16), state.totalDelegated)
+	require.Equal(t, num.NewUint(16), testEngine.engine.nextPartyDelegationState["party1"].totalDelegated)
+}
+
+func testNoAutoCompoundWithoutOptIn(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.stakingAccounts.partyToStake["party1"] = num.NewUint(15)
+	// the party isn't in auto delegation mode either, as it made a manual delegation.
+	testEngine.topology.nodeToIsValidator["node3"] = true
+	require.NoError(t, testEngine.engine.Delegate(context.Background(), "party1", "node3", num.NewUint(2)))
+
+	processEpochForAutoCompound(testEngine)
+	require.Equal(t, num.NewUint(12), testEngine.engine.partyDelegationState["party1"].totalDelegated)
+}
+
+func testUndelegateStopsAutoCompound(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.SetAutoCompound("party1", true)
+	require.NoError(t, testEngine.engine.UndelegateAtEndOfEpoch(context.Background(), "party1", "node2", num.NewUint(4)))
+	require.False(t, testEngine.engine.IsAutoCompounding("party1"))
+
+	processEpochForAutoCompound(testEngine)
+	require.Equal(t, num.NewUint(6), testEngine.engine.partyDelegationState["party1"].totalDelegated)
+}
+
+func testAutoCompoundOnlyRewards(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.SetAutoCompound("party1", true)
+	// the party associated more stake and received 4 in staking rewards, only the rewards are compounded.
+	testEngine.stakingAccounts.partyToStake["party1"] = num.NewUint(20)
+	require.NoError(t, testEngine.engine.Delegate(context.Background(), "party1", "node2", num.NewUint(2)))
+	testEngine.engine.OnStakingReward(context.Background(), "party1", num.NewUint(4))
+	// rewards paid to a party who didn't opt in are ignored.
+	testEngine.engine.OnStakingReward(context.Background(), "party2", num.NewUint(4))
+
+	processEpochForAutoCompound(testEngine)
+	state := testEngine.engine.partyDelegationState["party1"]
+	require.Equal(t, num.NewUint(8), state.nodeToAmount["node1"])
+	require.Equal(t, num.NewUint(8), state.nodeToAmount["node2"])
+	require.Equal(t, num.NewUint(16), state.totalDelegated)
+	require.Equal(t, num.UintZero(), testEngine.engine.autoCompound["party1"])
+	require.False(t, testEngine.engine.IsAutoCompounding("party2"))
+
+	// nothing left to compound in the next epoch.
+	now := time.Now()
+	testEngine.engine.ProcessEpochDelegations(context.Background(), types.Epoch{Seq: 2, StartTime: now, EndTime: now.Add(time.Hour)})
+	require.Equal(t, num.NewUint(16), testEngine.engine.partyDelegationState["party1"].totalDelegated)
+}
+
+func testAutoCompoundSnapshotRoundTrip(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.SetAutoCompound("party1", true)
+	testEngine.engine.SetAutoCompound("party2", true)
+	testEngine.engine.OnStakingReward(context.Background(), "party1", num.NewUint(3))
+
+	state, _, err := testEngine.engine.GetState(autoCompoundKey)
+	require.NoError(t, err)
+
+	var pl snapshot.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+
+	snapEngine := getEngine(t)
+	setupDefaultDelegationState(snapEngine, 10, 5)
+	_, err = snapEngine.engine.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+	require.True(t, snapEngine.engine.IsAutoCompounding("party1"))
+	require.True(t, snapEngine.engine.IsAutoCompounding("party2"))
+	require.Equal(t, num.NewUint(3), snapEngine.engine.autoCompound["party1"])
+
+	statePostReload, _, err := snapEngine.engine.GetState(autoCompoundKey)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state, statePostReload))
+}
@@ -36,6 +36,7 @@ func (e *Engine) Checkpoint() ([]byte, error) {
 		Active:  e.getActive(),
 		Pending: e.getPendingBackwardCompatible(),
 		Auto:    e.getAuto(),
+		Slashed: e.getSlashed(),
 	}
 	return proto.Marshal(data.IntoProto())
 }
@@ -54,6 +55,7 @@ func (e *Engine) Load(ctx context.Context, data []byte) error {
 
 	e.autoDelegationMode = map[string]struct{}{}
 	e.setAuto(cpData.Auto)
+	e.setSlashed(cpData.Slashed)
 
 	return nil
 }
@@ -35,10 +35,13 @@ var minRatioForAutoDelegation, _ = num.DecimalFromString("0.95")
 const reconciliationInterval = 30 * time.Second
 
 var (
-	activeKey    = (&types.PayloadDelegationActive{}).Key()
-	pendingKey   = (&types.PayloadDelegationPending{}).Key()
-	autoKey      = (&types.PayloadDelegationAuto{}).Key()
-	lastReconKey = (&types.PayloadDelegationLastReconTime{}).Key()
+	activeKey        = (&types.PayloadDelegationActive{}).Key()
+	pendingKey       = (&types.PayloadDelegationPending{}).Key()
+	autoKey          = (&types.PayloadDelegationAuto{}).Key()
+	lastReconKey     = (&types.PayloadDelegationLastReconTime{}).Key()
+	slashedKey       = (&types.PayloadDelegationSlashed{}).Key()
+	redelegationsKey = (&types.PayloadDelegationRedelegations{}).Key()
+	autoCompoundKey  = (&types.PayloadDelegationAutoCompound{}).Key()
 )
 
 var (
@@ -81,6 +84,11 @@ type StakingAccounts interface {
 	GetAvailableBalanceInRange(party string, from, to time.Time) (*num.Uint, error)
 }
 
+// StakeSeizer takes the slashed stake held on the network from a party.
+type StakeSeizer interface {
+	SeizeStake(ctx context.Context, party string, amount *num.Uint) *num.Uint
+}
+
 type EpochEngine interface {
 	NotifyOnEpoch(f func(context.Context, types.Epoch), r func(context.Context, types.Epoch))
 }
@@ -108,6 +116,7 @@ type Engine struct {
 	broker                   Broker
 	topology                 ValidatorTopology           // an interface to the topoology to interact with validator nodes if needed
 	stakingAccounts          StakingAccounts             // an interface to the staking account for getting party balances
+	stakeSeizer              StakeSeizer                 // an interface to seize the slashed stake held on the network
 	partyDelegationState     map[string]*partyDelegation // party to active delegation balances
 	nextPartyDelegationState map[string]*partyDelegation // party to next epoch delegation balances
 	minDelegationAmount      *num.Uint                   // min delegation amount per delegation request
@@ -115,10 +124,14 @@ type Engine struct {
 	autoDelegationMode       map[string]struct{}         // parties entered auto-delegation mode
 	dss                      *delegationSnapshotState    // snapshot state
 	lastReconciliation       time.Time                   // last time staking balance has been reconciled against delegation balance
+	slashedStake             map[string]*num.Uint        // party to stake slashed, which cannot be delegated anymore
+	redelegations            map[string]uint64           // party to number of redelegations made in the current epoch
+	autoCompound             map[string]*num.Uint        // parties who opted in for auto-compounding to the staking rewards not yet compounded
+	maxRedelegationsPerEpoch uint64                      // max number of redelegations per party per epoch
 }
 
 // New instantiates a new delegation engine.
-func New(log *logging.Logger, config Config, broker Broker, topology ValidatorTopology, stakingAccounts StakingAccounts, epochEngine EpochEngine, ts TimeService) *Engine {
+func New(log *logging.Logger, config Config, broker Broker, topology ValidatorTopology, stakingAccounts StakingAccounts, epochEngine EpochEngine, ts TimeService, stakeSeizer StakeSeizer) *Engine {
 	log = log.Named(namedLogger)
 	log.SetLevel(config.Level.Get())
 	e := &Engine{
@@ -127,11 +140,15 @@ func New(log *logging.Logger, config Config, broker Broker, topology ValidatorTo
 		broker:                   broker,
 		topology:                 topology,
 		stakingAccounts:          stakingAccounts,
+		stakeSeizer:              stakeSeizer,
 		partyDelegationState:     map[string]*partyDelegation{},
 		nextPartyDelegationState: map[string]*partyDelegation{},
 		autoDelegationMode:       map[string]struct{}{},
 		dss:                      &delegationSnapshotState{},
 		lastReconciliation:       time.Time{},
+		slashedStake:             map[string]*num.Uint{},
+		redelegations:            map[string]uint64{},
+		autoCompound:             map[string]*num.Uint{},
 	}
 	// register for epoch notifications
 	epochEngine.NotifyOnEpoch(e.onEpochEvent, e.onEpochRestore)
@@ -177,6 +194,7 @@ func (e *Engine) onEpochEvent(ctx context.Context, epoch types.Epoch) {
 				e.sendDelegatedBalanceEvent(ctx, p, n, epoch.Seq+1, e.nextPartyDelegationState[p].nodeToAmount[n])
 			}
 		}
+		e.redelegations = map[string]uint64{}
 	}
 	e.currentEpoch = epoch
 }
@@ -186,7 +204,7 @@ func (e *Engine) reconcileAssociationWithNomination(ctx context.Context, from, t
 	// for current epoch we reconcile against the minimum balance for the epoch as given by the partial function
 	e.reconcile(ctx, e.partyDelegationState, e.stakeInRangeFunc(from, to), epochSeq)
 	// for the next epoch we reconcile against the current balance
-	e.reconcile(ctx, e.nextPartyDelegationState, e.stakingAccounts.GetAvailableBalance, epochSeq+1)
+	e.reconcile(ctx, e.nextPartyDelegationState, e.getAvailableStake, epochSeq+1)
 	e.lastReconciliation = to
 }
 
@@ -276,7 +294,7 @@ func (e *Engine) Delegate(ctx context.Context, party string, nodeID string, amou
 	}
 
 	// check if the delegator has a staking account
-	partyBalance, err := e.stakingAccounts.GetAvailableBalance(party)
+	partyBalance, err := e.getAvailableStake(party)
 	if err != nil {
 		e.log.Error("Party has no staking account balance", logging.Uint64("epoch", e.currentEpoch.Seq), logging.String("party", party), logging.String("validator", nodeID))
 		return ErrPartyHasNoStakingAccount
@@ -353,6 +371,7 @@ func (e *Engine) UndelegateAtEndOfEpoch(ctx context.Context, party string, nodeI
 
 	// get out of auto delegation mode as the party made explicit undelegations
 	delete(e.autoDelegationMode, party)
+	delete(e.autoCompound, party)
 	return nil
 }
 
@@ -406,6 +425,7 @@ func (e *Engine) UndelegateNow(ctx context.Context, party string, nodeID string,
 
 	// get out of auto delegation mode
 	delete(e.autoDelegationMode, party)
+	delete(e.autoCompound, party)
 	return nil
 }
 
@@ -452,10 +472,11 @@ func (e *Engine) ProcessEpochDelegations(ctx context.Context, epoch types.Epoch)
 	// process auto delegations
 	// this is updating the state for the epoch that's about to begin therefore it needs to have incremented sequence
 	e.processAutoDelegation(ctx, e.eligiblePartiesForAutoDelegtion(excludeFromAutoDelegation), epoch.Seq+1)
+	e.resetRewardsToCompound()
 
 	for p, state := range e.partyDelegationState {
 		if _, ok := e.autoDelegationMode[p]; !ok {
-			if balance, err := e.stakingAccounts.GetAvailableBalance(p); err == nil {
+			if balance, err := e.getAvailableStake(p); err == nil {
 				if state.totalDelegated.ToDecimal().Div(balance.ToDecimal()).GreaterThanOrEqual(minRatioForAutoDelegation) {
 					e.autoDelegationMode[p] = struct{}{}
 				}
@@ -530,7 +551,11 @@ func (e *Engine) sortParties(delegation map[string]*partyDelegation) []string {
 
 func (e *Engine) stakeInRangeFunc(from, to time.Time) func(string) (*num.Uint, error) {
 	return func(party string) (*num.Uint, error) {
-		return e.stakingAccounts.GetAvailableBalanceInRange(party, from, to)
+		balance, err := e.stakingAccounts.GetAvailableBalanceInRange(party, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return e.withoutSlashedStake(party, balance), nil
 	}
 }
 
@@ -560,20 +585,30 @@ func (e *Engine) prepareNextEpochDelegationState() map[string]*partyDelegation {
 
 // eligiblePartiesForAutoDelegtion calculates how much is available for auto delegation in parties that have qualifies for auto delegation
 // and have not done any manual actions during the past epoch and have any active delegations and have available balance.
+// Parties who opted in for auto-compounding are eligible regardless of their manual actions, but only up to the staking rewards
+// they received since the last compounding.
 func (e *Engine) eligiblePartiesForAutoDelegtion(exclude map[string]struct{}) map[string]*num.Uint {
-	partyToAvailableBalance := map[string]*num.Uint{}
+	candidates := make(map[string]*num.Uint, len(e.autoDelegationMode)+len(e.autoCompound))
 	for party := range e.autoDelegationMode {
-		// if the party has no delegation we can't auto delegate
-		if _, ok := e.partyDelegationState[party]; !ok {
-			continue
+		if _, ok := exclude[party]; !ok {
+			candidates[party] = nil
 		}
+	}
+	for party, rewards := range e.autoCompound {
+		if _, ok := candidates[party]; !ok {
+			candidates[party] = rewards
+		}
+	}
 
-		if _, ok := exclude[party]; ok {
+	partyToAvailableBalance := map[string]*num.Uint{}
+	for party, maxAmount := range candidates {
+		// if the party has no delegation we can't auto delegate
+		if _, ok := e.partyDelegationState[party]; !ok {
 			continue
 		}
 
 		// check if they have balance
-		balance, err := e.stakingAccounts.GetAvailableBalance(party)
+		balance, err := e.getAvailableStake(party)
 		if err != nil {
 			continue
 		}
@@ -586,6 +621,9 @@ func (e *Engine) eligiblePartiesForAutoDelegtion(exclude map[string]struct{}) ma
 
 		// calculate the available balance
 		available := num.UintZero().Sub(balance, delegated)
+		if maxAmount != nil {
+			available = num.Min(available, maxAmount)
+		}
 		if !available.IsZero() {
 			partyToAvailableBalance[party] = available
 		}
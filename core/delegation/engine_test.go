@@ -50,6 +50,7 @@ type testEngine struct {
 	broker          *mocks.MockBroker
 	stakingAccounts *TestStakingAccount
 	topology        *TestTopology
+	stakeSeizer     *TestStakeSeizer
 }
 
 func Test(t *testing.T) {
@@ -1987,8 +1988,9 @@ func getEngine(t *testing.T) *testEngine {
 	stakingAccounts := newTestStakingAccount()
 	topology := newTestTopology()
 	ts := dmocks.NewMockTimeService(ctrl)
+	stakeSeizer := newTestStakeSeizer(stakingAccounts)
 
-	engine := New(logger, conf, broker, topology, stakingAccounts, &TestEpochEngine{}, ts)
+	engine := New(logger, conf, broker, topology, stakingAccounts, &TestEpochEngine{}, ts, stakeSeizer)
 	engine.onEpochEvent(context.Background(), types.Epoch{Seq: 1, StartTime: time.Now()})
 	engine.OnMinAmountChanged(context.Background(), num.NewDecimalFromFloat(2))
 	broker.EXPECT().Send(gomock.Any()).AnyTimes()
@@ -1999,6 +2001,7 @@ func getEngine(t *testing.T) *testEngine {
 		broker:          broker,
 		stakingAccounts: stakingAccounts,
 		topology:        topology,
+		stakeSeizer:     stakeSeizer,
 	}
 }
 
@@ -2040,6 +2043,33 @@ func (t *TestStakingAccount) GetAvailableBalanceInRange(party string, from, to t
 	return p, nil
 }
 
+// TestStakeSeizer seizes the stake held on the network, taking it out of
+// the staking account.
+type TestStakeSeizer struct {
+	stakingAccounts *TestStakingAccount
+	partyToSeizable map[string]*num.Uint
+}
+
+func newTestStakeSeizer(stakingAccounts *TestStakingAccount) *TestStakeSeizer {
+	return &TestStakeSeizer{
+		stakingAccounts: stakingAccounts,
+		partyToSeizable: make(map[string]*num.Uint),
+	}
+}
+
+func (t *TestStakeSeizer) SeizeStake(_ context.Context, party string, amount *num.Uint) *num.Uint {
+	seizable, ok := t.partyToSeizable[party]
+	if !ok {
+		return num.UintZero()
+	}
+	seized := num.Min(seizable, amount)
+	seizable.Sub(seizable, seized)
+	if stake, ok := t.stakingAccounts.partyToStake[party]; ok {
+		t.stakingAccounts.partyToStake[party] = num.UintZero().Sub(stake, num.Min(stake, seized))
+	}
+	return seized
+}
+
 type TestTopology struct {
 	nodeToIsValidator map[string]bool
 }
@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+)
+
+var (
+	// ErrRedelegationToSameNode is returned when the redelegation is from and to the same node.
+	ErrRedelegationToSameNode = errors.New("cannot redelegate to the same node")
+	// ErrIncorrectTokenAmountForRedelegation is returned when the amount to redelegate is greater than the delegation balance for the next epoch.
+	ErrIncorrectTokenAmountForRedelegation = errors.New("incorrect token amount for redelegation")
+	// ErrRedelegationLimitReached is returned when the party has already made the maximum number of redelegations allowed in the epoch.
+	ErrRedelegationLimitReached = errors.New("maximum number of redelegations for the epoch reached")
+)
+
+// OnMaxRedelegationsPerEpochUpdate updates the network parameter for the maximum number of redelegations per party and epoch.
+func (e *Engine) OnMaxRedelegationsPerEpochUpdate(_ context.Context, maxRedelegations *num.Uint) error {
+	e.maxRedelegationsPerEpoch = maxRedelegations.Uint64()
+	return nil
+}
+
+// Redelegate moves, at the end of the epoch, the delegation balance of the party from a node to another.
+// The delegation of the current epoch, used for rewarding, is unchanged, and as the total delegated by the
+// party doesn't change no undelegation period applies.
+// If the amount is zero, the whole balance delegated to the node for the next epoch is moved.
+func (e *Engine) Redelegate(ctx context.Context, party, fromNodeID, toNodeID string, amount *num.Uint) error {
+	if fromNodeID == toNodeID {
+		return ErrRedelegationToSameNode
+	}
+	if !e.topology.IsValidatorNodeID(fromNodeID) || !e.topology.IsValidatorNodeID(toNodeID) {
+		e.log.Error("Trying to redelegate with an invalid node", logging.Uint64("epoch", e.currentEpoch.Seq), logging.String("party", party), logging.String("from", fromNodeID), logging.String("to", toNodeID))
+		return ErrInvalidNodeID
+	}
+	if e.redelegations[party] >= e.maxRedelegationsPerEpoch {
+		return ErrRedelegationLimitReached
+	}
+
+	nextEpochBalanceOnNode := num.UintZero()
+	if nextEpoch, ok := e.nextPartyDelegationState[party]; ok {
+		if nodeAmount, ok := nextEpoch.nodeToAmount[fromNodeID]; ok {
+			nextEpochBalanceOnNode = nodeAmount
+		}
+	}
+
+	amt := amount.Clone()
+	if amt.IsZero() {
+		amt = nextEpochBalanceOnNode.Clone()
+	}
+	if amt.IsZero() || amt.GT(nextEpochBalanceOnNode) {
+		e.log.Error("Invalid redelegation - trying to redelegate more than delegated", logging.Uint64("epoch", e.currentEpoch.Seq), logging.String("party", party), logging.String("validator", fromNodeID), logging.String("redelegationAmount", num.UintToString(amt)), logging.String("totalDelegationBalance", num.UintToString(nextEpochBalanceOnNode)))
+		return ErrIncorrectTokenAmountForRedelegation
+	}
+	if amt.LT(e.minDelegationAmount) {
+		return ErrAmountLTMinAmountForDelegation
+	}
+
+	// the total delegated by the party for the next epoch is unchanged, so is what the reconciliation
+	// of the association with the nomination compares to the staking account balance.
+	nextEpochState := e.nextPartyDelegationState[party]
+	nextEpochState.nodeToAmount[fromNodeID].Sub(nextEpochState.nodeToAmount[fromNodeID], amt)
+	if _, ok := nextEpochState.nodeToAmount[toNodeID]; !ok {
+		nextEpochState.nodeToAmount[toNodeID] = num.UintZero()
+	}
+	nextEpochState.nodeToAmount[toNodeID].AddSum(amt)
+	e.sendDelegatedBalanceEvent(ctx, party, fromNodeID, e.currentEpoch.Seq+1, nextEpochState.nodeToAmount[fromNodeID])
+	e.sendDelegatedBalanceEvent(ctx, party, toNodeID, e.currentEpoch.Seq+1, nextEpochState.nodeToAmount[toNodeID])
+	if nextEpochState.nodeToAmount[fromNodeID].IsZero() {
+		delete(nextEpochState.nodeToAmount, fromNodeID)
+	}
+
+	// get out of auto delegation mode as the party chose its nodes explicitly
+	delete(e.autoDelegationMode, party)
+	e.redelegations[party]++
+	return nil
+}
+
+func (e *Engine) getRedelegations() []*snapshotpb.PartyRedelegations {
+	redelegations := make([]*snapshotpb.PartyRedelegations, 0, len(e.redelegations))
+	for party, count := range e.redelegations {
+		redelegations = append(redelegations, &snapshotpb.PartyRedelegations{
+			Party: party,
+			Count: count,
+		})
+	}
+	sort.Slice(redelegations, func(i, j int) bool {
+		return redelegations[i].Party < redelegations[j].Party
+	})
+	return redelegations
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedelegation(t *testing.T) {
+	t.Run("redelegation moves the balance at the end of the epoch", testRedelegateAtEndOfEpoch)
+	t.Run("redelegation of the whole balance", testRedelegateWholeBalance)
+	t.Run("redelegation of more than delegated fails", testRedelegateInvalidAmount)
+	t.Run("redelegation to the same or an unknown node fails", testRedelegateInvalidNodes)
+	t.Run("redelegations are limited per epoch", testRedelegationLimit)
+	t.Run("redelegation exits the auto delegation mode", testRedelegationExitsAutoDelegation)
+	t.Run("redelegations snapshot round trip", testRedelegationsSnapshotRoundTrip)
+}
+
+func getRedelegationEngine(t *testing.T) *testEngine {
+	t.Helper()
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	require.NoError(t, testEngine.engine.OnMaxRedelegationsPerEpochUpdate(context.Background(), num.NewUint(1)))
+	return testEngine
+}
+
+func testRedelegateAtEndOfEpoch(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(4)))
+
+	// the current epoch is unchanged.
+	require.Equal(t, num.NewUint(6), testEngine.engine.partyDelegationState["party1"].nodeToAmount["node1"])
+	require.Equal(t, num.NewUint(4), testEngine.engine.partyDelegationState["party1"].nodeToAmount["node2"])
+
+	next := testEngine.engine.nextPartyDelegationState["party1"]
+	require.Equal(t, num.NewUint(2), next.nodeToAmount["node1"])
+	require.Equal(t, num.NewUint(8), next.nodeToAmount["node2"])
+	require.Equal(t, num.NewUint(10), next.totalDelegated)
+
+	now := time.Now()
+	testEngine.engine.ProcessEpochDelegations(context.Background(), types.Epoch{Seq: 1, StartTime: now, EndTime: now.Add(time.Hour)})
+	require.Equal(t, num.NewUint(2), testEngine.engine.partyDelegationState["party1"].nodeToAmount["node1"])
+	require.Equal(t, num.NewUint(8), testEngine.engine.partyDelegationState["party1"].nodeToAmount["node2"])
+}
+
+func testRedelegateWholeBalance(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party2", "node2", "node1", num.UintZero()))
+
+	next := testEngine.engine.nextPartyDelegationState["party2"]
+	require.Equal(t, num.NewUint(5), next.nodeToAmount["node1"])
+	// the node the party no longer delegates to is dropped.
+	_, ok := next.nodeToAmount["node2"]
+	require.False(t, ok)
+}
+
+func testRedelegateInvalidAmount(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(7)), ErrIncorrectTokenAmountForRedelegation)
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party3", "node1", "node2", num.NewUint(2)), ErrIncorrectTokenAmountForRedelegation)
+	// lower than the minimum delegation amount.
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(1)), ErrAmountLTMinAmountForDelegation)
+}
+
+func testRedelegateInvalidNodes(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node1", num.NewUint(2)), ErrRedelegationToSameNode)
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node3", num.NewUint(2)), ErrInvalidNodeID)
+}
+
+func testRedelegationLimit(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(2)))
+	require.ErrorIs(t, testEngine.engine.Redelegate(context.Background(), "party1", "node2", "node1", num.NewUint(2)), ErrRedelegationLimitReached)
+
+	// the limit is reset with the new epoch.
+	testEngine.engine.onEpochEvent(context.Background(), types.Epoch{Seq: 2, StartTime: time.Now()})
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party1", "node2", "node1", num.NewUint(2)))
+}
+
+func testRedelegationExitsAutoDelegation(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	testEngine.engine.autoDelegationMode["party1"] = struct{}{}
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(2)))
+	_, ok := testEngine.engine.autoDelegationMode["party1"]
+	require.False(t, ok)
+}
+
+func testRedelegationsSnapshotRoundTrip(t *testing.T) {
+	testEngine := getRedelegationEngine(t)
+	require.NoError(t, testEngine.engine.Redelegate(context.Background(), "party1", "node1", "node2", num.NewUint(2)))
+
+	state, _, err := testEngine.engine.GetState(redelegationsKey)
+	require.NoError(t, err)
+
+	var pl snapshot.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+
+	snapEngine := getRedelegationEngine(t)
+	_, err = snapEngine.engine.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	statePostReload, _, err := snapEngine.engine.GetState(redelegationsKey)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state, statePostReload))
+
+	// the restored counter still limits the redelegations of the epoch.
+	require.ErrorIs(t, snapEngine.engine.Redelegate(context.Background(), "party1", "node2", "node1", num.NewUint(2)), ErrRedelegationLimitReached)
+}
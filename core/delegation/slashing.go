@@ -0,0 +1,159 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"context"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/core/validators"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+// Slash removes the given fraction of the stake delegated to the node, by the
+// validator itself and by its delegators, for the current and the next epoch.
+// The slashed tokens held on the network are seized into the global reward pool,
+// the remainder, held on the Ethereum bridge, can no longer be delegated by the
+// party, even if still associated.
+func (e *Engine) Slash(ctx context.Context, nodeID string, reason validators.SlashingReason, fraction num.Decimal) {
+	slashedStakes := []*eventspb.SlashedStake{}
+	for _, party := range e.sortParties(e.partyDelegationState) {
+		if ss := e.slashParty(ctx, party, nodeID, fraction); ss != nil {
+			slashedStakes = append(slashedStakes, ss)
+		}
+	}
+	// parties who only start delegating to the node in the next epoch
+	for _, party := range e.sortParties(e.nextPartyDelegationState) {
+		if _, ok := e.partyDelegationState[party]; !ok {
+			if ss := e.slashParty(ctx, party, nodeID, fraction); ss != nil {
+				slashedStakes = append(slashedStakes, ss)
+			}
+		}
+	}
+	sort.Slice(slashedStakes, func(i, j int) bool {
+		return slashedStakes[i].PartyId < slashedStakes[j].PartyId
+	})
+
+	e.broker.Send(events.NewValidatorSlashingEvent(ctx, &eventspb.ValidatorSlashing{
+		NodeId:        nodeID,
+		Reason:        reason,
+		Fraction:      fraction.String(),
+		EpochSeq:      e.currentEpoch.Seq,
+		SlashedStakes: slashedStakes,
+	}))
+}
+
+func (e *Engine) slashParty(ctx context.Context, party, nodeID string, fraction num.Decimal) *eventspb.SlashedStake {
+	slashed := num.UintZero()
+	epochs := []struct {
+		seq   uint64
+		state map[string]*partyDelegation
+	}{
+		{seq: e.currentEpoch.Seq, state: e.partyDelegationState},
+		{seq: e.currentEpoch.Seq + 1, state: e.nextPartyDelegationState},
+	}
+	for _, epoch := range epochs {
+		seq, state := epoch.seq, epoch.state
+		partyState, ok := state[party]
+		if !ok {
+			continue
+		}
+		amount, ok := partyState.nodeToAmount[nodeID]
+		if !ok || amount.IsZero() {
+			continue
+		}
+		toSlash, _ := num.UintFromDecimal(amount.ToDecimal().Mul(fraction))
+		if toSlash.IsZero() {
+			continue
+		}
+		e.decreaseBalanceAndFireEvent(ctx, party, nodeID, toSlash, seq, state, false, true)
+		slashed = num.Max(slashed, toSlash)
+	}
+
+	if slashed.IsZero() {
+		return nil
+	}
+
+	seized := e.stakeSeizer.SeizeStake(ctx, party, slashed)
+	e.log.Info("delegation slashed",
+		logging.PartyID(party),
+		logging.String("node-id", nodeID),
+		logging.BigUint("amount", slashed),
+		logging.BigUint("seized-amount", seized),
+	)
+	// the seized tokens have left the staking account, only the remainder
+	// is kept out of the delegations.
+	if remainder := num.UintZero().Sub(slashed, num.Min(slashed, seized)); !remainder.IsZero() {
+		if _, ok := e.slashedStake[party]; !ok {
+			e.slashedStake[party] = num.UintZero()
+		}
+		e.slashedStake[party].AddSum(remainder)
+	}
+	// the party made no explicit change, but the stake available to it has.
+	delete(e.autoDelegationMode, party)
+
+	return &eventspb.SlashedStake{
+		PartyId:      party,
+		Amount:       slashed.String(),
+		SeizedAmount: seized.String(),
+	}
+}
+
+func (e *Engine) getSlashed() []*types.SlashedStake {
+	slashed := make([]*types.SlashedStake, 0, len(e.slashedStake))
+	for party, amount := range e.slashedStake {
+		slashed = append(slashed, &types.SlashedStake{
+			Party:  party,
+			Amount: amount.Clone(),
+		})
+	}
+	sort.Slice(slashed, func(i, j int) bool {
+		return slashed[i].Party < slashed[j].Party
+	})
+	return slashed
+}
+
+func (e *Engine) setSlashed(slashed []*types.SlashedStake) {
+	e.slashedStake = make(map[string]*num.Uint, len(slashed))
+	for _, s := range slashed {
+		e.slashedStake[s.Party] = s.Amount.Clone()
+	}
+}
+
+// getAvailableStake returns the balance of the staking account of the party
+// which can be delegated, i.e. without the slashed stake.
+func (e *Engine) getAvailableStake(party string) (*num.Uint, error) {
+	balance, err := e.stakingAccounts.GetAvailableBalance(party)
+	if err != nil {
+		return nil, err
+	}
+	return e.withoutSlashedStake(party, balance), nil
+}
+
+func (e *Engine) withoutSlashedStake(party string, balance *num.Uint) *num.Uint {
+	slashed, ok := e.slashedStake[party]
+	if !ok {
+		return balance
+	}
+	if slashed.GTE(balance) {
+		return num.UintZero()
+	}
+	return num.UintZero().Sub(balance, slashed)
+}
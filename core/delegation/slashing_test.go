@@ -0,0 +1,169 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package delegation
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/broker/mocks"
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/core/validators"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashing(t *testing.T) {
+	t.Run("slashing reduces the delegations to the node for the current and next epoch", testSlashReducesDelegations)
+	t.Run("slashed stake cannot be delegated again", testSlashedStakeCannotBeDelegated)
+	t.Run("slashed stake is not reconciled away", testSlashedStakeReconciliation)
+	t.Run("slashed stake held on the network is seized", testSlashSeizesStake)
+	t.Run("slashing emits a validator slashing event", testSlashEmitsEvent)
+	t.Run("slashed stake snapshot round trip", testSlashedSnapshotRoundTrip)
+	t.Run("slashed stake checkpoint round trip", testSlashedCheckpointRoundTrip)
+}
+
+func testSlashReducesDelegations(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	for _, state := range []map[string]*partyDelegation{testEngine.engine.partyDelegationState, testEngine.engine.nextPartyDelegationState} {
+		require.Equal(t, num.NewUint(3), state["party1"].nodeToAmount["node1"])
+		require.Equal(t, num.NewUint(4), state["party1"].nodeToAmount["node2"])
+		require.Equal(t, num.NewUint(7), state["party1"].totalDelegated)
+		require.Equal(t, num.NewUint(1), state["party2"].nodeToAmount["node1"])
+		require.Equal(t, num.NewUint(3), state["party2"].nodeToAmount["node2"])
+		require.Equal(t, num.NewUint(4), state["party2"].totalDelegated)
+	}
+	require.Equal(t, num.NewUint(3), testEngine.engine.slashedStake["party1"])
+	require.Equal(t, num.NewUint(1), testEngine.engine.slashedStake["party2"])
+}
+
+func testSlashedStakeCannotBeDelegated(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	// party1 has 10 associated, 7 delegated and 3 slashed.
+	err := testEngine.engine.Delegate(context.Background(), "party1", "node2", num.NewUint(2))
+	require.ErrorIs(t, err, ErrInsufficientBalanceForDelegation)
+}
+
+func testSlashedStakeReconciliation(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	now := time.Now()
+	testEngine.engine.reconcileAssociationWithNomination(context.Background(), now, now.Add(time.Minute), 1)
+	require.Equal(t, num.NewUint(7), testEngine.engine.nextPartyDelegationState["party1"].totalDelegated)
+	require.Equal(t, num.NewUint(4), testEngine.engine.nextPartyDelegationState["party2"].totalDelegated)
+}
+
+func testSlashSeizesStake(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	// party1 holds 2 tokens on the network, party2 holds enough to cover its slashing.
+	testEngine.stakeSeizer.partyToSeizable["party1"] = num.NewUint(2)
+	testEngine.stakeSeizer.partyToSeizable["party2"] = num.NewUint(5)
+
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	require.Equal(t, num.NewUint(1), testEngine.engine.slashedStake["party1"])
+	_, ok := testEngine.engine.slashedStake["party2"]
+	require.False(t, ok)
+	require.Equal(t, num.NewUint(8), testEngine.stakingAccounts.partyToStake["party1"])
+	require.Equal(t, num.NewUint(4), testEngine.stakingAccounts.partyToStake["party2"])
+
+	// party1 has 8 associated, 7 delegated and 1 slashed.
+	err := testEngine.engine.Delegate(context.Background(), "party1", "node2", num.NewUint(2))
+	require.ErrorIs(t, err, ErrInsufficientBalanceForDelegation)
+}
+
+func testSlashEmitsEvent(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.stakeSeizer.partyToSeizable["party1"] = num.NewUint(2)
+
+	broker := mocks.NewMockBroker(testEngine.ctrl)
+	testEngine.engine.broker = broker
+	var slashing *eventspb.ValidatorSlashing
+	broker.EXPECT().Send(gomock.Any()).AnyTimes().Do(func(evt events.Event) {
+		if e, ok := evt.(*events.ValidatorSlashing); ok {
+			slashing = e.ValidatorSlashing()
+		}
+	})
+
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDoubleSign, num.DecimalFromFloat(0.5))
+
+	require.NotNil(t, slashing)
+	require.Equal(t, "node1", slashing.NodeId)
+	require.Equal(t, eventspb.ValidatorSlashing_REASON_DOUBLE_SIGN, slashing.Reason)
+	require.Equal(t, "0.5", slashing.Fraction)
+	require.Equal(t, uint64(1), slashing.EpochSeq)
+	require.Len(t, slashing.SlashedStakes, 2)
+	require.Equal(t, &eventspb.SlashedStake{PartyId: "party1", Amount: "3", SeizedAmount: "2"}, slashing.SlashedStakes[0])
+	require.Equal(t, &eventspb.SlashedStake{PartyId: "party2", Amount: "1", SeizedAmount: "0"}, slashing.SlashedStakes[1])
+}
+
+func testSlashedSnapshotRoundTrip(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	state, _, err := testEngine.engine.GetState(slashedKey)
+	require.NoError(t, err)
+
+	var pl snapshot.Payload
+	require.NoError(t, proto.Unmarshal(state, &pl))
+
+	snapEngine := getEngine(t)
+	_, err = snapEngine.engine.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+	require.Equal(t, num.NewUint(3), snapEngine.engine.slashedStake["party1"])
+	require.Equal(t, num.NewUint(1), snapEngine.engine.slashedStake["party2"])
+
+	statePostReload, _, err := snapEngine.engine.GetState(slashedKey)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state, statePostReload))
+}
+
+func testSlashedCheckpointRoundTrip(t *testing.T) {
+	testEngine := getEngine(t)
+	setupDefaultDelegationState(testEngine, 10, 5)
+	testEngine.engine.Slash(context.Background(), "node1", validators.SlashingReasonDowntime, num.DecimalFromFloat(0.5))
+
+	cp, err := testEngine.engine.Checkpoint()
+	require.NoError(t, err)
+
+	cpEngine := getEngine(t)
+	cpEngine.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	require.NoError(t, cpEngine.engine.Load(context.Background(), cp))
+	require.Equal(t, num.NewUint(3), cpEngine.engine.slashedStake["party1"])
+	require.Equal(t, num.NewUint(1), cpEngine.engine.slashedStake["party2"])
+}
@@ -21,8 +21,11 @@ import (
 	"time"
 
 	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
+	checkpoint "code.vegaprotocol.io/vega/protos/vega/checkpoint/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
 
 var hashKeys = []string{
@@ -30,13 +33,19 @@ var hashKeys = []string{
 	pendingKey,
 	autoKey,
 	lastReconKey,
+	slashedKey,
+	redelegationsKey,
+	autoCompoundKey,
 }
 
 type delegationSnapshotState struct {
-	serialisedActive    []byte
-	serialisedPending   []byte
-	serialisedAuto      []byte
-	serialisedLastRecon []byte
+	serialisedActive        []byte
+	serialisedPending       []byte
+	serialisedAuto          []byte
+	serialisedLastRecon     []byte
+	serialisedSlashed       []byte
+	serialisedRedelegations []byte
+	serialisedAutoCompound  []byte
 }
 
 func (e *Engine) Namespace() types.SnapshotNamespace {
@@ -121,6 +130,38 @@ func (e *Engine) serialiseAuto() ([]byte, error) {
 	return proto.Marshal(payload.IntoProto())
 }
 
+func (e *Engine) serialiseSlashed() ([]byte, error) {
+	slashed := e.getSlashed()
+	slashedStakes := make([]*checkpoint.SlashedStake, 0, len(slashed))
+	for _, s := range slashed {
+		slashedStakes = append(slashedStakes, s.IntoProto())
+	}
+	payload := types.Payload{
+		Data: &types.PayloadDelegationSlashed{
+			DelegationSlashed: &snapshotpb.DelegationSlashed{SlashedStakes: slashedStakes},
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
+func (e *Engine) serialiseRedelegations() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadDelegationRedelegations{
+			DelegationRedelegations: &snapshotpb.DelegationRedelegations{Redelegations: e.getRedelegations()},
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
+func (e *Engine) serialiseAutoCompound() ([]byte, error) {
+	payload := types.Payload{
+		Data: &types.PayloadDelegationAutoCompound{
+			DelegationAutoCompound: &snapshotpb.DelegationAutoCompound{Parties: e.getAutoCompound()},
+		},
+	}
+	return proto.Marshal(payload.IntoProto())
+}
+
 func (e *Engine) serialiseK(serialFunc func() ([]byte, error), dataField *[]byte) ([]byte, error) {
 	data, err := serialFunc()
 	if err != nil {
@@ -141,6 +182,12 @@ func (e *Engine) serialise(k string) ([]byte, error) {
 		return e.serialiseK(e.serialiseAuto, &e.dss.serialisedAuto)
 	case lastReconKey:
 		return e.serialiseK(e.serialiseLastReconTime, &e.dss.serialisedLastRecon)
+	case slashedKey:
+		return e.serialiseK(e.serialiseSlashed, &e.dss.serialisedSlashed)
+	case redelegationsKey:
+		return e.serialiseK(e.serialiseRedelegations, &e.dss.serialisedRedelegations)
+	case autoCompoundKey:
+		return e.serialiseK(e.serialiseAutoCompound, &e.dss.serialisedAutoCompound)
 	default:
 		return nil, types.ErrSnapshotKeyDoesNotExist
 	}
@@ -165,6 +212,12 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 		return nil, e.restoreAuto(pl.DelegationAuto, p)
 	case *types.PayloadDelegationLastReconTime:
 		return nil, e.restoreLastReconTime(pl.LastReconcilicationTime, p)
+	case *types.PayloadDelegationSlashed:
+		return nil, e.restoreSlashed(pl.DelegationSlashed, p)
+	case *types.PayloadDelegationRedelegations:
+		return nil, e.restoreRedelegations(pl.DelegationRedelegations, p)
+	case *types.PayloadDelegationAutoCompound:
+		return nil, e.restoreAutoCompound(pl.DelegationAutoCompound, p)
 	default:
 		return nil, types.ErrUnknownSnapshotType
 	}
@@ -222,6 +275,38 @@ func (e *Engine) restoreAuto(delegations *types.DelegationAuto, p *types.Payload
 	return err
 }
 
+func (e *Engine) restoreSlashed(slashed *snapshotpb.DelegationSlashed, p *types.Payload) error {
+	slashedStakes := make([]*types.SlashedStake, 0, len(slashed.SlashedStakes))
+	for _, s := range slashed.SlashedStakes {
+		slashedStakes = append(slashedStakes, types.NewSlashedStakeFromProto(s))
+	}
+	e.setSlashed(slashedStakes)
+	var err error
+	e.dss.serialisedSlashed, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreRedelegations(redelegations *snapshotpb.DelegationRedelegations, p *types.Payload) error {
+	e.redelegations = make(map[string]uint64, len(redelegations.Redelegations))
+	for _, r := range redelegations.Redelegations {
+		e.redelegations[r.Party] = r.Count
+	}
+	var err error
+	e.dss.serialisedRedelegations, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreAutoCompound(autoCompound *snapshotpb.DelegationAutoCompound, p *types.Payload) error {
+	e.autoCompound = make(map[string]*num.Uint, len(autoCompound.Parties))
+	for _, pr := range autoCompound.Parties {
+		rewards, _ := num.UintFromString(pr.Rewards, 10)
+		e.autoCompound[pr.Party] = rewards
+	}
+	var err error
+	e.dss.serialisedAutoCompound, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
 func (e *Engine) onEpochRestore(ctx context.Context, epoch types.Epoch) {
 	e.log.Debug("epoch restoration notification received", logging.String("epoch", epoch.String()))
 	e.currentEpoch = epoch
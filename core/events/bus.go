@@ -179,6 +179,8 @@ const (
 	VolumeRebateProgramUpdatedEvent
 	VolumeRebateStatsUpdatedEvent
 	AutomatedPurchaseAnnouncedEvent
+	TeamCompetitionEvent
+	ValidatorSlashingEvent
 )
 
 var (
@@ -287,6 +289,8 @@ var (
 		eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_UPDATED:           VolumeRebateProgramUpdatedEvent,
 		eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_STATS_UPDATED:             VolumeRebateStatsUpdatedEvent,
 		eventspb.BusEventType_BUS_EVENT_TYPE_AUTOMATED_PURCHASE_ANNOUNCED:            AutomatedPurchaseAnnouncedEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_TEAM_COMPETITION:                        TeamCompetitionEvent,
+		eventspb.BusEventType_BUS_EVENT_TYPE_VALIDATOR_SLASHING:                      ValidatorSlashingEvent,
 		// If adding a type here, please also add it to datanode/broker/convert.go
 	}
 
@@ -386,6 +390,8 @@ var (
 		VolumeRebateProgramUpdatedEvent:          eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_PROGRAM_UPDATED,
 		VolumeRebateStatsUpdatedEvent:            eventspb.BusEventType_BUS_EVENT_TYPE_VOLUME_REBATE_STATS_UPDATED,
 		AutomatedPurchaseAnnouncedEvent:          eventspb.BusEventType_BUS_EVENT_TYPE_AUTOMATED_PURCHASE_ANNOUNCED,
+		TeamCompetitionEvent:                     eventspb.BusEventType_BUS_EVENT_TYPE_TEAM_COMPETITION,
+		ValidatorSlashingEvent:                   eventspb.BusEventType_BUS_EVENT_TYPE_VALIDATOR_SLASHING,
 
 		// If adding a type here, please also add it to datanode/broker/convert.go
 	}
@@ -485,6 +491,8 @@ var (
 		VolumeRebateProgramUpdatedEvent:          "VolumeRebateProgramUpdatedEvent",
 		VolumeRebateStatsUpdatedEvent:            "VolumeRebateStatsUpdatedEvent",
 		AutomatedPurchaseAnnouncedEvent:          "AutomatedPurchaseAnnouncedEvent",
+		TeamCompetitionEvent:                     "TeamCompetitionEvent",
+		ValidatorSlashingEvent:                   "ValidatorSlashingEvent",
 	}
 )
 
@@ -27,15 +27,17 @@ type ProtocolUpgradeProposalEvent struct {
 	VegaReleaseTag     string
 	AcceptedBy         []string
 	ProposalStatus     eventspb.ProtocolUpgradeProposalStatus
+	Readiness          []*eventspb.ProtocolUpgradeApproverReadiness
 }
 
-func NewProtocolUpgradeProposalEvent(ctx context.Context, upgradeBlockHeight uint64, vegaReleaseTag string, acceptedBy []string, status eventspb.ProtocolUpgradeProposalStatus) *ProtocolUpgradeProposalEvent {
+func NewProtocolUpgradeProposalEvent(ctx context.Context, upgradeBlockHeight uint64, vegaReleaseTag string, acceptedBy []string, status eventspb.ProtocolUpgradeProposalStatus, readiness []*eventspb.ProtocolUpgradeApproverReadiness) *ProtocolUpgradeProposalEvent {
 	return &ProtocolUpgradeProposalEvent{
 		Base:               newBase(ctx, ProtocolUpgradeEvent),
 		UpgradeBlockHeight: upgradeBlockHeight,
 		VegaReleaseTag:     vegaReleaseTag,
 		AcceptedBy:         acceptedBy,
 		ProposalStatus:     status,
+		Readiness:          readiness,
 	}
 }
 
@@ -45,6 +47,7 @@ func (pup ProtocolUpgradeProposalEvent) Proto() eventspb.ProtocolUpgradeEvent {
 		UpgradeBlockHeight: pup.UpgradeBlockHeight,
 		Approvers:          pup.AcceptedBy,
 		Status:             pup.ProposalStatus,
+		Readiness:          pup.Readiness,
 	}
 }
 
@@ -74,5 +77,6 @@ func ProtocolUpgradeProposalEventFromStream(ctx context.Context, be *eventspb.Bu
 		VegaReleaseTag:     event.VegaReleaseTag,
 		AcceptedBy:         event.Approvers,
 		ProposalStatus:     event.Status,
+		Readiness:          event.Readiness,
 	}
 }
@@ -21,6 +21,7 @@ import (
 
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/ptr"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 
 	"golang.org/x/exp/slices"
@@ -120,6 +121,11 @@ func NewReferralSetStatsUpdatedEvent(ctx context.Context, update *types.Referral
 		return strings.Compare(a.PartyId, b.PartyId)
 	})
 
+	var upstreamSetID *string
+	if len(update.UpstreamSetID) > 0 {
+		upstreamSetID = ptr.From(string(update.UpstreamSetID))
+	}
+
 	return &ReferralSetStatsUpdated{
 		Base: newBase(ctx, ReferralSetStatsUpdatedEvent),
 		e: eventspb.ReferralSetStatsUpdated{
@@ -132,6 +138,7 @@ func NewReferralSetStatsUpdatedEvent(ctx context.Context, update *types.Referral
 			RewardFactors:                         update.RewardFactors.IntoRewardFactorsProto(),
 			RewardsMultiplier:                     update.RewardsMultiplier.String(),
 			RewardFactorsMultiplier:               update.RewardsFactorsMultiplier.IntoRewardFactorsProto(),
+			UpstreamSetId:                         upstreamSetID,
 		},
 	}
 }
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/types"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type TeamCompetition struct {
+	*Base
+	c vegapb.TeamCompetition
+}
+
+func NewTeamCompetitionEvent(ctx context.Context, c *types.TeamCompetition) *TeamCompetition {
+	return &TeamCompetition{
+		Base: newBase(ctx, TeamCompetitionEvent),
+		c:    *c.IntoProto(),
+	}
+}
+
+func (t TeamCompetition) TeamCompetition() *vegapb.TeamCompetition {
+	return &t.c
+}
+
+func (t TeamCompetition) Proto() vegapb.TeamCompetition {
+	return t.c
+}
+
+func (t TeamCompetition) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(t.Base)
+	busEvent.Event = &eventspb.BusEvent_TeamCompetition{
+		TeamCompetition: &t.c,
+	}
+
+	return busEvent
+}
+
+func TeamCompetitionEventFromStream(ctx context.Context, be *eventspb.BusEvent) *TeamCompetition {
+	c := be.GetTeamCompetition()
+	if c == nil {
+		return nil
+	}
+
+	return &TeamCompetition{
+		Base: newBaseFromBusEvent(ctx, TeamCompetitionEvent, be),
+		c:    *c,
+	}
+}
@@ -260,6 +260,38 @@ func (t *TransactionResult) setTx(tx interface{}) *TransactionResult {
 		t.evt.Transaction = &eventspb.TransactionResult_CancelAmm{
 			CancelAmm: tv,
 		}
+	case *commandspb.NewTeamCompetition:
+		t.evt.Transaction = &eventspb.TransactionResult_NewTeamCompetition{
+			NewTeamCompetition: tv,
+		}
+	case *commandspb.CancelTeamCompetition:
+		t.evt.Transaction = &eventspb.TransactionResult_CancelTeamCompetition{
+			CancelTeamCompetition: tv,
+		}
+	case *commandspb.CreateSubAccount:
+		t.evt.Transaction = &eventspb.TransactionResult_CreateSubAccount{
+			CreateSubAccount: tv,
+		}
+	case *commandspb.CancelDelayedWithdrawal:
+		t.evt.Transaction = &eventspb.TransactionResult_CancelDelayedWithdrawal{
+			CancelDelayedWithdrawal: tv,
+		}
+	case *commandspb.DelegateVote:
+		t.evt.Transaction = &eventspb.TransactionResult_DelegateVote{
+			DelegateVote: tv,
+		}
+	case *commandspb.VetoProposal:
+		t.evt.Transaction = &eventspb.TransactionResult_VetoProposal{
+			VetoProposal: tv,
+		}
+	case *commandspb.RedelegateSubmission:
+		t.evt.Transaction = &eventspb.TransactionResult_RedelegateSubmission{
+			RedelegateSubmission: tv,
+		}
+	case *commandspb.AutoCompoundSubmission:
+		t.evt.Transaction = &eventspb.TransactionResult_AutoCompoundSubmission{
+			AutoCompoundSubmission: tv,
+		}
 	default:
 		panic(fmt.Sprintf("unsupported command %T", tv))
 	}
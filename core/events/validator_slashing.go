@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type ValidatorSlashing struct {
+	*Base
+	s eventspb.ValidatorSlashing
+}
+
+func NewValidatorSlashingEvent(ctx context.Context, s *eventspb.ValidatorSlashing) *ValidatorSlashing {
+	return &ValidatorSlashing{
+		Base: newBase(ctx, ValidatorSlashingEvent),
+		s:    *s,
+	}
+}
+
+func (v ValidatorSlashing) ValidatorSlashing() *eventspb.ValidatorSlashing {
+	return &v.s
+}
+
+func (v ValidatorSlashing) Proto() eventspb.ValidatorSlashing {
+	return v.s
+}
+
+func (v ValidatorSlashing) StreamMessage() *eventspb.BusEvent {
+	busEvent := newBusEventFromBase(v.Base)
+	busEvent.Event = &eventspb.BusEvent_ValidatorSlashing{
+		ValidatorSlashing: &v.s,
+	}
+
+	return busEvent
+}
+
+func ValidatorSlashingEventFromStream(ctx context.Context, be *eventspb.BusEvent) *ValidatorSlashing {
+	s := be.GetValidatorSlashing()
+	if s == nil {
+		return nil
+	}
+
+	return &ValidatorSlashing{
+		Base: newBaseFromBusEvent(ctx, ValidatorSlashingEvent, be),
+		s:    *s,
+	}
+}
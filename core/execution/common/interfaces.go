@@ -202,6 +202,7 @@ type Collateral interface {
 	GetSystemAccountBalance(asset, market string, accountType types.AccountType) (*num.Uint, error)
 	EarmarkForAutomatedPurchase(asset string, accountType types.AccountType, min, max *num.Uint) (*num.Uint, error)
 	UnearmarkForAutomatedPurchase(asset string, accountType types.AccountType, releaseRequest *num.Uint) error
+	UpdateCrossAssetCollateralPrice(asset, settlementAsset string, price num.Decimal)
 
 	// amm stuff
 	SubAccountClosed(ctx context.Context, party, subAccount, asset, market string) ([]*types.LedgerMovement, error)
@@ -291,6 +292,7 @@ type LiquidityEngine interface {
 	GetPartyLiquidityScore(orders []*types.Order, bestBid, bestAsk num.Decimal, minP, maxP *num.Uint) num.Decimal
 
 	LiquidityProviderSLAStats(t time.Time) []*types.LiquidityProviderSLA
+	LiquidityProviderSLAForecast(party string, now, epochEnd time.Time, midPrice *num.Uint) (*types.LiquidityProviderSLAForecast, error)
 
 	RegisterAllocatedFeesPerParty(feesPerParty map[string]*num.Uint)
 	PaidLiquidityFeesStats() *types.PaidLiquidityFeesStats
@@ -359,6 +361,7 @@ type CommonMarket interface {
 	StartOpeningAuction(context.Context) error
 	GetEquityShares() *EquityShares
 	GetEquitySharesForParty(partyID string) num.Decimal
+	GetLiquidityProviderSLAForecast(partyID string) (*types.LiquidityProviderSLAForecast, error)
 	IntoType() types.Market
 	OnEpochEvent(ctx context.Context, epoch types.Epoch)
 	OnEpochRestore(ctx context.Context, epoch types.Epoch)
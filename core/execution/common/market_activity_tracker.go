@@ -88,6 +88,10 @@ type marketTracker struct {
 	partyRealisedReturn map[string]num.Decimal
 	twNotional          map[string]*twNotional
 
+	quotingDepth             map[string]num.Decimal // notional quoted within the max spread by party as of the last record
+	quotingDepthTime         time.Time              // time of the last recorded quoting depth
+	currentEpochQuotingDepth map[string]num.Decimal // current epoch's running quoting depth weighted by nanoseconds
+
 	// historical data.
 	epochMakerFeesReceived      []map[string]*num.Uint
 	epochMakerFeesPaid          []map[string]*num.Uint
@@ -100,6 +104,7 @@ type marketTracker struct {
 	epochPartyM2M               []map[string]num.Decimal
 	epochPartyRealisedReturn    []map[string]num.Decimal
 	epochNotionalVolume         []*num.Uint
+	epochQuotingDepth           []map[string]num.Decimal
 
 	valueTraded     *num.Uint
 	proposersPaid   map[string]struct{} // identifier of payout_asset : funder : markets_in_scope
@@ -122,6 +127,7 @@ type MarketActivityTracker struct {
 	currentEpoch                        uint64
 	epochStartTime                      time.Time
 	minEpochsInTeamForRewardEligibility uint64
+	quotingDepthMaxSpread               num.Decimal
 	assetToMarketTrackers               map[string]map[string]*marketTracker
 	partyContributionCache              map[string][]*types.PartyContributionScore
 	partyTakerNotionalVolume            map[string]*num.Uint
@@ -271,6 +277,7 @@ func (mat *MarketActivityTracker) PublishGameMetric(ctx context.Context, dispatc
 			mt.processPositionAtMilestone(mat.epochStartTime, now)
 			mt.processM2MAtMilestone()
 			mt.processPartyRealisedReturnAtMilestone()
+			mt.processQuotingDepthAtMilestone(mat.epochStartTime, now)
 			mt.calcFeesAtMilestone()
 		}
 	}
@@ -293,6 +300,7 @@ func (mat *MarketActivityTracker) PublishGameMetric(ctx context.Context, dispatc
 			mt.epochTimeWeightedPosition = mt.epochTimeWeightedPosition[:len(mt.epochTimeWeightedPosition)-1]
 			mt.epochPartyM2M = mt.epochPartyM2M[:len(mt.epochPartyM2M)-1]
 			mt.epochPartyRealisedReturn = mt.epochPartyRealisedReturn[:len(mt.epochPartyRealisedReturn)-1]
+			mt.epochQuotingDepth = mt.epochQuotingDepth[:len(mt.epochQuotingDepth)-1]
 			mt.epochMakerFeesReceived = mt.epochMakerFeesReceived[:len(mt.epochMakerFeesReceived)-1]
 			mt.epochMakerFeesPaid = mt.epochMakerFeesPaid[:len(mt.epochMakerFeesPaid)-1]
 			mt.epochLpFees = mt.epochLpFees[:len(mt.epochLpFees)-1]
@@ -555,6 +563,7 @@ func (mat *MarketActivityTracker) OnEpochEvent(ctx context.Context, epoch types.
 				mt.processPositionEndOfEpoch(epoch.StartTime, epoch.EndTime)
 				mt.processM2MEndOfEpoch()
 				mt.processPartyRealisedReturnOfEpoch()
+				mt.processQuotingDepthEndOfEpoch(epoch.StartTime, epoch.EndTime)
 				mt.clearFeeActivity()
 				if len(mt.epochNotionalVolume) == maxWindowSize {
 					mt.epochNotionalVolume = mt.epochNotionalVolume[1:]
@@ -1185,6 +1194,11 @@ func (mat *MarketActivityTracker) calculateMetricForParty(asset, party string, m
 				found = true
 				total = total.Add(t)
 			}
+		case vega.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH:
+			if t, ok := marketTracker.getQuotingDepthMetricTotal(party, windowSize); ok {
+				found = true
+				total = total.Add(t)
+			}
 		case vega.DispatchMetric_DISPATCH_METRIC_RETURN_VOLATILITY:
 			r, ok := marketTracker.getReturns(party, windowSize)
 			if !ok {
@@ -1232,7 +1246,7 @@ func (mat *MarketActivityTracker) calculateMetricForParty(asset, party string, m
 		// descaling the total tw position metric by dividing by the scaling factor
 		v := total.Div(num.DecimalFromInt64(int64(windowSize) * scalingFactor))
 		return v, found
-	case vega.DispatchMetric_DISPATCH_METRIC_RELATIVE_RETURN, vega.DispatchMetric_DISPATCH_METRIC_REALISED_RETURN:
+	case vega.DispatchMetric_DISPATCH_METRIC_RELATIVE_RETURN, vega.DispatchMetric_DISPATCH_METRIC_REALISED_RETURN, vega.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH:
 		return total.Div(num.DecimalFromInt64(int64(windowSize))), found
 	case vega.DispatchMetric_DISPATCH_METRIC_RETURN_VOLATILITY:
 		filteredReturns := []num.Decimal{}
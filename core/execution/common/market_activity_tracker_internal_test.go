@@ -16,6 +16,7 @@
 package common
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -25,8 +26,10 @@ import (
 	"code.vegaprotocol.io/vega/core/execution/common/mocks"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
 	vgproto "code.vegaprotocol.io/vega/protos/vega"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -1754,3 +1757,102 @@ func TestEpochTakerFeesToProto(t *testing.T) {
 		require.Equal(t, "31", num.UintFromBytes(res[1].EpochPartyTakerFeesPaid[4].TakerFees[0].TakerFees).String())
 	}
 }
+
+func TestCalculateMetricForPartyQuotingDepth(t *testing.T) {
+	epochService := &DummyEpochEngine{}
+	ctrl := gomock.NewController(t)
+	teams := mocks.NewMockTeams(ctrl)
+	balanceChecker := mocks.NewMockAccountBalanceChecker(ctrl)
+	broker := bmocks.NewMockBroker(ctrl)
+	collateralService := mocks.NewMockCollateral(ctrl)
+	tracker := NewMarketActivityTracker(logging.NewTestLogger(), teams, balanceChecker, broker, collateralService)
+	epochService.NotifyOnEpoch(tracker.OnEpochEvent, tracker.OnEpochRestore)
+	require.NoError(t, tracker.OnQuotingDepthMaxSpreadUpdate(context.Background(), num.DecimalFromFloat(0.01)))
+	epochService.target(context.Background(), types.Epoch{Seq: 1, Action: vgproto.EpochAction_EPOCH_ACTION_START, StartTime: time.Unix(0, 0)})
+
+	tracker.MarketProposed("a1", "m1", "z1")
+
+	order := func(party string, price, size uint64, status types.OrderStatus) *types.Order {
+		return &types.Order{Party: party, Price: num.NewUint(price), Remaining: size, Status: status}
+	}
+
+	// p1 quotes 2 at 995 within 1% of the mid price and 5 at 1020 outside of it,
+	// p2 quotes 1 at 1005 and p3 has no active order
+	tracker.RecordQuotingDepth("a1", "m1", num.NewUint(1000), []*types.Order{
+		order("p1", 995, 2, types.OrderStatusActive),
+		order("p1", 1020, 5, types.OrderStatusActive),
+		order("p2", 1005, 1, types.OrderStatusActive),
+		order("p3", 1000, 10, types.OrderStatusParked),
+	}, num.DecimalOne(), time.Unix(0, 0))
+
+	// p2 pulls its order half way through the epoch
+	tracker.RecordQuotingDepth("a1", "m1", num.NewUint(1000), []*types.Order{
+		order("p1", 995, 2, types.OrderStatusActive),
+	}, num.DecimalOne(), time.Unix(30, 0))
+
+	epochService.target(context.Background(), types.Epoch{Seq: 1, Action: vgproto.EpochAction_EPOCH_ACTION_END, StartTime: time.Unix(0, 0), EndTime: time.Unix(60, 0)})
+
+	score, ok := tracker.calculateMetricForParty("a1", "p1", []string{"m1"}, vgproto.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH, 1)
+	require.True(t, ok)
+	require.Equal(t, "1990", score.String())
+
+	score, ok = tracker.calculateMetricForParty("a1", "p2", []string{"m1"}, vgproto.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH, 1)
+	require.True(t, ok)
+	require.Equal(t, "502.5", score.String())
+
+	_, ok = tracker.calculateMetricForParty("a1", "p3", []string{"m1"}, vgproto.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH, 1)
+	require.False(t, ok)
+
+	// with no quotes in the second epoch the average over 2 epochs is halved
+	epochService.target(context.Background(), types.Epoch{Seq: 2, Action: vgproto.EpochAction_EPOCH_ACTION_START, StartTime: time.Unix(60, 0)})
+	tracker.RecordQuotingDepth("a1", "m1", num.NewUint(1000), []*types.Order{}, num.DecimalOne(), time.Unix(60, 0))
+	epochService.target(context.Background(), types.Epoch{Seq: 2, Action: vgproto.EpochAction_EPOCH_ACTION_END, StartTime: time.Unix(60, 0), EndTime: time.Unix(120, 0)})
+
+	score, ok = tracker.calculateMetricForParty("a1", "p1", []string{"m1"}, vgproto.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH, 2)
+	require.True(t, ok)
+	require.Equal(t, "995", score.String())
+}
+
+func TestQuotingDepthSnapshot(t *testing.T) {
+	newTracker := func() (*MarketActivityTracker, *DummyEpochEngine) {
+		epochService := &DummyEpochEngine{}
+		ctrl := gomock.NewController(t)
+		tracker := NewMarketActivityTracker(logging.NewTestLogger(), mocks.NewMockTeams(ctrl), mocks.NewMockAccountBalanceChecker(ctrl), bmocks.NewMockBroker(ctrl), mocks.NewMockCollateral(ctrl))
+		epochService.NotifyOnEpoch(tracker.OnEpochEvent, tracker.OnEpochRestore)
+		require.NoError(t, tracker.OnQuotingDepthMaxSpreadUpdate(context.Background(), num.DecimalFromFloat(0.01)))
+		return tracker, epochService
+	}
+
+	tracker, epochService := newTracker()
+	epochService.target(context.Background(), types.Epoch{Seq: 1, Action: vgproto.EpochAction_EPOCH_ACTION_START, StartTime: time.Unix(0, 0)})
+	tracker.MarketProposed("a1", "m1", "z1")
+
+	order := &types.Order{Party: "p1", Price: num.NewUint(995), Remaining: 2, Status: types.OrderStatusActive}
+	tracker.RecordQuotingDepth("a1", "m1", num.NewUint(1000), []*types.Order{order}, num.DecimalOne(), time.Unix(0, 0))
+	epochService.target(context.Background(), types.Epoch{Seq: 1, Action: vgproto.EpochAction_EPOCH_ACTION_END, StartTime: time.Unix(0, 0), EndTime: time.Unix(60, 0)})
+	epochService.target(context.Background(), types.Epoch{Seq: 2, Action: vgproto.EpochAction_EPOCH_ACTION_START, StartTime: time.Unix(60, 0)})
+	// the running depth of the current epoch is accumulated on the next record.
+	tracker.RecordQuotingDepth("a1", "m1", num.NewUint(1000), []*types.Order{order}, num.DecimalOne(), time.Unix(90, 0))
+
+	key := (&types.PayloadMarketActivityTracker{}).Key()
+	state1, _, err := tracker.GetState(key)
+	require.NoError(t, err)
+
+	trackerLoad, _ := newTracker()
+	pl := snapshotpb.Payload{}
+	require.NoError(t, proto.Unmarshal(state1, &pl))
+	_, err = trackerLoad.LoadState(context.Background(), types.PayloadFromProto(&pl))
+	require.NoError(t, err)
+
+	state2, _, err := trackerLoad.GetState(key)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(state1, state2))
+
+	loaded := trackerLoad.assetToMarketTrackers["a1"]["m1"]
+	original := tracker.assetToMarketTrackers["a1"]["m1"]
+	require.Equal(t, original.quotingDepthTime.UnixNano(), loaded.quotingDepthTime.UnixNano())
+	require.Equal(t, "1990", loaded.quotingDepth["p1"].String())
+	require.True(t, original.currentEpochQuotingDepth["p1"].Equal(loaded.currentEpochQuotingDepth["p1"]))
+	require.Len(t, loaded.epochQuotingDepth, 1)
+	require.Equal(t, "1990", loaded.epochQuotingDepth[0]["p1"].String())
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+func (mat *MarketActivityTracker) OnQuotingDepthMaxSpreadUpdate(_ context.Context, maxSpread num.Decimal) error {
+	mat.quotingDepthMaxSpread = maxSpread
+	return nil
+}
+
+// RecordQuotingDepth is called at the end of every block with the orders on the book of the parties
+// that are not liquidity providers. The notional of the orders within the maximum spread of the
+// mid price is the quoting depth of the party until the next call.
+func (mat *MarketActivityTracker) RecordQuotingDepth(asset, market string, midPrice *num.Uint, orders []*types.Order, positionFactor num.Decimal, now time.Time) {
+	tracker, ok := mat.getMarketTracker(asset, market)
+	if !ok {
+		return
+	}
+	tracker.accumulateQuotingDepth(mat.epochStartTime, now)
+
+	depth := map[string]num.Decimal{}
+	if midPrice != nil && !midPrice.IsZero() {
+		mid := midPrice.ToDecimal()
+		maxDistance := mid.Mul(mat.quotingDepthMaxSpread)
+		for _, o := range orders {
+			if o.Status != types.OrderStatusActive || o.Price == nil || o.Remaining == 0 {
+				continue
+			}
+			price := o.Price.ToDecimal()
+			if price.Sub(mid).Abs().GreaterThan(maxDistance) {
+				continue
+			}
+			notional := price.Mul(num.DecimalFromInt64(int64(o.Remaining))).Div(positionFactor)
+			depth[o.Party] = depth[o.Party].Add(notional)
+			tracker.allPartiesCache[o.Party] = struct{}{}
+		}
+	}
+	tracker.quotingDepth = depth
+}
+
+// accumulateQuotingDepth adds the quoting depth of every party weighted by the time elapsed since the
+// last recorded depth to the running total of the epoch.
+func (mt *marketTracker) accumulateQuotingDepth(epochStartTime, now time.Time) {
+	from := mt.quotingDepthTime
+	if from.Before(epochStartTime) {
+		from = epochStartTime
+	}
+	mt.quotingDepthTime = now
+	elapsed := now.Sub(from)
+	if elapsed <= 0 {
+		return
+	}
+	if mt.currentEpochQuotingDepth == nil {
+		mt.currentEpochQuotingDepth = map[string]num.Decimal{}
+	}
+	weight := num.DecimalFromInt64(elapsed.Nanoseconds())
+	for party, depth := range mt.quotingDepth {
+		mt.currentEpochQuotingDepth[party] = mt.currentEpochQuotingDepth[party].Add(depth.Mul(weight))
+	}
+}
+
+// timeWeightedQuotingDepth returns the quoting depth of every party averaged over the time elapsed in the epoch.
+func (mt *marketTracker) timeWeightedQuotingDepth(epochStartTime, now time.Time) map[string]num.Decimal {
+	mt.accumulateQuotingDepth(epochStartTime, now)
+	m := make(map[string]num.Decimal, len(mt.currentEpochQuotingDepth))
+	elapsed := now.Sub(epochStartTime).Nanoseconds()
+	if elapsed <= 0 {
+		return m
+	}
+	for party, depth := range mt.currentEpochQuotingDepth {
+		m[party] = depth.Div(num.DecimalFromInt64(elapsed))
+	}
+	return m
+}
+
+func (mt *marketTracker) processQuotingDepthEndOfEpoch(epochStartTime, endEpochTime time.Time) {
+	m := mt.timeWeightedQuotingDepth(epochStartTime, endEpochTime)
+	if len(mt.epochQuotingDepth) == maxWindowSize {
+		mt.epochQuotingDepth = mt.epochQuotingDepth[1:]
+	}
+	mt.epochQuotingDepth = append(mt.epochQuotingDepth, m)
+	mt.currentEpochQuotingDepth = map[string]num.Decimal{}
+}
+
+func (mt *marketTracker) processQuotingDepthAtMilestone(epochStartTime, milestoneTime time.Time) {
+	mt.epochQuotingDepth = append(mt.epochQuotingDepth, mt.timeWeightedQuotingDepth(epochStartTime, milestoneTime))
+}
+
+// getQuotingDepthMetricTotal returns the sum of the epoch's time weighted quoting depth over the time window.
+func (mt *marketTracker) getQuotingDepthMetricTotal(party string, windowSize int) (num.Decimal, bool) {
+	return calcTotalForWindowD(party, mt.epochQuotingDepth, windowSize)
+}
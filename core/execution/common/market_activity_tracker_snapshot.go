@@ -323,6 +323,11 @@ func (mt *marketTracker) IntoProto(market string) *checkpoint.MarketActivityTrac
 		epochNotionalVolume = append(epochNotionalVolume, env.String())
 	}
 
+	var quotingDepthTime int64
+	if !mt.quotingDepthTime.IsZero() {
+		quotingDepthTime = mt.quotingDepthTime.UnixNano()
+	}
+
 	return &checkpoint.MarketActivityTracker{
 		Market:                          market,
 		Asset:                           mt.asset,
@@ -351,6 +356,10 @@ func (mt *marketTracker) IntoProto(market string) *checkpoint.MarketActivityTrac
 		AmmParties:                      ammParties,
 		NotionalVolumeForEpoch:          mt.notionalVolumeForEpoch.String(),
 		EpochNotionalVolume:             epochNotionalVolume,
+		QuotingDepth:                    returnsDataToProto(mt.quotingDepth),
+		QuotingDepthTime:                quotingDepthTime,
+		CurrentEpochQuotingDepth:        returnsDataToProto(mt.currentEpochQuotingDepth),
+		QuotingDepthHistory:             epochReturnDataToProto(mt.epochQuotingDepth),
 	}
 }
 
@@ -641,6 +650,29 @@ func marketTrackerFromProto(tracker *checkpoint.MarketActivityTracker) *marketTr
 		}
 	}
 
+	if len(tracker.QuotingDepth) > 0 {
+		mft.quotingDepth = make(map[string]num.Decimal, len(tracker.QuotingDepth))
+		for _, qd := range tracker.QuotingDepth {
+			mft.quotingDepth[qd.Party], _ = num.UnmarshalBinaryDecimal(qd.Return)
+		}
+	}
+	if tracker.QuotingDepthTime != 0 {
+		mft.quotingDepthTime = time.Unix(0, tracker.QuotingDepthTime)
+	}
+	if len(tracker.CurrentEpochQuotingDepth) > 0 {
+		mft.currentEpochQuotingDepth = make(map[string]num.Decimal, len(tracker.CurrentEpochQuotingDepth))
+		for _, qd := range tracker.CurrentEpochQuotingDepth {
+			mft.currentEpochQuotingDepth[qd.Party], _ = num.UnmarshalBinaryDecimal(qd.Return)
+		}
+	}
+	for _, eqd := range tracker.QuotingDepthHistory {
+		depth := make(map[string]num.Decimal, len(eqd.Returns))
+		for _, qd := range eqd.Returns {
+			depth[qd.Party], _ = num.UnmarshalBinaryDecimal(qd.Return)
+		}
+		mft.epochQuotingDepth = append(mft.epochQuotingDepth, depth)
+	}
+
 	mft.asset = tracker.Asset
 	return mft
 }
@@ -1071,6 +1071,18 @@ func (mr *MockCollateralMockRecorder) UnearmarkForAutomatedPurchase(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnearmarkForAutomatedPurchase", reflect.TypeOf((*MockCollateral)(nil).UnearmarkForAutomatedPurchase), arg0, arg1, arg2)
 }
 
+// UpdateCrossAssetCollateralPrice mocks base method.
+func (m *MockCollateral) UpdateCrossAssetCollateralPrice(arg0, arg1 string, arg2 decimal.Decimal) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateCrossAssetCollateralPrice", arg0, arg1, arg2)
+}
+
+// UpdateCrossAssetCollateralPrice indicates an expected call of UpdateCrossAssetCollateralPrice.
+func (mr *MockCollateralMockRecorder) UpdateCrossAssetCollateralPrice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCrossAssetCollateralPrice", reflect.TypeOf((*MockCollateral)(nil).UpdateCrossAssetCollateralPrice), arg0, arg1, arg2)
+}
+
 // Withdraw mocks base method.
 func (m *MockCollateral) Withdraw(arg0 context.Context, arg1, arg2 string, arg3 *num.Uint) (*types.LedgerMovement, error) {
 	m.ctrl.T.Helper()
@@ -1851,6 +1863,21 @@ func (mr *MockLiquidityEngineMockRecorder) IsProbabilityOfTradingInitialised() *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProbabilityOfTradingInitialised", reflect.TypeOf((*MockLiquidityEngine)(nil).IsProbabilityOfTradingInitialised))
 }
 
+// LiquidityProviderSLAForecast mocks base method.
+func (m *MockLiquidityEngine) LiquidityProviderSLAForecast(arg0 string, arg1, arg2 time.Time, arg3 *num.Uint) (*types.LiquidityProviderSLAForecast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiquidityProviderSLAForecast", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.LiquidityProviderSLAForecast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiquidityProviderSLAForecast indicates an expected call of LiquidityProviderSLAForecast.
+func (mr *MockLiquidityEngineMockRecorder) LiquidityProviderSLAForecast(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiquidityProviderSLAForecast", reflect.TypeOf((*MockLiquidityEngine)(nil).LiquidityProviderSLAForecast), arg0, arg1, arg2, arg3)
+}
+
 // LiquidityProviderSLAStats mocks base method.
 func (m *MockLiquidityEngine) LiquidityProviderSLAStats(arg0 time.Time) []*vega.LiquidityProviderSLA {
 	m.ctrl.T.Helper()
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// MarketNetParamDecimal returns the value of the network parameter for the market,
+// its override if the market has one, the network wide value otherwise.
+func MarketNetParamDecimal(mkt *types.Market, key string, d num.Decimal) num.Decimal {
+	if mkt == nil {
+		return d
+	}
+	if v, ok := mkt.NetworkParameterOverride(key); ok {
+		if od, err := num.DecimalFromString(v); err == nil {
+			return od
+		}
+	}
+	return d
+}
+
+// MarketNetParamDuration returns the value of the network parameter for the market,
+// its override if the market has one, the network wide value otherwise.
+func MarketNetParamDuration(mkt *types.Market, key string, d time.Duration) time.Duration {
+	if mkt == nil {
+		return d
+	}
+	if v, ok := mkt.NetworkParameterOverride(key); ok {
+		if od, err := time.ParseDuration(v); err == nil {
+			return od
+		}
+	}
+	return d
+}
+
+// MarketNetParamUint returns the value of the network parameter for the market,
+// its override if the market has one, the network wide value otherwise.
+func MarketNetParamUint(mkt *types.Market, key string, u *num.Uint) *num.Uint {
+	if mkt == nil {
+		return u
+	}
+	if v, ok := mkt.NetworkParameterOverride(key); ok {
+		if ou, overflow := num.UintFromString(v, 10); !overflow {
+			return ou
+		}
+	}
+	return u
+}
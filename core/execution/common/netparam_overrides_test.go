@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/execution/common"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketNetParamOverrides(t *testing.T) {
+	mkt := &types.Market{
+		NetworkParameterOverrides: []*types.NetworkParameter{
+			{Key: netparams.MarketAuctionMinimumDuration, Value: "30m"},
+			{Key: netparams.MarketLiquidityStakeToCCYVolume, Value: "2"},
+			{Key: netparams.SpamProtectionMaxStopOrdersPerMarket, Value: "10"},
+		},
+	}
+	global := num.DecimalFromFloat(0.5)
+
+	// overridden values take precedence over the network wide ones.
+	require.Equal(t, 30*time.Minute, common.MarketNetParamDuration(mkt, netparams.MarketAuctionMinimumDuration, time.Minute))
+	require.Equal(t, "2", common.MarketNetParamDecimal(mkt, netparams.MarketLiquidityStakeToCCYVolume, global).String())
+	require.Equal(t, "10", common.MarketNetParamUint(mkt, netparams.SpamProtectionMaxStopOrdersPerMarket, num.NewUint(4)).String())
+
+	// otherwise the network wide value is used.
+	require.Equal(t, time.Hour, common.MarketNetParamDuration(mkt, netparams.MarketValueWindowLength, time.Hour))
+	require.True(t, global.Equal(common.MarketNetParamDecimal(mkt, netparams.MarketLiquidityEarlyExitPenalty, global)))
+	require.True(t, global.Equal(common.MarketNetParamDecimal(nil, netparams.MarketLiquidityStakeToCCYVolume, global)))
+	require.Equal(t, "4", common.MarketNetParamUint(nil, netparams.SpamProtectionMaxStopOrdersPerMarket, num.NewUint(4)).String())
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	dscommon "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/products"
+	"code.vegaprotocol.io/vega/core/types"
+	vegacontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+var (
+	ErrInvalidCrossAssetLiquidationMarket = errors.New("liquidation market doesn't trade the asset against the settlement asset")
+	ErrCrossAssetCollateralSizeTooSmall   = errors.New("cross-asset collateral is smaller than the position decimals of the liquidation market")
+)
+
+// OnCrossAssetCollateralUpdate subscribes to the price oracle of each asset approved
+// as cross-asset collateral, in place of the previous ones. The prices are given to
+// the collateral engine, which values the collateral with them.
+func (e *Engine) OnCrossAssetCollateralUpdate(ctx context.Context, v string) error {
+	cfgs, err := types.ParseCrossAssetCollateral(v)
+	if err != nil {
+		return err
+	}
+
+	for _, oracle := range e.crossAssetPriceOracles {
+		oracle.UnsubAll(ctx)
+	}
+	e.crossAssetPriceOracles = make([]*products.CompositePriceOracle, 0, len(cfgs))
+	for _, c := range cfgs {
+		oracle, err := e.subscribeCrossAssetPriceOracle(ctx, c)
+		if err != nil {
+			// the network parameter is valid, only this asset goes unpriced.
+			e.log.Error("could not subscribe to the price oracle of cross-asset collateral",
+				logging.AssetID(c.Asset),
+				logging.String("settlement-asset", c.SettlementAsset),
+				logging.Error(err))
+			continue
+		}
+		e.crossAssetPriceOracles = append(e.crossAssetPriceOracles, oracle)
+	}
+	return nil
+}
+
+func (e *Engine) subscribeCrossAssetPriceOracle(ctx context.Context, c *types.CrossAssetCollateral) (*products.CompositePriceOracle, error) {
+	var oracle *products.CompositePriceOracle
+	cb := func(_ context.Context, data dscommon.Data) error {
+		pd, err := oracle.GetData(data)
+		if err != nil {
+			return err
+		}
+		if price, ok := crossAssetOraclePrice(pd, oracle.GetDecimals()); ok {
+			e.collateral.UpdateCrossAssetCollateralPrice(c.Asset, c.SettlementAsset, price)
+		}
+		return nil
+	}
+
+	oracle, err := products.NewCompositePriceOracle(ctx, e.oracle, c.PriceOracle, c.PriceOracleBinding, cb)
+	return oracle, err
+}
+
+// crossAssetOraclePrice returns the price given by the oracle, with its decimals applied.
+func crossAssetOraclePrice(pd *num.Numeric, decimals int64) (num.Decimal, bool) {
+	switch {
+	case pd == nil:
+		return num.DecimalZero(), false
+	case pd.IsDecimal():
+		return *pd.Decimal(), pd.Decimal().IsPositive()
+	case pd.IsUint():
+		price := pd.Uint().ToDecimal().Div(num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(decimals)))
+		return price, price.IsPositive()
+	default:
+		return num.DecimalZero(), false
+	}
+}
+
+// SellCrossAssetCollateral sells the amount of the asset, held in the general account
+// of the party, with an IOC market order on the liquidation market. The proceeds land
+// in the general account of the party, in the settlement asset.
+func (e *Engine) SellCrossAssetCollateral(ctx context.Context, party, asset, settlementAsset, market string, amount *num.Uint) error {
+	mkt, ok := e.spotMarkets[market]
+	if !ok {
+		return ErrMarketDoesNotExist
+	}
+	if assets := mkt.GetAssets(); assets[0] != asset || assets[1] != settlementAsset {
+		return ErrInvalidCrossAssetLiquidationMarket
+	}
+
+	assetDetails, err := e.assets.Get(asset)
+	if err != nil {
+		return err
+	}
+	// the size is rounded down, as no more than the amount can be sold.
+	factor := num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(int64(assetDetails.DecimalPlaces()) - mkt.Mkt().PositionDecimalPlaces))
+	size := amount.ToDecimal().Div(factor).Floor()
+	if !size.IsPositive() {
+		return ErrCrossAssetCollateralSizeTooSmall
+	}
+
+	submission := &types.OrderSubmission{
+		MarketID:    market,
+		Side:        types.SideSell,
+		Size:        uint64(size.IntPart()),
+		Type:        types.OrderTypeMarket,
+		TimeInForce: types.OrderTimeInForceIOC,
+		Reference:   "cross-asset-collateral-liquidation",
+	}
+	_, blockHash := vegacontext.TraceIDFromContext(ctx)
+	deterministicID := crypto.HashStrToHex(blockHash + party + market + strconv.Itoa(e.crossAssetSales))
+	e.crossAssetSales++
+	_, err = mkt.SubmitOrder(ctx, submission, party, deterministicID)
+	return err
+}
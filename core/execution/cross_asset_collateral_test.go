@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package execution_test
+
+import (
+	"context"
+	"testing"
+
+	dstypes "code.vegaprotocol.io/vega/core/datasource/common"
+	"code.vegaprotocol.io/vega/core/datasource/spec"
+	"code.vegaprotocol.io/vega/core/execution"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const crossAssetCollateralConfig = `[{
+	"asset": "WETH",
+	"settlement_asset": "USDT",
+	"haircut": "0.2",
+	"price_oracle": {"external": {"oracle": {
+		"signers": [{"pubKey": {"key": "0xCAFED00D"}}],
+		"filters": [{"key": {"name": "prices.ETH.value", "type": "TYPE_INTEGER", "numberDecimalPlaces": "5"}}]
+	}}},
+	"price_oracle_spec_binding": {"price_source_property": "prices.ETH.value"},
+	"liquidation_market": "spot"
+}]`
+
+func TestCrossAssetCollateralPriceOracle(t *testing.T) {
+	eng := getMockedEngine(t)
+	defer eng.ctrl.Finish()
+	ctx := context.Background()
+
+	var cb spec.OnMatchedData
+	unsubscribed := 0
+	eng.oracle.EXPECT().Subscribe(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+		func(_ context.Context, _ spec.Spec, fn spec.OnMatchedData) (spec.SubscriptionID, spec.Unsubscriber, error) {
+			cb = fn
+			return spec.SubscriptionID(1), func(context.Context, spec.SubscriptionID) { unsubscribed++ }, nil
+		})
+	require.NoError(t, eng.OnCrossAssetCollateralUpdate(ctx, crossAssetCollateralConfig))
+	require.NotNil(t, cb)
+
+	// the price of the oracle has 5 decimals.
+	eng.collateral.EXPECT().UpdateCrossAssetCollateralPrice("WETH", "USDT", gomock.Any()).Times(1).Do(
+		func(_, _ string, price num.Decimal) {
+			assert.Equal(t, "10.5", price.String())
+		})
+	require.NoError(t, cb(ctx, dstypes.Data{Data: map[string]string{"prices.ETH.value": "1050000"}}))
+
+	// the previous oracle is dropped when the collateral is updated.
+	require.NoError(t, eng.OnCrossAssetCollateralUpdate(ctx, crossAssetCollateralConfig))
+	assert.Equal(t, 1, unsubscribed)
+}
+
+func TestSellCrossAssetCollateralUnknownMarket(t *testing.T) {
+	eng := getMockedEngine(t)
+	defer eng.ctrl.Finish()
+
+	err := eng.SellCrossAssetCollateral(context.Background(), "party", "WETH", "USDT", "spot", num.NewUint(100))
+	require.ErrorIs(t, err, execution.ErrMarketDoesNotExist)
+}
@@ -30,6 +30,8 @@ import (
 	"code.vegaprotocol.io/vega/core/fee"
 	"code.vegaprotocol.io/vega/core/metrics"
 	"code.vegaprotocol.io/vega/core/monitor"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/products"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/crypto"
 	"code.vegaprotocol.io/vega/libs/num"
@@ -123,6 +125,11 @@ type Engine struct {
 	lock sync.RWMutex
 
 	delayTransactionsTarget common.DelayTransactionsTarget
+
+	// the price oracles of the assets counting towards the margin of the markets settled in another asset.
+	crossAssetPriceOracles []*products.CompositePriceOracle
+	// number of cross-asset collateral sales in the block, to derive their order IDs.
+	crossAssetSales int
 }
 
 // NewEngine takes stores and engines and returns
@@ -578,9 +585,14 @@ func (e *Engine) UpdateSpotMarket(ctx context.Context, marketConfig *types.Marke
 	e.log.Info("update spot market", logging.Market(*marketConfig))
 
 	mkt := e.spotMarkets[marketConfig.ID]
+	previousOverrides := mkt.Mkt().NetworkParameterOverrides
 	if err := mkt.Update(ctx, marketConfig); err != nil {
 		return err
 	}
+	if !networkParametersEqual(previousOverrides, marketConfig.NetworkParameterOverrides) {
+		e.propagateNetParamOverrides(ctx, mkt)
+		mkt.OnMinimalHoldingQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumHoldingQuantumMultiple, e.minHoldingQuantumMultiplier))
+	}
 	e.delayTransactionsTarget.MarketDelayRequiredUpdated(mkt.GetID(), marketConfig.EnableTxReordering)
 	e.publishUpdateMarketInfos(ctx, mkt.GetMarketData(), *mkt.Mkt())
 	return nil
@@ -636,9 +648,14 @@ func (e *Engine) UpdateMarketState(ctx context.Context, changes *types.MarketSta
 func (e *Engine) UpdateMarket(ctx context.Context, marketConfig *types.Market) error {
 	e.log.Info("update market", logging.Market(*marketConfig))
 	mkt := e.futureMarkets[marketConfig.ID]
+	previousOverrides := mkt.Mkt().NetworkParameterOverrides
 	if err := mkt.Update(ctx, marketConfig, e.oracle); err != nil {
 		return err
 	}
+	if !networkParametersEqual(previousOverrides, marketConfig.NetworkParameterOverrides) {
+		e.propagateNetParamOverrides(ctx, mkt)
+		mkt.OnMinimalMarginQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumMarginQuantumMultiple, e.minMaintenanceMarginQuantumMultiplier))
+	}
 	e.delayTransactionsTarget.MarketDelayRequiredUpdated(mkt.GetID(), marketConfig.EnableTxReordering)
 	e.publishUpdateMarketInfos(ctx, mkt.GetMarketData(), *mkt.Mkt())
 	return nil
@@ -1397,6 +1414,7 @@ func (e *Engine) BlockEnd(ctx context.Context) {
 }
 
 func (e *Engine) BeginBlock(ctx context.Context, prevBlockDuration time.Duration) {
+	e.crossAssetSales = 0
 	for _, mkt := range e.allMarketsCpy {
 		mkt.BeginBlock(ctx)
 	}
@@ -1471,6 +1489,28 @@ func (e *Engine) GetMarketCounters() map[string]*types.MarketCounters {
 	return counters
 }
 
+// GetLiquidityProviderSLAForecast returns the projected end of epoch SLA penalties
+// for the given liquidity provider in the given market.
+func (e *Engine) GetLiquidityProviderSLAForecast(market, party string) (*types.LiquidityProviderSLAForecast, error) {
+	mkt, ok := e.allMarkets[market]
+	if !ok {
+		return nil, ErrMarketDoesNotExist
+	}
+	return mkt.GetLiquidityProviderSLAForecast(party)
+}
+
+// GetOpenPositions returns the open positions of the given market. Spot markets
+// have no positions.
+func (e *Engine) GetOpenPositions(market string) ([]events.MarketPosition, error) {
+	if mkt, ok := e.futureMarkets[market]; ok {
+		return mkt.GetOpenPositions(), nil
+	}
+	if _, ok := e.allMarkets[market]; ok {
+		return nil, nil
+	}
+	return nil, ErrMarketDoesNotExist
+}
+
 func (e *Engine) GetMarketStats() map[string]*types.MarketStats {
 	stats := map[string]*types.MarketStats{}
 	for id, cm := range e.allMarkets {
@@ -1518,7 +1558,7 @@ func (e *Engine) UpdateMarginMode(ctx context.Context, party, marketID string, m
 func (e *Engine) OnMinimalMarginQuantumMultipleUpdate(_ context.Context, multiplier num.Decimal) error {
 	e.minMaintenanceMarginQuantumMultiplier = multiplier
 	for _, mkt := range e.futureMarketsCpy {
-		mkt.OnMinimalMarginQuantumMultipleUpdate(multiplier)
+		mkt.OnMinimalMarginQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumMarginQuantumMultiple, multiplier))
 	}
 	return nil
 }
@@ -1526,7 +1566,7 @@ func (e *Engine) OnMinimalMarginQuantumMultipleUpdate(_ context.Context, multipl
 func (e *Engine) OnMinimalHoldingQuantumMultipleUpdate(_ context.Context, multiplier num.Decimal) error {
 	e.minHoldingQuantumMultiplier = multiplier
 	for _, mkt := range e.spotMarketsCpy {
-		mkt.OnMinimalHoldingQuantumMultipleUpdate(multiplier)
+		mkt.OnMinimalHoldingQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumHoldingQuantumMultiple, multiplier))
 	}
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"code.vegaprotocol.io/vega/core/execution/common"
 	"code.vegaprotocol.io/vega/core/execution/future"
 	"code.vegaprotocol.io/vega/core/execution/spot"
+	"code.vegaprotocol.io/vega/core/netparams"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
@@ -109,7 +110,7 @@ func defaultNetParamsValues() netParamsValues {
 
 func (e *Engine) OnMarketAuctionMinimumDurationUpdate(ctx context.Context, d time.Duration) error {
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, d)
+		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMinimumDuration, d))
 	}
 	e.npv.auctionMinDuration = d
 	return nil
@@ -118,7 +119,7 @@ func (e *Engine) OnMarketAuctionMinimumDurationUpdate(ctx context.Context, d tim
 func (e *Engine) OnMarketAuctionMaximumDurationUpdate(ctx context.Context, d time.Duration) error {
 	for _, mkt := range e.allMarketsCpy {
 		if mkt.IsOpeningAuction() {
-			mkt.OnMarketAuctionMaximumDurationUpdate(ctx, d)
+			mkt.OnMarketAuctionMaximumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMaximumDuration, d))
 		}
 	}
 	e.npv.auctionMaxDuration = d
@@ -152,7 +153,7 @@ func (e *Engine) OnMarketLiquidityV2BondPenaltyUpdate(_ context.Context, d num.D
 	// Set immediately during opening auction
 	for _, mkt := range e.allMarketsCpy {
 		if mkt.IsOpeningAuction() {
-			mkt.OnMarketLiquidityV2BondPenaltyFactorUpdate(d)
+			mkt.OnMarketLiquidityV2BondPenaltyFactorUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityBondPenaltyParameter, d))
 		}
 	}
 
@@ -172,7 +173,7 @@ func (e *Engine) OnMarketLiquidityV2EarlyExitPenaltyUpdate(_ context.Context, d
 	// Set immediately during opening auction
 	for _, mkt := range e.allMarketsCpy {
 		if mkt.IsOpeningAuction() {
-			mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(d)
+			mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEarlyExitPenalty, d))
 		}
 	}
 
@@ -192,7 +193,7 @@ func (e *Engine) OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(_ conte
 	// Set immediately during opening auction
 	for _, mkt := range e.allMarketsCpy {
 		if mkt.IsOpeningAuction() {
-			mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(d)
+			mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityMaximumLiquidityFeeFactorLevel, d))
 		}
 	}
 
@@ -212,7 +213,7 @@ func (e *Engine) OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(_ co
 	// Set immediately during opening auction
 	for _, mkt := range e.allMarketsCpy {
 		if mkt.IsOpeningAuction() {
-			mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(d)
+			mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltySlope, d))
 		}
 	}
 
@@ -232,7 +233,7 @@ func (e *Engine) OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(_ cont
 	for _, m := range e.futureMarketsCpy {
 		// Set immediately during opening auction
 		if m.IsOpeningAuction() {
-			m.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(d)
+			m.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(common.MarketNetParamDecimal(m.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltyMax, d))
 		}
 	}
 
@@ -252,7 +253,7 @@ func (e *Engine) OnMarketLiquidityV2StakeToCCYVolumeUpdate(_ context.Context, d
 	for _, m := range e.futureMarketsCpy {
 		// Set immediately during opening auction
 		if m.IsOpeningAuction() {
-			m.OnMarketLiquidityV2StakeToCCYVolume(d)
+			m.OnMarketLiquidityV2StakeToCCYVolume(common.MarketNetParamDecimal(m.Mkt(), netparams.MarketLiquidityStakeToCCYVolume, d))
 		}
 	}
 
@@ -327,7 +328,7 @@ func (e *Engine) OnMarketFeeFactorsMakerFeeUpdate(ctx context.Context, d num.Dec
 	}
 
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnFeeFactorsMakerFeeUpdate(ctx, d)
+		mkt.OnFeeFactorsMakerFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsMakerFee, d))
 	}
 	e.npv.makerFee = d
 	return nil
@@ -368,7 +369,7 @@ func (e *Engine) OnMarketFeeFactorsInfrastructureFeeUpdate(ctx context.Context,
 		)
 	}
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, d)
+		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsInfrastructureFee, d))
 	}
 	e.npv.infrastructureFee = d
 	return nil
@@ -382,7 +383,7 @@ func (e *Engine) OnMarketValueWindowLengthUpdate(_ context.Context, d time.Durat
 	}
 
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketValueWindowLengthUpdate(d)
+		mkt.OnMarketValueWindowLengthUpdate(common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketValueWindowLength, d))
 	}
 	e.npv.marketValueWindowLength = d
 	return nil
@@ -397,7 +398,7 @@ func (e *Engine) OnMarketLiquidityMaximumLiquidityFeeFactorLevelUpdate(_ context
 	}
 
 	for _, mkt := range e.futureMarketsCpy {
-		mkt.OnMarketLiquidityMaximumLiquidityFeeFactorLevelUpdate(d)
+		mkt.OnMarketLiquidityMaximumLiquidityFeeFactorLevelUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityMaximumLiquidityFeeFactorLevel, d))
 	}
 	e.npv.maxLiquidityFee = d
 
@@ -411,7 +412,7 @@ func (e *Engine) OnMarketLiquidityEquityLikeShareFeeFractionUpdate(_ context.Con
 		)
 	}
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(d)
+		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEquityLikeShareFeeFraction, d))
 	}
 	e.npv.liquidityELSFeeFraction = d
 	return nil
@@ -424,7 +425,7 @@ func (e *Engine) OnMarketProbabilityOfTradingTauScalingUpdate(ctx context.Contex
 		)
 	}
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, d)
+		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketProbabilityOfTradingTauScaling, d))
 	}
 	e.npv.probabilityOfTradingTauScaling = d
 	return nil
@@ -438,7 +439,7 @@ func (e *Engine) OnMarketMinProbabilityOfTradingForLPOrdersUpdate(ctx context.Co
 	}
 
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketMinProbabilityOfTradingLPOrdersUpdate(ctx, d)
+		mkt.OnMarketMinProbabilityOfTradingLPOrdersUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinProbabilityOfTradingForLPOrders, d))
 	}
 	e.npv.minProbabilityOfTradingLPOrders = d
 	return nil
@@ -451,7 +452,7 @@ func (e *Engine) OnMinLpStakeQuantumMultipleUpdate(ctx context.Context, d num.De
 		)
 	}
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, d)
+		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinLpStakeQuantumMultiple, d))
 	}
 	e.npv.minLpStakeQuantumMultiple = d
 	return nil
@@ -475,7 +476,7 @@ func (e *Engine) OnMarketPartiesMaximumStopOrdersUpdate(ctx context.Context, u *
 	}
 	e.npv.marketPartiesMaximumStopOrdersUpdate = u
 	for _, mkt := range e.allMarketsCpy {
-		mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, u)
+		mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, common.MarketNetParamUint(mkt.Mkt(), netparams.SpamProtectionMaxStopOrdersPerMarket, u))
 	}
 	return nil
 }
@@ -519,20 +520,20 @@ func (e *Engine) OnMarketAMMMaxCalculationLevels(ctx context.Context, c *num.Uin
 
 func (e *Engine) propagateSpotInitialNetParams(ctx context.Context, mkt *spot.Market, isRestore bool) error {
 	if !e.npv.minLpStakeQuantumMultiple.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, e.npv.minLpStakeQuantumMultiple)
+		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinLpStakeQuantumMultiple, e.npv.minLpStakeQuantumMultiple))
 	}
 	if e.npv.auctionMinDuration != -1 {
-		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, e.npv.auctionMinDuration)
+		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMinimumDuration, e.npv.auctionMinDuration))
 	}
 	if e.npv.auctionMaxDuration > 0 {
-		mkt.OnMarketAuctionMaximumDurationUpdate(ctx, e.npv.auctionMaxDuration)
+		mkt.OnMarketAuctionMaximumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMaximumDuration, e.npv.auctionMaxDuration))
 	}
 	if !e.npv.infrastructureFee.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, e.npv.infrastructureFee)
+		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsInfrastructureFee, e.npv.infrastructureFee))
 	}
 
 	if !e.npv.makerFee.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnFeeFactorsMakerFeeUpdate(ctx, e.npv.makerFee)
+		mkt.OnFeeFactorsMakerFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsMakerFee, e.npv.makerFee))
 	}
 
 	if !e.npv.buyBackFee.Equal(num.DecimalFromInt64(-1)) {
@@ -544,7 +545,7 @@ func (e *Engine) propagateSpotInitialNetParams(ctx context.Context, mkt *spot.Ma
 	}
 
 	if e.npv.marketValueWindowLength != -1 {
-		mkt.OnMarketValueWindowLengthUpdate(e.npv.marketValueWindowLength)
+		mkt.OnMarketValueWindowLengthUpdate(common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketValueWindowLength, e.npv.marketValueWindowLength))
 	}
 
 	if e.npv.markPriceUpdateMaximumFrequency > 0 {
@@ -552,59 +553,59 @@ func (e *Engine) propagateSpotInitialNetParams(ctx context.Context, mkt *spot.Ma
 	}
 
 	if !e.npv.liquidityV2EarlyExitPenalty.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(e.npv.liquidityV2EarlyExitPenalty)
+		mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEarlyExitPenalty, e.npv.liquidityV2EarlyExitPenalty))
 	}
 
 	if !e.npv.liquidityV2MaxLiquidityFee.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(e.npv.liquidityV2MaxLiquidityFee)
+		mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityMaximumLiquidityFeeFactorLevel, e.npv.liquidityV2MaxLiquidityFee))
 	}
 
 	if !e.npv.liquidityV2SLANonPerformanceBondPenaltySlope.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(e.npv.liquidityV2SLANonPerformanceBondPenaltySlope)
+		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltySlope, e.npv.liquidityV2SLANonPerformanceBondPenaltySlope))
 	}
 
 	if !e.npv.liquidityV2SLANonPerformanceBondPenaltyMax.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(e.npv.liquidityV2SLANonPerformanceBondPenaltyMax)
+		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltyMax, e.npv.liquidityV2SLANonPerformanceBondPenaltyMax))
 	}
 
 	if !e.npv.liquidityV2StakeToCCYVolume.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2StakeToCCYVolume(e.npv.liquidityV2StakeToCCYVolume)
+		mkt.OnMarketLiquidityV2StakeToCCYVolume(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityStakeToCCYVolume, e.npv.liquidityV2StakeToCCYVolume))
 	}
 
-	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, e.npv.marketPartiesMaximumStopOrdersUpdate)
-	mkt.OnMinimalHoldingQuantumMultipleUpdate(e.minHoldingQuantumMultiplier)
+	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, common.MarketNetParamUint(mkt.Mkt(), netparams.SpamProtectionMaxStopOrdersPerMarket, e.npv.marketPartiesMaximumStopOrdersUpdate))
+	mkt.OnMinimalHoldingQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumHoldingQuantumMultiple, e.minHoldingQuantumMultiplier))
 
 	e.propagateSLANetParams(ctx, mkt, isRestore)
 
 	if !e.npv.liquidityELSFeeFraction.IsZero() {
-		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(e.npv.liquidityELSFeeFraction)
+		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEquityLikeShareFeeFraction, e.npv.liquidityELSFeeFraction))
 	}
 	return nil
 }
 
 func (e *Engine) propagateInitialNetParamsToFutureMarket(ctx context.Context, mkt *future.Market, isRestore bool) error {
 	if !e.npv.probabilityOfTradingTauScaling.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, e.npv.probabilityOfTradingTauScaling)
+		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketProbabilityOfTradingTauScaling, e.npv.probabilityOfTradingTauScaling))
 	}
 	if !e.npv.minProbabilityOfTradingLPOrders.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnMarketMinProbabilityOfTradingLPOrdersUpdate(ctx, e.npv.minProbabilityOfTradingLPOrders)
+		mkt.OnMarketMinProbabilityOfTradingLPOrdersUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinProbabilityOfTradingForLPOrders, e.npv.minProbabilityOfTradingLPOrders))
 	}
 	if !e.npv.minLpStakeQuantumMultiple.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, e.npv.minLpStakeQuantumMultiple)
+		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinLpStakeQuantumMultiple, e.npv.minLpStakeQuantumMultiple))
 	}
 	if e.npv.auctionMinDuration != -1 {
-		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, e.npv.auctionMinDuration)
+		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMinimumDuration, e.npv.auctionMinDuration))
 	}
 	if e.npv.auctionMaxDuration > 0 {
-		mkt.OnMarketAuctionMaximumDurationUpdate(ctx, e.npv.auctionMaxDuration)
+		mkt.OnMarketAuctionMaximumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMaximumDuration, e.npv.auctionMaxDuration))
 	}
 
 	if !e.npv.infrastructureFee.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, e.npv.infrastructureFee)
+		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsInfrastructureFee, e.npv.infrastructureFee))
 	}
 
 	if !e.npv.makerFee.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnFeeFactorsMakerFeeUpdate(ctx, e.npv.makerFee)
+		mkt.OnFeeFactorsMakerFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsMakerFee, e.npv.makerFee))
 	}
 
 	if !e.npv.buyBackFee.Equal(num.DecimalFromInt64(-1)) {
@@ -622,11 +623,11 @@ func (e *Engine) propagateInitialNetParamsToFutureMarket(ctx context.Context, mk
 	}
 
 	if e.npv.marketValueWindowLength != -1 {
-		mkt.OnMarketValueWindowLengthUpdate(e.npv.marketValueWindowLength)
+		mkt.OnMarketValueWindowLengthUpdate(common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketValueWindowLength, e.npv.marketValueWindowLength))
 	}
 
 	if !e.npv.maxLiquidityFee.Equal(num.DecimalFromInt64(-1)) {
-		mkt.OnMarketLiquidityMaximumLiquidityFeeFactorLevelUpdate(e.npv.maxLiquidityFee)
+		mkt.OnMarketLiquidityMaximumLiquidityFeeFactorLevelUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityMaximumLiquidityFeeFactorLevel, e.npv.maxLiquidityFee))
 	}
 	if e.npv.markPriceUpdateMaximumFrequency > 0 {
 		mkt.OnMarkPriceUpdateMaximumFrequency(ctx, e.npv.markPriceUpdateMaximumFrequency)
@@ -635,11 +636,11 @@ func (e *Engine) propagateInitialNetParamsToFutureMarket(ctx context.Context, mk
 		mkt.OnInternalCompositePriceUpdateFrequency(ctx, e.npv.internalCompositePriceUpdateFrequency)
 	}
 	if !e.npv.liquidityELSFeeFraction.IsZero() {
-		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(e.npv.liquidityELSFeeFraction)
+		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEquityLikeShareFeeFraction, e.npv.liquidityELSFeeFraction))
 	}
 
-	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, e.npv.marketPartiesMaximumStopOrdersUpdate)
-	mkt.OnMinimalMarginQuantumMultipleUpdate(e.minMaintenanceMarginQuantumMultiplier)
+	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, common.MarketNetParamUint(mkt.Mkt(), netparams.SpamProtectionMaxStopOrdersPerMarket, e.npv.marketPartiesMaximumStopOrdersUpdate))
+	mkt.OnMinimalMarginQuantumMultipleUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MinimumMarginQuantumMultiple, e.minMaintenanceMarginQuantumMultiplier))
 
 	mkt.OnAMMMinCommitmentQuantumUpdate(ctx, e.npv.ammCommitmentQuantum)
 	mkt.OnMarketAMMMaxCalculationLevels(ctx, e.npv.ammCalculationLevels)
@@ -649,29 +650,78 @@ func (e *Engine) propagateInitialNetParamsToFutureMarket(ctx context.Context, mk
 	return nil
 }
 
+// propagateNetParamOverrides sends the values of the network parameters a market can override
+// after its overrides have been changed. As for the network wide updates, the liquidity parameters
+// are only applied at once during the opening auction, and at the start of the next epoch otherwise.
+func (e *Engine) propagateNetParamOverrides(ctx context.Context, mkt common.CommonMarket) {
+	if e.npv.auctionMinDuration != -1 {
+		mkt.OnMarketAuctionMinimumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMinimumDuration, e.npv.auctionMinDuration))
+	}
+	if mkt.IsOpeningAuction() && e.npv.auctionMaxDuration > 0 {
+		mkt.OnMarketAuctionMaximumDurationUpdate(ctx, common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketAuctionMaximumDuration, e.npv.auctionMaxDuration))
+	}
+	if !e.npv.makerFee.Equal(num.DecimalFromInt64(-1)) {
+		mkt.OnFeeFactorsMakerFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsMakerFee, e.npv.makerFee))
+	}
+	if !e.npv.infrastructureFee.Equal(num.DecimalFromInt64(-1)) {
+		mkt.OnFeeFactorsInfrastructureFeeUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketFeeFactorsInfrastructureFee, e.npv.infrastructureFee))
+	}
+	if e.npv.marketValueWindowLength != -1 {
+		mkt.OnMarketValueWindowLengthUpdate(common.MarketNetParamDuration(mkt.Mkt(), netparams.MarketValueWindowLength, e.npv.marketValueWindowLength))
+	}
+	if !e.npv.probabilityOfTradingTauScaling.Equal(num.DecimalFromInt64(-1)) {
+		mkt.OnMarketProbabilityOfTradingTauScalingUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketProbabilityOfTradingTauScaling, e.npv.probabilityOfTradingTauScaling))
+	}
+	if !e.npv.minProbabilityOfTradingLPOrders.Equal(num.DecimalFromInt64(-1)) {
+		mkt.OnMarketMinProbabilityOfTradingLPOrdersUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinProbabilityOfTradingForLPOrders, e.npv.minProbabilityOfTradingLPOrders))
+	}
+	if !e.npv.minLpStakeQuantumMultiple.Equal(num.DecimalFromInt64(-1)) {
+		mkt.OnMarketMinLpStakeQuantumMultipleUpdate(ctx, common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketMinLpStakeQuantumMultiple, e.npv.minLpStakeQuantumMultiple))
+	}
+	if !e.npv.liquidityELSFeeFraction.IsZero() {
+		mkt.OnMarketLiquidityEquityLikeShareFeeFractionUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEquityLikeShareFeeFraction, e.npv.liquidityELSFeeFraction))
+	}
+	mkt.OnMarketPartiesMaximumStopOrdersUpdate(ctx, common.MarketNetParamUint(mkt.Mkt(), netparams.SpamProtectionMaxStopOrdersPerMarket, e.npv.marketPartiesMaximumStopOrdersUpdate))
+	if mkt.IsOpeningAuction() {
+		e.propagateSLANetParams(ctx, mkt, true)
+	}
+}
+
+func networkParametersEqual(a, b []*types.NetworkParameter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *Engine) propagateSLANetParams(_ context.Context, mkt common.CommonMarket, isRestore bool) {
 	if !e.npv.liquidityV2BondPenaltyFactor.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2BondPenaltyFactorUpdate(e.npv.liquidityV2BondPenaltyFactor)
+		mkt.OnMarketLiquidityV2BondPenaltyFactorUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityBondPenaltyParameter, e.npv.liquidityV2BondPenaltyFactor))
 	}
 
 	if !e.npv.liquidityV2EarlyExitPenalty.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(e.npv.liquidityV2EarlyExitPenalty)
+		mkt.OnMarketLiquidityV2EarlyExitPenaltyUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityEarlyExitPenalty, e.npv.liquidityV2EarlyExitPenalty))
 	}
 
 	if !e.npv.liquidityV2MaxLiquidityFee.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(e.npv.liquidityV2MaxLiquidityFee)
+		mkt.OnMarketLiquidityV2MaximumLiquidityFeeFactorLevelUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityMaximumLiquidityFeeFactorLevel, e.npv.liquidityV2MaxLiquidityFee))
 	}
 
 	if !e.npv.liquidityV2SLANonPerformanceBondPenaltySlope.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(e.npv.liquidityV2SLANonPerformanceBondPenaltySlope)
+		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltySlopeUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltySlope, e.npv.liquidityV2SLANonPerformanceBondPenaltySlope))
 	}
 
 	if !e.npv.liquidityV2SLANonPerformanceBondPenaltyMax.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(e.npv.liquidityV2SLANonPerformanceBondPenaltyMax)
+		mkt.OnMarketLiquidityV2SLANonPerformanceBondPenaltyMaxUpdate(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquiditySLANonPerformanceBondPenaltyMax, e.npv.liquidityV2SLANonPerformanceBondPenaltyMax))
 	}
 
 	if !e.npv.liquidityV2StakeToCCYVolume.Equal(num.DecimalFromInt64(-1)) { //nolint:staticcheck
-		mkt.OnMarketLiquidityV2StakeToCCYVolume(e.npv.liquidityV2StakeToCCYVolume)
+		mkt.OnMarketLiquidityV2StakeToCCYVolume(common.MarketNetParamDecimal(mkt.Mkt(), netparams.MarketLiquidityStakeToCCYVolume, e.npv.liquidityV2StakeToCCYVolume))
 	}
 
 	if !isRestore && e.npv.liquidityV2ProvidersFeeCalculationTimeStep != 0 {
@@ -613,6 +613,12 @@ func (m *Market) GetEquitySharesForParty(partyID string) num.Decimal {
 	return primary
 }
 
+// GetLiquidityProviderSLAForecast projects the SLA penalties of the given liquidity provider
+// at the end of the current epoch.
+func (m *Market) GetLiquidityProviderSLAForecast(partyID string) (*types.LiquidityProviderSLAForecast, error) {
+	return m.liquidityEngine.LiquidityProviderSLAForecast(partyID, m.timeService.GetTimeNow(), m.epoch.ExpireTime, m.midPrice())
+}
+
 func (m *Market) ResetParentIDAndInsurancePoolFraction() {
 	m.mkt.ParentMarketID = ""
 	m.mkt.InsurancePoolFraction = num.DecimalZero()
@@ -671,6 +677,9 @@ func (m *Market) Update(ctx context.Context, config *types.Market, oracleEngine
 	m.pMonitor.UpdateSettings(m.tradableInstrument.RiskModel, m.mkt.PriceMonitoringSettings, m.as)
 	m.liquidity.UpdateMarketConfig(m.tradableInstrument.RiskModel, m.pMonitor)
 	m.amm.UpdateAllowedEmptyLevels(m.mkt.AllowedEmptyAmmLevels)
+	if err := m.fee.UpdateMakerFeeTiers(m.mkt.Fees.MakerFeeTiers); err != nil {
+		return err
+	}
 
 	if err := m.markPriceCalculator.UpdateConfig(ctx, oracleEngine, m.mkt.MarkPriceConfiguration); err != nil {
 		m.markPriceCalculator.SetOraclePriceScalingFunc(m.scaleOracleData)
@@ -1230,12 +1239,32 @@ func (m *Market) BlockEnd(ctx context.Context) {
 		markPriceCopy = m.markPriceCalculator.GetPrice().Clone()
 	}
 	m.liquidity.EndBlock(markPriceCopy, m.midPrice(), m.positionFactor)
+	m.recordQuotingDepth()
 
 	if !m.matching.CheckBook() {
 		m.log.Panic("ontick book has orders pegged to nothing")
 	}
 }
 
+// recordQuotingDepth reports the orders of the parties that are not liquidity providers
+// to the market activity tracker so it can score how tightly they quote around the mid price.
+func (m *Market) recordQuotingDepth() {
+	midPrice := num.UintZero()
+	if !m.as.InAuction() {
+		midPrice = m.midPrice()
+	}
+	orders := []*types.Order{}
+	if !midPrice.IsZero() {
+		for _, mp := range m.position.Positions() {
+			if m.liquidityEngine.IsLiquidityProvider(mp.Party()) {
+				continue
+			}
+			orders = append(orders, m.matching.GetOrdersPerParty(mp.Party())...)
+		}
+	}
+	m.marketActivityTracker.RecordQuotingDepth(m.settlementAsset, m.mkt.ID, midPrice, orders, m.positionFactor, m.timeService.GetTimeNow())
+}
+
 func (m *Market) removeAllStopOrders(
 	ctx context.Context,
 	positions ...events.MarketPosition,
@@ -5077,6 +5106,18 @@ func (m *Market) GetTotalOpenPositionCount() uint64 {
 	return m.position.GetOpenPositionCount()
 }
 
+// GetOpenPositions returns the positions of the market with a non-zero open volume.
+func (m *Market) GetOpenPositions() []events.MarketPosition {
+	positions := m.position.Positions()
+	open := make([]events.MarketPosition, 0, len(positions))
+	for _, p := range positions {
+		if p.Size() != 0 {
+			open = append(open, p)
+		}
+	}
+	return open
+}
+
 // getMarketObservable returns current mark price once market is out of opening auction, during opening auction the indicative uncrossing price is returned.
 func (m *Market) getMarketObservable(fallbackPrice *num.Uint) *num.Uint {
 	// this is used for margin calculations, so if there's a max price, return that.
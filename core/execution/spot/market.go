@@ -316,6 +316,9 @@ func (m *Market) Update(ctx context.Context, config *types.Market) error {
 	}
 	m.pMonitor.UpdateSettings(riskModel, m.mkt.PriceMonitoringSettings, m.as)
 	m.liquidity.UpdateMarketConfig(riskModel, m.pMonitor)
+	if err := m.fee.UpdateMakerFeeTiers(m.mkt.Fees.MakerFeeTiers); err != nil {
+		return err
+	}
 	m.updateLiquidityFee(ctx)
 
 	clear(m.allowedSellers)
@@ -364,6 +367,12 @@ func (m *Market) GetEquitySharesForParty(partyID string) num.Decimal {
 	return primary
 }
 
+// GetLiquidityProviderSLAForecast projects the SLA penalties of the given liquidity provider
+// at the end of the current epoch.
+func (m *Market) GetLiquidityProviderSLAForecast(partyID string) (*types.LiquidityProviderSLAForecast, error) {
+	return m.liquidityEngine.LiquidityProviderSLAForecast(partyID, m.timeService.GetTimeNow(), m.epoch.ExpireTime, m.midPrice())
+}
+
 func (m *Market) SetNextMTM(tm time.Time) {
 	m.nextMTM = tm
 }
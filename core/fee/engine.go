@@ -29,6 +29,7 @@ import (
 var (
 	ErrEmptyTrades      = errors.New("empty trades slice sent to fees")
 	ErrInvalidFeeFactor = errors.New("fee factors must be positive")
+	ErrInvalidFeeTiers  = errors.New("maker fee tiers must have distinct minimum maker volume fractions in [0, 1]")
 )
 
 //go:generate go run github.com/golang/mock/mockgen -destination mocks/mocks.go -package mocks code.vegaprotocol.io/vega/core/fee ReferralDiscountRewardService,VolumeDiscountService,VolumeRebateService
@@ -36,6 +37,7 @@ type ReferralDiscountRewardService interface {
 	ReferralDiscountFactorsForParty(party types.PartyID) types.Factors
 	RewardsFactorsMultiplierAppliedForParty(party types.PartyID) types.Factors
 	GetReferrer(referee types.PartyID) (types.PartyID, error)
+	SplitReferrerReward(referee types.PartyID, reward *num.Uint) []*types.ReferrerRewardShare
 }
 
 type VolumeDiscountService interface {
@@ -44,6 +46,7 @@ type VolumeDiscountService interface {
 
 type VolumeRebateService interface {
 	VolumeRebateFactorForParty(party types.PartyID) num.Decimal
+	MakerVolumeFractionForParty(party types.PartyID) num.Decimal
 }
 
 type Engine struct {
@@ -64,6 +67,8 @@ type factors struct {
 	liquidityFee      num.Decimal
 	treasuryFee       num.Decimal
 	buyBackFee        num.Decimal
+	// market specific maker fee tiers, sorted by descending minimum maker volume fraction
+	makerFeeTiers []*types.MakerFeeTier
 }
 
 func New(
@@ -147,6 +152,26 @@ func (e *Engine) UpdateFeeFactors(fees types.Fees) error {
 	e.f.buyBackFee = fees.Factors.BuyBackFee
 
 	e.feeCfg = fees
+	return e.UpdateMakerFeeTiers(fees.MakerFeeTiers)
+}
+
+// UpdateMakerFeeTiers sets the market specific maker fee tiers. Makers qualify for a tier
+// based on their maker volume fraction, makers which do not qualify for any tier pay the
+// market maker fee factor.
+func (e *Engine) UpdateMakerFeeTiers(tiers []*types.MakerFeeTier) error {
+	sorted := types.MakerFeeTiersDeepClone(tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinimumMakerVolumeFraction.GreaterThan(sorted[j].MinimumMakerVolumeFraction)
+	})
+	for i, t := range sorted {
+		if t.MinimumMakerVolumeFraction.IsNegative() || t.MinimumMakerVolumeFraction.GreaterThan(num.DecimalOne()) {
+			return ErrInvalidFeeTiers
+		}
+		if i > 0 && t.MinimumMakerVolumeFraction.Equal(sorted[i-1].MinimumMakerVolumeFraction) {
+			return ErrInvalidFeeTiers
+		}
+	}
+	e.f.makerFeeTiers = sorted
 	return nil
 }
 
@@ -175,6 +200,8 @@ func (e *Engine) CalculateForContinuousMode(
 		totalInfrastructureFeeAmount = num.UintZero()
 		totalLiquidityFeeAmount      = num.UintZero()
 		totalRewardAmount            = num.UintZero()
+		rewardPerReferrer            = map[types.PartyID]*num.Uint{}
+		referrers                    = []types.PartyID{}
 		// we allocate the len of the trades + 2
 		// len(trade) = number of makerFee + 1 infra fee + 1 liquidity fee
 		transfers     = make([]*types.Transfer, 0, (len(trades)*2)+2)
@@ -191,7 +218,8 @@ func (e *Engine) CalculateForContinuousMode(
 		size := num.NewUint(trade.Size)
 		// multiply by size
 		tradeValueForFee := size.Mul(trade.Price, size).ToDecimal().Div(e.positionFactor)
-		fee, reward := e.applyDiscountsAndRewards(taker, maker, tradeValueForFee, e.calculateContinuousModeFees(trade), referral, volumeDiscountService, volumeRebateService)
+		fee, tierRebate := e.applyMakerFeeTier(maker, tradeValueForFee, e.calculateContinuousModeFees(trade), volumeRebateService)
+		fee, reward := e.applyDiscountsAndRewards(taker, maker, tradeValueForFee, fee, referral, volumeDiscountService, volumeRebateService)
 
 		e.feesStats.RegisterMakerFee(maker, taker, fee.MakerFee)
 
@@ -233,6 +261,18 @@ func (e *Engine) CalculateForContinuousMode(
 			Type: types.TransferTypeMakerFeeReceive,
 		})
 
+		if !tierRebate.IsZero() {
+			// the negative maker fee of the tier is paid by the network, not the taker
+			transfersRecv = append(transfersRecv, &types.Transfer{
+				Owner: maker,
+				Amount: &types.FinancialAmount{
+					Asset:  e.asset,
+					Amount: tierRebate,
+				},
+				Type: types.TransferTypeMakerFeeTierRebate,
+			})
+		}
+
 		if !fee.HighVolumeMakerFee.IsZero() {
 			// create a transfer for the aggressor
 			transfers = append(transfers, &types.Transfer{
@@ -280,6 +320,13 @@ func (e *Engine) CalculateForContinuousMode(
 		totalRewardAmount.AddSum(reward.InfrastructureFeeReferrerReward)
 		totalRewardAmount.AddSum(reward.LiquidityFeeReferrerReward)
 		totalRewardAmount.AddSum(reward.MakerFeeReferrerReward)
+		for _, share := range reward.Shares {
+			if _, ok := rewardPerReferrer[share.Referrer]; !ok {
+				rewardPerReferrer[share.Referrer] = num.UintZero()
+				referrers = append(referrers, share.Referrer)
+			}
+			rewardPerReferrer[share.Referrer].AddSum(share.Amount)
+		}
 	}
 
 	// now create transfer for the infrastructure
@@ -301,9 +348,9 @@ func (e *Engine) CalculateForContinuousMode(
 		Type: types.TransferTypeLiquidityFeePay,
 	})
 
-	// if there's a referral reward - add transfers for it
+	// if there's a referral reward - add transfers for it, the reward
+	// is distributed to every referrer of the referral chain of the taker
 	if !totalRewardAmount.IsZero() {
-		referrer, _ := referral.GetReferrer(types.PartyID(taker))
 		transfers = append(transfers, &types.Transfer{
 			Owner: taker,
 			Amount: &types.FinancialAmount{
@@ -312,14 +359,16 @@ func (e *Engine) CalculateForContinuousMode(
 			},
 			Type: types.TransferTypeFeeReferrerRewardPay,
 		})
-		transfersRecv = append(transfersRecv, &types.Transfer{
-			Owner: string(referrer),
-			Amount: &types.FinancialAmount{
-				Asset:  e.asset,
-				Amount: totalRewardAmount.Clone(),
-			},
-			Type: types.TransferTypeFeeReferrerRewardDistribute,
-		})
+		for _, referrer := range referrers {
+			transfersRecv = append(transfersRecv, &types.Transfer{
+				Owner: string(referrer),
+				Amount: &types.FinancialAmount{
+					Asset:  e.asset,
+					Amount: rewardPerReferrer[referrer],
+				},
+				Type: types.TransferTypeFeeReferrerRewardDistribute,
+			})
+		}
 	}
 
 	return &feesTransfer{
@@ -663,6 +712,37 @@ func (e *Engine) getNetworkFeeTransfers(fees *types.Fee) ([]*types.Transfer, *nu
 	return transfers, num.Sum(fees.MakerFee, fees.InfrastructureFee, fees.LiquidityFee, fees.BuyBackFee, fees.HighVolumeMakerFee)
 }
 
+// makerFeeTierForParty returns the maker fee tier the maker qualifies for, if any.
+func (e *Engine) makerFeeTierForParty(maker string, volumeRebate VolumeRebateService) *types.MakerFeeTier {
+	if len(e.f.makerFeeTiers) == 0 {
+		return nil
+	}
+	makerFraction := volumeRebate.MakerVolumeFractionForParty(types.PartyID(maker))
+	for _, t := range e.f.makerFeeTiers {
+		if makerFraction.GreaterThanOrEqual(t.MinimumMakerVolumeFraction) {
+			return t
+		}
+	}
+	return nil
+}
+
+// applyMakerFeeTier replaces the maker fee with the one of the tier the maker qualifies for.
+// A negative tier maker fee results in no maker fee being paid by the taker, and a rebate
+// being returned which is paid to the maker from the network maker fee rebates account.
+func (e *Engine) applyMakerFeeTier(maker string, tradeValueForFeePurposes num.Decimal, fees *types.Fee, volumeRebate VolumeRebateService) (*types.Fee, *num.Uint) {
+	tier := e.makerFeeTierForParty(maker, volumeRebate)
+	if tier == nil {
+		return fees, num.UintZero()
+	}
+	if tier.MakerFee.IsNegative() {
+		fees.MakerFee = num.UintZero()
+		rebate, _ := num.UintFromDecimal(tier.MakerFee.Neg().Mul(tradeValueForFeePurposes))
+		return fees, rebate
+	}
+	fees.MakerFee, _ = num.UintFromDecimal(tradeValueForFeePurposes.Mul(tier.MakerFee).Ceil())
+	return fees, num.UintZero()
+}
+
 func (e *Engine) applyDiscountsAndRewards(taker string, maker string, tradeValueForFeePurposes num.Decimal, fees *types.Fee, referral ReferralDiscountRewardService, volumeDiscount VolumeDiscountService, volumeRebate VolumeRebateService) (*types.Fee, *types.ReferrerReward) {
 	referralDiscountFactors := referral.ReferralDiscountFactorsForParty(types.PartyID(taker))
 	volumeDiscountFactors := volumeDiscount.VolumeDiscountFactorForParty(types.PartyID(taker))
@@ -747,23 +827,26 @@ func (e *Engine) applyDiscountsAndRewards(taker string, maker string, tradeValue
 	referrerReward.InfrastructureFeeReferrerReward, _ = num.UintFromDecimal(factors.Infra.Mul(inf.ToDecimal()).Floor())
 	referrerReward.LiquidityFeeReferrerReward, _ = num.UintFromDecimal(factors.Liquidity.Mul(lf.ToDecimal()).Floor())
 
+	totalReward := num.Sum(
+		referrerReward.MakerFeeReferrerReward,
+		referrerReward.InfrastructureFeeReferrerReward,
+		referrerReward.LiquidityFeeReferrerReward,
+	)
+	referrerReward.Shares = referral.SplitReferrerReward(types.PartyID(taker), totalReward)
+	if len(referrerReward.Shares) == 0 {
+		if !totalReward.IsZero() {
+			e.log.Error("could not load referrer from taker of trade", logging.PartyID(taker))
+		}
+		// nobody to pay the reward to, the fees are left untouched.
+		return f, nil
+	}
+
 	mf = mf.Sub(mf, referrerReward.MakerFeeReferrerReward)
 	inf = inf.Sub(inf, referrerReward.InfrastructureFeeReferrerReward)
 	lf = lf.Sub(lf, referrerReward.LiquidityFeeReferrerReward)
 
-	referrer, err := referral.GetReferrer(types.PartyID(taker))
-	if err != nil {
-		e.log.Error("could not load referrer from taker of trade", logging.PartyID(taker))
-	} else {
-		e.feesStats.RegisterReferrerReward(
-			string(referrer),
-			taker,
-			num.Sum(
-				referrerReward.MakerFeeReferrerReward,
-				referrerReward.InfrastructureFeeReferrerReward,
-				referrerReward.LiquidityFeeReferrerReward,
-			),
-		)
+	for _, share := range referrerReward.Shares {
+		e.feesStats.RegisterReferrerReward(string(share.Referrer), taker, share.Amount)
 	}
 
 	f.MakerFee = mf
@@ -787,17 +870,15 @@ func (e *Engine) getAuctionModeFeesAndTransfers(t *types.Trade, referral Referra
 			buyerFees.InfrastructureFee, buyerFees.LiquidityFee, buyerFees.BuyBackFee, buyerFees.TreasuryFee, t.Buyer)...)
 
 	if buyerReferrerRewards != nil {
-		referrerParty, _ := referral.GetReferrer(types.PartyID(t.Buyer))
 		transfers = append(transfers,
 			e.getAuctionModeFeeReferrerRewardTransfers(
-				num.Sum(buyerReferrerRewards.InfrastructureFeeReferrerReward, buyerReferrerRewards.LiquidityFeeReferrerReward), t.Buyer, string(referrerParty))...)
+				num.Sum(buyerReferrerRewards.InfrastructureFeeReferrerReward, buyerReferrerRewards.LiquidityFeeReferrerReward), t.Buyer, buyerReferrerRewards.Shares)...)
 	}
 
 	if sellerReferrerRewards != nil {
-		referrerParty, _ := referral.GetReferrer(types.PartyID(t.Seller))
 		transfers = append(transfers,
 			e.getAuctionModeFeeReferrerRewardTransfers(
-				num.Sum(sellerReferrerRewards.InfrastructureFeeReferrerReward, sellerReferrerRewards.LiquidityFeeReferrerReward), t.Seller, string(referrerParty))...)
+				num.Sum(sellerReferrerRewards.InfrastructureFeeReferrerReward, sellerReferrerRewards.LiquidityFeeReferrerReward), t.Seller, sellerReferrerRewards.Shares)...)
 	}
 
 	return buyerFees, sellerFees, transfers
@@ -838,26 +919,29 @@ func (e *Engine) calculateAuctionModeFees(trade *types.Trade) *types.Fee {
 	}
 }
 
-func (e *Engine) getAuctionModeFeeReferrerRewardTransfers(reward *num.Uint, p, referrer string) []*types.Transfer {
-	return []*types.Transfer{
-		{
-			Owner: p,
-			Amount: &types.FinancialAmount{
-				Amount: reward.Clone(),
-				Asset:  e.asset,
-			},
-			Type:      types.TransferTypeFeeReferrerRewardPay,
-			MinAmount: reward.Clone(),
-		}, {
-			Owner: referrer,
+func (e *Engine) getAuctionModeFeeReferrerRewardTransfers(reward *num.Uint, p string, shares []*types.ReferrerRewardShare) []*types.Transfer {
+	transfers := make([]*types.Transfer, 0, len(shares)+1)
+	transfers = append(transfers, &types.Transfer{
+		Owner: p,
+		Amount: &types.FinancialAmount{
+			Amount: reward.Clone(),
+			Asset:  e.asset,
+		},
+		Type:      types.TransferTypeFeeReferrerRewardPay,
+		MinAmount: reward.Clone(),
+	})
+	for _, share := range shares {
+		transfers = append(transfers, &types.Transfer{
+			Owner: string(share.Referrer),
 			Amount: &types.FinancialAmount{
-				Amount: reward.Clone(),
+				Amount: share.Amount.Clone(),
 				Asset:  e.asset,
 			},
 			Type:      types.TransferTypeFeeReferrerRewardDistribute,
-			MinAmount: reward.Clone(),
-		},
+			MinAmount: share.Amount.Clone(),
+		})
 	}
+	return transfers
 }
 
 func (e *Engine) getAuctionModeFeeTransfers(infraFee, liquiFee, buyBackFee, treasuryFee *num.Uint, p string) []*types.Transfer {
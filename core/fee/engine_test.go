@@ -482,6 +482,7 @@ func testCalcContinuousTradingAndCheckAmountsWithDiscount(t *testing.T) {
 			Liquidity: num.NewDecimalFromFloat(0.1),
 		})
 	discountRewardService.EXPECT().GetReferrer(gomock.Any()).Return(types.PartyID("party3"), nil).AnyTimes()
+	expectReferrerRewardSplitToDirectReferrer(discountRewardService, types.PartyID("party3"))
 	require.NoError(t, eng.UpdateFeeFactors(types.Fees{
 		Factors: &types.FeeFactors{
 			MakerFee:          num.DecimalFromFloat(.000250),
@@ -620,6 +621,7 @@ func testCalcContinuousTradingAndCheckAmountsWithDiscountExtended(t *testing.T)
 			Liquidity: num.NewDecimalFromFloat(0.1),
 		})
 	discountRewardService.EXPECT().GetReferrer(gomock.Any()).Return(types.PartyID("party3"), nil).AnyTimes()
+	expectReferrerRewardSplitToDirectReferrer(discountRewardService, types.PartyID("party3"))
 	require.NoError(t, eng.UpdateFeeFactors(types.Fees{
 		Factors: &types.FeeFactors{
 			MakerFee:          num.DecimalFromFloat(.000250),
@@ -800,6 +802,7 @@ func testCalcContinuousTradingAndCheckAmountsWithDiscountsAndRewardsBySide(t *te
 		Liquidity: num.NewDecimalFromFloat(0.25),
 	})
 	discountRewardService.EXPECT().GetReferrer(types.PartyID(aggressor)).Return(types.PartyID("referrer"), nil).AnyTimes()
+	expectReferrerRewardSplitToDirectReferrer(discountRewardService, types.PartyID("referrer"))
 	discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(types.PartyID("party1")).Return(types.Factors{
 		Infra:     num.NewDecimalFromFloat(0.3),
 		Maker:     num.NewDecimalFromFloat(0.3),
@@ -925,6 +928,7 @@ func testCalcContinuousTradingAndCheckAmountsWithDiscountsAndRewardsBySideMultip
 	}).AnyTimes()
 	discountRewardService.EXPECT().GetReferrer(gomock.Any()).Return(types.PartyID("referrer"), nil).AnyTimes()
 	discountRewardService.EXPECT().GetReferrer(types.PartyID(aggressor)).Return(types.PartyID("referrer"), nil).AnyTimes()
+	expectReferrerRewardSplitToDirectReferrer(discountRewardService, types.PartyID("referrer"))
 	discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(aggressor).Return(types.Factors{
 		Infra:     num.NewDecimalFromFloat(0.3),
 		Maker:     num.NewDecimalFromFloat(0.3),
@@ -1441,6 +1445,7 @@ func TestCalcAuctionTradingWithDiscountsAndRewards(t *testing.T) {
 			return types.PartyID(""), errors.New("No referrer")
 		}
 	}).AnyTimes()
+	expectReferrerRewardSplitToDirectReferrer(discountRewardService, types.PartyID("referrer"))
 	discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(types.PartyID("party1")).Return(types.Factors{
 		Infra:     num.NewDecimalFromFloat(0.5),
 		Maker:     num.NewDecimalFromFloat(0.5),
@@ -1753,3 +1758,224 @@ func testCloseoutFees(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcContinuousTradingWithMakerFeeTiers(t *testing.T) {
+	testCases := []struct {
+		desc                string
+		makerVolumeFraction num.Decimal
+		expectedMakerFee    *num.Uint
+		expectedRebate      *num.Uint
+		expectedBuyBack     *num.Uint
+		expectedTreasury    *num.Uint
+	}{
+		{
+			desc:                "maker does not qualify for any tier",
+			makerVolumeFraction: num.DecimalFromFloat(0.05),
+			expectedMakerFee:    num.NewUint(125),
+			expectedRebate:      num.UintZero(),
+			expectedBuyBack:     num.NewUint(1000),
+			expectedTreasury:    num.NewUint(1500),
+		},
+		{
+			desc:                "maker qualifies for a reduced maker fee",
+			makerVolumeFraction: num.DecimalFromFloat(0.2),
+			expectedMakerFee:    num.NewUint(50),
+			expectedRebate:      num.UintZero(),
+			expectedBuyBack:     num.NewUint(1000),
+			expectedTreasury:    num.NewUint(1500),
+		},
+		{
+			desc:                "maker qualifies for a negative maker fee",
+			makerVolumeFraction: num.DecimalFromFloat(0.6),
+			expectedMakerFee:    num.UintZero(),
+			expectedRebate:      num.NewUint(250),
+			expectedBuyBack:     num.NewUint(1000),
+			expectedTreasury:    num.NewUint(1500),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			eng := getExtendedTestFee(t)
+			ctrl := gomock.NewController(t)
+			discountRewardService := mocks.NewMockReferralDiscountRewardService(ctrl)
+			volumeDiscountService := mocks.NewMockVolumeDiscountService(ctrl)
+			volumeRebateService := mocks.NewMockVolumeRebateService(ctrl)
+			volumeRebateService.EXPECT().VolumeRebateFactorForParty(gomock.Any()).Return(num.DecimalZero()).AnyTimes()
+			volumeRebateService.EXPECT().MakerVolumeFractionForParty(types.PartyID("party2")).Return(tc.makerVolumeFraction).AnyTimes()
+			discountRewardService.EXPECT().ReferralDiscountFactorsForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+			discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+			volumeDiscountService.EXPECT().VolumeDiscountFactorForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+			require.NoError(t, eng.UpdateFeeFactors(types.Fees{
+				Factors: &types.FeeFactors{
+					MakerFee:          num.DecimalFromFloat(.000250),
+					InfrastructureFee: num.DecimalFromFloat(0.0005),
+					LiquidityFee:      num.DecimalFromFloat(0.001),
+					BuyBackFee:        num.DecimalFromFloat(0.002),
+					TreasuryFee:       num.DecimalFromFloat(0.003),
+				},
+				MakerFeeTiers: []*types.MakerFeeTier{
+					{MinimumMakerVolumeFraction: num.DecimalFromFloat(0.1), MakerFee: num.DecimalFromFloat(0.0001)},
+					{MinimumMakerVolumeFraction: num.DecimalFromFloat(0.5), MakerFee: num.DecimalFromFloat(-0.0005)},
+				},
+			}))
+			trades := []*types.Trade{
+				{
+					Aggressor: types.SideSell,
+					Seller:    "party1",
+					Buyer:     "party2",
+					Size:      5,
+					Price:     num.NewUint(100000),
+				},
+			}
+
+			ft, err := eng.CalculateForContinuousMode(trades, discountRewardService, volumeDiscountService, volumeRebateService)
+			require.NoError(t, err)
+
+			rebate := num.UintZero()
+			for _, v := range ft.Transfers() {
+				switch v.Type {
+				case types.TransferTypeMakerFeePay, types.TransferTypeMakerFeeReceive:
+					assert.Equal(t, tc.expectedMakerFee, v.Amount.Amount)
+				case types.TransferTypeMakerFeeTierRebate:
+					assert.Equal(t, types.PartyID("party2"), types.PartyID(v.Owner))
+					rebate = v.Amount.Amount
+				case types.TransferTypeHighMakerRebatePay:
+					t.Fatal("a negative maker fee tier must not be paid from the taker's fees")
+				case types.TransferTypeBuyBackFeePay:
+					assert.Equal(t, tc.expectedBuyBack, v.Amount.Amount)
+				case types.TransferTypeTreasuryPay:
+					assert.Equal(t, tc.expectedTreasury, v.Amount.Amount)
+				}
+			}
+			assert.Equal(t, tc.expectedRebate, rebate)
+		})
+	}
+}
+
+func TestUpdateMakerFeeTiersError(t *testing.T) {
+	eng := getExtendedTestFee(t)
+
+	require.ErrorIs(t, eng.UpdateMakerFeeTiers([]*types.MakerFeeTier{
+		{MinimumMakerVolumeFraction: num.DecimalFromFloat(1.1), MakerFee: num.DecimalFromFloat(0.0001)},
+	}), fee.ErrInvalidFeeTiers)
+
+	require.ErrorIs(t, eng.UpdateMakerFeeTiers([]*types.MakerFeeTier{
+		{MinimumMakerVolumeFraction: num.DecimalFromFloat(0.1), MakerFee: num.DecimalFromFloat(0.0001)},
+		{MinimumMakerVolumeFraction: num.DecimalFromFloat(0.1), MakerFee: num.DecimalFromFloat(0.0002)},
+	}), fee.ErrInvalidFeeTiers)
+}
+
+func expectReferrerRewardSplitToDirectReferrer(svc *mocks.MockReferralDiscountRewardService, referrer types.PartyID) {
+	svc.EXPECT().SplitReferrerReward(gomock.Any(), gomock.Any()).DoAndReturn(func(_ types.PartyID, reward *num.Uint) []*types.ReferrerRewardShare {
+		return []*types.ReferrerRewardShare{{Referrer: referrer, Level: 1, Amount: reward.Clone()}}
+	}).AnyTimes()
+}
+
+func TestCalcContinuousTradingWithReferralChain(t *testing.T) {
+	eng := getTestFee(t)
+	ctrl := gomock.NewController(t)
+	discountRewardService := mocks.NewMockReferralDiscountRewardService(ctrl)
+	volumeDiscountService := mocks.NewMockVolumeDiscountService(ctrl)
+	volumeRebateService := mocks.NewMockVolumeRebateService(ctrl)
+	volumeRebateService.EXPECT().VolumeRebateFactorForParty(gomock.Any()).Return(num.DecimalZero()).AnyTimes()
+	discountRewardService.EXPECT().ReferralDiscountFactorsForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+	volumeDiscountService.EXPECT().VolumeDiscountFactorForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+	discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(types.PartyID("party1")).Return(types.Factors{
+		Infra:     num.NewDecimalFromFloat(0.5),
+		Maker:     num.NewDecimalFromFloat(0.5),
+		Liquidity: num.NewDecimalFromFloat(0.5),
+	}).AnyTimes()
+	// the referrer passes on a third of its reward to its own referrer
+	discountRewardService.EXPECT().SplitReferrerReward(types.PartyID("party1"), gomock.Any()).DoAndReturn(func(_ types.PartyID, reward *num.Uint) []*types.ReferrerRewardShare {
+		upstream := num.UintZero().Div(reward, num.NewUint(3))
+		return []*types.ReferrerRewardShare{
+			{Referrer: "referrer", Level: 1, Amount: num.UintZero().Sub(reward, upstream)},
+			{Referrer: "upstream", Level: 2, Amount: upstream},
+		}
+	}).AnyTimes()
+
+	trades := []*types.Trade{
+		{
+			Aggressor: types.SideSell,
+			Seller:    "party1",
+			Buyer:     "party2",
+			Size:      10,
+			Price:     num.NewUint(10000),
+		},
+		{
+			Aggressor: types.SideSell,
+			Seller:    "party1",
+			Buyer:     "party3",
+			Size:      5,
+			Price:     num.NewUint(11000),
+		},
+	}
+
+	ft, err := eng.CalculateForContinuousMode(trades, discountRewardService, volumeDiscountService, volumeRebateService)
+	require.NoError(t, err)
+
+	paid := num.UintZero()
+	distributed := map[string]*num.Uint{}
+	for _, tr := range ft.Transfers() {
+		switch tr.Type {
+		case types.TransferTypeFeeReferrerRewardPay:
+			require.Equal(t, "party1", tr.Owner)
+			paid.AddSum(tr.Amount.Amount)
+		case types.TransferTypeFeeReferrerRewardDistribute:
+			require.NotContains(t, distributed, tr.Owner)
+			distributed[tr.Owner] = tr.Amount.Amount
+		}
+	}
+
+	// trade 1: maker 2000, infra 5000, liquidity 10000 => reward 8500, split 5667/2833
+	// trade 2: maker 1100, infra 2750, liquidity 5500 => reward 4675, split 3117/1558
+	require.Equal(t, "13175", paid.String())
+	require.Len(t, distributed, 2)
+	require.Equal(t, "8784", distributed["referrer"].String())
+	require.Equal(t, "4391", distributed["upstream"].String())
+}
+
+func TestCalcContinuousTradingWithoutReferrerKeepsFees(t *testing.T) {
+	eng := getTestFee(t)
+	ctrl := gomock.NewController(t)
+	discountRewardService := mocks.NewMockReferralDiscountRewardService(ctrl)
+	volumeDiscountService := mocks.NewMockVolumeDiscountService(ctrl)
+	volumeRebateService := mocks.NewMockVolumeRebateService(ctrl)
+	volumeRebateService.EXPECT().VolumeRebateFactorForParty(gomock.Any()).Return(num.DecimalZero()).AnyTimes()
+	discountRewardService.EXPECT().ReferralDiscountFactorsForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+	volumeDiscountService.EXPECT().VolumeDiscountFactorForParty(gomock.Any()).Return(types.EmptyFactors).AnyTimes()
+	discountRewardService.EXPECT().RewardsFactorsMultiplierAppliedForParty(types.PartyID("party1")).Return(types.Factors{
+		Infra:     num.NewDecimalFromFloat(0.5),
+		Maker:     num.NewDecimalFromFloat(0.5),
+		Liquidity: num.NewDecimalFromFloat(0.5),
+	}).AnyTimes()
+	// the referrer of the taker cannot be found
+	discountRewardService.EXPECT().SplitReferrerReward(types.PartyID("party1"), gomock.Any()).Return(nil).AnyTimes()
+
+	trades := []*types.Trade{
+		{
+			Aggressor: types.SideSell,
+			Seller:    "party1",
+			Buyer:     "party2",
+			Size:      10,
+			Price:     num.NewUint(10000),
+		},
+	}
+
+	ft, err := eng.CalculateForContinuousMode(trades, discountRewardService, volumeDiscountService, volumeRebateService)
+	require.NoError(t, err)
+
+	for _, tr := range ft.Transfers() {
+		switch tr.Type {
+		case types.TransferTypeFeeReferrerRewardPay, types.TransferTypeFeeReferrerRewardDistribute:
+			t.Fatalf("unexpected referrer reward transfer %v", tr.Type)
+		case types.TransferTypeMakerFeePay:
+			require.Equal(t, "2000", tr.Amount.Amount.String())
+		case types.TransferTypeInfrastructureFeePay:
+			require.Equal(t, "5000", tr.Amount.Amount.String())
+		case types.TransferTypeLiquidityFeePay:
+			require.Equal(t, "10000", tr.Amount.Amount.String())
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	types "code.vegaprotocol.io/vega/core/types"
+	num "code.vegaprotocol.io/vega/libs/num"
 	gomock "github.com/golang/mock/gomock"
 	decimal "github.com/shopspring/decimal"
 )
@@ -78,6 +79,20 @@ func (mr *MockReferralDiscountRewardServiceMockRecorder) RewardsFactorsMultiplie
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewardsFactorsMultiplierAppliedForParty", reflect.TypeOf((*MockReferralDiscountRewardService)(nil).RewardsFactorsMultiplierAppliedForParty), arg0)
 }
 
+// SplitReferrerReward mocks base method.
+func (m *MockReferralDiscountRewardService) SplitReferrerReward(arg0 types.PartyID, arg1 *num.Uint) []*types.ReferrerRewardShare {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitReferrerReward", arg0, arg1)
+	ret0, _ := ret[0].([]*types.ReferrerRewardShare)
+	return ret0
+}
+
+// SplitReferrerReward indicates an expected call of SplitReferrerReward.
+func (mr *MockReferralDiscountRewardServiceMockRecorder) SplitReferrerReward(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitReferrerReward", reflect.TypeOf((*MockReferralDiscountRewardService)(nil).SplitReferrerReward), arg0, arg1)
+}
+
 // MockVolumeDiscountService is a mock of VolumeDiscountService interface.
 type MockVolumeDiscountService struct {
 	ctrl     *gomock.Controller
@@ -138,6 +153,20 @@ func (m *MockVolumeRebateService) EXPECT() *MockVolumeRebateServiceMockRecorder
 	return m.recorder
 }
 
+// MakerVolumeFractionForParty mocks base method.
+func (m *MockVolumeRebateService) MakerVolumeFractionForParty(arg0 types.PartyID) decimal.Decimal {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakerVolumeFractionForParty", arg0)
+	ret0, _ := ret[0].(decimal.Decimal)
+	return ret0
+}
+
+// MakerVolumeFractionForParty indicates an expected call of MakerVolumeFractionForParty.
+func (mr *MockVolumeRebateServiceMockRecorder) MakerVolumeFractionForParty(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakerVolumeFractionForParty", reflect.TypeOf((*MockVolumeRebateService)(nil).MakerVolumeFractionForParty), arg0)
+}
+
 // VolumeRebateFactorForParty mocks base method.
 func (m *MockVolumeRebateService) VolumeRebateFactorForParty(arg0 types.PartyID) decimal.Decimal {
 	m.ctrl.T.Helper()
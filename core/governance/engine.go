@@ -76,6 +76,7 @@ type Markets interface {
 	IsSucceeded(mktID string) bool
 	ValidateSettlementData(mktID string, data *num.Uint) bool
 	MarketHasActivePAP(market string) (bool, error)
+	GetOpenPositions(market string) ([]events.MarketPosition, error)
 }
 
 // StakingAccounts ...
@@ -97,6 +98,7 @@ type Assets interface {
 type Banking interface {
 	VerifyGovernanceTransfer(transfer *types.NewTransferConfiguration) error
 	VerifyCancelGovernanceTransfer(transferID string) error
+	VerifyCancelDelayedWithdrawal(withdrawalID string) error
 }
 
 // TimeService ...
@@ -112,6 +114,8 @@ type Witness interface {
 
 type NetParams interface {
 	Validate(string, string) error
+	ValidateSchedule(string, string, *types.NetworkParameterSchedule) error
+	ValidateMarketOverrides([]*types.NetworkParameter) error
 	Update(context.Context, string, string) error
 	GetDecimal(string) (num.Decimal, error)
 	GetInt(string) (int64, error)
@@ -146,6 +150,11 @@ type Engine struct {
 	gss *governanceSnapshotState
 	// main chain ID
 	chainID uint64
+
+	// delegator -> parties their governance weight is delegated to
+	voteDelegations map[string]*voteDelegation
+	// proposal id -> council members and token holders who vetoed it
+	proposalVetoes map[string]*proposalVetoes
 }
 
 func NewEngine(
@@ -178,6 +187,8 @@ func NewEngine(
 		netp:                   netp,
 		gss:                    &governanceSnapshotState{},
 		banking:                banking,
+		voteDelegations:        map[string]*voteDelegation{},
+		proposalVetoes:         map[string]*proposalVetoes{},
 	}
 	return e
 }
@@ -266,6 +277,7 @@ func (e *Engine) preEnactProposal(ctx context.Context, p *proposal) (te *ToEnact
 		unp := p.Terms.GetUpdateNetworkParameter()
 		if unp != nil {
 			te.n = unp.Changes
+			te.nSchedule = unp.Schedule
 		}
 		if err := e.netp.Validate(unp.Changes.Key, unp.Changes.Value); err != nil {
 			return nil, types.ProposalErrorNetworkParameterInvalidValue, err
@@ -291,6 +303,8 @@ func (e *Engine) preEnactProposal(ctx context.Context, p *proposal) (te *ToEnact
 		te.t = &ToEnactTransfer{}
 	case types.ProposalTermsTypeCancelTransfer:
 		te.c = &ToEnactCancelTransfer{}
+	case types.ProposalTermsTypeCancelDelayedWithdrawal:
+		te.cdw = &ToEnactCancelDelayedWithdrawal{}
 	case types.ProposalTermsTypeUpdateMarketState:
 		te.msu = &ToEnactMarketStateUpdate{}
 	case types.ProposalTermsTypeUpdateReferralProgram:
@@ -329,8 +343,9 @@ func (e *Engine) removeActiveProposalByID(ctx context.Context, id string) {
 			copy(e.activeProposals[i:], e.activeProposals[i+1:])
 			e.activeProposals[len(e.activeProposals)-1] = nil
 			e.activeProposals = e.activeProposals[:len(e.activeProposals)-1]
+			delete(e.proposalVetoes, id)
 
-			if p.State == types.ProposalStateDeclined || p.State == types.ProposalStateFailed || p.State == types.ProposalStateRejected {
+			if p.State == types.ProposalStateDeclined || p.State == types.ProposalStateFailed || p.State == types.ProposalStateRejected || p.State == types.ProposalStateVetoed {
 				// if it's an asset proposal we need to update it's
 				// state in the asset engine
 				switch p.Terms.Change.GetTermType() {
@@ -374,7 +389,7 @@ func (e *Engine) OnTick(ctx context.Context, t time.Time) ([]*ToEnact, []*VoteCl
 
 		if !proposal.IsOpen() && !proposal.IsPassed() {
 			toBeRemoved = append(toBeRemoved, proposal.ID)
-		} else if proposal.IsPassed() && (e.isAutoEnactableProposal(proposal.Proposal) || proposal.IsTimeToEnact(now)) {
+		} else if proposal.IsPassed() && (e.isAutoEnactableProposal(proposal.Proposal) || (proposal.IsTimeToEnact(now) && !e.isInVetoWindow(proposal.Proposal, now))) {
 			enact, perr, err := e.preEnactProposal(ctx, proposal)
 			if err != nil {
 				e.broker.Send(events.NewProposalEvent(ctx, *proposal.Proposal))
@@ -735,6 +750,9 @@ func (e *Engine) getProposalParams(proposalTerm types.ProposalTerm) (*types.Prop
 	case types.ProposalTermsTypeCancelTransfer:
 		// for governance transfer cancellation reuse the governance transfer proposal params
 		return e.getNewTransferProposalParameters(), nil
+	case types.ProposalTermsTypeCancelDelayedWithdrawal:
+		// for delayed withdrawal cancellation reuse the governance transfer proposal params
+		return e.getNewTransferProposalParameters(), nil
 	case types.ProposalTermsTypeNewSpotMarket:
 		return e.getNewSpotMarketProposalParameters(), nil
 	case types.ProposalTermsTypeUpdateSpotMarket:
@@ -756,6 +774,10 @@ func (e *Engine) getProposalParams(proposalTerm types.ProposalTerm) (*types.Prop
 }
 
 func (e *Engine) validateOpenProposal(proposal *types.Proposal, params *types.ProposalParameters) (types.ProposalError, error) {
+	if e.isFastTrackProposal(proposal) {
+		params = e.fastTrackProposalParameters(params)
+	}
+
 	// assign all requirement to the proposal itself.
 	proposal.RequiredMajority = params.RequiredMajority
 	proposal.RequiredParticipation = params.RequiredParticipation
@@ -916,7 +938,8 @@ func (e *Engine) addVote(ctx context.Context, cmd types.VoteSubmission, proposal
 		return err
 	}
 
-	if err := e.canVote(proposal.Proposal, params, party); err != nil {
+	accounts := e.votingAccounts(proposal.Terms.Change.GetTermType(), proposal.yes, proposal.no)
+	if err := e.canVote(proposal.Proposal, params, party, accounts); err != nil {
 		e.log.Debug("invalid vote submission",
 			logging.PartyID(party),
 			logging.String("vote", cmd.String()),
@@ -930,7 +953,7 @@ func (e *Engine) addVote(ctx context.Context, cmd types.VoteSubmission, proposal
 		ProposalID:                  cmd.ProposalID,
 		Value:                       cmd.Value,
 		Timestamp:                   e.timeService.GetTimeNow().UnixNano(),
-		TotalGovernanceTokenBalance: getTokensBalance(e.accs, party),
+		TotalGovernanceTokenBalance: getTokensBalance(accounts, party),
 		TotalGovernanceTokenWeight:  num.DecimalZero(),
 		TotalEquityLikeShareWeight:  num.DecimalZero(),
 	}
@@ -958,8 +981,9 @@ func (e *Engine) canVote(
 	proposal *types.Proposal,
 	params *types.ProposalParameters,
 	party string,
+	accounts StakingAccounts,
 ) error {
-	voterTokens, err := getGovernanceTokens(e.accs, party)
+	voterTokens, err := getGovernanceTokens(accounts, party)
 	if err != nil {
 		return err
 	}
@@ -1079,11 +1103,13 @@ func (e *Engine) validateChange(terms *types.ProposalTerms) (types.ProposalError
 	case types.ProposalTermsTypeUpdateAsset:
 		return terms.GetUpdateAsset().Validate()
 	case types.ProposalTermsTypeUpdateNetworkParameter:
-		return validateNetworkParameterUpdate(e.netp, terms.GetUpdateNetworkParameter().Changes)
+		return validateNetworkParameterUpdate(e.netp, terms.GetUpdateNetworkParameter())
 	case types.ProposalTermsTypeNewTransfer:
 		return e.validateGovernanceTransfer(terms.GetNewTransfer())
 	case types.ProposalTermsTypeCancelTransfer:
 		return e.validateCancelGovernanceTransfer(terms.GetCancelTransfer().Changes.TransferID)
+	case types.ProposalTermsTypeCancelDelayedWithdrawal:
+		return e.validateCancelDelayedWithdrawal(terms.GetCancelDelayedWithdrawal().Changes.WithdrawalID)
 	case types.ProposalTermsTypeUpdateMarketState:
 		return e.validateMarketUpdateState(terms.GetMarketStateUpdate().Changes)
 	case types.ProposalTermsTypeNewSpotMarket:
@@ -1124,6 +1150,13 @@ func (e *Engine) validateCancelGovernanceTransfer(transferID string) (types.Prop
 	return types.ProposalErrorUnspecified, nil
 }
 
+func (e *Engine) validateCancelDelayedWithdrawal(withdrawalID string) (types.ProposalError, error) {
+	if err := e.banking.VerifyCancelDelayedWithdrawal(withdrawalID); err != nil {
+		return types.ProposalErrorFailedDelayedWithdrawalCancel, err
+	}
+	return types.ProposalErrorUnspecified, nil
+}
+
 func (e *Engine) validateMarketUpdateState(update *types.MarketStateUpdateConfiguration) (types.ProposalError, error) {
 	marketID := update.MarketID
 	if !e.markets.MarketExists(marketID) {
@@ -1192,11 +1225,14 @@ func (e *Engine) validateNewAssetProposal(newAsset *types.NewAsset) (types.Propo
 		}
 	}
 
-	if err := e.assets.ValidateEthereumAddress(erc20.ContractAddress, erc20.ChainID); err != nil {
-		if err == assets.ErrErc20AddressAlreadyInUse {
-			return types.ProposalErrorERC20AddressAlreadyInUse, err
+	for _, chainID := range erc20.ChainIDs() {
+		address, _ := erc20.ContractAddressOnChain(chainID)
+		if err := e.assets.ValidateEthereumAddress(address, chainID); err != nil {
+			if err == assets.ErrErc20AddressAlreadyInUse {
+				return types.ProposalErrorERC20AddressAlreadyInUse, err
+			}
+			return types.ProposalErrorInvalidAssetDetails, err
 		}
-		return types.ProposalErrorInvalidAssetDetails, err
 	}
 
 	return types.ProposalErrorUnspecified, nil
@@ -1207,7 +1243,7 @@ func (e *Engine) closeProposal(ctx context.Context, proposal *proposal) {
 		return
 	}
 
-	proposal.Close(e.accs, e.markets)
+	proposal.Close(e.votingAccounts(proposal.Terms.Change.GetTermType(), proposal.yes, proposal.no), e.markets)
 	if proposal.IsPassed() {
 		e.log.Debug("Proposal passed", logging.ProposalID(proposal.ID))
 	} else if proposal.IsDeclined() {
@@ -1282,6 +1318,7 @@ func (e *Engine) updatedSpotMarketFromProposal(p *proposal) (*types.Market, type
 			LiquidityFeeSettings:      terms.Changes.LiquidityFeeSettings,
 			EnableTxReordering:        terms.Changes.EnableTxReordering,
 			AllowedSellers:            append([]string{}, terms.Changes.AllowedSellers...),
+			MakerFeeTiers:             terms.Changes.MakerFeeTiers,
 		},
 	}
 
@@ -1335,12 +1372,14 @@ func (e *Engine) updatedMarketFromProposal(p *proposal) (*types.Market, types.Pr
 			LinearSlippageFactor:          terms.Changes.LinearSlippageFactor,
 			QuadraticSlippageFactor:       terms.Changes.QuadraticSlippageFactor,
 			LiquidityFeeSettings:          terms.Changes.LiquidityFeeSettings,
+			MakerFeeTiers:                 terms.Changes.MakerFeeTiers,
 			LiquidationStrategy:           terms.Changes.LiquidationStrategy,
 			MarkPriceConfiguration:        terms.Changes.MarkPriceConfiguration,
 			TickSize:                      terms.Changes.TickSize,
 			EnableTxReordering:            terms.Changes.EnableTxReordering,
 			AllowedEmptyAmmLevels:         &allowedEmptyAMMLevels,
 			AllowedSellers:                append([]string{}, terms.Changes.AllowedSellers...),
+			NetworkParameterOverrides:     terms.Changes.NetworkParameterOverrides,
 		},
 	}
 
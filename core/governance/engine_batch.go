@@ -210,7 +210,7 @@ func (e *Engine) evaluateBatchProposals(
 			// or, if the parent market state is gone (ie succession window has expired), the proposal simply
 			// loses its parent market reference
 			if proposal.ShouldClose(now) {
-				proposal.Close(e.accs, e.markets)
+				proposal.Close(e.votingAccounts(proposal.Terms.Change.GetTermType(), proposal.yes, proposal.no), e.markets)
 				if proposal.IsPassed() {
 					e.log.Debug("Proposal passed",
 						logging.ProposalID(proposal.ID),
@@ -353,7 +353,8 @@ func (e *Engine) addBatchVote(ctx context.Context, batchProposal *batchProposal,
 
 	perMarketELS := map[string]num.Decimal{}
 	for _, proposal := range batchProposal.Proposals {
-		if err := e.canVote(proposal, batchProposal.ProposalParameters, party); err != nil {
+		accounts := e.votingAccounts(proposal.Terms.Change.GetTermType(), batchProposal.yes, batchProposal.no)
+		if err := e.canVote(proposal, batchProposal.ProposalParameters, party, accounts); err != nil {
 			validationErrs.Add(fmt.Errorf("proposal term %q has failed with: %w", proposal.Terms.Change.GetTermType(), err))
 			continue
 		}
@@ -28,6 +28,7 @@ import (
 	"code.vegaprotocol.io/vega/core/datasource/external/signedoracle"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/governance"
+	"code.vegaprotocol.io/vega/core/netparams"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/libs/ptr"
@@ -60,6 +61,9 @@ func TestProposalForNewMarket(t *testing.T) {
 	t.Run("Submitting a proposal for new market with bad risk parameter fails", testSubmittingProposalForNewMarketWithBadRiskParameterFails)
 	t.Run("Submitting a proposal for new market with internal time termination with bad risk parameter fails", testSubmittingProposalForNewMarketWithInternalTimeTerminationWithBadRiskParameterFails)
 	t.Run("Submitting a proposal for a ne market without disposal slippage range fails", testSubmittingProposalWithoutDisposalSlippageFails)
+	t.Run("Submitting a proposal for new market with out of range maker fee tier fails", testSubmittingProposalWithOutOfRangeMakerFeeTierFails)
+	t.Run("Submitting a proposal for new market with network parameter overrides succeeds", testSubmittingProposalWithNetworkParameterOverridesSucceeds)
+	t.Run("Submitting a proposal for new market overriding a non overridable network parameter fails", testSubmittingProposalWithNonOverridableNetworkParameterFails)
 
 	t.Run("Rejecting a proposal for new market succeeds", testRejectingProposalForNewMarketSucceeds)
 
@@ -1351,6 +1355,80 @@ func testSubmittingProposalWithoutDisposalSlippageFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "liquidation strategy must specify a disposal slippage range > 0")
 }
 
+func testSubmittingProposalWithOutOfRangeMakerFeeTierFails(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	// given
+	party := eng.newValidParty("a-valid-party", 1)
+	eng.ensureAllAssetEnabled(t)
+
+	proposal := eng.newProposalForNewMarket(party.Id, eng.tsvc.GetTimeNow().Add(2*time.Hour), nil, nil, false)
+	proposal.Terms.GetNewMarket().Changes.MakerFeeTiers = []*types.MakerFeeTier{
+		{
+			MinimumMakerVolumeFraction: num.DecimalFromFloat(0.1),
+			MakerFee:                   num.DecimalFromFloat(-1.5),
+		},
+	}
+
+	// setup
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1)
+
+	// when
+	_, err := eng.submitProposal(t, proposal)
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "maker fee tier maker fee must be in range [-1, 1]")
+}
+
+func testSubmittingProposalWithNetworkParameterOverridesSucceeds(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	// given
+	party := eng.newValidParty("a-valid-party", 123456789)
+	eng.ensureAllAssetEnabled(t)
+
+	proposal := eng.newProposalForNewMarket(party.Id, eng.tsvc.GetTimeNow().Add(2*time.Hour), nil, nil, true)
+	proposal.Terms.GetNewMarket().Changes.NetworkParameterOverrides = []*types.NetworkParameter{
+		{Key: netparams.MarketLiquidityStakeToCCYVolume, Value: "2"},
+		{Key: netparams.MarketAuctionMinimumDuration, Value: "30m"},
+	}
+
+	// setup
+	eng.expectOpenProposalEvent(t, party.Id, proposal.ID)
+
+	// when
+	toSubmit, err := eng.submitProposal(t, proposal)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, toSubmit)
+	overrides := toSubmit.NewMarket().Market().NetworkParameterOverrides
+	require.Len(t, overrides, 2)
+	// the overrides are sorted by key on the market.
+	assert.Equal(t, netparams.MarketAuctionMinimumDuration, overrides[0].Key)
+	assert.Equal(t, netparams.MarketLiquidityStakeToCCYVolume, overrides[1].Key)
+}
+
+func testSubmittingProposalWithNonOverridableNetworkParameterFails(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	// given
+	party := eng.newValidParty("a-valid-party", 1)
+	eng.ensureAllAssetEnabled(t)
+
+	proposal := eng.newProposalForNewMarket(party.Id, eng.tsvc.GetTimeNow().Add(2*time.Hour), nil, nil, true)
+	proposal.Terms.GetNewMarket().Changes.NetworkParameterOverrides = []*types.NetworkParameter{
+		{Key: netparams.GovernanceProposalMarketMinClose, Value: "1h"},
+	}
+
+	// setup
+	eng.broker.EXPECT().Send(gomock.Any()).Times(1)
+
+	// when
+	_, err := eng.submitProposal(t, proposal)
+
+	// then
+	require.ErrorIs(t, err, netparams.ErrMarketOverrideNotAllowed)
+}
+
 func testOutOfRangeRiskParamFail(t *testing.T, lnm *types.LogNormalRiskModel) {
 	t.Helper()
 	eng := getTestEngine(t, time.Now())
@@ -62,6 +62,7 @@ type tstEngine struct {
 	proposalCounter uint                          // to streamline proposal generation
 	tokenBal        map[string]uint64             // party > balance
 	els             map[string]map[string]float64 // market > party > ELS
+	setTimeNow      func(time.Time)               // changes the time returned by the time service
 }
 
 func TestSubmitProposals(t *testing.T) {
@@ -1424,7 +1425,8 @@ func getTestEngine(t *testing.T, now time.Time) *tstEngine {
 
 	ctx := context.Background()
 
-	ts.EXPECT().GetTimeNow().Return(now).AnyTimes()
+	currentTime := now
+	ts.EXPECT().GetTimeNow().DoAndReturn(func() time.Time { return currentTime }).AnyTimes()
 
 	broker.EXPECT().Send(events.NewNetworkParameterEvent(ctx, netparams.GovernanceProposalMarketMinVoterBalance, "1")).Times(1)
 	require.NoError(t, netp.Update(ctx, netparams.GovernanceProposalMarketMinVoterBalance, "1"))
@@ -1451,6 +1453,9 @@ func getTestEngine(t *testing.T, now time.Time) *tstEngine {
 		netp:     netp,
 		tokenBal: map[string]uint64{},
 		els:      map[string]map[string]float64{},
+		setTimeNow: func(t time.Time) {
+			currentTime = t
+		},
 	}
 	// ensure the balance is always returned as expected
 
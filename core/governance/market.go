@@ -18,6 +18,7 @@ package governance
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -75,6 +76,10 @@ var (
 
 const defaultAllowedEmptyAMMLevels = uint64(100)
 
+// MaxMakerFeeTiers is the maximum number of maker fee tiers a market can define, it bounds the
+// cost of finding the tier of the maker of every trade.
+const MaxMakerFeeTiers = 10
+
 func assignProduct(
 	source *types.InstrumentConfiguration,
 	target *types.Instrument,
@@ -279,6 +284,7 @@ func buildMarketFromProposal(
 				BuyBackFee:        buybackFeeDec,
 			},
 			LiquidityFeeSettings: definition.Changes.LiquidityFeeSettings,
+			MakerFeeTiers:        types.MakerFeeTiersDeepClone(definition.Changes.MakerFeeTiers),
 		},
 		OpeningAuction: &types.AuctionDuration{
 			Duration: int64(openingAuctionDuration.Seconds()),
@@ -299,6 +305,7 @@ func buildMarketFromProposal(
 		TickSize:                      definition.Changes.TickSize,
 		EnableTxReordering:            definition.Changes.EnableTxReordering,
 		AllowedEmptyAmmLevels:         allowedEmptyAMMLevels,
+		NetworkParameterOverrides:     sortedNetworkParameterOverrides(definition.Changes.NetworkParameterOverrides),
 	}
 	if fCap := market.TradableInstrument.Instrument.Product.Cap(); fCap != nil {
 		marginCalc.FullyCollateralised = fCap.FullyCollateralised
@@ -364,6 +371,7 @@ func buildSpotMarketFromProposal(
 				BuyBackFee:        buybackFeeDec,
 			},
 			LiquidityFeeSettings: definition.Changes.LiquidityFeeSettings,
+			MakerFeeTiers:        types.MakerFeeTiersDeepClone(definition.Changes.MakerFeeTiers),
 		},
 		OpeningAuction: &types.AuctionDuration{
 			Duration: int64(openingAuctionDuration.Seconds()),
@@ -786,6 +794,52 @@ func validateLPSLAParams(slaParams *types.LiquiditySLAParams) (types.ProposalErr
 	return types.ProposalErrorUnspecified, nil
 }
 
+// validateMakerFeeTiers checks the market specific maker fee tiers. A negative maker fee is a rebate
+// paid from the network maker fee rebates account.
+func validateMakerFeeTiers(tiers []*types.MakerFeeTier) (types.ProposalError, error) {
+	if len(tiers) == 0 {
+		return types.ProposalErrorUnspecified, nil
+	}
+	if len(tiers) > MaxMakerFeeTiers {
+		return types.ProposalErrorInvalidMarket, fmt.Errorf("%v maker fee tiers set, maximum allowed is %v", len(tiers), MaxMakerFeeTiers)
+	}
+
+	seen := map[string]struct{}{}
+	for _, t := range tiers {
+		if t.MinimumMakerVolumeFraction.IsNegative() || t.MinimumMakerVolumeFraction.GreaterThan(num.DecimalOne()) {
+			return types.ProposalErrorInvalidMarket, fmt.Errorf("maker fee tier minimum maker volume fraction must be in range [0, 1]")
+		}
+		if _, ok := seen[t.MinimumMakerVolumeFraction.String()]; ok {
+			return types.ProposalErrorInvalidMarket, fmt.Errorf("maker fee tiers must have distinct minimum maker volume fractions")
+		}
+		seen[t.MinimumMakerVolumeFraction.String()] = struct{}{}
+		if t.MakerFee.LessThan(num.DecimalOne().Neg()) || t.MakerFee.GreaterThan(num.DecimalOne()) {
+			return types.ProposalErrorInvalidMarket, fmt.Errorf("maker fee tier maker fee must be in range [-1, 1]")
+		}
+	}
+	return types.ProposalErrorUnspecified, nil
+}
+
+// validateNetworkParameterOverrides checks the network parameters can be overridden
+// by a market, each one only once, and that the values are valid for the market.
+func validateNetworkParameterOverrides(overrides []*types.NetworkParameter, netp NetParams) (types.ProposalError, error) {
+	if err := netp.ValidateMarketOverrides(overrides); err != nil {
+		return types.ProposalErrorNetworkParameterValidationFailed, err
+	}
+	return types.ProposalErrorUnspecified, nil
+}
+
+func sortedNetworkParameterOverrides(overrides []*types.NetworkParameter) []*types.NetworkParameter {
+	if len(overrides) == 0 {
+		return nil
+	}
+	cpy := types.NetworkParametersDeepClone(overrides)
+	sort.Slice(cpy, func(i, j int) bool {
+		return cpy[i].Key < cpy[j].Key
+	})
+	return cpy
+}
+
 func validateAuctionDuration(proposedDuration time.Duration, netp NetParams) (types.ProposalError, error) {
 	minAuctionDuration, _ := netp.GetDuration(netparams.MarketAuctionMinimumDuration)
 	if proposedDuration < minAuctionDuration {
@@ -867,6 +921,9 @@ func validateNewSpotMarketChange(
 	if perr, err := validateLPSLAParams(terms.Changes.SLAParams); err != nil {
 		return perr, err
 	}
+	if perr, err := validateMakerFeeTiers(terms.Changes.MakerFeeTiers); err != nil {
+		return perr, err
+	}
 	return types.ProposalErrorUnspecified, nil
 }
 
@@ -904,6 +961,12 @@ func validateNewMarketChange(
 	if perr, err := validateLPSLAParams(terms.Changes.LiquiditySLAParameters); err != nil {
 		return perr, err
 	}
+	if perr, err := validateMakerFeeTiers(terms.Changes.MakerFeeTiers); err != nil {
+		return perr, err
+	}
+	if perr, err := validateNetworkParameterOverrides(terms.Changes.NetworkParameterOverrides, netp); err != nil {
+		return perr, err
+	}
 	if perr, err := validateSlippageFactor(terms.Changes.LinearSlippageFactor, true); err != nil {
 		return perr, err
 	}
@@ -975,6 +1038,9 @@ func validateUpdateSpotMarketChange(terms *types.UpdateSpotMarket) (types.Propos
 	if perr, err := validateLPSLAParams(terms.Changes.SLAParams); err != nil {
 		return perr, err
 	}
+	if perr, err := validateMakerFeeTiers(terms.Changes.MakerFeeTiers); err != nil {
+		return perr, err
+	}
 	return types.ProposalErrorUnspecified, nil
 }
 
@@ -989,6 +1055,12 @@ func validateUpdateMarketChange(terms *types.UpdateMarket, mkt types.Market, etu
 	if perr, err := validateLPSLAParams(terms.Changes.LiquiditySLAParameters); err != nil {
 		return perr, err
 	}
+	if perr, err := validateMakerFeeTiers(terms.Changes.MakerFeeTiers); err != nil {
+		return perr, err
+	}
+	if perr, err := validateNetworkParameterOverrides(terms.Changes.NetworkParameterOverrides, netp); err != nil {
+		return perr, err
+	}
 	if perr, err := validateSlippageFactor(terms.Changes.LinearSlippageFactor, true); err != nil {
 		return perr, err
 	}
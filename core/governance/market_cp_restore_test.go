@@ -195,10 +195,9 @@ func createExecutionEngine(t *testing.T, tm time.Time) (*execution.Engine, *gove
 	epochEngine.EXPECT().NotifyOnEpoch(gomock.Any(), gomock.Any()).Times(1)
 
 	primaryBridgeView := amocks.NewMockERC20BridgeView(ctrl)
-	secondaryBridgeView := amocks.NewMockERC20BridgeView(ctrl)
 	notary := amocks.NewMockNotary(ctrl)
 
-	asset, _ := assets.New(context.Background(), log, assets.NewDefaultConfig(), getNodeWallet().Ethereum, nil, nil, broker, primaryBridgeView, secondaryBridgeView, notary, false)
+	asset, _ := assets.New(context.Background(), log, assets.NewDefaultConfig(), getNodeWallet().Ethereum, nil, broker, primaryBridgeView, notary, false)
 	teams := emocks.NewMockTeams(ctrl)
 	bc := emocks.NewMockAccountBalanceChecker(ctrl)
 	marketTracker := common.NewMarketActivityTracker(log, teams, bc, broker, collateralService)
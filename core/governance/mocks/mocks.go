@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	assets "code.vegaprotocol.io/vega/core/assets"
+	events "code.vegaprotocol.io/vega/core/events"
 	netparams "code.vegaprotocol.io/vega/core/netparams"
 	types "code.vegaprotocol.io/vega/core/types"
 	validators "code.vegaprotocol.io/vega/core/validators"
@@ -87,6 +88,21 @@ func (mr *MockMarketsMockRecorder) GetMarketState(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMarketState", reflect.TypeOf((*MockMarkets)(nil).GetMarketState), arg0)
 }
 
+// GetOpenPositions mocks base method.
+func (m *MockMarkets) GetOpenPositions(arg0 string) ([]events.MarketPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPositions", arg0)
+	ret0, _ := ret[0].([]events.MarketPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPositions indicates an expected call of GetOpenPositions.
+func (mr *MockMarketsMockRecorder) GetOpenPositions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPositions", reflect.TypeOf((*MockMarkets)(nil).GetOpenPositions), arg0)
+}
+
 // IsSucceeded mocks base method.
 func (m *MockMarkets) IsSucceeded(arg0 string) bool {
 	m.ctrl.T.Helper()
@@ -589,6 +605,34 @@ func (mr *MockNetParamsMockRecorder) Validate(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockNetParams)(nil).Validate), arg0, arg1)
 }
 
+// ValidateMarketOverrides mocks base method.
+func (m *MockNetParams) ValidateMarketOverrides(arg0 []*types.NetworkParameter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMarketOverrides", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMarketOverrides indicates an expected call of ValidateMarketOverrides.
+func (mr *MockNetParamsMockRecorder) ValidateMarketOverrides(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMarketOverrides", reflect.TypeOf((*MockNetParams)(nil).ValidateMarketOverrides), arg0)
+}
+
+// ValidateSchedule mocks base method.
+func (m *MockNetParams) ValidateSchedule(arg0, arg1 string, arg2 *types.NetworkParameterSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSchedule indicates an expected call of ValidateSchedule.
+func (mr *MockNetParamsMockRecorder) ValidateSchedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSchedule", reflect.TypeOf((*MockNetParams)(nil).ValidateSchedule), arg0, arg1, arg2)
+}
+
 // MockBanking is a mock of Banking interface.
 type MockBanking struct {
 	ctrl     *gomock.Controller
@@ -612,6 +656,20 @@ func (m *MockBanking) EXPECT() *MockBankingMockRecorder {
 	return m.recorder
 }
 
+// VerifyCancelDelayedWithdrawal mocks base method.
+func (m *MockBanking) VerifyCancelDelayedWithdrawal(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCancelDelayedWithdrawal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyCancelDelayedWithdrawal indicates an expected call of VerifyCancelDelayedWithdrawal.
+func (mr *MockBankingMockRecorder) VerifyCancelDelayedWithdrawal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCancelDelayedWithdrawal", reflect.TypeOf((*MockBanking)(nil).VerifyCancelDelayedWithdrawal), arg0)
+}
+
 // VerifyCancelGovernanceTransfer mocks base method.
 func (m *MockBanking) VerifyCancelGovernanceTransfer(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -272,8 +272,9 @@ func (e *Engine) getProposalParametersFromNetParams(
 }
 
 func validateNetworkParameterUpdate(
-	netp NetParams, np *types.NetworkParameter,
+	netp NetParams, unp *types.UpdateNetworkParameter,
 ) (types.ProposalError, error) {
+	np := unp.Changes
 	if len(np.Key) <= 0 {
 		return types.ProposalErrorNetworkParameterInvalidKey, ErrEmptyNetParamKey
 	}
@@ -288,6 +289,9 @@ func validateNetworkParameterUpdate(
 		perr = types.ProposalErrorUnspecified
 		err  = netp.Validate(np.Key, np.Value)
 	)
+	if err == nil && unp.Schedule != nil {
+		err = netp.ValidateSchedule(np.Key, np.Value, unp.Schedule)
+	}
 	if err != nil {
 		perr = types.ProposalErrorNetworkParameterValidationFailed
 	}
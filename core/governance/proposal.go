@@ -246,12 +246,14 @@ type ToEnact struct {
 	newAsset               *types.Asset
 	updatedAsset           *types.Asset
 	n                      *types.NetworkParameter
+	nSchedule              *types.NetworkParameterSchedule
 	as                     *types.AssetDetails
 	updatedMarket          *types.Market
 	updatedSpotMarket      *types.Market
 	f                      *ToEnactFreeform
 	t                      *ToEnactTransfer
 	c                      *ToEnactCancelTransfer
+	cdw                    *ToEnactCancelDelayedWithdrawal
 	msu                    *ToEnactMarketStateUpdate
 	referralProgramChanges *types.ReferralProgram
 	volumeDiscountProgram  *types.VolumeDiscountProgram
@@ -265,6 +267,8 @@ type ToEnactTransfer struct{}
 
 type ToEnactCancelTransfer struct{}
 
+type ToEnactCancelDelayedWithdrawal struct{}
+
 type ToEnactAutomatedPurchase struct{}
 
 // ToEnactNewMarket is just a empty struct, to signal
@@ -298,6 +302,10 @@ func (t ToEnact) IsCancelTransfer() bool {
 	return t.c != nil
 }
 
+func (t ToEnact) IsCancelDelayedWithdrawal() bool {
+	return t.cdw != nil
+}
+
 func (t ToEnact) IsNewTransfer() bool {
 	return t.t != nil
 }
@@ -355,6 +363,10 @@ func (t *ToEnact) CancelTransfer() *ToEnactCancelTransfer {
 	return t.c
 }
 
+func (t *ToEnact) CancelDelayedWithdrawal() *ToEnactCancelDelayedWithdrawal {
+	return t.cdw
+}
+
 func (t *ToEnact) NewMarket() *ToEnactNewMarket {
 	return t.m
 }
@@ -371,6 +383,12 @@ func (t *ToEnact) UpdateNetworkParameter() *types.NetworkParameter {
 	return t.n
 }
 
+// UpdateNetworkParameterSchedule returns how the network parameter change
+// is rolled out, nil if it's applied at once.
+func (t *ToEnact) UpdateNetworkParameterSchedule() *types.NetworkParameterSchedule {
+	return t.nSchedule
+}
+
 func (t *ToEnact) ReferralProgramChanges() *types.ReferralProgram {
 	return t.referralProgramChanges
 }
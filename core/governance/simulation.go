@@ -0,0 +1,239 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"sort"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/risk"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// simulatedProposalID is the ID given to a simulated proposal, and so to the
+// market a simulated new market proposal would create.
+const simulatedProposalID = "simulated-proposal"
+
+// ProposalSimulation is the outcome of a dry-run of a proposal: whether it would
+// be accepted if submitted now, what it would need to pass, and what would be
+// enacted.
+type ProposalSimulation struct {
+	// Error and Reason are set if the proposal would be rejected.
+	Error  types.ProposalError
+	Reason error
+
+	RequiredMajority        num.Decimal
+	RequiredParticipation   num.Decimal
+	RequiredLPMajority      num.Decimal
+	RequiredLPParticipation num.Decimal
+
+	// Market is the configuration of the market after enactment, for the new
+	// market and the market update proposals.
+	Market *types.Market
+	// NetworkParameter is the network parameter after enactment, with its
+	// current value, for the network parameter update proposals.
+	NetworkParameter *SimulatedNetworkParameter
+	// MarginImpact is the effect on the margin levels of a market update.
+	MarginImpact *MarginImpact
+}
+
+type SimulatedNetworkParameter struct {
+	Key          string
+	CurrentValue string
+	Value        string
+}
+
+// MarginImpact compares the risk factors, and margin scaling factors, of a market
+// before and after an update. The part of the maintenance margin of the long and
+// short positions coming from the risk factors is scaled by LongRatio and
+// ShortRatio respectively.
+type MarginImpact struct {
+	CurrentRiskFactor  *types.RiskFactor
+	ProposedRiskFactor *types.RiskFactor
+	LongRatio          num.Decimal
+	ShortRatio         num.Decimal
+
+	CurrentScalingFactors  *types.ScalingFactors
+	ProposedScalingFactors *types.ScalingFactors
+
+	// Positions is the effect on the open positions of the market.
+	Positions []*PositionMarginImpact
+}
+
+// PositionMarginImpact is the part of the maintenance margin of an open position
+// coming from the risk factors, at the current mark price, before and after a
+// market update. The margins are in the settlement asset decimals.
+type PositionMarginImpact struct {
+	Party                     string
+	OpenVolume                int64
+	CurrentMaintenanceMargin  num.Decimal
+	ProposedMaintenanceMargin num.Decimal
+}
+
+// SimulateProposal runs the validation of the proposal terms as if they were
+// submitted by the party now, and builds what would be enacted, without changing
+// the state of the engine. Batch proposals aren't supported.
+func (e *Engine) SimulateProposal(terms *types.ProposalTerms, party string) *ProposalSimulation {
+	sim := &ProposalSimulation{
+		RequiredMajority:        num.DecimalZero(),
+		RequiredParticipation:   num.DecimalZero(),
+		RequiredLPMajority:      num.DecimalZero(),
+		RequiredLPParticipation: num.DecimalZero(),
+	}
+
+	p := &types.Proposal{
+		ID:                      simulatedProposalID,
+		Timestamp:               e.timeService.GetTimeNow().UnixNano(),
+		Party:                   party,
+		State:                   types.ProposalStateOpen,
+		Terms:                   terms,
+		RequiredMajority:        num.DecimalZero(),
+		RequiredParticipation:   num.DecimalZero(),
+		RequiredLPMajority:      num.DecimalZero(),
+		RequiredLPParticipation: num.DecimalZero(),
+	}
+
+	params, err := e.getProposalParams(terms.Change)
+	if err != nil {
+		sim.Error, sim.Reason = types.ProposalErrorUnknownType, err
+		return sim
+	}
+
+	perr, err := e.validateOpenProposal(p, params)
+	sim.RequiredMajority = p.RequiredMajority
+	sim.RequiredParticipation = p.RequiredParticipation
+	sim.RequiredLPMajority = p.RequiredLPMajority
+	sim.RequiredLPParticipation = p.RequiredLPParticipation
+	if err != nil {
+		sim.Error, sim.Reason = perr, err
+		return sim
+	}
+
+	sim.Error, sim.Reason = e.simulateEnactment(p, sim)
+	return sim
+}
+
+func (e *Engine) simulateEnactment(p *types.Proposal, sim *ProposalSimulation) (types.ProposalError, error) {
+	var (
+		perr types.ProposalError
+		err  error
+	)
+
+	auctionDuration := time.Unix(p.Terms.EnactmentTimestamp, 0).Sub(time.Unix(p.Terms.ClosingTimestamp, 0))
+	switch p.Terms.Change.GetTermType() {
+	case types.ProposalTermsTypeNewMarket:
+		sim.Market, perr, err = buildMarketFromProposal(p.ID, p.Terms.GetNewMarket(), e.netp, auctionDuration)
+	case types.ProposalTermsTypeNewSpotMarket:
+		sim.Market, perr, err = buildSpotMarketFromProposal(p.ID, p.Terms.GetNewSpotMarket(), e.netp, auctionDuration)
+	case types.ProposalTermsTypeUpdateMarket:
+		sim.Market, perr, err = e.updatedMarketFromProposal(&proposal{Proposal: p})
+		if err == nil {
+			existing, _ := e.markets.GetMarket(p.Terms.GetUpdateMarket().MarketID, false)
+			sim.MarginImpact, err = marginImpact(&existing, sim.Market)
+			if err != nil {
+				perr = types.ProposalErrorUnknownRiskParameterType
+				break
+			}
+			positions, err := e.markets.GetOpenPositions(existing.ID)
+			if err != nil {
+				return types.ProposalErrorInvalidMarket, err
+			}
+			sim.MarginImpact.Positions = positionsMarginImpact(positions, existing.PositionDecimalPlaces, sim.MarginImpact)
+		}
+	case types.ProposalTermsTypeUpdateSpotMarket:
+		sim.Market, perr, err = e.updatedSpotMarketFromProposal(&proposal{Proposal: p})
+	case types.ProposalTermsTypeUpdateNetworkParameter:
+		changes := p.Terms.GetUpdateNetworkParameter().Changes
+		current, _ := e.netp.Get(changes.Key)
+		sim.NetworkParameter = &SimulatedNetworkParameter{
+			Key:          changes.Key,
+			CurrentValue: current,
+			Value:        changes.Value,
+		}
+	}
+	if err != nil {
+		sim.Market = nil
+		return perr, err
+	}
+	return types.ProposalErrorUnspecified, nil
+}
+
+func marginImpact(current, proposed *types.Market) (*MarginImpact, error) {
+	currentFactor, err := marketRiskFactor(current)
+	if err != nil {
+		return nil, err
+	}
+	proposedFactor, err := marketRiskFactor(proposed)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := &MarginImpact{
+		CurrentRiskFactor:  currentFactor,
+		ProposedRiskFactor: proposedFactor,
+		LongRatio:          num.DecimalOne(),
+		ShortRatio:         num.DecimalOne(),
+	}
+	if !currentFactor.Long.IsZero() {
+		impact.LongRatio = proposedFactor.Long.Div(currentFactor.Long)
+	}
+	if !currentFactor.Short.IsZero() {
+		impact.ShortRatio = proposedFactor.Short.Div(currentFactor.Short)
+	}
+	if mc := current.TradableInstrument.MarginCalculator; mc != nil {
+		impact.CurrentScalingFactors = mc.ScalingFactors
+	}
+	if mc := proposed.TradableInstrument.MarginCalculator; mc != nil {
+		impact.ProposedScalingFactors = mc.ScalingFactors
+	}
+	return impact, nil
+}
+
+func positionsMarginImpact(positions []events.MarketPosition, positionDecimals int64, impact *MarginImpact) []*PositionMarginImpact {
+	positionFactor := num.DecimalFromInt64(10).Pow(num.DecimalFromInt64(positionDecimals))
+	out := make([]*PositionMarginImpact, 0, len(positions))
+	for _, pos := range positions {
+		// the risk part of the maintenance margin is the open volume valued at the
+		// mark price, times the risk factor of the side of the position.
+		notional := num.DecimalFromInt64(pos.Size()).Div(positionFactor).Abs().Mul(pos.Price().ToDecimal())
+		current, proposed := impact.CurrentRiskFactor.Long, impact.ProposedRiskFactor.Long
+		if pos.Size() < 0 {
+			current, proposed = impact.CurrentRiskFactor.Short, impact.ProposedRiskFactor.Short
+		}
+		out = append(out, &PositionMarginImpact{
+			Party:                     pos.Party(),
+			OpenVolume:                pos.Size(),
+			CurrentMaintenanceMargin:  notional.Mul(current),
+			ProposedMaintenanceMargin: notional.Mul(proposed),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Party < out[j].Party })
+	return out
+}
+
+func marketRiskFactor(mkt *types.Market) (*types.RiskFactor, error) {
+	assets, err := mkt.GetAssets()
+	if err != nil {
+		return nil, err
+	}
+	model, err := risk.NewModel(mkt.TradableInstrument.RiskModel, assets[0])
+	if err != nil {
+		return nil, err
+	}
+	return model.CalculateRiskFactors(), nil
+}
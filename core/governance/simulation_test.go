@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	vgrand "code.vegaprotocol.io/vega/libs/rand"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateProposal(t *testing.T) {
+	t.Run("a valid network parameter update is simulated", testSimulateNetParamUpdate)
+	t.Run("a network parameter update that would be rejected is reported", testSimulateInvalidNetParamUpdate)
+	t.Run("the margin impact of a market update on the open positions is simulated", testSimulateMarketUpdateMarginImpact)
+}
+
+func testSimulateNetParamUpdate(t *testing.T) {
+	now := time.Now()
+	eng := getTestEngine(t, now)
+	party := eng.newValidParty("a-valid-party", 1)
+
+	// no event is sent, and no proposal is started.
+	p := eng.newProposalForNetParam(party.Id, netparams.MarketAuctionMinimumDuration, "1h", now.Add(48*time.Hour))
+	sim := eng.SimulateProposal(p.Terms, party.Id)
+
+	require.NoError(t, sim.Reason)
+	assert.Equal(t, types.ProposalErrorUnspecified, sim.Error)
+	assert.Equal(t, "0.66", sim.RequiredMajority.String())
+	require.NotNil(t, sim.NetworkParameter)
+	assert.Equal(t, netparams.MarketAuctionMinimumDuration, sim.NetworkParameter.Key)
+	assert.Equal(t, "30m0s", sim.NetworkParameter.CurrentValue)
+	assert.Equal(t, "1h", sim.NetworkParameter.Value)
+	assert.Nil(t, sim.Market)
+}
+
+func testSimulateInvalidNetParamUpdate(t *testing.T) {
+	now := time.Now()
+	eng := getTestEngine(t, now)
+	party := eng.newValidParty("a-valid-party", 1)
+
+	p := eng.newProposalForNetParam(party.Id, netparams.MarketAuctionMinimumDuration, "169h", now.Add(48*time.Hour))
+	sim := eng.SimulateProposal(p.Terms, party.Id)
+
+	require.Error(t, sim.Reason)
+	assert.Equal(t, "unable to validate market.auction.minimumDuration: expect <= 24h0m0s got 169h0m0s, expect < 168h0m0s (market.auction.maximumDuration) got 169h0m0s", sim.Reason.Error())
+	assert.Nil(t, sim.NetworkParameter)
+}
+
+func testSimulateMarketUpdateMarginImpact(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+
+	proposer := vgrand.RandomStr(5)
+	proposal := eng.newProposalForMarketUpdate("market-1", proposer, eng.tsvc.GetTimeNow(), nil, nil, true)
+	marketID := proposal.MarketUpdate().MarketID
+
+	eng.ensureTokenBalanceForParty(t, proposer, 1000)
+	eng.ensureEquityLikeShareForMarketAndParty(t, marketID, proposer, 0.1)
+	eng.ensureExistingMarket(t, marketID)
+	eng.markets.EXPECT().GetMarket(marketID, gomock.Any()).AnyTimes().Return(types.Market{
+		ID:                    marketID,
+		PositionDecimalPlaces: 1,
+		OpeningAuction:        &types.AuctionDuration{Duration: 10},
+		TradableInstrument: &types.TradableInstrument{
+			Instrument: &types.Instrument{
+				Product: &types.InstrumentFuture{
+					Future: &types.Future{SettlementAsset: "BTC"},
+				},
+			},
+			RiskModel: &types.TradableInstrumentSimpleRiskModel{
+				SimpleRiskModel: &types.SimpleRiskModel{
+					Params: &types.SimpleModelParams{
+						FactorLong:  num.DecimalFromFloat(0.1),
+						FactorShort: num.DecimalFromFloat(0.2),
+					},
+				},
+			},
+		},
+	}, true)
+	eng.markets.EXPECT().GetOpenPositions(marketID).Times(1).Return([]events.MarketPosition{
+		testPosition{party: "party2", size: -20, price: num.NewUint(1000)},
+		testPosition{party: "party1", size: 50, price: num.NewUint(1000)},
+	}, nil)
+
+	sim := eng.SimulateProposal(proposal.Terms, proposer)
+
+	require.NoError(t, sim.Reason)
+	require.NotNil(t, sim.Market)
+	require.NotNil(t, sim.MarginImpact)
+	proposed := sim.MarginImpact.ProposedRiskFactor
+
+	// the open volumes are scaled by the position decimals.
+	require.Len(t, sim.MarginImpact.Positions, 2)
+	long := sim.MarginImpact.Positions[0]
+	assert.Equal(t, "party1", long.Party)
+	assert.Equal(t, int64(50), long.OpenVolume)
+	assert.Equal(t, "500", long.CurrentMaintenanceMargin.String())
+	assert.Equal(t, num.DecimalFromInt64(5000).Mul(proposed.Long).String(), long.ProposedMaintenanceMargin.String())
+
+	short := sim.MarginImpact.Positions[1]
+	assert.Equal(t, "party2", short.Party)
+	assert.Equal(t, int64(-20), short.OpenVolume)
+	assert.Equal(t, "400", short.CurrentMaintenanceMargin.String())
+	assert.Equal(t, num.DecimalFromInt64(2000).Mul(proposed.Short).String(), short.ProposedMaintenanceMargin.String())
+}
+
+type testPosition struct {
+	party string
+	size  int64
+	price *num.Uint
+}
+
+func (p testPosition) Party() string                { return p.party }
+func (p testPosition) Size() int64                  { return p.size }
+func (p testPosition) Buy() int64                   { return 0 }
+func (p testPosition) Sell() int64                  { return 0 }
+func (p testPosition) Price() *num.Uint             { return p.price }
+func (p testPosition) BuySumProduct() *num.Uint     { return num.UintZero() }
+func (p testPosition) SellSumProduct() *num.Uint    { return num.UintZero() }
+func (p testPosition) VWBuy() *num.Uint             { return num.UintZero() }
+func (p testPosition) VWSell() *num.Uint            { return num.UintZero() }
+func (p testPosition) AverageEntryPrice() *num.Uint { return p.price }
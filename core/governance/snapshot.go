@@ -36,12 +36,16 @@ var (
 	enactedKey        = (&types.PayloadGovernanceEnacted{}).Key()
 	nodeValidationKey = (&types.PayloadGovernanceNode{}).Key()
 	batchActiveKey    = (&types.PayloadGovernanceBatchActive{}).Key()
+	voteDelegationKey = (&types.PayloadGovernanceVoteDelegations{}).Key()
+	proposalVetoesKey = (&types.PayloadGovernanceProposalVetoes{}).Key()
 
 	hashKeys = []string{
 		activeKey,
 		enactedKey,
 		nodeValidationKey,
 		batchActiveKey,
+		voteDelegationKey,
+		proposalVetoesKey,
 	}
 	defaultMarkPriceConfig = &types.CompositePriceConfiguration{
 		DecayWeight:        num.DecimalZero(),
@@ -56,6 +60,8 @@ type governanceSnapshotState struct {
 	serialisedEnacted        []byte
 	serialisedNodeValidation []byte
 	serialisedBatchActive    []byte
+	serialisedVoteDelegation []byte
+	serialisedProposalVetoes []byte
 }
 
 func (e *Engine) OnStateLoaded(ctx context.Context) error {
@@ -231,6 +237,67 @@ func (e *Engine) serialiseNodeProposals() ([]byte, error) {
 	return proto.Marshal(pl.IntoProto())
 }
 
+// serialiseVoteDelegations returns the governance vote delegations of the parties.
+func (e *Engine) serialiseVoteDelegations() ([]byte, error) {
+	delegators := maps.Keys(e.voteDelegations)
+	sort.Strings(delegators)
+	delegations := make([]*snapshotpb.VoteDelegation, 0, len(delegators))
+	for _, delegator := range delegators {
+		d := e.voteDelegations[delegator]
+		proposalTypes := maps.Keys(d.perType)
+		sort.Slice(proposalTypes, func(i, j int) bool { return proposalTypes[i] < proposalTypes[j] })
+		perType := make([]*snapshotpb.ProposalTypeVoteDelegation, 0, len(proposalTypes))
+		for _, t := range proposalTypes {
+			perType = append(perType, &snapshotpb.ProposalTypeVoteDelegation{
+				ProposalType: t.IntoProto(),
+				Delegate:     d.perType[t],
+			})
+		}
+		delegations = append(delegations, &snapshotpb.VoteDelegation{
+			Delegator:      delegator,
+			GlobalDelegate: d.global,
+			PerType:        perType,
+		})
+	}
+
+	pl := types.Payload{
+		Data: &types.PayloadGovernanceVoteDelegations{
+			GovernanceVoteDelegations: &snapshotpb.GovernanceVoteDelegations{
+				Delegations: delegations,
+			},
+		},
+	}
+	return proto.Marshal(pl.IntoProto())
+}
+
+// serialiseProposalVetoes returns the parties which vetoed the passed proposals.
+func (e *Engine) serialiseProposalVetoes() ([]byte, error) {
+	proposalIDs := maps.Keys(e.proposalVetoes)
+	sort.Strings(proposalIDs)
+	vetoes := make([]*snapshotpb.ProposalVetoes, 0, len(proposalIDs))
+	for _, id := range proposalIDs {
+		v := e.proposalVetoes[id]
+		council := maps.Keys(v.council)
+		sort.Strings(council)
+		tokenHolders := maps.Keys(v.tokenHolders)
+		sort.Strings(tokenHolders)
+		vetoes = append(vetoes, &snapshotpb.ProposalVetoes{
+			ProposalId:     id,
+			CouncilMembers: council,
+			TokenHolders:   tokenHolders,
+		})
+	}
+
+	pl := types.Payload{
+		Data: &types.PayloadGovernanceProposalVetoes{
+			GovernanceProposalVetoes: &snapshotpb.GovernanceProposalVetoes{
+				Vetoes: vetoes,
+			},
+		},
+	}
+	return proto.Marshal(pl.IntoProto())
+}
+
 func (e *Engine) serialiseK(serialFunc func() ([]byte, error), dataField *[]byte) ([]byte, error) {
 	data, err := serialFunc()
 	if err != nil {
@@ -250,6 +317,10 @@ func (e *Engine) serialise(k string) ([]byte, error) {
 		return e.serialiseK(e.serialiseNodeProposals, &e.gss.serialisedNodeValidation)
 	case batchActiveKey:
 		return e.serialiseK(e.serialiseBatchActiveProposals, &e.gss.serialisedBatchActive)
+	case voteDelegationKey:
+		return e.serialiseK(e.serialiseVoteDelegations, &e.gss.serialisedVoteDelegation)
+	case proposalVetoesKey:
+		return e.serialiseK(e.serialiseProposalVetoes, &e.gss.serialisedProposalVetoes)
 	default:
 		return nil, types.ErrSnapshotKeyDoesNotExist
 	}
@@ -287,6 +358,10 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 		return nil, e.restoreNodeProposals(ctx, pl.GovernanceNode, p)
 	case *types.PayloadGovernanceBatchActive:
 		return nil, e.restoreBatchActiveProposals(ctx, pl.GovernanceBatchActive, p)
+	case *types.PayloadGovernanceVoteDelegations:
+		return nil, e.restoreVoteDelegations(pl.GovernanceVoteDelegations, p)
+	case *types.PayloadGovernanceProposalVetoes:
+		return nil, e.restoreProposalVetoes(pl.GovernanceProposalVetoes, p)
 	default:
 		return nil, types.ErrUnknownSnapshotType
 	}
@@ -465,6 +540,46 @@ func (e *Engine) restoreNodeProposals(ctx context.Context, node *types.Governanc
 	return err
 }
 
+func (e *Engine) restoreVoteDelegations(delegations *snapshotpb.GovernanceVoteDelegations, p *types.Payload) error {
+	e.voteDelegations = make(map[string]*voteDelegation, len(delegations.Delegations))
+	for _, d := range delegations.Delegations {
+		vd := &voteDelegation{
+			global:  d.GlobalDelegate,
+			perType: make(map[types.ProposalTermsType]string, len(d.PerType)),
+		}
+		for _, pt := range d.PerType {
+			t, err := types.ProposalTermsTypeFromProto(pt.ProposalType)
+			if err != nil {
+				return err
+			}
+			vd.perType[t] = pt.Delegate
+		}
+		e.voteDelegations[d.Delegator] = vd
+	}
+
+	var err error
+	e.gss.serialisedVoteDelegation, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
+func (e *Engine) restoreProposalVetoes(vetoes *snapshotpb.GovernanceProposalVetoes, p *types.Payload) error {
+	e.proposalVetoes = make(map[string]*proposalVetoes, len(vetoes.Vetoes))
+	for _, v := range vetoes.Vetoes {
+		pv := newProposalVetoes()
+		for _, member := range v.CouncilMembers {
+			pv.council[member] = struct{}{}
+		}
+		for _, party := range v.TokenHolders {
+			pv.tokenHolders[party] = struct{}{}
+		}
+		e.proposalVetoes[v.ProposalId] = pv
+	}
+
+	var err error
+	e.gss.serialisedProposalVetoes, err = proto.Marshal(p.IntoProto())
+	return err
+}
+
 // votesAsSlice returns a sorted slice of votes from a given map of votes.
 func votesAsSlice(votes map[string]*types.Vote) []*types.Vote {
 	ret := make([]*types.Vote, 0, len(votes))
@@ -0,0 +1,170 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"context"
+	"errors"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+var (
+	ErrNotSecurityCouncilMember = errors.New("party is not a member of the security council")
+	ErrProposalCannotBeVetoed   = errors.New("only passed proposals waiting for their enactment can be vetoed")
+	ErrProposalAlreadyVetoed    = errors.New("proposal already vetoed by this party")
+	ErrVetoRequiresTokens       = errors.New("veto requires governance tokens")
+)
+
+const (
+	councilVetoedProposalDetails     = "vetoed by the security council"
+	tokenHolderVetoedProposalDetails = "vetoed by the token holders"
+)
+
+// proposalVetoes holds the parties which vetoed a passed proposal, the members of
+// the security council and the token holders.
+type proposalVetoes struct {
+	council      map[string]struct{}
+	tokenHolders map[string]struct{}
+}
+
+func newProposalVetoes() *proposalVetoes {
+	return &proposalVetoes{
+		council:      map[string]struct{}{},
+		tokenHolders: map[string]struct{}{},
+	}
+}
+
+// VetoProposal records the veto of a passed proposal, during its veto window. The veto of
+// a member of the security council counts towards the threshold of the council, the
+// veto of any other party is a token-holder veto, weighted by its governance token
+// balance, when they are enabled. Once either threshold is reached the proposal is
+// vetoed and won't be enacted.
+func (e *Engine) VetoProposal(ctx context.Context, party, proposalID string) error {
+	council := e.getSecurityCouncil()
+	_, isMember := council.Members[party]
+	requiredParticipation, _ := e.netp.GetDecimal(netparams.GovernanceProposalVetoRequiredParticipation)
+	if !isMember && requiredParticipation.IsZero() {
+		return ErrNotSecurityCouncilMember
+	}
+
+	p, ok := e.getProposal(proposalID)
+	if !ok || !p.IsPassed() || !e.isInVetoWindow(p.Proposal, e.timeService.GetTimeNow().Unix()) {
+		return ErrProposalCannotBeVetoed
+	}
+
+	vetoes, ok := e.proposalVetoes[proposalID]
+	if !ok {
+		vetoes = newProposalVetoes()
+	}
+
+	var vetoed bool
+	if isMember {
+		if _, ok := vetoes.council[party]; ok {
+			return ErrProposalAlreadyVetoed
+		}
+		vetoes.council[party] = struct{}{}
+		vetoed = len(vetoes.council) >= council.Threshold
+		if vetoed {
+			p.ErrorDetails = councilVetoedProposalDetails
+		}
+	} else {
+		if _, ok := vetoes.tokenHolders[party]; ok {
+			return ErrProposalAlreadyVetoed
+		}
+		if balance, err := e.accs.GetAvailableBalance(party); err != nil || balance.IsZero() {
+			return ErrVetoRequiresTokens
+		}
+		vetoes.tokenHolders[party] = struct{}{}
+		vetoed = e.tokenHoldersVetoed(vetoes, requiredParticipation)
+		if vetoed {
+			p.ErrorDetails = tokenHolderVetoedProposalDetails
+		}
+	}
+	e.proposalVetoes[proposalID] = vetoes
+	if !vetoed {
+		return nil
+	}
+
+	e.log.Info("proposal vetoed",
+		logging.ProposalID(proposalID),
+		logging.String("details", p.ErrorDetails))
+	p.State = types.ProposalStateVetoed
+	e.broker.Send(events.NewProposalEvent(ctx, *p.Proposal))
+	e.removeActiveProposalByID(ctx, proposalID)
+	return nil
+}
+
+// tokenHoldersVetoed returns true if the token holders vetoing the proposal hold
+// the required share of the governance token supply.
+func (e *Engine) tokenHoldersVetoed(vetoes *proposalVetoes, requiredParticipation num.Decimal) bool {
+	vetoing := num.UintZero()
+	for party := range vetoes.tokenHolders {
+		if balance, err := e.accs.GetAvailableBalance(party); err == nil {
+			vetoing.AddSum(balance)
+		}
+	}
+	threshold := num.DecimalFromUint(e.accs.GetStakingAssetTotalSupply()).Mul(requiredParticipation)
+	return num.DecimalFromUint(vetoing).GreaterThanOrEqual(threshold)
+}
+
+// isInVetoWindow returns true if the security council can still veto the proposal,
+// in which case it cannot be enacted yet. The window starts when the proposal
+// closes, fast-tracked proposals don't have one.
+func (e *Engine) isInVetoWindow(p *types.Proposal, now int64) bool {
+	if e.isFastTrackProposal(p) {
+		return false
+	}
+	window, _ := e.netp.GetDuration(netparams.GovernanceProposalVetoWindow)
+	return window > 0 && now <= p.Terms.ClosingTimestamp+int64(window.Seconds())
+}
+
+// isFastTrackProposal returns true for the emergency proposals allowed to close and
+// be enacted sooner: the market suspensions and the delayed withdrawal cancellations,
+// when fast-tracking is enabled.
+func (e *Engine) isFastTrackProposal(p *types.Proposal) bool {
+	minClose, _ := e.netp.GetDuration(netparams.GovernanceProposalFastTrackMinClose)
+	if minClose == 0 {
+		return false
+	}
+	if p.Terms.GetCancelDelayedWithdrawal() != nil {
+		return true
+	}
+	update := p.Terms.GetMarketStateUpdate()
+	return update != nil && update.Changes != nil && update.Changes.UpdateType == types.MarketStateUpdateTypeSuspend
+}
+
+// fastTrackProposalParameters returns the proposal parameters with the minimum
+// close and enactment durations of the fast-tracked proposals.
+func (e *Engine) fastTrackProposalParameters(params *types.ProposalParameters) *types.ProposalParameters {
+	fastTrack := *params
+	fastTrack.MinClose, _ = e.netp.GetDuration(netparams.GovernanceProposalFastTrackMinClose)
+	fastTrack.MinEnact, _ = e.netp.GetDuration(netparams.GovernanceProposalFastTrackMinEnact)
+	return &fastTrack
+}
+
+func (e *Engine) getSecurityCouncil() *types.SecurityCouncil {
+	v, _ := e.netp.Get(netparams.GovernanceSecurityCouncil)
+	council, err := types.ParseSecurityCouncil(v)
+	if err != nil {
+		return &types.SecurityCouncil{}
+	}
+	return council
+}
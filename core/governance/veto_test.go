@@ -0,0 +1,221 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/governance"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	councilMember1 = "69464e35bcb8e8a2900ca0f87acaf252d50cf2ab2fc73694845a16b7c8a0dc6f"
+	councilMember2 = "c8744329a7ba1ef6a2fd0b6bee8a3cd5d3fc6c3e3a44ac0ed3e4c0a3dfc3b1b2"
+)
+
+func TestProposalVeto(t *testing.T) {
+	t.Run("passed proposal isn't enacted during the veto window", testPassedProposalWaitsForVetoWindow)
+	t.Run("proposal is declined once enough council members vetoed it", testSecurityCouncilVetoesProposal)
+	t.Run("only council members can veto a proposal", testVetoByNonCouncilMember)
+	t.Run("proposal cannot be vetoed outside the veto window", testVetoOutsideVetoWindow)
+	t.Run("proposal is vetoed once enough token holders vetoed it", testTokenHoldersVetoProposal)
+	t.Run("token holders need governance tokens to veto a proposal", testTokenHolderVetoRequiresTokens)
+	t.Run("vetoes are restored from a snapshot", testProposalVetoesSnapshotRoundTrip)
+}
+
+func setupSecurityCouncil(t *testing.T, eng *tstEngine) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceProposalVetoWindow, "72h0m0s"))
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceSecurityCouncil,
+		`{"members": ["`+councilMember1+`", "`+councilMember2+`"], "threshold": 2}`))
+}
+
+func submitPassedNetParamProposal(t *testing.T, eng *tstEngine) types.Proposal {
+	t.Helper()
+	proposer := eng.newValidParty("proposer", 1)
+	p := eng.newProposalForNetParam(proposer.Id, netparams.MarketAuctionMaximumDuration, "10h", eng.tsvc.GetTimeNow().Add(5*24*time.Hour))
+	_, err := eng.submitProposal(t, p)
+	require.NoError(t, err)
+
+	voter := eng.newValidParty("voter", 7)
+	require.NoError(t, eng.addYesVote(t, voter.Id, p.ID))
+
+	eng.accounts.EXPECT().GetStakingAssetTotalSupply().AnyTimes().Return(num.NewUint(9))
+	eng.OnTick(context.Background(), time.Unix(p.Terms.ClosingTimestamp, 0).Add(time.Second))
+	return p
+}
+
+func testPassedProposalWaitsForVetoWindow(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	p := submitPassedNetParamProposal(t, eng)
+
+	// the veto window, starting at the closing time, is still open at the enactment time.
+	enacted, _ := eng.OnTick(context.Background(), time.Unix(p.Terms.EnactmentTimestamp, 0).Add(time.Second))
+	assert.Empty(t, enacted)
+
+	enacted, _ = eng.OnTick(context.Background(), time.Unix(p.Terms.ClosingTimestamp, 0).Add(73*time.Hour))
+	require.Len(t, enacted, 1)
+	assert.Equal(t, p.ID, enacted[0].Proposal().ID)
+}
+
+func testSecurityCouncilVetoesProposal(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	lastProposal := captureLastProposalEvent(eng)
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	p := submitPassedNetParamProposal(t, eng)
+
+	require.NoError(t, eng.VetoProposal(ctx, councilMember1, p.ID))
+	require.ErrorIs(t, eng.VetoProposal(ctx, councilMember1, p.ID), governance.ErrProposalAlreadyVetoed)
+	require.NoError(t, eng.VetoProposal(ctx, councilMember2, p.ID))
+	assert.Equal(t, types.ProposalStateVetoed, lastProposal().State)
+	assert.Equal(t, "vetoed by the security council", lastProposal().GetErrorDetails())
+
+	// the proposal is gone.
+	require.ErrorIs(t, eng.VetoProposal(ctx, councilMember1, p.ID), governance.ErrProposalCannotBeVetoed)
+	enacted, _ := eng.OnTick(ctx, time.Unix(p.Terms.ClosingTimestamp, 0).Add(73*time.Hour))
+	assert.Empty(t, enacted)
+}
+
+func testVetoByNonCouncilMember(t *testing.T) {
+	eng := getTestEngine(t, time.Now())
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	p := submitPassedNetParamProposal(t, eng)
+
+	require.ErrorIs(t, eng.VetoProposal(context.Background(), "someone", p.ID), governance.ErrNotSecurityCouncilMember)
+}
+
+func testVetoOutsideVetoWindow(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	p := submitPassedNetParamProposal(t, eng)
+
+	// the window closes 72h after the closing time.
+	eng.setTimeNow(time.Unix(p.Terms.ClosingTimestamp, 0).Add(73 * time.Hour))
+	require.ErrorIs(t, eng.VetoProposal(ctx, councilMember1, p.ID), governance.ErrProposalCannotBeVetoed)
+
+	// there is no window when it is disabled.
+	eng.setTimeNow(time.Unix(p.Terms.ClosingTimestamp, 0).Add(time.Hour))
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceProposalVetoWindow, "0s"))
+	require.ErrorIs(t, eng.VetoProposal(ctx, councilMember1, p.ID), governance.ErrProposalCannotBeVetoed)
+}
+
+func testTokenHoldersVetoProposal(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	lastProposal := captureLastProposalEvent(eng)
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceProposalVetoRequiredParticipation, "0.5"))
+	p := submitPassedNetParamProposal(t, eng)
+
+	// 2 out of a supply of 9 isn't enough.
+	holder1 := eng.newValidParty("holder1", 2)
+	holder2 := eng.newValidParty("holder2", 3)
+	require.NoError(t, eng.VetoProposal(ctx, holder1.Id, p.ID))
+	require.ErrorIs(t, eng.VetoProposal(ctx, holder1.Id, p.ID), governance.ErrProposalAlreadyVetoed)
+	assert.Equal(t, types.ProposalStatePassed, lastProposal().State)
+
+	// 5 out of 9 is.
+	require.NoError(t, eng.VetoProposal(ctx, holder2.Id, p.ID))
+	assert.Equal(t, types.ProposalStateVetoed, lastProposal().State)
+	assert.Equal(t, "vetoed by the token holders", lastProposal().GetErrorDetails())
+
+	enacted, _ := eng.OnTick(ctx, time.Unix(p.Terms.ClosingTimestamp, 0).Add(73*time.Hour))
+	assert.Empty(t, enacted)
+}
+
+func testTokenHolderVetoRequiresTokens(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceProposalVetoRequiredParticipation, "0.5"))
+	p := submitPassedNetParamProposal(t, eng)
+
+	require.ErrorIs(t, eng.VetoProposal(ctx, "no-tokens", p.ID), governance.ErrVetoRequiresTokens)
+}
+
+func testProposalVetoesSnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	key := (&types.PayloadGovernanceProposalVetoes{}).Key()
+	eng := getTestEngine(t, time.Now())
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	eng.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	setupSecurityCouncil(t, eng)
+	require.NoError(t, eng.netp.Update(ctx, netparams.GovernanceProposalVetoRequiredParticipation, "0.5"))
+	p := submitPassedNetParamProposal(t, eng)
+
+	holder := eng.newValidParty("holder", 2)
+	require.NoError(t, eng.VetoProposal(ctx, councilMember1, p.ID))
+	require.NoError(t, eng.VetoProposal(ctx, holder.Id, p.ID))
+
+	state, _, err := eng.GetState(key)
+	require.NoError(t, err)
+
+	snap := &snapshotpb.Payload{}
+	require.NoError(t, proto.Unmarshal(state, snap))
+	vetoes := snap.GetGovernanceProposalVetoes().Vetoes
+	require.Len(t, vetoes, 1)
+	assert.Equal(t, p.ID, vetoes[0].ProposalId)
+	assert.Equal(t, []string{councilMember1}, vetoes[0].CouncilMembers)
+	assert.Equal(t, []string{holder.Id}, vetoes[0].TokenHolders)
+
+	snapEng := getTestEngine(t, time.Now())
+	_, err = snapEng.LoadState(ctx, types.PayloadFromProto(snap))
+	require.NoError(t, err)
+
+	restored, _, err := snapEng.GetState(key)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(state, restored))
+}
+
+// captureLastProposalEvent returns a function giving the proposal of the last
+// proposal event sent by the engine.
+func captureLastProposalEvent(eng *tstEngine) func() *vegapb.Proposal {
+	var last *vegapb.Proposal
+	eng.broker.EXPECT().Send(gomock.Any()).AnyTimes().Do(func(evt events.Event) {
+		if pe, ok := evt.(*events.Proposal); ok {
+			last = pe.StreamMessage().GetProposal()
+		}
+	})
+	return func() *vegapb.Proposal { return last }
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+
+	"golang.org/x/exp/maps"
+)
+
+var (
+	ErrCannotDelegateVoteToSelf = errors.New("cannot delegate vote to self")
+	ErrNoVoteDelegation         = errors.New("no vote delegation to revoke")
+)
+
+// voteDelegation holds the parties a delegator gives their governance weight to,
+// for all the proposals, and for the proposals of a given type. The delegation for
+// the type of a proposal takes precedence.
+type voteDelegation struct {
+	global  string
+	perType map[types.ProposalTermsType]string
+}
+
+// DelegateVote delegates the governance weight of the delegator to the delegate,
+// for the proposals of the given type, or for all of them if no type is given.
+// The weight is counted with the delegate's votes, unless the delegator votes
+// on the proposal themselves. Delegations aren't transitive.
+func (e *Engine) DelegateVote(_ context.Context, delegator, delegate string, proposalType *types.ProposalTermsType) error {
+	if delegator == delegate {
+		return ErrCannotDelegateVoteToSelf
+	}
+
+	d, ok := e.voteDelegations[delegator]
+	if !ok {
+		d = &voteDelegation{perType: map[types.ProposalTermsType]string{}}
+		e.voteDelegations[delegator] = d
+	}
+	if proposalType == nil {
+		d.global = delegate
+	} else {
+		d.perType[*proposalType] = delegate
+	}
+
+	e.log.Debug("governance vote delegated",
+		logging.PartyID(delegator),
+		logging.String("delegate", delegate))
+	return nil
+}
+
+// RevokeVoteDelegation revokes the delegation of the delegator for the proposals
+// of the given type, or its global delegation if no type is given. The votes of
+// the open proposals are weighed with the delegations in place when they close.
+func (e *Engine) RevokeVoteDelegation(_ context.Context, delegator string, proposalType *types.ProposalTermsType) error {
+	d, ok := e.voteDelegations[delegator]
+	if !ok {
+		return ErrNoVoteDelegation
+	}
+	if proposalType == nil {
+		if len(d.global) == 0 {
+			return ErrNoVoteDelegation
+		}
+		d.global = ""
+	} else {
+		if _, ok := d.perType[*proposalType]; !ok {
+			return ErrNoVoteDelegation
+		}
+		delete(d.perType, *proposalType)
+	}
+
+	if len(d.global) == 0 && len(d.perType) == 0 {
+		delete(e.voteDelegations, delegator)
+	}
+	return nil
+}
+
+// GetVoteDelegate returns the party the delegator's governance weight goes to for
+// the proposals of the given type.
+func (e *Engine) GetVoteDelegate(delegator string, proposalType types.ProposalTermsType) (string, bool) {
+	d, ok := e.voteDelegations[delegator]
+	if !ok {
+		return "", false
+	}
+	if delegate, ok := d.perType[proposalType]; ok {
+		return delegate, true
+	}
+	return d.global, len(d.global) > 0
+}
+
+// votingAccounts returns the staking accounts weighing the votes of a proposal of
+// the given type: the balance of a party includes the balances delegated to it by
+// the parties which haven't voted.
+func (e *Engine) votingAccounts(proposalType types.ProposalTermsType, yes, no map[string]*types.Vote) StakingAccounts {
+	if len(e.voteDelegations) == 0 {
+		return e.accs
+	}
+
+	delegators := maps.Keys(e.voteDelegations)
+	sort.Strings(delegators)
+	delegated := map[string]*num.Uint{}
+	for _, delegator := range delegators {
+		if _, ok := yes[delegator]; ok {
+			continue
+		}
+		if _, ok := no[delegator]; ok {
+			continue
+		}
+		delegate, ok := e.GetVoteDelegate(delegator, proposalType)
+		if !ok {
+			continue
+		}
+		balance, err := e.accs.GetAvailableBalance(delegator)
+		if err != nil || balance.IsZero() {
+			continue
+		}
+		if sum, ok := delegated[delegate]; ok {
+			sum.AddSum(balance)
+		} else {
+			delegated[delegate] = balance.Clone()
+		}
+	}
+	return &delegatedStakingAccounts{StakingAccounts: e.accs, delegated: delegated}
+}
+
+type delegatedStakingAccounts struct {
+	StakingAccounts
+	// party -> balance delegated to it
+	delegated map[string]*num.Uint
+}
+
+func (d *delegatedStakingAccounts) GetAvailableBalance(party string) (*num.Uint, error) {
+	balance, err := d.StakingAccounts.GetAvailableBalance(party)
+	delegated, ok := d.delegated[party]
+	if !ok {
+		return balance, err
+	}
+	if err != nil {
+		return delegated.Clone(), nil
+	}
+	return num.Sum(balance, delegated), nil
+}
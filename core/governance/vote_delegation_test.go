@@ -0,0 +1,147 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package governance_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/governance"
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/proto"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteDelegation(t *testing.T) {
+	t.Run("the delegated balance is counted with the vote of the delegate", testDelegatedBalanceCountedWithDelegateVote)
+	t.Run("the delegated balance isn't counted when the delegator votes", testDelegatorVoteOverridesDelegation)
+	t.Run("delegations can be revoked", testRevokeVoteDelegation)
+	t.Run("delegations are restored from a snapshot", testVoteDelegationSnapshotRoundTrip)
+}
+
+func testDelegatedBalanceCountedWithDelegateVote(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	proposer := eng.newValidParty("proposer", 1)
+	delegate := eng.newValidParty("delegate", 1)
+	eng.ensureTokenBalanceForParty(t, "delegator", 7)
+	require.NoError(t, eng.DelegateVote(ctx, "delegator", delegate.Id, nil))
+
+	p := eng.newProposalForNetParam(proposer.Id, netparams.MarketAuctionMaximumDuration, "10h", eng.tsvc.GetTimeNow().Add(5*24*time.Hour))
+	eng.expectOpenProposalEvent(t, proposer.Id, p.ID)
+	_, err := eng.submitProposal(t, p)
+	require.NoError(t, err)
+
+	eng.expectVoteEvent(t, delegate.Id, p.ID)
+	require.NoError(t, eng.addYesVote(t, delegate.Id, p.ID))
+
+	eng.ensureStakingAssetTotalSupply(t, 9)
+	eng.expectPassedProposalEvent(t, p.ID)
+	eng.expectTotalGovernanceTokenFromVoteEvents(t, "1", "8")
+	eng.OnTick(ctx, time.Unix(p.Terms.ClosingTimestamp, 0).Add(time.Second))
+}
+
+func testDelegatorVoteOverridesDelegation(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	proposer := eng.newValidParty("proposer", 1)
+	delegate := eng.newValidParty("delegate", 1)
+	delegator := eng.newValidParty("delegator", 7)
+
+	// the delegation for the type of the proposal takes precedence.
+	netParamUpdate := types.ProposalTermsTypeUpdateNetworkParameter
+	require.NoError(t, eng.DelegateVote(ctx, delegator.Id, "someone-else", nil))
+	require.NoError(t, eng.DelegateVote(ctx, delegator.Id, delegate.Id, &netParamUpdate))
+	got, ok := eng.GetVoteDelegate(delegator.Id, netParamUpdate)
+	require.True(t, ok)
+	assert.Equal(t, delegate.Id, got)
+
+	p := eng.newProposalForNetParam(proposer.Id, netparams.MarketAuctionMaximumDuration, "10h", eng.tsvc.GetTimeNow().Add(5*24*time.Hour))
+	eng.expectOpenProposalEvent(t, proposer.Id, p.ID)
+	_, err := eng.submitProposal(t, p)
+	require.NoError(t, err)
+
+	eng.expectVoteEvent(t, delegate.Id, p.ID)
+	require.NoError(t, eng.addYesVote(t, delegate.Id, p.ID))
+	eng.expectVoteEvent(t, delegator.Id, p.ID)
+	require.NoError(t, eng.addNoVote(t, delegator.Id, p.ID))
+
+	// 1 yes against 7 no.
+	eng.ensureStakingAssetTotalSupply(t, 9)
+	eng.expectDeclinedProposalEvent(t, p.ID, types.ProposalErrorMajorityThresholdNotReached)
+	eng.expectVoteEvents(t)
+	eng.OnTick(ctx, time.Unix(p.Terms.ClosingTimestamp, 0).Add(time.Second))
+}
+
+func testRevokeVoteDelegation(t *testing.T) {
+	ctx := context.Background()
+	eng := getTestEngine(t, time.Now())
+	netParamUpdate := types.ProposalTermsTypeUpdateNetworkParameter
+
+	require.ErrorIs(t, eng.DelegateVote(ctx, "delegator", "delegator", nil), governance.ErrCannotDelegateVoteToSelf)
+	require.ErrorIs(t, eng.RevokeVoteDelegation(ctx, "delegator", nil), governance.ErrNoVoteDelegation)
+
+	require.NoError(t, eng.DelegateVote(ctx, "delegator", "delegate", nil))
+	require.ErrorIs(t, eng.RevokeVoteDelegation(ctx, "delegator", &netParamUpdate), governance.ErrNoVoteDelegation)
+	require.NoError(t, eng.RevokeVoteDelegation(ctx, "delegator", nil))
+
+	_, ok := eng.GetVoteDelegate("delegator", netParamUpdate)
+	assert.False(t, ok)
+}
+
+func testVoteDelegationSnapshotRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	key := (&types.PayloadGovernanceVoteDelegations{}).Key()
+	eng := getTestEngine(t, time.Now())
+	netParamUpdate := types.ProposalTermsTypeUpdateNetworkParameter
+	newAsset := types.ProposalTermsTypeNewAsset
+
+	require.NoError(t, eng.DelegateVote(ctx, "delegator1", "delegate1", nil))
+	require.NoError(t, eng.DelegateVote(ctx, "delegator1", "delegate2", &netParamUpdate))
+	require.NoError(t, eng.DelegateVote(ctx, "delegator2", "delegate2", &newAsset))
+
+	state, _, err := eng.GetState(key)
+	require.NoError(t, err)
+
+	snap := &snapshotpb.Payload{}
+	require.NoError(t, proto.Unmarshal(state, snap))
+
+	snapEng := getTestEngine(t, time.Now())
+	_, err = snapEng.LoadState(ctx, types.PayloadFromProto(snap))
+	require.NoError(t, err)
+
+	restored, _, err := snapEng.GetState(key)
+	require.NoError(t, err)
+	assert.True(t, bytes.Equal(state, restored))
+
+	got, ok := snapEng.GetVoteDelegate("delegator1", types.ProposalTermsTypeNewAsset)
+	require.True(t, ok)
+	assert.Equal(t, "delegate1", got)
+	got, ok = snapEng.GetVoteDelegate("delegator1", netParamUpdate)
+	require.True(t, ok)
+	assert.Equal(t, "delegate2", got)
+	got, ok = snapEng.GetVoteDelegate("delegator2", newAsset)
+	require.True(t, ok)
+	assert.Equal(t, "delegate2", got)
+	_, ok = snapEng.GetVoteDelegate("delegator2", netParamUpdate)
+	assert.False(t, ok)
+}
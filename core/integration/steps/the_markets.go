@@ -489,7 +489,7 @@ func newPerpMarket(config *market.Config, row marketRow) types.Market {
 		ID:                    row.id(),
 		DecimalPlaces:         row.decimalPlaces(),
 		PositionDecimalPlaces: row.positionDecimalPlaces(),
-		Fees:                  types.FeesFromProto(fees),
+		Fees:                  mustFeesFromProto(fees),
 		LiquidationStrategy:   liqStrat,
 		TradableInstrument: &types.TradableInstrument{
 			Instrument: &types.Instrument{
@@ -538,6 +538,14 @@ func newPerpMarket(config *market.Config, row marketRow) types.Market {
 	return m
 }
 
+func mustFeesFromProto(fees *proto.Fees) *types.Fees {
+	f, err := types.FeesFromProto(fees)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
 func newMarket(config *market.Config, row marketRow) types.Market {
 	fees, err := config.FeesConfig.Get(row.fees())
 	if err != nil {
@@ -611,7 +619,7 @@ func newMarket(config *market.Config, row marketRow) types.Market {
 		ID:                    row.id(),
 		DecimalPlaces:         row.decimalPlaces(),
 		PositionDecimalPlaces: row.positionDecimalPlaces(),
-		Fees:                  types.FeesFromProto(fees),
+		Fees:                  mustFeesFromProto(fees),
 		LiquidationStrategy:   liqStrat,
 		TradableInstrument: &types.TradableInstrument{
 			Instrument: &types.Instrument{
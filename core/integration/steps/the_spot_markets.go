@@ -164,7 +164,7 @@ func newSpotMarket(config *market.Config, row spotMarketRow) types.Market {
 		ID:                    row.id(),
 		DecimalPlaces:         row.decimalPlaces(),
 		PositionDecimalPlaces: row.positionDecimalPlaces(),
-		Fees:                  types.FeesFromProto(fees),
+		Fees:                  mustFeesFromProto(fees),
 		TradableInstrument: &types.TradableInstrument{
 			Instrument: &types.Instrument{
 				ID:   fmt.Sprintf("Crypto/%s/Spots", row.id()),
@@ -155,25 +155,10 @@ func (e *Engine) doesLPMeetsCommitment(
 		return false
 	}
 
-	var minPrice, maxPrice num.Decimal
-	if e.auctionState.InAuction() {
-		minPriceFactor := num.Min(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
-		maxPriceFactor := num.Max(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
-
-		// (1.0-market.liquidity.priceRange) x min(last trade price, indicative uncrossing price)
-		minPrice = e.openMinusPriceRange.Mul(minPriceFactor)
-		// (1.0+market.liquidity.priceRange) x max(last trade price, indicative uncrossing price)
-		maxPrice = e.openPlusPriceRange.Mul(maxPriceFactor)
-	} else {
-		// if there is no mid price then LP is not meeting their committed volume of notional.
-		if midPrice.IsZero() {
-			return false
-		}
-		midD := midPrice.ToDecimal()
-		// (1.0 - market.liquidity.priceRange) x mid
-		minPrice = e.openMinusPriceRange.Mul(midD)
-		// (1.0 + market.liquidity.priceRange) x mid
-		maxPrice = e.openPlusPriceRange.Mul(midD)
+	minPrice, maxPrice, ok := e.slaPriceRange(midPrice)
+	// if there is no mid price then LP is not meeting their committed volume of notional.
+	if !ok {
+		return false
 	}
 
 	notionalVolumeBuys := num.DecimalZero()
@@ -205,6 +190,27 @@ func (e *Engine) doesLPMeetsCommitment(
 		notionalVolumeSells.GreaterThanOrEqual(requiredLiquidity)
 }
 
+// slaPriceRange returns the price range within which orders count towards the SLA commitment.
+// It returns false if the range cannot be determined, i.e. there is no mid price in continuous trading.
+func (e *Engine) slaPriceRange(midPrice *num.Uint) (num.Decimal, num.Decimal, bool) {
+	if e.auctionState.InAuction() {
+		minPriceFactor := num.Min(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
+		maxPriceFactor := num.Max(e.orderBook.GetLastTradedPrice(), e.orderBook.GetIndicativePrice()).ToDecimal()
+
+		// (1.0-market.liquidity.priceRange) x min(last trade price, indicative uncrossing price)
+		// (1.0+market.liquidity.priceRange) x max(last trade price, indicative uncrossing price)
+		return e.openMinusPriceRange.Mul(minPriceFactor), e.openPlusPriceRange.Mul(maxPriceFactor), true
+	}
+
+	if midPrice.IsZero() {
+		return num.DecimalZero(), num.DecimalZero(), false
+	}
+	midD := midPrice.ToDecimal()
+	// (1.0 - market.liquidity.priceRange) x mid
+	// (1.0 + market.liquidity.priceRange) x mid
+	return e.openMinusPriceRange.Mul(midD), e.openPlusPriceRange.Mul(midD), true
+}
+
 func (e *Engine) calculateCurrentFeePenalty(timeBookFraction num.Decimal) num.Decimal {
 	one := num.DecimalOne()
 
@@ -294,6 +300,60 @@ func (e *Engine) LiquidityProviderSLAStats(now time.Time) []*types.LiquidityProv
 	return stats
 }
 
+// LiquidityProviderSLAForecast projects the SLA penalties the given party would incur at the end of the
+// current epoch, assuming the commitment status observed at the last block is maintained until epochEnd.
+// It also lists the party's active orders currently sitting outside of the SLA price range.
+func (e *Engine) LiquidityProviderSLAForecast(
+	party string,
+	now, epochEnd time.Time,
+	midPrice *num.Uint,
+) (*types.LiquidityProviderSLAForecast, error) {
+	commitment, ok := e.slaPerformance[party]
+	if !ok {
+		return nil, ErrPartyHaveNoLiquidityProvision
+	}
+
+	currentTimeBookFraction := e.calculateCurrentTimeBookFraction(now, commitment.start, commitment.s)
+	meetsCommitment := !commitment.start.IsZero()
+
+	projectedTimeBookFraction := currentTimeBookFraction
+	if epochEnd.After(now) {
+		s := commitment.s
+		if meetsCommitment {
+			// the LP keeps meeting their commitment until the end of the epoch
+			s += epochEnd.Sub(commitment.start)
+		}
+		projectedTimeBookFraction = e.calculateCurrentTimeBookFraction(epochEnd, time.Time{}, s)
+	}
+
+	feePenalty := e.calculateCurrentFeePenalty(projectedTimeBookFraction)
+	bondPenalty := num.DecimalZero()
+	if projectedTimeBookFraction.LessThan(e.slaParams.CommitmentMinTimeFraction) {
+		bondPenalty = e.calculateBondPenalty(projectedTimeBookFraction)
+	}
+
+	ordersOutsideRange := []string{}
+	if minPrice, maxPrice, ok := e.slaPriceRange(midPrice); ok {
+		for _, o := range e.getAllActiveOrders(party) {
+			price := o.Price.ToDecimal()
+			if price.LessThan(minPrice) || price.GreaterThan(maxPrice) {
+				ordersOutsideRange = append(ordersOutsideRange, o.ID)
+			}
+		}
+		sort.Strings(ordersOutsideRange)
+	}
+
+	return &types.LiquidityProviderSLAForecast{
+		Party:                              party,
+		CurrentEpochFractionOfTimeOnBook:   currentTimeBookFraction,
+		ProjectedEpochFractionOfTimeOnBook: projectedTimeBookFraction,
+		MeetsCommitment:                    meetsCommitment,
+		ProjectedFeePenalty:                e.calculateHysteresisFeePenalty(feePenalty, commitment.previousPenalties.Slice()),
+		ProjectedBondPenalty:               bondPenalty,
+		OrdersOutsidePriceRange:            ordersOutsideRange,
+	}, nil
+}
+
 func (e *Engine) RegisterAllocatedFeesPerParty(feesPerParty map[string]*num.Uint) {
 	e.allocatedFeesStats.RegisterTotalFeesAmountPerParty(feesPerParty)
 }
@@ -625,3 +625,112 @@ func TestSLAParamChangePushesOutOfCommitment(t *testing.T) {
 	stats = te.engine.LiquidityProviderSLAStats(epochEndAgain)
 	require.Equal(t, "0", stats[0].CurrentEpochFractionOfTimeOnBook)
 }
+
+func TestLiquidityProviderSLAForecast(t *testing.T) {
+	testCases := []struct {
+		desc string
+
+		// represents list of active orders by a party on a book in a given block
+		buyOrdersPerBlock   [][]uint64
+		sellsOrdersPerBlock [][]uint64
+
+		// expected result
+		expectedMeetsCommitment bool
+		expectedCurrentFraction num.Decimal
+		expectedFraction        num.Decimal
+		expectedFeePenalty      num.Decimal
+		expectedBondPenalty     num.Decimal
+	}{
+		{
+			desc:                    "Meeting commitment is projected until the end of the epoch",
+			buyOrdersPerBlock:       [][]uint64{{15, 15, 17, 18, 12, 12, 12}, {15, 15, 17, 18, 12, 12, 12}},
+			sellsOrdersPerBlock:     [][]uint64{{15, 15, 17, 18, 12, 12, 12, 25}, {15, 15, 17, 18, 12, 12, 12, 25}},
+			expectedMeetsCommitment: true,
+			expectedCurrentFraction: num.DecimalFromFloat(1),
+			expectedFraction:        num.DecimalFromFloat(1),
+			expectedFeePenalty:      num.DecimalFromFloat(0),
+			expectedBondPenalty:     num.DecimalFromFloat(0),
+		},
+		{
+			desc:                    "Not meeting commitment is projected until the end of the epoch",
+			buyOrdersPerBlock:       [][]uint64{{15, 15, 17, 18, 12, 12, 12}, {}},
+			sellsOrdersPerBlock:     [][]uint64{{15, 15, 17, 18, 12, 12, 12}, {25}},
+			expectedMeetsCommitment: false,
+			expectedCurrentFraction: num.DecimalFromFloat(0.5),
+			expectedFraction:        num.DecimalFromFloat(0.25),
+			expectedFeePenalty:      num.DecimalFromFloat(1),
+			expectedBondPenalty:     num.DecimalFromFloat(0.5),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			te := newTestEngine(t)
+			te.engine.UpdateMarketConfig(te.riskModel, te.priceMonitor)
+
+			idGen := &stubIDGen{}
+			ctx := context.Background()
+			party := "lp-party-1"
+
+			te.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+			te.auctionState.EXPECT().IsOpeningAuction().Return(false).AnyTimes()
+			te.auctionState.EXPECT().InAuction().Return(false).AnyTimes()
+
+			lps := &types.LiquidityProvisionSubmission{
+				MarketID:         te.marketID,
+				CommitmentAmount: num.NewUint(100),
+				Fee:              num.NewDecimalFromFloat(0.5),
+				Reference:        fmt.Sprintf("provision-by-%s", party),
+			}
+
+			_, err := te.engine.SubmitLiquidityProvision(ctx, lps, party, idGen)
+			require.NoError(t, err)
+
+			orders := []*types.Order{}
+			te.orderbook.EXPECT().GetOrdersPerParty(party).DoAndReturn(func(party string) []*types.Order {
+				return orders
+			}).AnyTimes()
+
+			epochStart := time.Now()
+			epochEnd := epochStart.Add(4 * time.Second)
+
+			one := num.UintOne()
+			positionFactor := num.DecimalOne()
+			midPrice := num.NewUint(15)
+
+			te.engine.ResetSLAEpoch(epochStart, one, midPrice, positionFactor)
+			te.engine.ApplyPendingProvisions(ctx, epochStart)
+
+			for i := range tC.buyOrdersPerBlock {
+				orders = generateOrders(*idGen, te.marketID, tC.buyOrdersPerBlock[i], tC.sellsOrdersPerBlock[i])
+
+				te.tsvc.SetTime(epochStart.Add(time.Duration(i) * time.Second))
+				te.engine.EndBlock(one, midPrice, positionFactor)
+			}
+
+			now := epochStart.Add(2 * time.Second)
+			forecast, err := te.engine.LiquidityProviderSLAForecast(party, now, epochEnd, midPrice)
+			require.NoError(t, err)
+
+			require.Equal(t, tC.expectedMeetsCommitment, forecast.MeetsCommitment)
+			require.Truef(t, forecast.CurrentEpochFractionOfTimeOnBook.Equal(tC.expectedCurrentFraction), "actual fraction: %s, expected fraction: %s \n", forecast.CurrentEpochFractionOfTimeOnBook, tC.expectedCurrentFraction)
+			require.Truef(t, forecast.ProjectedEpochFractionOfTimeOnBook.Equal(tC.expectedFraction), "actual fraction: %s, expected fraction: %s \n", forecast.ProjectedEpochFractionOfTimeOnBook, tC.expectedFraction)
+			require.Truef(t, forecast.ProjectedFeePenalty.Equal(tC.expectedFeePenalty), "actual penalty: %s, expected penalty: %s \n", forecast.ProjectedFeePenalty, tC.expectedFeePenalty)
+			require.Truef(t, forecast.ProjectedBondPenalty.Equal(tC.expectedBondPenalty), "actual penalty: %s, expected penalty: %s \n", forecast.ProjectedBondPenalty, tC.expectedBondPenalty)
+
+			// the order priced at 25 sits outside of the [12, 18] SLA price range
+			require.Len(t, forecast.OrdersOutsidePriceRange, 1)
+			for _, o := range orders {
+				if o.Price.EQ(num.NewUint(25)) {
+					require.Equal(t, o.ID, forecast.OrdersOutsidePriceRange[0])
+				}
+			}
+		})
+	}
+
+	t.Run("Party without liquidity provision", func(t *testing.T) {
+		te := newTestEngine(t)
+		_, err := te.engine.LiquidityProviderSLAForecast("unknown-party", time.Now(), time.Now(), num.NewUint(15))
+		require.ErrorIs(t, err, liquidity.ErrPartyHaveNoLiquidityProvision)
+	})
+}
@@ -41,6 +41,7 @@ func (s *Store) Checkpoint() ([]byte, error) {
 			Value: v.String(),
 		})
 	}
+	params.Schedules = s.checkpointSchedules()
 	s.mu.RUnlock()
 	// no net params, we can stop here
 	if len(params.Params) == 0 {
@@ -68,6 +69,7 @@ func (s *Store) Load(ctx context.Context, data []byte) error {
 	if err := s.updateBatch(ctx, np); err != nil {
 		return err
 	}
+	s.loadCheckpointSchedules(params.Schedules)
 	// force the updates dispatch
 	s.OnTick(ctx, time.Time{})
 	return nil
@@ -89,12 +89,14 @@ func defaultNetParams() map[string]value {
 		// markets
 		MarketAggressiveOrderBlockDelay:           NewUint(gteU0).Mutable(true).MustUpdate("1"),
 		MarketMarginScalingFactors:                NewJSON(&proto.ScalingFactors{}, checks.MarginScalingFactor(), checks.MarginScalingFactorRange(num.DecimalOne(), num.DecimalFromInt64(100))).Mutable(true).MustUpdate(`{"search_level": 1.1, "initial_margin": 1.2, "collateral_release": 1.4}`),
+		MarketMarginCrossAssetCollateral:          NewString(types.CheckCrossAssetCollateral).Mutable(true).MustUpdate("[]"),
 		MarketFeeFactorsMakerFee:                  NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.00025"),
 		MarketFeeFactorsInfrastructureFee:         NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.0005"),
 		MarketFeeFactorsBuyBackFee:                NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
 		MarketFeeFactorsTreasuryFee:               NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
 		MarketAuctionMinimumDuration:              NewDuration(gte1s, lte1d).Mutable(true).MustUpdate("30m0s"),
 		MarketAuctionMaximumDuration:              NewDuration(gte1s, lte1mo).Mutable(true).MustUpdate(week),
+		MarketInsurancePoolTreasurySweepFraction:  NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
 		MarketLiquidityTargetStakeTriggeringRatio: NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
 		MarketProbabilityOfTradingTauScaling:      NewDecimal(DecimalGTE(num.MustDecimalFromString("0.0001")), lteD1000).Mutable(true).MustUpdate("1"),
 		MarketMinProbabilityOfTradingForLPOrders:  NewDecimal(DecimalGTE(num.MustDecimalFromString("1e-12")), DecimalLTE(num.MustDecimalFromString("0.1"))).Mutable(true).MustUpdate("1e-8"),
@@ -158,6 +160,13 @@ func defaultNetParams() map[string]value {
 		GovernanceProposalUpdateMarketRequiredMajorityLP:         NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.66"),
 		GovernanceProposalUpdateMarketMinProposerEquityLikeShare: NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.66"),
 
+		// governance veto window and fast-track
+		GovernanceProposalVetoWindow:                NewDuration(gte0s, lte1y).Mutable(true).MustUpdate("0s"),
+		GovernanceSecurityCouncil:                   NewString(types.CheckSecurityCouncil).Mutable(true).MustUpdate(`{"members": [], "threshold": 0}`),
+		GovernanceProposalVetoRequiredParticipation: NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
+		GovernanceProposalFastTrackMinClose:         NewDuration(gte0s, lte1y).Mutable(true).MustUpdate("0s"),
+		GovernanceProposalFastTrackMinEnact:         NewDuration(gte0s, lte1y).Mutable(true).MustUpdate("0s"),
+
 		// governance UpdateNetParam proposal
 		GovernanceProposalUpdateNetParamMinClose:              NewDuration(gte1s, lte1y).Mutable(true).MustUpdate("48h0m0s"),
 		GovernanceProposalUpdateNetParamMaxClose:              NewDuration(gte1s, lte1y).Mutable(true).MustUpdate("8760h0m0s"),
@@ -226,7 +235,8 @@ func defaultNetParams() map[string]value {
 		GovernanceProposalAutomatedPurchaseConfigMinVoterBalance:       NewUint(gteU1, ltMaxU).Mutable(true).MustUpdate("1000000000000000000"),
 
 		// Delegation default params
-		DelegationMinAmount: NewDecimal(gtD0).Mutable(true).MustUpdate("1"),
+		DelegationMinAmount:                NewDecimal(gtD0).Mutable(true).MustUpdate("1"),
+		DelegationMaxRedelegationsPerEpoch: NewUint(gteU0).Mutable(true).MustUpdate("1"),
 
 		// staking and delegation
 		StakingAndDelegationRewardPayoutFraction:          NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("1.0"),
@@ -241,6 +251,7 @@ func defaultNetParams() map[string]value {
 
 		// team rewards - //TODO review the constraint and defaults
 		MinEpochsInTeamForMetricRewardEligibility: NewInt(gteI0, lteI500).Mutable(true).MustUpdate("5"),
+		RewardsQuotingDepthMaxSpread:              NewDecimal(gtD0, lteD1).Mutable(true).MustUpdate("0.01"),
 
 		// spam protection policies
 		SpamProtectionMaxVotes:                         NewInt(gteI1).Mutable(true).MustUpdate("3"),
@@ -271,6 +282,10 @@ func defaultNetParams() map[string]value {
 			MustUpdate(`{"configs":[{"network_id":"100","chain_id":"100","confirmations":3,"name":"Gnosis Chain", "block_interval": 3}, {"network_id":"42161","chain_id":"42161","confirmations":3,"name":"Arbitrum One", "block_interval": 50}]}`),
 		BlockchainsPrimaryEthereumConfig: NewJSON(&proto.EthereumConfig{}, types.CheckUntypedEthereumConfig).Mutable(true).
 			MustUpdate(`{"network_id": "XXX", "chain_id": "XXX", "collateral_bridge_contract": { "address": "0xXXX" }, "confirmations": 3, "staking_bridge_contract": { "address": "0xXXX", "deployment_block_height": 0}, "token_vesting_contract": { "address": "0xXXX", "deployment_block_height": 0 }, "multisig_control_contract": { "address": "0xXXX", "deployment_block_height": 0 }}`),
+		BlockchainsWithdrawalBatchingEnabled: NewInt(gteI0, lteI1).Mutable(true).MustUpdate("0"),
+		BlockchainsWithdrawalDelayThresholds: NewString(types.CheckWithdrawalDelayThresholds).Mutable(true).MustUpdate("[]"),
+		BlockchainsWithdrawalDelayPeriod:     NewDuration(gte0s, lte1mo).Mutable(true).MustUpdate("24h0m0s"),
+		BlockchainsWithdrawalGuardians:       NewString(types.CheckWithdrawalGuardians).Mutable(true).MustUpdate("[]"),
 		BlockchainsEVMBridgeConfigs: NewJSON(&proto.EVMBridgeConfigs{}, types.CheckUntypedEVMChainConfig).Mutable(true).
 			MustUpdate(`{"configs": [{"network_id": "XXX", "chain_id": "XXX", "collateral_bridge_contract": { "address": "0xXXX" }, "confirmations": 3, "multisig_control_contract": {"address": "0xXXX", "deployment_block_height": 0}}]}`),
 
@@ -293,6 +308,10 @@ func defaultNetParams() map[string]value {
 		ErsatzvalidatorsRewardFactor:               NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.5"),
 		MultipleOfTendermintValidatorsForEtsatzSet: NewDecimal(gteD0).Mutable(true).MustUpdate("0.5"),
 		MinimumEthereumEventsForNewValidator:       NewUint(gteU0).Mutable(true).MustUpdate("3"),
+		ValidatorsSlashingEnabled:                  NewUint(gteU0, lteU1).Mutable(true).MustUpdate("0"),
+		ValidatorsSlashingMissedHeartbeats:         NewUint(gteU1, UintLTE(num.NewUint(9))).Mutable(true).MustUpdate("5"),
+		ValidatorsSlashingDowntimeFraction:         NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.01"),
+		ValidatorsSlashingDoubleSignFraction:       NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.05"),
 
 		// transfers
 		TransferFeeFactor:                       NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.001"),
@@ -336,6 +355,8 @@ func defaultNetParams() map[string]value {
 		ReferralProgramMaxPartyNotionalVolumeByQuantumPerEpoch: NewUint(UintGTE(num.NewUint(0))).Mutable(true).MustUpdate("250000"),
 		ReferralProgramMinStakedVegaTokens:                     NewUint(UintGTE(num.NewUint(0))).Mutable(true).MustUpdate("0"),
 		ReferralProgramMaxReferralRewardProportion:             NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0.5"),
+		ReferralProgramMaxReferralDepth:                        NewUint(UintGTE(num.NewUint(1)), UintLTE(num.NewUint(5))).Mutable(true).MustUpdate("1"),
+		ReferralProgramUpstreamReferrerRewardShare:             NewDecimal(gteD0, lteD1).Mutable(true).MustUpdate("0"),
 
 		VolumeDiscountProgramMaxVolumeDiscountFactor: NewDecimal(gteD0, DecimalLTE(num.MustDecimalFromString("1"))).Mutable(true).MustUpdate("0.9"),
 		VolumeDiscountProgramMaxBenefitTiers:         NewUint(UintGTE(num.NewUint(0)), UintLTE(num.NewUint(10))).Mutable(true).MustUpdate("10"),
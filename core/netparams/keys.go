@@ -29,9 +29,16 @@ const (
 	MarketFeeFactorsTreasuryFee       = "market.fee.factors.treasuryFee"
 	MarketFeeFactorsBuyBackFee        = "market.fee.factors.buybackFee"
 
+	// assets, with their haircut, counting towards the margin of the markets settled in another asset.
+	MarketMarginCrossAssetCollateral = "market.margin.crossAssetCollateral"
+
 	MarketAuctionMinimumDuration = "market.auction.minimumDuration"
 	MarketAuctionMaximumDuration = "market.auction.maximumDuration"
 
+	// fraction of the insurance pool of a closed market swept to the network treasury,
+	// the remainder goes to the global insurance pool.
+	MarketInsurancePoolTreasurySweepFraction = "market.insurancePool.treasurySweepFraction"
+
 	MinimumMarginQuantumMultiple  = "spam.order.minimumMarginQuantumMultiple"
 	MinimumHoldingQuantumMultiple = "spam.order.minimumHoldingQuantumMultiple"
 
@@ -104,6 +111,18 @@ const (
 	GovernanceProposalUpdateMarketRequiredParticipationLP    = "governance.proposal.updateMarket.requiredParticipationLP"
 	GovernanceProposalUpdateMarketRequiredMajorityLP         = "governance.proposal.updateMarket.requiredMajorityLP"
 
+	// a passed proposal isn't enacted before the end of the veto window following its close.
+	GovernanceProposalVetoWindow = "governance.proposal.vetoWindow"
+	// the keys which can veto a passed proposal, and how many of them are required.
+	GovernanceSecurityCouncil = "governance.securityCouncil"
+	// the share of the governance token supply vetoing a passed proposal for the token holders
+	// to veto it, token-holder vetoes are disabled when it's 0.
+	GovernanceProposalVetoRequiredParticipation = "governance.proposal.vetoRequiredParticipation"
+	// the minimum close and enactment durations of the market suspension and delayed withdrawal
+	// cancellation proposals, fast-tracking is disabled when the minimum close is 0.
+	GovernanceProposalFastTrackMinClose = "governance.proposal.fastTrack.minClose"
+	GovernanceProposalFastTrackMinEnact = "governance.proposal.fastTrack.minEnact"
+
 	// updateNetParam proposal parameters.
 	GovernanceProposalUpdateNetParamMinClose              = "governance.proposal.updateNetParam.minClose"
 	GovernanceProposalUpdateNetParamMaxClose              = "governance.proposal.updateNetParam.maxClose"
@@ -188,6 +207,9 @@ const (
 	RewardMarketCreationQuantumMultiple       = "rewards.marketCreationQuantumMultiple"
 	MinEpochsInTeamForMetricRewardEligibility = "rewards.team.minEpochsInTeam"
 	RewardsUpdateFrequency                    = "rewards.updateFrequency"
+	// RewardsQuotingDepthMaxSpread is the maximum distance from the mid price, as a fraction of it,
+	// within which the orders of a party count towards its quoting depth.
+	RewardsQuotingDepthMaxSpread = "rewards.quotingDepth.maxSpread"
 
 	// spam policies params.
 	SpamProtectionMaxVotes                         = "spam.protection.max.votes"
@@ -216,11 +238,21 @@ const (
 	BlockchainsPrimaryEthereumConfig = "blockchains.ethereumConfig"
 	BlockchainsEVMBridgeConfigs      = "blockchains.evmBridgeConfigs"
 	BlockchainsEthereumL2Configs     = "blockchains.ethereumRpcAndEvmCompatDataSourcesConfig"
+	// batch the withdrawals of an epoch in a single bundle per bridge.
+	BlockchainsWithdrawalBatchingEnabled = "blockchains.withdrawalBatching.enabled"
+	// per asset amount above which a withdrawal waits in the delay queue.
+	BlockchainsWithdrawalDelayThresholds = "blockchains.withdrawalDelay.thresholds"
+	// how long a withdrawal waits in the delay queue.
+	BlockchainsWithdrawalDelayPeriod = "blockchains.withdrawalDelay.period"
+	// keys allowed to cancel a withdrawal in the delay queue.
+	BlockchainsWithdrawalGuardians = "blockchains.withdrawalDelay.guardians"
 
 	// length of epoch in seconds.
 	ValidatorsEpochLength = "validators.epoch.length"
 	// delegation params.
 	DelegationMinAmount = "validators.delegation.minAmount"
+	// maximum number of redelegations a party can make during an epoch.
+	DelegationMaxRedelegationsPerEpoch = "validators.delegation.maxRedelegationsPerEpoch"
 
 	ValidatorsVoteRequired = "validators.vote.required"
 
@@ -240,6 +272,16 @@ const (
 	MultipleOfTendermintValidatorsForEtsatzSet = "network.validators.ersatz.multipleOfTendermintValidators"
 	MinimumEthereumEventsForNewValidator       = "network.validators.minimumEthereumEventsForNewValidator"
 
+	// validators slashing, opt-in.
+	ValidatorsSlashingEnabled = "network.validators.slashing.enabled"
+	// number of heartbeats missed in a row for a validator to be slashed, at most 9
+	// as only the result of the last 10 heartbeats is kept.
+	ValidatorsSlashingMissedHeartbeats = "network.validators.slashing.missedHeartbeats"
+	// fraction of the stake delegated to a validator slashed for prolonged downtime.
+	ValidatorsSlashingDowntimeFraction = "network.validators.slashing.downtimeFraction"
+	// fraction of the stake delegated to a validator slashed for double-signing.
+	ValidatorsSlashingDoubleSignFraction = "network.validators.slashing.doubleSignFraction"
+
 	TransferFeeFactor                       = "transfer.fee.factor"
 	TransferMinTransferQuantumMultiple      = "transfer.minTransferQuantumMultiple"
 	TransferMaxCommandsPerEpoch             = "spam.protection.maxUserTransfersPerEpoch"
@@ -283,6 +325,8 @@ const (
 	ReferralProgramMaxPartyNotionalVolumeByQuantumPerEpoch = "referralProgram.maxPartyNotionalVolumeByQuantumPerEpoch"
 	ReferralProgramMinStakedVegaTokens                     = "referralProgram.minStakedVegaTokens"
 	ReferralProgramMaxReferralRewardProportion             = "referralProgram.maxReferralRewardProportion"
+	ReferralProgramMaxReferralDepth                        = "referralProgram.maxReferralDepth"
+	ReferralProgramUpstreamReferrerRewardShare             = "referralProgram.upstreamReferrerRewardShare"
 
 	// volume discount program.
 	VolumeDiscountProgramMaxBenefitTiers         = "volumeDiscountProgram.maxBenefitTiers"
@@ -325,6 +369,7 @@ var AllKeys = map[string]struct{}{
 	GovernanceProposalVolumeDiscountProgramMinVoterBalance:         {},
 	ReferralProgramMaxReferralRewardProportion:                     {},
 	MinEpochsInTeamForMetricRewardEligibility:                      {},
+	RewardsQuotingDepthMaxSpread:                                   {},
 	RewardsVestingBenefitTiers:                                     {},
 	RewardsVestingMinimumTransfer:                                  {},
 	RewardsActivityStreakInactivityLimit:                           {},
@@ -343,12 +388,14 @@ var AllKeys = map[string]struct{}{
 	RewardMarketCreationQuantumMultiple:                            {},
 	MarketAggressiveOrderBlockDelay:                                {},
 	MarketMarginScalingFactors:                                     {},
+	MarketMarginCrossAssetCollateral:                               {},
 	MarketFeeFactorsMakerFee:                                       {},
 	MarketFeeFactorsInfrastructureFee:                              {},
 	MarketFeeFactorsTreasuryFee:                                    {},
 	MarketFeeFactorsBuyBackFee:                                     {},
 	MarketAuctionMinimumDuration:                                   {},
 	MarketAuctionMaximumDuration:                                   {},
+	MarketInsurancePoolTreasurySweepFraction:                       {},
 	MarketLiquidityBondPenaltyParameter:                            {},
 	MarketLiquidityMaximumLiquidityFeeFactorLevel:                  {},
 	MarketLiquidityTargetStakeTriggeringRatio:                      {},
@@ -388,6 +435,11 @@ var AllKeys = map[string]struct{}{
 	GovernanceProposalAssetMinProposerBalance:                      {},
 	GovernanceProposalAssetMinVoterBalance:                         {},
 	GovernanceProposalUpdateMarketMinClose:                         {},
+	GovernanceProposalVetoWindow:                                   {},
+	GovernanceSecurityCouncil:                                      {},
+	GovernanceProposalVetoRequiredParticipation:                    {},
+	GovernanceProposalFastTrackMinClose:                            {},
+	GovernanceProposalFastTrackMinEnact:                            {},
 	GovernanceProposalUpdateMarketMaxClose:                         {},
 	GovernanceProposalUpdateMarketMinEnact:                         {},
 	GovernanceProposalUpdateMarketMaxEnact:                         {},
@@ -445,6 +497,7 @@ var AllKeys = map[string]struct{}{
 	MarketMinProbabilityOfTradingForLPOrders:                       {},
 	ValidatorsEpochLength:                                          {},
 	DelegationMinAmount:                                            {},
+	DelegationMaxRedelegationsPerEpoch:                             {},
 	StakingAndDelegationRewardPayoutFraction:                       {},
 	StakingAndDelegationRewardMaxPayoutPerParticipant:              {},
 	StakingAndDelegationRewardPayoutDelay:                          {},
@@ -472,6 +525,10 @@ var AllKeys = map[string]struct{}{
 	ErsatzvalidatorsRewardFactor:                                   {},
 	MultipleOfTendermintValidatorsForEtsatzSet:                     {},
 	MinimumEthereumEventsForNewValidator:                           {},
+	ValidatorsSlashingEnabled:                                      {},
+	ValidatorsSlashingMissedHeartbeats:                             {},
+	ValidatorsSlashingDowntimeFraction:                             {},
+	ValidatorsSlashingDoubleSignFraction:                           {},
 	TransferMinTransferQuantumMultiple:                             {},
 	TransferFeeMaxQuantumAmount:                                    {},
 	TransferFeeDiscountDecayFraction:                               {},
@@ -506,6 +563,8 @@ var AllKeys = map[string]struct{}{
 	ReferralProgramMaxReferralDiscountFactor:                       {},
 	ReferralProgramMaxPartyNotionalVolumeByQuantumPerEpoch:         {},
 	ReferralProgramMinStakedVegaTokens:                             {},
+	ReferralProgramMaxReferralDepth:                                {},
+	ReferralProgramUpstreamReferrerRewardShare:                     {},
 	VolumeDiscountProgramMaxBenefitTiers:                           {},
 	VolumeDiscountProgramMaxVolumeDiscountFactor:                   {},
 	SpamProtectionMaxCreateReferralSet:                             {},
@@ -515,6 +574,10 @@ var AllKeys = map[string]struct{}{
 	SpamProtectionApplyReferralMinFunds:                            {},
 	SpamProtectionReferralSetMinFunds:                              {},
 	BlockchainsEthereumL2Configs:                                   {},
+	BlockchainsWithdrawalBatchingEnabled:                           {},
+	BlockchainsWithdrawalDelayThresholds:                           {},
+	BlockchainsWithdrawalDelayPeriod:                               {},
+	BlockchainsWithdrawalGuardians:                                 {},
 	VolumeRebateProgramMaxBenefitTiers:                             {},
 	MinimumMarginQuantumMultiple:                                   {},
 	MinimumHoldingQuantumMultiple:                                  {},
@@ -96,6 +96,11 @@ type Store struct {
 
 	state *snapState
 
+	// schedules are the changes being rolled out, by key.
+	schedules    map[string]*schedule
+	currentEpoch uint64
+	height       uint64
+
 	isProtocolUpgradeRunning bool
 }
 
@@ -112,6 +117,7 @@ func New(log *logging.Logger, cfg Config, broker Broker) *Store {
 		paramUpdates:         map[string]struct{}{},
 		checkpointOverwrites: map[string]struct{}{},
 		state:                newSnapState(store),
+		schedules:            map[string]*schedule{},
 	}
 }
 
@@ -252,6 +258,8 @@ func (s *Store) OnTick(ctx context.Context, _ time.Time) {
 		s.isProtocolUpgradeRunning = false
 	}
 
+	s.applyHeightSchedules(ctx)
+
 	if len(s.paramUpdates) <= 0 {
 		return
 	}
@@ -300,8 +308,13 @@ func (s *Store) Validate(key, value string) error {
 
 // Update will update the stored value for a given key
 // will return an error if the value do not pass validation.
+// Any change scheduled for the key is cancelled.
 func (s *Store) Update(ctx context.Context, key, value string) error {
-	return s.UpdateOptionalValidation(ctx, key, value, true, true)
+	if err := s.UpdateOptionalValidation(ctx, key, value, true, true); err != nil {
+		return err
+	}
+	s.cancelSchedule(key)
+	return nil
 }
 
 func (s *Store) UpdateOptionalValidation(ctx context.Context, key, value string, validate, failIfUnknown bool) error {
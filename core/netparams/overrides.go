@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package netparams
+
+import (
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/types"
+)
+
+var (
+	ErrMarketOverrideNotAllowed = errors.New("network parameter cannot be overridden by a market")
+	ErrMarketOverrideDuplicated = errors.New("network parameter is overridden more than once")
+)
+
+// MarketOverridableKeys are the network parameters a market can set its own value for.
+var MarketOverridableKeys = map[string]struct{}{
+	MarketAuctionMinimumDuration:                     {},
+	MarketAuctionMaximumDuration:                     {},
+	MarketFeeFactorsMakerFee:                         {},
+	MarketFeeFactorsInfrastructureFee:                {},
+	MarketValueWindowLength:                          {},
+	MarketProbabilityOfTradingTauScaling:             {},
+	MarketMinProbabilityOfTradingForLPOrders:         {},
+	MarketMinLpStakeQuantumMultiple:                  {},
+	MarketLiquidityBondPenaltyParameter:              {},
+	MarketLiquidityEarlyExitPenalty:                  {},
+	MarketLiquidityMaximumLiquidityFeeFactorLevel:    {},
+	MarketLiquiditySLANonPerformanceBondPenaltySlope: {},
+	MarketLiquiditySLANonPerformanceBondPenaltyMax:   {},
+	MarketLiquidityStakeToCCYVolume:                  {},
+	MarketLiquidityEquityLikeShareFeeFraction:        {},
+	SpamProtectionMaxStopOrdersPerMarket:             {},
+	MinimumMarginQuantumMultiple:                     {},
+	MinimumHoldingQuantumMultiple:                    {},
+}
+
+// ValidateMarketOverrides checks the network parameters can be overridden by a market
+// and that the values are valid for them. The values are checked against the rules of
+// each network parameter as if the overrides of the market were in effect, so rules
+// depending on another parameter, such as the minimum auction duration being lower
+// than the maximum one, use the value the market sees for it.
+func (s *Store) ValidateMarketOverrides(overrides []*types.NetworkParameter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]struct{}, len(overrides))
+	for _, o := range overrides {
+		if _, ok := MarketOverridableKeys[o.Key]; !ok {
+			return fmt.Errorf("%w: %s", ErrMarketOverrideNotAllowed, o.Key)
+		}
+		if _, ok := seen[o.Key]; ok {
+			return fmt.Errorf("%w: %s", ErrMarketOverrideDuplicated, o.Key)
+		}
+		seen[o.Key] = struct{}{}
+	}
+
+	// put the overrides in effect to validate them against each other,
+	// and restore the network wide values after.
+	previous := make(map[string]string, len(overrides))
+	for _, o := range overrides {
+		previous[o.Key] = s.store[o.Key].String()
+		_ = s.store[o.Key].UpdateOptionalValidation(o.Value, false)
+	}
+	defer func() {
+		for k, v := range previous {
+			_ = s.store[k].UpdateOptionalValidation(v, false)
+		}
+	}()
+
+	for _, o := range overrides {
+		if err := s.store[o.Key].Validate(o.Value); err != nil {
+			return fmt.Errorf("unable to validate %s: %w", o.Key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package netparams_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMarketOverrides(t *testing.T) {
+	netp := getTestNetParams(t)
+
+	require.NoError(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketFeeFactorsMakerFee, Value: "0.001"},
+		{Key: netparams.SpamProtectionMaxStopOrdersPerMarket, Value: "10"},
+	}))
+
+	require.ErrorIs(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.GovernanceProposalMarketMinClose, Value: "1h"},
+	}), netparams.ErrMarketOverrideNotAllowed)
+
+	require.ErrorIs(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketFeeFactorsMakerFee, Value: "0.001"},
+		{Key: netparams.MarketFeeFactorsMakerFee, Value: "0.002"},
+	}), netparams.ErrMarketOverrideDuplicated)
+
+	// the bounds of the network parameters apply to the market values.
+	require.Error(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketFeeFactorsMakerFee, Value: "2"},
+	}))
+	require.Error(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.SpamProtectionMaxStopOrdersPerMarket, Value: "101"},
+	}))
+}
+
+func TestValidateMarketOverridesAgainstEachOther(t *testing.T) {
+	netp := getTestNetParams(t)
+
+	// a maximum auction duration below the network wide minimum is fine
+	// as long as the market lowers its minimum too.
+	require.Error(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketAuctionMaximumDuration, Value: "10m"},
+	}))
+	require.NoError(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketAuctionMaximumDuration, Value: "10m"},
+		{Key: netparams.MarketAuctionMinimumDuration, Value: "5m"},
+	}))
+
+	// the minimum cannot exceed the maximum of the market.
+	require.Error(t, netp.ValidateMarketOverrides([]*types.NetworkParameter{
+		{Key: netparams.MarketAuctionMaximumDuration, Value: "2h"},
+		{Key: netparams.MarketAuctionMinimumDuration, Value: "3h"},
+	}))
+
+	// the network wide values are left untouched.
+	minDuration, err := netp.Get(netparams.MarketAuctionMinimumDuration)
+	require.NoError(t, err)
+	assert.Equal(t, "30m0s", minDuration)
+	maxDuration, err := netp.Get(netparams.MarketAuctionMaximumDuration)
+	require.NoError(t, err)
+	assert.Equal(t, "168h0m0s", maxDuration)
+}
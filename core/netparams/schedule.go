@@ -0,0 +1,338 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package netparams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	vgcontext "code.vegaprotocol.io/vega/libs/context"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	proto "code.vegaprotocol.io/vega/protos/vega"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"golang.org/x/exp/maps"
+)
+
+// maxRampEpochs bounds the number of epochs a parameter can be ramped over, as
+// every intermediate value is validated when the change is scheduled.
+const maxRampEpochs = 1000
+
+var (
+	ErrScheduleInvalidType     = errors.New("invalid network parameter schedule type")
+	ErrScheduleNotRampable     = errors.New("only decimal and integer network parameters can be ramped")
+	ErrScheduleInvalidEpochs   = errors.New("network parameter ramp must span at least one epoch")
+	ErrScheduleTooManyEpochs   = fmt.Errorf("network parameter ramp cannot span more than %d epochs", maxRampEpochs)
+	ErrScheduleInvalidHeight   = errors.New("network parameter schedule height must be positive")
+	ErrScheduleDisallowedParam = errors.New("network parameter cannot be scheduled")
+)
+
+// schedule is a change of network parameter being rolled out.
+type schedule struct {
+	Key        string
+	Type       types.NetworkParameterScheduleType
+	From       string
+	To         string
+	StartEpoch uint64
+	Epochs     uint64
+	Height     uint64
+}
+
+func scheduleFromProto(p *snapshot.NetworkParameterScheduleState) *schedule {
+	return &schedule{
+		Key:        p.Key,
+		Type:       p.Schedule.Type,
+		From:       p.From,
+		To:         p.To,
+		StartEpoch: p.StartEpoch,
+		Epochs:     p.Schedule.Epochs,
+		Height:     p.Schedule.Height,
+	}
+}
+
+func (sc *schedule) IntoProto() *snapshot.NetworkParameterScheduleState {
+	return &snapshot.NetworkParameterScheduleState{
+		Key: sc.Key,
+		Schedule: &proto.NetworkParameterSchedule{
+			Type:   sc.Type,
+			Epochs: sc.Epochs,
+			Height: sc.Height,
+		},
+		From:       sc.From,
+		To:         sc.To,
+		StartEpoch: sc.StartEpoch,
+	}
+}
+
+// valueAt returns the value of the parameter at the given epoch of a ramp.
+func (sc *schedule) valueAt(v value, epoch uint64) string {
+	if epoch < sc.StartEpoch {
+		return sc.From
+	}
+	step := epoch - sc.StartEpoch
+	if step >= sc.Epochs {
+		return sc.To
+	}
+
+	from, _ := num.DecimalFromString(sc.From)
+	to, _ := num.DecimalFromString(sc.To)
+	current := from.Add(to.Sub(from).Mul(num.DecimalFromInt64(int64(step))).Div(num.DecimalFromInt64(int64(sc.Epochs))))
+	switch v.(type) {
+	case *Int:
+		return current.Round(0).String()
+	case *Uint:
+		return current.Floor().String()
+	default:
+		return current.String()
+	}
+}
+
+// ValidateSchedule checks the network parameter can be rolled out to the value with the schedule.
+// For ramps, every intermediate value from the current one must be valid for the parameter.
+func (s *Store) ValidateSchedule(key, value string, sc *types.NetworkParameterSchedule) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.store[key]
+	if !ok {
+		return ErrUnknownKey
+	}
+	if key == BlockchainsPrimaryEthereumConfig || key == BlockchainsEVMBridgeConfigs {
+		return ErrScheduleDisallowedParam
+	}
+
+	switch sc.Type {
+	case types.NetworkParameterScheduleTypeRamp:
+		if sc.Epochs == 0 {
+			return ErrScheduleInvalidEpochs
+		}
+		if sc.Epochs > maxRampEpochs {
+			return ErrScheduleTooManyEpochs
+		}
+		switch v.(type) {
+		case *Decimal, *Int, *Uint:
+		default:
+			return ErrScheduleNotRampable
+		}
+		if _, err := num.DecimalFromString(value); err != nil {
+			return fmt.Errorf("unable to validate %s: %w", key, err)
+		}
+		ramp := &schedule{
+			Key:    key,
+			Type:   sc.Type,
+			From:   v.String(),
+			To:     value,
+			Epochs: sc.Epochs,
+		}
+		for epoch := uint64(1); epoch < sc.Epochs; epoch++ {
+			if err := v.Validate(ramp.valueAt(v, epoch)); err != nil {
+				return fmt.Errorf("unable to validate %s at epoch %d of the ramp: %w", key, epoch, err)
+			}
+		}
+	case types.NetworkParameterScheduleTypeAtHeight:
+		if sc.Height == 0 {
+			return ErrScheduleInvalidHeight
+		}
+	default:
+		return ErrScheduleInvalidType
+	}
+	return nil
+}
+
+// ScheduleUpdate rolls out the change of the network parameter with the schedule,
+// replacing any change already scheduled for the parameter.
+func (s *Store) ScheduleUpdate(ctx context.Context, key, value string, sc *types.NetworkParameterSchedule) error {
+	if err := s.Validate(key, value); err != nil {
+		return err
+	}
+	if err := s.ValidateSchedule(key, value, sc); err != nil {
+		return err
+	}
+
+	// the height is already reached, nothing to wait for.
+	if sc.Type == types.NetworkParameterScheduleTypeAtHeight && sc.Height <= s.height {
+		return s.Update(ctx, key, value)
+	}
+
+	from, err := s.Get(key)
+	if err != nil {
+		return err
+	}
+	s.schedules[key] = &schedule{
+		Key:        key,
+		Type:       sc.Type,
+		From:       from,
+		To:         value,
+		StartEpoch: s.currentEpoch,
+		Epochs:     sc.Epochs,
+		Height:     sc.Height,
+	}
+	s.updateSchedulesState()
+
+	s.log.Info("network parameter change scheduled",
+		logging.String("key", key),
+		logging.String("value", value),
+		logging.String("schedule", sc.String()),
+	)
+	return nil
+}
+
+func (s *Store) OnEpochEvent(ctx context.Context, epoch types.Epoch) {
+	if epoch.Action != proto.EpochAction_EPOCH_ACTION_START {
+		return
+	}
+	s.currentEpoch = epoch.Seq
+	s.applySchedules(ctx, func(sc *schedule) (string, bool) {
+		if sc.Type != types.NetworkParameterScheduleTypeRamp {
+			return "", false
+		}
+		return sc.valueAt(s.store[sc.Key], epoch.Seq), true
+	})
+}
+
+func (s *Store) OnEpochRestore(_ context.Context, epoch types.Epoch) {
+	s.currentEpoch = epoch.Seq
+}
+
+// applyHeightSchedules sets the values of the changes scheduled at or before the current block height.
+func (s *Store) applyHeightSchedules(ctx context.Context) {
+	height, _ := vgcontext.BlockHeightFromContext(ctx)
+	s.height = uint64(height)
+	s.applySchedules(ctx, func(sc *schedule) (string, bool) {
+		if sc.Type != types.NetworkParameterScheduleTypeAtHeight || sc.Height > s.height {
+			return "", false
+		}
+		return sc.To, true
+	})
+}
+
+// applySchedules updates the parameters with the value returned for their schedule, if any, and
+// dispatches them to the watchers in the order of the keys. The schedules are removed once
+// they reach their final value.
+func (s *Store) applySchedules(ctx context.Context, valueFn func(*schedule) (string, bool)) {
+	if len(s.schedules) == 0 {
+		return
+	}
+
+	keys := maps.Keys(s.schedules)
+	sort.Strings(keys)
+	updated := false
+	for _, k := range keys {
+		sc := s.schedules[k]
+		v, ok := valueFn(sc)
+		if !ok {
+			continue
+		}
+		if v != s.store[k].String() {
+			if err := s.UpdateOptionalValidation(ctx, k, v, false, true); err != nil {
+				s.log.Error("could not apply scheduled network parameter change",
+					logging.String("key", k),
+					logging.String("value", v),
+					logging.Error(err),
+				)
+				delete(s.schedules, k)
+				updated = true
+				continue
+			}
+			if err := s.dispatchUpdate(ctx, k); err != nil {
+				s.log.Debug("unable to dispatch netparams update", logging.Error(err))
+			}
+			delete(s.paramUpdates, k)
+		}
+		if v == sc.To {
+			delete(s.schedules, k)
+			updated = true
+		}
+	}
+	if updated {
+		s.updateSchedulesState()
+	}
+}
+
+// cancelSchedule drops the change scheduled for the parameter, if any.
+func (s *Store) cancelSchedule(key string) {
+	if _, ok := s.schedules[key]; !ok {
+		return
+	}
+	delete(s.schedules, key)
+	s.updateSchedulesState()
+}
+
+func (s *Store) updateSchedulesState() {
+	s.state.updateSchedules(s.serialiseSchedules())
+}
+
+// serialiseSchedules returns the pending schedules sorted by key.
+func (s *Store) serialiseSchedules() []*snapshot.NetworkParameterScheduleState {
+	keys := maps.Keys(s.schedules)
+	sort.Strings(keys)
+	schedules := make([]*snapshot.NetworkParameterScheduleState, 0, len(keys))
+	for _, k := range keys {
+		schedules = append(schedules, s.schedules[k].IntoProto())
+	}
+	return schedules
+}
+
+func (s *Store) loadSchedules(schedules []*snapshot.NetworkParameterScheduleState) {
+	s.schedules = make(map[string]*schedule, len(schedules))
+	for _, sc := range schedules {
+		s.schedules[sc.Key] = scheduleFromProto(sc)
+	}
+	s.updateSchedulesState()
+}
+
+// checkpointSchedules returns the pending schedules relative to the current epoch and
+// block height, as both restart from scratch on the network loading the checkpoint.
+// Ramps carry on from the current value over their remaining epochs.
+func (s *Store) checkpointSchedules() []*snapshot.NetworkParameterScheduleState {
+	schedules := s.serialiseSchedules()
+	for _, sc := range schedules {
+		switch sc.Schedule.Type {
+		case types.NetworkParameterScheduleTypeRamp:
+			elapsed := uint64(0)
+			if s.currentEpoch > sc.StartEpoch {
+				elapsed = s.currentEpoch - sc.StartEpoch
+			}
+			sc.From = s.store[sc.Key].String()
+			sc.Schedule.Epochs -= elapsed
+			sc.StartEpoch = 0
+		case types.NetworkParameterScheduleTypeAtHeight:
+			sc.Schedule.Height -= s.height
+		}
+	}
+	return schedules
+}
+
+// loadCheckpointSchedules restores the schedules saved by checkpointSchedules
+// relative to the current epoch and block height.
+func (s *Store) loadCheckpointSchedules(schedules []*snapshot.NetworkParameterScheduleState) {
+	for _, sc := range schedules {
+		if _, ok := s.checkpointOverwrites[sc.Key]; ok {
+			continue
+		}
+		if _, ok := s.store[sc.Key]; !ok {
+			continue
+		}
+		loaded := scheduleFromProto(sc)
+		loaded.StartEpoch = s.currentEpoch
+		loaded.Height += s.height
+		s.schedules[sc.Key] = loaded
+	}
+	s.updateSchedulesState()
+}
@@ -0,0 +1,296 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package netparams_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/netparams"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/libs/proto"
+	vgtest "code.vegaprotocol.io/vega/libs/test"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledUpdates(t *testing.T) {
+	t.Run("validate schedule", testValidateSchedule)
+	t.Run("validate the intermediate values of a ramp", testValidateRampIntermediateValues)
+	t.Run("ramp a decimal over epochs", testRampDecimal)
+	t.Run("ramp a uint over epochs", testRampUint)
+	t.Run("apply at block height", testApplyAtHeight)
+	t.Run("update cancels the schedule", testUpdateCancelsSchedule)
+	t.Run("schedules are restored from snapshot", testSchedulesSnapshotRoundTrip)
+	t.Run("schedules are restored from checkpoint", testSchedulesCheckpointRoundTrip)
+}
+
+func startEpoch(ctx context.Context, netp *testNetParams, seq uint64) {
+	netp.OnEpochEvent(ctx, types.Epoch{Seq: seq, Action: vegapb.EpochAction_EPOCH_ACTION_START})
+}
+
+func testValidateSchedule(t *testing.T) {
+	netp := getTestNetParams(t)
+
+	ramp := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeRamp, Epochs: 2}
+	require.NoError(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", ramp))
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.GovernanceProposalMarketMinClose, "72h0m0s", ramp), netparams.ErrScheduleNotRampable)
+	require.ErrorIs(t, netp.ValidateSchedule("not.a.param", "5", ramp), netparams.ErrUnknownKey)
+
+	noEpochs := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeRamp}
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", noEpochs), netparams.ErrScheduleInvalidEpochs)
+	tooManyEpochs := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeRamp, Epochs: 1001}
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", tooManyEpochs), netparams.ErrScheduleTooManyEpochs)
+
+	atHeight := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeAtHeight, Height: 10}
+	require.NoError(t, netp.ValidateSchedule(netparams.GovernanceProposalMarketMinClose, "72h0m0s", atHeight))
+	noHeight := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeAtHeight}
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.GovernanceProposalMarketMinClose, "72h0m0s", noHeight), netparams.ErrScheduleInvalidHeight)
+
+	unspecified := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeUnspecified}
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", unspecified), netparams.ErrScheduleInvalidType)
+}
+
+func testValidateRampIntermediateValues(t *testing.T) {
+	ctx := context.Background()
+	netp := getTestNetParams(t)
+
+	// only the end values of the ramp are allowed.
+	errNotAllowed := errors.New("not allowed")
+	require.NoError(t, netp.AddRules(netparams.AddParamRules{
+		Param: netparams.DelegationMinAmount,
+		Rules: []interface{}{netparams.DecimalRule(func(d num.Decimal) error {
+			if !d.Equal(num.DecimalFromInt64(1)) && !d.Equal(num.DecimalFromInt64(5)) {
+				return errNotAllowed
+			}
+			return nil
+		})},
+	}))
+	require.NoError(t, netp.Validate(netparams.DelegationMinAmount, "5"))
+
+	sc := &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeRamp, Epochs: 4}
+	require.ErrorIs(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", sc), errNotAllowed)
+	require.ErrorIs(t, netp.ScheduleUpdate(ctx, netparams.DelegationMinAmount, "5", sc), errNotAllowed)
+
+	// a single epoch ramp has no intermediate value.
+	sc.Epochs = 1
+	require.NoError(t, netp.ValidateSchedule(netparams.DelegationMinAmount, "5", sc))
+}
+
+func testRampDecimal(t *testing.T) {
+	ctx := context.Background()
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	dispatched := []string{}
+	require.NoError(t, netp.Watch(netparams.WatchParam{
+		Param: netparams.DelegationMinAmount,
+		Watcher: func(_ context.Context, d num.Decimal) error {
+			dispatched = append(dispatched, d.String())
+			return nil
+		},
+	}))
+
+	startEpoch(ctx, netp, 10)
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.DelegationMinAmount, "5", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeRamp,
+		Epochs: 4,
+	}))
+
+	// nothing changes until the next epoch.
+	v, err := netp.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	require.Equal(t, "1", v)
+
+	for seq := uint64(11); seq <= 16; seq++ {
+		startEpoch(ctx, netp, seq)
+	}
+	assert.Equal(t, []string{"2", "3", "4", "5"}, dispatched)
+
+	v, err = netp.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	require.Equal(t, "5", v)
+}
+
+func testRampUint(t *testing.T) {
+	ctx := context.Background()
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	startEpoch(ctx, netp, 1)
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.SpamPoWNumberOfPastBlocks, "105", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeRamp,
+		Epochs: 2,
+	}))
+
+	startEpoch(ctx, netp, 2)
+	v, err := netp.Get(netparams.SpamPoWNumberOfPastBlocks)
+	require.NoError(t, err)
+	require.Equal(t, "102", v)
+
+	startEpoch(ctx, netp, 3)
+	v, err = netp.Get(netparams.SpamPoWNumberOfPastBlocks)
+	require.NoError(t, err)
+	require.Equal(t, "105", v)
+}
+
+func testApplyAtHeight(t *testing.T) {
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	var minClose time.Duration
+	require.NoError(t, netp.Watch(netparams.WatchParam{
+		Param: netparams.GovernanceProposalMarketMinClose,
+		Watcher: func(_ context.Context, d time.Duration) error {
+			minClose = d
+			return nil
+		},
+	}))
+
+	netp.OnTick(vgtest.VegaContext("chainid", 5), time.Now())
+	require.NoError(t, netp.ScheduleUpdate(context.Background(), netparams.GovernanceProposalMarketMinClose, "72h0m0s", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeAtHeight,
+		Height: 10,
+	}))
+
+	netp.OnTick(vgtest.VegaContext("chainid", 9), time.Now())
+	require.Zero(t, minClose)
+	v, err := netp.Get(netparams.GovernanceProposalMarketMinClose)
+	require.NoError(t, err)
+	require.Equal(t, "48h0m0s", v)
+
+	netp.OnTick(vgtest.VegaContext("chainid", 10), time.Now())
+	require.Equal(t, 72*time.Hour, minClose)
+	v, err = netp.Get(netparams.GovernanceProposalMarketMinClose)
+	require.NoError(t, err)
+	require.Equal(t, "72h0m0s", v)
+}
+
+func testUpdateCancelsSchedule(t *testing.T) {
+	ctx := context.Background()
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	startEpoch(ctx, netp, 1)
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.DelegationMinAmount, "5", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeRamp,
+		Epochs: 4,
+	}))
+	require.NoError(t, netp.Update(ctx, netparams.DelegationMinAmount, "3"))
+
+	startEpoch(ctx, netp, 2)
+	v, err := netp.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	require.Equal(t, "3", v)
+}
+
+func testSchedulesSnapshotRoundTrip(t *testing.T) {
+	ctx := vgtest.VegaContext("chainid", 100)
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+
+	startEpoch(ctx, netp, 1)
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.DelegationMinAmount, "5", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeRamp,
+		Epochs: 4,
+	}))
+	startEpoch(ctx, netp, 2)
+
+	key := netp.Keys()[0]
+	state, _, err := netp.GetState(key)
+	require.NoError(t, err)
+
+	pl := &snapshot.Payload{}
+	require.NoError(t, proto.Unmarshal(state, pl))
+
+	restored := getTestNetParams(t)
+	restored.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	_, err = restored.LoadState(ctx, types.PayloadFromProto(pl))
+	require.NoError(t, err)
+	restored.OnEpochRestore(ctx, types.Epoch{Seq: 2})
+
+	restoredState, _, err := restored.GetState(key)
+	require.NoError(t, err)
+	require.Equal(t, state, restoredState)
+
+	// both carry on with the ramp in the same way.
+	startEpoch(ctx, netp, 3)
+	startEpoch(ctx, restored, 3)
+
+	v1, err := netp.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	v2, err := restored.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	require.Equal(t, "3", v1)
+	require.Equal(t, v1, v2)
+}
+
+func testSchedulesCheckpointRoundTrip(t *testing.T) {
+	ctx := vgtest.VegaContext("chainid", 100)
+	netp := getTestNetParams(t)
+	netp.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	netp.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+
+	netp.OnTick(ctx, time.Now())
+	startEpoch(ctx, netp, 1)
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.DelegationMinAmount, "5", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeRamp,
+		Epochs: 4,
+	}))
+	require.NoError(t, netp.ScheduleUpdate(ctx, netparams.GovernanceProposalMarketMinClose, "72h0m0s", &types.NetworkParameterSchedule{
+		Type:   types.NetworkParameterScheduleTypeAtHeight,
+		Height: 110,
+	}))
+	startEpoch(ctx, netp, 2)
+
+	cp, err := netp.Checkpoint()
+	require.NoError(t, err)
+
+	// the network loading the checkpoint starts from scratch.
+	restored := getTestNetParams(t)
+	restored.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	restored.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	require.NoError(t, restored.Load(vgtest.VegaContext("chainid", 0), cp))
+
+	v, err := restored.Get(netparams.DelegationMinAmount)
+	require.NoError(t, err)
+	require.Equal(t, "2", v)
+
+	// the ramp carries on over its 3 remaining epochs.
+	for seq, expected := range []string{"2", "3", "4", "5"} {
+		startEpoch(ctx, restored, uint64(seq))
+		v, err = restored.Get(netparams.DelegationMinAmount)
+		require.NoError(t, err)
+		require.Equal(t, expected, v)
+	}
+
+	// and the value is set after the remaining blocks.
+	restored.OnTick(vgtest.VegaContext("chainid", 9), time.Now())
+	v, err = restored.Get(netparams.GovernanceProposalMarketMinClose)
+	require.NoError(t, err)
+	require.Equal(t, "48h0m0s", v)
+	restored.OnTick(vgtest.VegaContext("chainid", 10), time.Now())
+	v, err = restored.Get(netparams.GovernanceProposalMarketMinClose)
+	require.NoError(t, err)
+	require.Equal(t, "72h0m0s", v)
+}
@@ -26,6 +26,7 @@ import (
 	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
 	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	snapshot "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
 
 type snapState struct {
@@ -104,10 +105,15 @@ func (s *snapState) update(k, v string) {
 		s.pl.Params = append(s.pl.Params, &types.NetworkParameter{
 			Key: k,
 		})
+		s.index[k] = i
 	}
 	s.pl.Params[i].Value = v
 }
 
+func (s *snapState) updateSchedules(schedules []*snapshot.NetworkParameterScheduleState) {
+	s.pl.Schedules = schedules
+}
+
 // make Store implement/forward the dataprovider interface
 
 func (s *Store) Namespace() types.SnapshotNamespace {
@@ -144,6 +150,7 @@ func (s *Store) LoadState(ctx context.Context, pl *types.Payload) ([]types.State
 			return nil, err
 		}
 	}
+	s.loadSchedules(np.NetParams.Schedules)
 
 	if vgcontext.InProgressUpgradeFrom(ctx, "v0.76.8") {
 		bridgeConfig := &vegapb.EthereumConfig{}
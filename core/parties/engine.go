@@ -17,21 +17,27 @@ package parties
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"slices"
 
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/crypto"
 	"code.vegaprotocol.io/vega/libs/num"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 
 	"golang.org/x/exp/maps"
 )
 
+// MaxSubAccounts is the maximum number of sub-accounts a party can own.
+const MaxSubAccounts = 64
+
 var (
-	ErrAliasIsReserved   = errors.New("this alias is reserved")
-	ReservedPartyAliases = []string{"network"}
+	ErrAliasIsReserved        = errors.New("this alias is reserved")
+	ErrInvalidSubAccountIndex = fmt.Errorf("sub-account index must be between 1 and %d", MaxSubAccounts)
+	ReservedPartyAliases      = []string{"network"}
 )
 
 type Engine struct {
@@ -40,6 +46,10 @@ type Engine struct {
 	// profiles tracks all parties profiles by party ID.
 	profiles                  map[types.PartyID]*types.PartyProfile
 	minBalanceToUpdateProfile *num.Uint
+
+	// subAccounts maps the sub-accounts to the party owning them. Sub-accounts
+	// are stored as derived keys, this is only a lookup rebuilt on restore.
+	subAccounts map[string]types.PartyID
 }
 
 func (e *Engine) OnMinBalanceForUpdatePartyProfileUpdated(_ context.Context, min *num.Uint) error {
@@ -69,6 +79,43 @@ func (e *Engine) CheckDerivedKeyOwnership(party types.PartyID, derivedKey string
 	return ok
 }
 
+// DeriveSubAccount returns the key of the sub-account with the given index owned by the party.
+func DeriveSubAccount(party types.PartyID, index uint64) string {
+	hash := crypto.Hash([]byte(fmt.Sprintf("subaccount%v%v", party, index)))
+	return hex.EncodeToString(hash)
+}
+
+// CreateSubAccount opens the sub-account with the given index for the party. A
+// sub-account is a derived key of the party, so it has its own general and
+// margin accounts, and its own margin mode on every market.
+func (e *Engine) CreateSubAccount(ctx context.Context, party types.PartyID, index uint64) (string, error) {
+	if index == 0 || index > MaxSubAccounts {
+		return "", ErrInvalidSubAccountIndex
+	}
+
+	subAccount := DeriveSubAccount(party, index)
+	e.AssignDeriveKey(ctx, party, subAccount)
+	e.subAccounts[subAccount] = party
+	return subAccount, nil
+}
+
+// CheckSubAccountOwnership returns true if the key is a sub-account owned by the party.
+func (e *Engine) CheckSubAccountOwnership(party types.PartyID, subAccount string) bool {
+	owner, ok := e.subAccounts[subAccount]
+	return ok && owner == party
+}
+
+// SubAccounts returns the sub-accounts owned by the party, ordered by index.
+func (e *Engine) SubAccounts(party types.PartyID) []string {
+	subAccounts := []string{}
+	for index := uint64(1); index <= MaxSubAccounts; index++ {
+		if subAccount := DeriveSubAccount(party, index); e.CheckSubAccountOwnership(party, subAccount) {
+			subAccounts = append(subAccounts, subAccount)
+		}
+	}
+	return subAccounts
+}
+
 // RelatedKeys returns all keys related to the specified key.
 // If a derived key is provided, it returns all other derived keys and the party key.
 // If a party key is provided, it returns all derived keys and the party key itself.
@@ -139,6 +186,19 @@ func (e *Engine) loadPartiesFromSnapshot(partiesPayload *types.PayloadParties) {
 		}
 
 		e.profiles[profile.PartyID] = profile
+		e.restoreSubAccounts(profile)
+	}
+}
+
+func (e *Engine) restoreSubAccounts(profile *types.PartyProfile) {
+	if len(profile.DerivedKeys) == 0 {
+		return
+	}
+	for index := uint64(1); index <= MaxSubAccounts; index++ {
+		subAccount := DeriveSubAccount(profile.PartyID, index)
+		if _, ok := profile.DerivedKeys[subAccount]; ok {
+			e.subAccounts[subAccount] = profile.PartyID
+		}
 	}
 }
 
@@ -178,6 +238,7 @@ func NewEngine(broker Broker) *Engine {
 
 		profiles:                  map[types.PartyID]*types.PartyProfile{},
 		minBalanceToUpdateProfile: num.UintZero(),
+		subAccounts:               map[string]types.PartyID{},
 	}
 
 	return engine
@@ -16,13 +16,17 @@
 package parties_test
 
 import (
+	"context"
 	"testing"
 
+	"code.vegaprotocol.io/vega/core/parties"
 	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/proto"
 	vgrand "code.vegaprotocol.io/vega/libs/rand"
 	vgtest "code.vegaprotocol.io/vega/libs/test"
 	vegapb "code.vegaprotocol.io/vega/protos/vega"
 	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 
 	"github.com/stretchr/testify/require"
 )
@@ -189,3 +193,50 @@ func TestAssigningDerivedKeys(t *testing.T) {
 		},
 	}, te.engine.ListProfiles())
 }
+
+func TestSubAccounts(t *testing.T) {
+	ctx := vgtest.VegaContext(vgrand.RandomStr(5), vgtest.RandomI64())
+
+	te := newEngine(t)
+
+	party1 := newPartyID(t)
+	party2 := newPartyID(t)
+
+	_, err := te.engine.CreateSubAccount(ctx, party1, 0)
+	require.ErrorIs(t, err, parties.ErrInvalidSubAccountIndex)
+	_, err = te.engine.CreateSubAccount(ctx, party1, parties.MaxSubAccounts+1)
+	require.ErrorIs(t, err, parties.ErrInvalidSubAccountIndex)
+
+	sub1, err := te.engine.CreateSubAccount(ctx, party1, 1)
+	require.NoError(t, err)
+	require.Equal(t, parties.DeriveSubAccount(party1, 1), sub1)
+	sub3, err := te.engine.CreateSubAccount(ctx, party1, 3)
+	require.NoError(t, err)
+
+	// a sub-account is a derived key of its owner.
+	require.True(t, te.engine.CheckSubAccountOwnership(party1, sub1))
+	require.True(t, te.engine.CheckDerivedKeyOwnership(party1, sub1))
+	require.False(t, te.engine.CheckSubAccountOwnership(party2, sub1))
+	require.Equal(t, []string{sub1, sub3}, te.engine.SubAccounts(party1))
+	require.Empty(t, te.engine.SubAccounts(party2))
+
+	// other derived keys are not sub-accounts.
+	te.engine.AssignDeriveKey(ctx, party1, "derivedKey1")
+	require.False(t, te.engine.CheckSubAccountOwnership(party1, "derivedKey1"))
+
+	// the sub-accounts are restored from the derived keys.
+	var state []byte
+	for _, key := range te.engine.Keys() {
+		state, _, err = te.engine.GetState(key)
+		require.NoError(t, err)
+	}
+	payload := &snapshotpb.Payload{}
+	require.NoError(t, proto.Unmarshal(state, payload))
+
+	te2 := newEngine(t)
+	_, err = te2.engine.LoadState(context.Background(), types.PayloadFromProto(payload))
+	require.NoError(t, err)
+	require.True(t, te2.engine.CheckSubAccountOwnership(party1, sub1))
+	require.False(t, te2.engine.CheckSubAccountOwnership(party1, "derivedKey1"))
+	require.Equal(t, []string{sub1, sub3}, te2.engine.SubAccounts(party1))
+}
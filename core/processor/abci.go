@@ -87,6 +87,7 @@ var (
 	ErrSpotMarketProposalDisabled  = errors.New("spot market proposal disabled")
 	ErrPerpsMarketProposalDisabled = errors.New("perps market proposal disabled")
 	ErrAMMPoolDisabled             = errors.New("amm pool disabled")
+	ErrNotSubAccountOwner          = errors.New("sub-account is not owned by the party")
 	ErrOracleDataNormalization     = func(err error) error {
 		return fmt.Errorf("error normalizing incoming oracle data: %w", err)
 	}
@@ -110,6 +111,12 @@ type SpamEngine interface {
 	CheckBlockTx(tx abci.Tx) error
 }
 
+// StateQueries holds the API queries against the state while a block is processed.
+type StateQueries interface {
+	BlockStarted()
+	BlockCommitted()
+}
+
 type PoWEngine interface {
 	api.ProofOfWorkParams
 	BeginBlock(blockHeight uint64, blockHash string, txs []abci.Tx)
@@ -156,6 +163,8 @@ type TeamsEngine interface {
 type PartiesEngine interface {
 	UpdateProfile(context.Context, types.PartyID, *commandspb.UpdatePartyProfile) error
 	CheckSufficientBalanceToUpdateProfile(party types.PartyID, balance *num.Uint) error
+	CreateSubAccount(ctx context.Context, party types.PartyID, index uint64) (string, error)
+	CheckSubAccountOwnership(party types.PartyID, subAccount string) bool
 }
 
 type ReferralProgram interface {
@@ -182,7 +191,7 @@ type BlockchainClient interface {
 
 type ProtocolUpgradeService interface {
 	BeginBlock(ctx context.Context, blockHeight uint64)
-	UpgradeProposal(ctx context.Context, pk string, upgradeBlockHeight uint64, vegaReleaseTag string) error
+	UpgradeProposal(ctx context.Context, pk string, upgradeBlockHeight uint64, vegaReleaseTag string, readiness *commandspb.ProtocolUpgradeReadiness) error
 	TimeForUpgrade() bool
 	GetUpgradeStatus() types.UpgradeStatus
 	SetReadyForUpgrade()
@@ -237,7 +246,7 @@ type App struct {
 	evtForwarder                   EvtForwarder
 	evtHeartbeat                   EvtForwarderHeartbeat
 	primaryChainID                 uint64
-	secondaryChainID               uint64
+	evmBridgeChainIDs              map[uint64]struct{}
 	exec                           ExecutionEngine
 	ghandler                       *genesis.Handler
 	gov                            GovernanceEngine
@@ -274,6 +283,7 @@ type App struct {
 
 	maxBatchSize atomic.Uint64
 	txCache      TxCache
+	stateQueries StateQueries
 }
 
 func NewApp(log *logging.Logger,
@@ -321,6 +331,7 @@ func NewApp(log *logging.Logger,
 	balanceChecker BalanceChecker,
 	partiesEngine PartiesEngine,
 	txCache TxCache,
+	stateQueries StateQueries,
 ) *App {
 	log = log.Named(namedLogger)
 	log.SetLevel(config.Level.Get())
@@ -372,6 +383,7 @@ func NewApp(log *logging.Logger,
 		balanceChecker:                 balanceChecker,
 		partiesEngine:                  partiesEngine,
 		txCache:                        txCache,
+		stateQueries:                   stateQueries,
 	}
 
 	// setup handlers
@@ -379,6 +391,7 @@ func NewApp(log *logging.Logger,
 	app.abci.OnProcessProposal = app.processProposal
 	app.abci.OnInitChain = app.OnInitChain
 	app.abci.OnBeginBlock = app.OnBeginBlock
+	app.abci.OnMisbehaviour = app.top.ProcessMisbehaviour
 	app.abci.OnEndBlock = app.OnEndBlock
 	app.abci.OnCommit = app.OnCommit
 	app.abci.OnCheckTx = app.OnCheckTx
@@ -456,6 +469,14 @@ func NewApp(log *logging.Logger,
 				addDeterministicID(app.DeliverTransferFunds),
 			),
 		).
+		HandleDeliverTx(txn.NewTeamCompetitionCommand,
+			app.SendTransactionResult(
+				addDeterministicID(app.DeliverNewTeamCompetition),
+			),
+		).
+		HandleDeliverTx(txn.CancelTeamCompetitionCommand,
+			app.SendTransactionResult(app.DeliverCancelTeamCompetition),
+		).
 		HandleDeliverTx(txn.SubmitOrderCommand,
 			app.SendTransactionResult(
 				addDeterministicID(app.DeliverSubmitOrder),
@@ -563,6 +584,24 @@ func NewApp(log *logging.Logger,
 		HandleDeliverTx(txn.UpdatePartyProfileCommand,
 			app.SendTransactionResult(app.UpdatePartyProfile),
 		).
+		HandleDeliverTx(txn.CreateSubAccountCommand,
+			app.SendTransactionResult(app.CreateSubAccount),
+		).
+		HandleDeliverTx(txn.CancelDelayedWithdrawalCommand,
+			app.SendTransactionResult(app.DeliverCancelDelayedWithdrawal),
+		).
+		HandleDeliverTx(txn.DelegateVoteCommand,
+			app.SendTransactionResult(app.DeliverDelegateVote),
+		).
+		HandleDeliverTx(txn.VetoProposalCommand,
+			app.SendTransactionResult(app.DeliverVetoProposal),
+		).
+		HandleDeliverTx(txn.RedelegateCommand,
+			app.SendTransactionResult(app.DeliverRedelegate),
+		).
+		HandleDeliverTx(txn.AutoCompoundCommand,
+			app.SendTransactionResult(app.DeliverAutoCompound),
+		).
 		HandleDeliverTx(txn.DelayedTransactionsWrapper,
 			app.SendTransactionResult(app.handleDelayedTransactionWrapper))
 
@@ -698,11 +737,15 @@ func (app *App) ensureConfig() {
 		return
 	}
 
-	secondaryChainID, err := strconv.ParseUint(bridgeConfigs.Configs[0].ChainId, 10, 64)
-	if err != nil {
-		return
+	evmBridgeChainIDs := make(map[uint64]struct{}, len(bridgeConfigs.Configs))
+	for _, cfg := range bridgeConfigs.Configs {
+		chainID, err := strconv.ParseUint(cfg.ChainId, 10, 64)
+		if err != nil {
+			return
+		}
+		evmBridgeChainIDs[chainID] = struct{}{}
 	}
-	app.secondaryChainID = secondaryChainID
+	app.evmBridgeChainIDs = evmBridgeChainIDs
 }
 
 // ReloadConf updates the internal configuration.
@@ -1313,6 +1356,7 @@ func (app *App) OnBeginBlock(blockHeight uint64, blockHash string, blockTime tim
 	app.log.Debug("entering begin block", logging.Time("at", time.Now()), logging.Uint64("height", blockHeight), logging.Time("time", blockTime), logging.String("blockHash", blockHash))
 	defer func() { app.log.Debug("leaving begin block", logging.Time("at", time.Now())) }()
 
+	app.stateQueries.BlockStarted()
 	app.txCache.SetRawTxs(nil, blockHeight)
 
 	ctx := vgcontext.WithBlockHeight(vgcontext.WithTraceID(app.chainCtx, blockHash), blockHeight)
@@ -1504,6 +1548,7 @@ func (app *App) OnCommit() (*tmtypes.ResponseCommit, error) {
 			Height: app.stats.Height(),
 		}),
 	)
+	app.stateQueries.BlockCommitted()
 
 	return &tmtypes.ResponseCommit{}, nil
 }
@@ -1871,8 +1916,13 @@ func (app *App) DeliverBatchMarketInstructions(
 		return err
 	}
 
+	party, err := app.subAccountParty(tx, batch.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	return NewBMIProcessor(app.log, app.exec, Validate{}).
-		ProcessBatch(ctx, batch, tx.Party(), deterministicID, app.stats)
+		ProcessBatch(ctx, batch, party, deterministicID, app.stats)
 }
 
 func (app *App) RequireValidatorMasterPubKey(_ context.Context, tx abci.Tx) error {
@@ -1896,7 +1946,7 @@ func (app *App) DeliverProtocolUpgradeCommand(ctx context.Context, tx abci.Tx) e
 	if err := tx.Unmarshal(pu); err != nil {
 		return err
 	}
-	return app.protocolUpgradeService.UpgradeProposal(ctx, tx.PubKeyHex(), pu.UpgradeBlockHeight, pu.VegaReleaseTag)
+	return app.protocolUpgradeService.UpgradeProposal(ctx, tx.PubKeyHex(), pu.UpgradeBlockHeight, pu.VegaReleaseTag, pu.Readiness)
 }
 
 func (app *App) DeliverAnnounceNode(ctx context.Context, tx abci.Tx) error {
@@ -1975,12 +2025,79 @@ func (app *App) DeliverCancelTransferFunds(ctx context.Context, tx abci.Tx) erro
 	return app.banking.CancelTransferFunds(ctx, types.NewCancelTransferFromProto(tx.Party(), cancel))
 }
 
+func (app *App) DeliverCancelDelayedWithdrawal(ctx context.Context, tx abci.Tx) error {
+	cancel := &commandspb.CancelDelayedWithdrawal{}
+	if err := tx.Unmarshal(cancel); err != nil {
+		return err
+	}
+
+	return app.banking.CancelDelayedWithdrawal(ctx, tx.Party(), cancel.WithdrawalId)
+}
+
+func (app *App) DeliverDelegateVote(ctx context.Context, tx abci.Tx) error {
+	delegation := &commandspb.DelegateVote{}
+	if err := tx.Unmarshal(delegation); err != nil {
+		return err
+	}
+
+	var proposalType *types.ProposalTermsType
+	if delegation.ProposalType != nil {
+		pt, err := types.ProposalTermsTypeFromProto(*delegation.ProposalType)
+		if err != nil {
+			return err
+		}
+		proposalType = &pt
+	}
+
+	if len(delegation.Delegate) == 0 {
+		return app.gov.RevokeVoteDelegation(ctx, tx.Party(), proposalType)
+	}
+	return app.gov.DelegateVote(ctx, tx.Party(), delegation.Delegate, proposalType)
+}
+
+func (app *App) DeliverVetoProposal(ctx context.Context, tx abci.Tx) error {
+	veto := &commandspb.VetoProposal{}
+	if err := tx.Unmarshal(veto); err != nil {
+		return err
+	}
+
+	return app.gov.VetoProposal(ctx, tx.Party(), veto.ProposalId)
+}
+
+func (app *App) DeliverNewTeamCompetition(ctx context.Context, tx abci.Tx, id string) error {
+	params := &commandspb.NewTeamCompetition{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize NewTeamCompetition command: %w", err)
+	}
+
+	competition, err := types.NewTeamCompetitionFromProto(id, tx.Party(), params)
+	if err != nil {
+		return err
+	}
+
+	return app.banking.NewTeamCompetition(ctx, competition)
+}
+
+func (app *App) DeliverCancelTeamCompetition(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.CancelTeamCompetition{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize CancelTeamCompetition command: %w", err)
+	}
+
+	return app.banking.CancelTeamCompetition(ctx, tx.Party(), params.CompetitionId)
+}
+
 func (app *App) DeliverStopOrdersSubmission(ctx context.Context, tx abci.Tx, deterministicID string) error {
 	s := &commandspb.StopOrdersSubmission{}
 	if err := tx.Unmarshal(s); err != nil {
 		return err
 	}
 
+	party, err := app.subAccountParty(tx, s.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	// Convert from proto to domain type
 	os, err := types.NewStopOrderSubmissionFromProto(s)
 	if err != nil {
@@ -1997,7 +2114,7 @@ func (app *App) DeliverStopOrdersSubmission(ctx context.Context, tx abci.Tx, det
 		risesAbove = ptr.From(idgen.NextID())
 	}
 
-	_, err = app.exec.SubmitStopOrders(ctx, os, tx.Party(), idgen, fallsBelow, risesAbove)
+	_, err = app.exec.SubmitStopOrders(ctx, os, party, idgen, fallsBelow, risesAbove)
 	if err != nil {
 		app.log.Error("could not submit stop order",
 			logging.StopOrderSubmission(os), logging.Error(err))
@@ -2012,12 +2129,17 @@ func (app *App) DeliverStopOrdersCancellation(ctx context.Context, tx abci.Tx, d
 		return err
 	}
 
+	party, err := app.subAccountParty(tx, s.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	// Convert from proto to domain type
 	os := types.NewStopOrderCancellationFromProto(s)
 
 	// Submit the create order request to the execution engine
 	idgen := idgeneration.New(deterministicID)
-	err := app.exec.CancelStopOrders(ctx, os, tx.Party(), idgen)
+	err = app.exec.CancelStopOrders(ctx, os, party, idgen)
 	if err != nil {
 		app.log.Error("could not submit stop order",
 			logging.StopOrderCancellation(os), logging.Error(err))
@@ -2034,6 +2156,11 @@ func (app *App) DeliverSubmitOrder(ctx context.Context, tx abci.Tx, deterministi
 
 	app.stats.IncTotalCreateOrder()
 
+	party, err := app.subAccountParty(tx, s.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	// Convert from proto to domain type
 	os, err := types.NewOrderSubmissionFromProto(s)
 	if err != nil {
@@ -2041,7 +2168,7 @@ func (app *App) DeliverSubmitOrder(ctx context.Context, tx abci.Tx, deterministi
 	}
 	// Submit the create order request to the execution engine
 	idgen := idgeneration.New(deterministicID)
-	conf, err := app.exec.SubmitOrder(ctx, os, tx.Party(), idgen, idgen.NextID())
+	conf, err := app.exec.SubmitOrder(ctx, os, party, idgen, idgen.NextID())
 	if conf != nil {
 		if app.log.GetLevel() <= logging.DebugLevel {
 			app.log.Debug("Order confirmed",
@@ -2077,10 +2204,15 @@ func (app *App) DeliverCancelOrder(ctx context.Context, tx abci.Tx, deterministi
 	app.stats.IncTotalCancelOrder()
 	app.log.Debug("Blockchain service received a CANCEL ORDER request", logging.String("order-id", porder.OrderId))
 
+	party, err := app.subAccountParty(tx, porder.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	order := types.OrderCancellationFromProto(porder)
 	// Submit the cancel new order request to the Vega trading core
 	idgen := idgeneration.New(deterministicID)
-	msg, err := app.exec.CancelOrder(ctx, order, tx.Party(), idgen)
+	msg, err := app.exec.CancelOrder(ctx, order, party, idgen)
 	if err != nil {
 		app.log.Error("error on cancelling order", logging.String("order-id", order.OrderID), logging.Error(err))
 		return err
@@ -2107,6 +2239,11 @@ func (app *App) DeliverAmendOrder(
 	app.stats.IncTotalAmendOrder()
 	app.log.Debug("Blockchain service received a AMEND ORDER request", logging.String("order-id", order.OrderId))
 
+	party, err := app.subAccountParty(tx, order.SubAccount)
+	if err != nil {
+		return err
+	}
+
 	// Convert protobuf into local domain type
 	oa, err := types.NewOrderAmendmentFromProto(order)
 	if err != nil {
@@ -2115,7 +2252,7 @@ func (app *App) DeliverAmendOrder(
 
 	// Submit the cancel new order request to the Vega trading core
 	idgen := idgeneration.New(deterministicID)
-	msg, err := app.exec.AmendOrder(ctx, oa, tx.Party(), idgen)
+	msg, err := app.exec.AmendOrder(ctx, oa, party, idgen)
 	if err != nil {
 		app.log.Error("error on amending order", logging.String("order-id", order.OrderId), logging.Error(err))
 		return err
@@ -2702,13 +2839,15 @@ func (app *App) onTick(ctx context.Context, t time.Time) {
 		case toEnact.IsUpdateSpotMarket():
 			app.enactUpdateSpotMarket(ctx, prop, toEnact.UpdateSpotMarket())
 		case toEnact.IsUpdateNetworkParameter():
-			app.enactNetworkParameterUpdate(ctx, prop, toEnact.UpdateNetworkParameter())
+			app.enactNetworkParameterUpdate(ctx, prop, toEnact.UpdateNetworkParameter(), toEnact.UpdateNetworkParameterSchedule())
 		case toEnact.IsFreeform():
 			app.enactFreeform(ctx, prop)
 		case toEnact.IsNewTransfer():
 			app.enactNewTransfer(ctx, prop)
 		case toEnact.IsCancelTransfer():
 			app.enactCancelTransfer(ctx, prop)
+		case toEnact.IsCancelDelayedWithdrawal():
+			app.enactCancelDelayedWithdrawal(ctx, prop)
 		case toEnact.IsMarketStateUpdate():
 			app.enactMarketStateUpdate(ctx, prop)
 		case toEnact.IsReferralProgramUpdate():
@@ -2869,6 +3008,21 @@ func (app *App) enactCancelTransfer(ctx context.Context, prop *types.Proposal) {
 	}
 }
 
+func (app *App) enactCancelDelayedWithdrawal(ctx context.Context, prop *types.Proposal) {
+	prop.State = types.ProposalStateEnacted
+	withdrawalID := prop.Terms.GetCancelDelayedWithdrawal().Changes.WithdrawalID
+	if err := app.banking.VerifyCancelDelayedWithdrawal(withdrawalID); err != nil {
+		app.log.Error("failed to enact delayed withdrawal cancellation - withdrawal is not delayed anymore", logging.String("proposal", prop.ID), logging.String("error", err.Error()))
+		prop.FailWithErr(types.ProposalErrorFailedDelayedWithdrawalCancel, err)
+		return
+	}
+	if err := app.banking.CancelGovDelayedWithdrawal(ctx, prop.ID, withdrawalID); err != nil {
+		app.log.Error("failed to enact delayed withdrawal cancellation", logging.String("proposal", prop.ID), logging.String("error", err.Error()))
+		prop.FailWithErr(types.ProposalErrorFailedDelayedWithdrawalCancel, err)
+		return
+	}
+}
+
 func (app *App) enactMarketStateUpdate(ctx context.Context, prop *types.Proposal) {
 	prop.State = types.ProposalStateEnacted
 	changes := prop.Terms.GetMarketStateUpdate().Changes
@@ -2883,9 +3037,15 @@ func (app *App) enactMarketStateUpdate(ctx context.Context, prop *types.Proposal
 	}
 }
 
-func (app *App) enactNetworkParameterUpdate(ctx context.Context, prop *types.Proposal, np *types.NetworkParameter) {
+func (app *App) enactNetworkParameterUpdate(ctx context.Context, prop *types.Proposal, np *types.NetworkParameter, schedule *types.NetworkParameterSchedule) {
 	prop.State = types.ProposalStateEnacted
-	if err := app.netp.Update(ctx, np.Key, np.Value); err != nil {
+	var err error
+	if schedule != nil {
+		err = app.netp.ScheduleUpdate(ctx, np.Key, np.Value, schedule)
+	} else {
+		err = app.netp.Update(ctx, np.Key, np.Value)
+	}
+	if err != nil {
 		prop.FailUnexpectedly(err)
 		app.log.Error("failed to update network parameters",
 			logging.ProposalID(prop.ID),
@@ -2937,6 +3097,33 @@ func (app *App) DeliverUndelegate(ctx context.Context, tx abci.Tx) (err error) {
 	}
 }
 
+func (app *App) DeliverRedelegate(ctx context.Context, tx abci.Tx) (err error) {
+	ce := &commandspb.RedelegateSubmission{}
+	if err := tx.Unmarshal(ce); err != nil {
+		return err
+	}
+
+	amount := num.UintZero()
+	if len(ce.Amount) > 0 {
+		var overflowed bool
+		if amount, overflowed = num.UintFromString(ce.Amount, 10); overflowed {
+			return errors.New("amount is not a valid base 10 number")
+		}
+	}
+
+	return app.delegation.Redelegate(ctx, tx.Party(), ce.FromNodeId, ce.ToNodeId, amount)
+}
+
+func (app *App) DeliverAutoCompound(_ context.Context, tx abci.Tx) error {
+	ce := &commandspb.AutoCompoundSubmission{}
+	if err := tx.Unmarshal(ce); err != nil {
+		return err
+	}
+
+	app.delegation.SetAutoCompound(tx.Party(), ce.Enabled)
+	return nil
+}
+
 func (app *App) DeliverKeyRotateSubmission(ctx context.Context, tx abci.Tx) error {
 	kr := &commandspb.KeyRotateSubmission{}
 	if err := tx.Unmarshal(kr); err != nil {
@@ -3041,6 +3228,10 @@ func (app *App) UpdateMarginMode(ctx context.Context, tx abci.Tx) error {
 	if err = tx.Unmarshal(params); err != nil {
 		return fmt.Errorf("could not deserialize UpdateMarginMode command: %w", err)
 	}
+	party, err := app.subAccountParty(tx, params.SubAccount)
+	if err != nil {
+		return err
+	}
 	marginFactor := num.DecimalZero()
 	if params.MarginFactor != nil && len(*params.MarginFactor) > 0 {
 		marginFactor, err = num.DecimalFromString(*params.MarginFactor)
@@ -3048,7 +3239,7 @@ func (app *App) UpdateMarginMode(ctx context.Context, tx abci.Tx) error {
 			return err
 		}
 	}
-	return app.exec.UpdateMarginMode(ctx, tx.Party(), params.MarketId, types.MarginMode(params.Mode), marginFactor)
+	return app.exec.UpdateMarginMode(ctx, party, params.MarketId, types.MarginMode(params.Mode), marginFactor)
 }
 
 func (app *App) DeliverSubmitAMM(ctx context.Context, tx abci.Tx, deterministicID string) error {
@@ -3183,6 +3374,31 @@ func (app *App) handleDelayedTransactionWrapper(ctx context.Context, tx abci.Tx)
 	return nil
 }
 
+func (app *App) CreateSubAccount(ctx context.Context, tx abci.Tx) error {
+	params := &commandspb.CreateSubAccount{}
+	if err := tx.Unmarshal(params); err != nil {
+		return fmt.Errorf("could not deserialize CreateSubAccount command: %w", err)
+	}
+
+	if _, err := app.partiesEngine.CreateSubAccount(ctx, types.PartyID(tx.Party()), params.Index); err != nil {
+		return fmt.Errorf("couldn't create sub-account: %w", err)
+	}
+
+	return nil
+}
+
+// subAccountParty returns the party an instruction is submitted for, that is the
+// given sub-account if it's set and owned by the submitter, or the submitter itself.
+func (app *App) subAccountParty(tx abci.Tx, subAccount *string) (string, error) {
+	if subAccount == nil {
+		return tx.Party(), nil
+	}
+	if !app.partiesEngine.CheckSubAccountOwnership(types.PartyID(tx.Party()), *subAccount) {
+		return "", ErrNotSubAccountOwner
+	}
+	return *subAccount, nil
+}
+
 func (app *App) UpdatePartyProfile(ctx context.Context, tx abci.Tx) error {
 	params := &commandspb.UpdatePartyProfile{}
 	if err := tx.Unmarshal(params); err != nil {
@@ -3225,10 +3441,14 @@ func (app *App) OnBlockchainEVMChainConfigUpdate(_ context.Context, conf any) er
 	if err != nil {
 		return err
 	}
-	cID, err := strconv.ParseUint(cfg.Configs[0].ChainID(), 10, 64)
-	if err != nil {
-		return err
+	evmBridgeChainIDs := make(map[uint64]struct{}, len(cfg.Configs))
+	for _, c := range cfg.Configs {
+		cID, err := strconv.ParseUint(c.ChainID(), 10, 64)
+		if err != nil {
+			return err
+		}
+		evmBridgeChainIDs[cID] = struct{}{}
 	}
-	app.secondaryChainID = cID
+	app.evmBridgeChainIDs = evmBridgeChainIDs
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"code.vegaprotocol.io/vega/core/api"
 	"code.vegaprotocol.io/vega/core/blockchain/abci"
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/genesis"
@@ -1016,6 +1017,7 @@ func getTestApp(t *testing.T, cfunc func(), stop func() error, PoW, Spam bool) *
 		balance,
 		parties,
 		txCache,
+		api.NewStateQueries(time.Second),
 	)
 
 	// embed the app
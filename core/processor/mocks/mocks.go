@@ -224,6 +224,32 @@ func (mr *MockDelegationEngineMockRecorder) ProcessEpochDelegations(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessEpochDelegations", reflect.TypeOf((*MockDelegationEngine)(nil).ProcessEpochDelegations), arg0, arg1)
 }
 
+// Redelegate mocks base method.
+func (m *MockDelegationEngine) Redelegate(arg0 context.Context, arg1, arg2, arg3 string, arg4 *num.Uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redelegate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Redelegate indicates an expected call of Redelegate.
+func (mr *MockDelegationEngineMockRecorder) Redelegate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redelegate", reflect.TypeOf((*MockDelegationEngine)(nil).Redelegate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SetAutoCompound mocks base method.
+func (m *MockDelegationEngine) SetAutoCompound(arg0 string, arg1 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAutoCompound", arg0, arg1)
+}
+
+// SetAutoCompound indicates an expected call of SetAutoCompound.
+func (mr *MockDelegationEngineMockRecorder) SetAutoCompound(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoCompound", reflect.TypeOf((*MockDelegationEngine)(nil).SetAutoCompound), arg0, arg1)
+}
+
 // UndelegateAtEndOfEpoch mocks base method.
 func (m *MockDelegationEngine) UndelegateAtEndOfEpoch(arg0 context.Context, arg1, arg2 string, arg3 *num.Uint) error {
 	m.ctrl.T.Helper()
@@ -718,6 +744,20 @@ func (mr *MockGovernanceEngineMockRecorder) AddVote(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVote", reflect.TypeOf((*MockGovernanceEngine)(nil).AddVote), arg0, arg1, arg2)
 }
 
+// DelegateVote mocks base method.
+func (m *MockGovernanceEngine) DelegateVote(arg0 context.Context, arg1, arg2 string, arg3 *types.ProposalTermsType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelegateVote", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelegateVote indicates an expected call of DelegateVote.
+func (mr *MockGovernanceEngineMockRecorder) DelegateVote(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelegateVote", reflect.TypeOf((*MockGovernanceEngine)(nil).DelegateVote), arg0, arg1, arg2, arg3)
+}
+
 // FinaliseEnactment mocks base method.
 func (m *MockGovernanceEngine) FinaliseEnactment(arg0 context.Context, arg1 *types.Proposal) {
 	m.ctrl.T.Helper()
@@ -801,6 +841,20 @@ func (mr *MockGovernanceEngineMockRecorder) RejectProposal(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectProposal", reflect.TypeOf((*MockGovernanceEngine)(nil).RejectProposal), arg0, arg1, arg2, arg3)
 }
 
+// RevokeVoteDelegation mocks base method.
+func (m *MockGovernanceEngine) RevokeVoteDelegation(arg0 context.Context, arg1 string, arg2 *types.ProposalTermsType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVoteDelegation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeVoteDelegation indicates an expected call of RevokeVoteDelegation.
+func (mr *MockGovernanceEngineMockRecorder) RevokeVoteDelegation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVoteDelegation", reflect.TypeOf((*MockGovernanceEngine)(nil).RevokeVoteDelegation), arg0, arg1, arg2)
+}
+
 // SubmitBatchProposal mocks base method.
 func (m *MockGovernanceEngine) SubmitBatchProposal(arg0 context.Context, arg1 types.BatchProposalSubmission, arg2, arg3 string) ([]*governance.ToSubmit, error) {
 	m.ctrl.T.Helper()
@@ -831,6 +885,20 @@ func (mr *MockGovernanceEngineMockRecorder) SubmitProposal(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitProposal", reflect.TypeOf((*MockGovernanceEngine)(nil).SubmitProposal), arg0, arg1, arg2, arg3)
 }
 
+// VetoProposal mocks base method.
+func (m *MockGovernanceEngine) VetoProposal(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VetoProposal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VetoProposal indicates an expected call of VetoProposal.
+func (mr *MockGovernanceEngineMockRecorder) VetoProposal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VetoProposal", reflect.TypeOf((*MockGovernanceEngine)(nil).VetoProposal), arg0, arg1, arg2)
+}
+
 // MockStats is a mock of Stats interface.
 type MockStats struct {
 	ctrl     *gomock.Controller
@@ -1512,6 +1580,18 @@ func (mr *MockValidatorTopologyMockRecorder) ProcessEthereumKeyRotation(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessEthereumKeyRotation", reflect.TypeOf((*MockValidatorTopology)(nil).ProcessEthereumKeyRotation), arg0, arg1, arg2, arg3)
 }
 
+// ProcessMisbehaviour mocks base method.
+func (m *MockValidatorTopology) ProcessMisbehaviour(arg0 context.Context, arg1 []types0.Misbehavior) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProcessMisbehaviour", arg0, arg1)
+}
+
+// ProcessMisbehaviour indicates an expected call of ProcessMisbehaviour.
+func (mr *MockValidatorTopologyMockRecorder) ProcessMisbehaviour(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessMisbehaviour", reflect.TypeOf((*MockValidatorTopology)(nil).ProcessMisbehaviour), arg0, arg1)
+}
+
 // ProcessValidatorHeartbeat mocks base method.
 func (m *MockValidatorTopology) ProcessValidatorHeartbeat(arg0 context.Context, arg1 *v10.ValidatorHeartbeat, arg2 func([]byte, []byte, []byte) error, arg3 func([]byte, []byte, string) error) error {
 	m.ctrl.T.Helper()
@@ -1752,6 +1832,34 @@ func (mr *MockBankingMockRecorder) BridgeStopped(arg0, arg1, arg2, arg3, arg4, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BridgeStopped", reflect.TypeOf((*MockBanking)(nil).BridgeStopped), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// CancelDelayedWithdrawal mocks base method.
+func (m *MockBanking) CancelDelayedWithdrawal(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDelayedWithdrawal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDelayedWithdrawal indicates an expected call of CancelDelayedWithdrawal.
+func (mr *MockBankingMockRecorder) CancelDelayedWithdrawal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDelayedWithdrawal", reflect.TypeOf((*MockBanking)(nil).CancelDelayedWithdrawal), arg0, arg1, arg2)
+}
+
+// CancelGovDelayedWithdrawal mocks base method.
+func (m *MockBanking) CancelGovDelayedWithdrawal(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelGovDelayedWithdrawal", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelGovDelayedWithdrawal indicates an expected call of CancelGovDelayedWithdrawal.
+func (mr *MockBankingMockRecorder) CancelGovDelayedWithdrawal(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelGovDelayedWithdrawal", reflect.TypeOf((*MockBanking)(nil).CancelGovDelayedWithdrawal), arg0, arg1, arg2)
+}
+
 // CancelGovTransfer mocks base method.
 func (m *MockBanking) CancelGovTransfer(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1766,6 +1874,20 @@ func (mr *MockBankingMockRecorder) CancelGovTransfer(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelGovTransfer", reflect.TypeOf((*MockBanking)(nil).CancelGovTransfer), arg0, arg1)
 }
 
+// CancelTeamCompetition mocks base method.
+func (m *MockBanking) CancelTeamCompetition(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelTeamCompetition", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelTeamCompetition indicates an expected call of CancelTeamCompetition.
+func (mr *MockBankingMockRecorder) CancelTeamCompetition(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTeamCompetition", reflect.TypeOf((*MockBanking)(nil).CancelTeamCompetition), arg0, arg1, arg2)
+}
+
 // CancelTransferFunds mocks base method.
 func (m *MockBanking) CancelTransferFunds(arg0 context.Context, arg1 *types.CancelTransferFunds) error {
 	m.ctrl.T.Helper()
@@ -1878,6 +2000,20 @@ func (mr *MockBankingMockRecorder) NewGovernanceTransfer(arg0, arg1, arg2, arg3
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGovernanceTransfer", reflect.TypeOf((*MockBanking)(nil).NewGovernanceTransfer), arg0, arg1, arg2, arg3)
 }
 
+// NewTeamCompetition mocks base method.
+func (m *MockBanking) NewTeamCompetition(arg0 context.Context, arg1 *types.TeamCompetition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTeamCompetition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewTeamCompetition indicates an expected call of NewTeamCompetition.
+func (mr *MockBankingMockRecorder) NewTeamCompetition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTeamCompetition", reflect.TypeOf((*MockBanking)(nil).NewTeamCompetition), arg0, arg1)
+}
+
 // OnBlockEnd mocks base method.
 func (m *MockBanking) OnBlockEnd(arg0 context.Context, arg1 time.Time) {
 	m.ctrl.T.Helper()
@@ -1918,6 +2054,20 @@ func (mr *MockBankingMockRecorder) UpdateERC20(arg0, arg1, arg2, arg3, arg4, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateERC20", reflect.TypeOf((*MockBanking)(nil).UpdateERC20), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// VerifyCancelDelayedWithdrawal mocks base method.
+func (m *MockBanking) VerifyCancelDelayedWithdrawal(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCancelDelayedWithdrawal", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyCancelDelayedWithdrawal indicates an expected call of VerifyCancelDelayedWithdrawal.
+func (mr *MockBankingMockRecorder) VerifyCancelDelayedWithdrawal(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCancelDelayedWithdrawal", reflect.TypeOf((*MockBanking)(nil).VerifyCancelDelayedWithdrawal), arg0)
+}
+
 // VerifyCancelGovernanceTransfer mocks base method.
 func (m *MockBanking) VerifyCancelGovernanceTransfer(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -2052,6 +2202,20 @@ func (mr *MockNetworkParametersMockRecorder) IsUpdateAllowed(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUpdateAllowed", reflect.TypeOf((*MockNetworkParameters)(nil).IsUpdateAllowed), arg0)
 }
 
+// ScheduleUpdate mocks base method.
+func (m *MockNetworkParameters) ScheduleUpdate(arg0 context.Context, arg1, arg2 string, arg3 *types.NetworkParameterSchedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleUpdate indicates an expected call of ScheduleUpdate.
+func (mr *MockNetworkParametersMockRecorder) ScheduleUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUpdate", reflect.TypeOf((*MockNetworkParameters)(nil).ScheduleUpdate), arg0, arg1, arg2, arg3)
+}
+
 // Update mocks base method.
 func (m *MockNetworkParameters) Update(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -3535,17 +3699,17 @@ func (mr *MockProtocolUpgradeServiceMockRecorder) TimeForUpgrade() *gomock.Call
 }
 
 // UpgradeProposal mocks base method.
-func (m *MockProtocolUpgradeService) UpgradeProposal(arg0 context.Context, arg1 string, arg2 uint64, arg3 string) error {
+func (m *MockProtocolUpgradeService) UpgradeProposal(arg0 context.Context, arg1 string, arg2 uint64, arg3 string, arg4 *v10.ProtocolUpgradeReadiness) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeProposal", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "UpgradeProposal", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpgradeProposal indicates an expected call of UpgradeProposal.
-func (mr *MockProtocolUpgradeServiceMockRecorder) UpgradeProposal(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+func (mr *MockProtocolUpgradeServiceMockRecorder) UpgradeProposal(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeProposal", reflect.TypeOf((*MockProtocolUpgradeService)(nil).UpgradeProposal), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeProposal", reflect.TypeOf((*MockProtocolUpgradeService)(nil).UpgradeProposal), arg0, arg1, arg2, arg3, arg4)
 }
 
 // MockEthCallEngine is a mock of EthCallEngine interface.
@@ -3655,6 +3819,20 @@ func (m *MockPartiesEngine) EXPECT() *MockPartiesEngineMockRecorder {
 	return m.recorder
 }
 
+// CheckSubAccountOwnership mocks base method.
+func (m *MockPartiesEngine) CheckSubAccountOwnership(arg0 types.PartyID, arg1 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSubAccountOwnership", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CheckSubAccountOwnership indicates an expected call of CheckSubAccountOwnership.
+func (mr *MockPartiesEngineMockRecorder) CheckSubAccountOwnership(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSubAccountOwnership", reflect.TypeOf((*MockPartiesEngine)(nil).CheckSubAccountOwnership), arg0, arg1)
+}
+
 // CheckSufficientBalanceToUpdateProfile mocks base method.
 func (m *MockPartiesEngine) CheckSufficientBalanceToUpdateProfile(arg0 types.PartyID, arg1 *num.Uint) error {
 	m.ctrl.T.Helper()
@@ -3669,6 +3847,21 @@ func (mr *MockPartiesEngineMockRecorder) CheckSufficientBalanceToUpdateProfile(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSufficientBalanceToUpdateProfile", reflect.TypeOf((*MockPartiesEngine)(nil).CheckSufficientBalanceToUpdateProfile), arg0, arg1)
 }
 
+// CreateSubAccount mocks base method.
+func (m *MockPartiesEngine) CreateSubAccount(arg0 context.Context, arg1 types.PartyID, arg2 uint64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubAccount indicates an expected call of CreateSubAccount.
+func (mr *MockPartiesEngineMockRecorder) CreateSubAccount(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubAccount", reflect.TypeOf((*MockPartiesEngine)(nil).CreateSubAccount), arg0, arg1, arg2)
+}
+
 // UpdateProfile mocks base method.
 func (m *MockPartiesEngine) UpdateProfile(arg0 context.Context, arg1 types.PartyID, arg2 *v10.UpdatePartyProfile) error {
 	m.ctrl.T.Helper()
@@ -176,12 +176,12 @@ func (app *App) processChainEventMultisig(
 	}
 
 	var multisig ERC20MultiSigTopology
-	switch cid {
-	case app.primaryChainID:
+	if cid == app.primaryChainID {
 		multisig = app.primaryErc20MultiSigTopology
-	case app.secondaryChainID:
+	} else if _, ok := app.evmBridgeChainIDs[cid]; ok {
+		// the EVM multisig topologies dispatch the event to the chain it comes from.
 		multisig = app.secondaryErc20MultiSigTopology
-	default:
+	} else {
 		return ErrNotBridgeChainID
 	}
 
@@ -66,6 +66,8 @@ type DelegationEngine interface {
 	Delegate(ctx context.Context, party string, nodeID string, amount *num.Uint) error
 	UndelegateAtEndOfEpoch(ctx context.Context, party string, nodeID string, amount *num.Uint) error
 	UndelegateNow(ctx context.Context, party string, nodeID string, amount *num.Uint) error
+	Redelegate(ctx context.Context, party, fromNodeID, toNodeID string, amount *num.Uint) error
+	SetAutoCompound(party string, enabled bool)
 	ProcessEpochDelegations(ctx context.Context, epoch types.Epoch) []*types.ValidatorData
 	Hash() []byte
 }
@@ -126,6 +128,9 @@ type GovernanceEngine interface {
 	SubmitBatchProposal(context.Context, types.BatchProposalSubmission, string, string) ([]*governance.ToSubmit, error)
 	FinaliseEnactment(ctx context.Context, prop *types.Proposal)
 	AddVote(context.Context, types.VoteSubmission, string) error
+	DelegateVote(ctx context.Context, delegator, delegate string, proposalType *types.ProposalTermsType) error
+	RevokeVoteDelegation(ctx context.Context, delegator string, proposalType *types.ProposalTermsType) error
+	VetoProposal(ctx context.Context, party, proposalID string) error
 	OnTick(context.Context, time.Time) ([]*governance.ToEnact, []*governance.VoteClosed)
 	RejectProposal(context.Context, *types.Proposal, types.ProposalError, error) error
 	RejectBatchProposal(context.Context, string, types.ProposalError, error) error
@@ -187,6 +192,7 @@ type ValidatorTopology interface {
 	AddKeyRotate(ctx context.Context, nodeID string, currentBlockHeight uint64, kr *commandspb.KeyRotateSubmission) error
 	ProcessEthereumKeyRotation(ctx context.Context, nodeID string, kr *commandspb.EthereumKeyRotateSubmission, verify func(message, signature []byte, hexAddress string) error) error
 	BeginBlock(ctx context.Context, blockHeight uint64, proposer string)
+	ProcessMisbehaviour(ctx context.Context, misbehaviour []abcitypes.Misbehavior)
 	GetValidatorPowerUpdates() []abcitypes.ValidatorUpdate
 	ProcessAnnounceNode(ctx context.Context, nr *commandspb.AnnounceNode) error
 	ProcessValidatorHeartbeat(context.Context, *commandspb.ValidatorHeartbeat, func(message, signature, pubkey []byte) error, func(message, signature []byte, hexAddress string) error) error
@@ -245,12 +251,18 @@ type Banking interface {
 	VerifyGovernanceTransfer(transfer *types.NewTransferConfiguration) error
 	VerifyCancelGovernanceTransfer(transferID string) error
 	CancelGovTransfer(ctx context.Context, ID string) error
+	CancelDelayedWithdrawal(ctx context.Context, guardian, id string) error
+	VerifyCancelDelayedWithdrawal(id string) error
+	CancelGovDelayedWithdrawal(ctx context.Context, proposalID, id string) error
+	NewTeamCompetition(ctx context.Context, competition *types.TeamCompetition) error
+	CancelTeamCompetition(ctx context.Context, party, competitionID string) error
 	OnBlockEnd(ctx context.Context, now time.Time)
 }
 
 // NetworkParameters ...
 type NetworkParameters interface {
 	Update(ctx context.Context, key, value string) error
+	ScheduleUpdate(ctx context.Context, key, value string, schedule *types.NetworkParameterSchedule) error
 	DispatchChanges(ctx context.Context)
 	IsUpdateAllowed(key string) error
 	GetInt(key string) (int64, error)
@@ -154,6 +154,22 @@ func (t Tx) Command() txn.Command {
 		return txn.AmendAMMCommand
 	case *commandspb.InputData_CancelAmm:
 		return txn.CancelAMMCommand
+	case *commandspb.InputData_NewTeamCompetition:
+		return txn.NewTeamCompetitionCommand
+	case *commandspb.InputData_CancelTeamCompetition:
+		return txn.CancelTeamCompetitionCommand
+	case *commandspb.InputData_CreateSubAccount:
+		return txn.CreateSubAccountCommand
+	case *commandspb.InputData_CancelDelayedWithdrawal:
+		return txn.CancelDelayedWithdrawalCommand
+	case *commandspb.InputData_DelegateVote:
+		return txn.DelegateVoteCommand
+	case *commandspb.InputData_VetoProposal:
+		return txn.VetoProposalCommand
+	case *commandspb.InputData_RedelegateSubmission:
+		return txn.RedelegateCommand
+	case *commandspb.InputData_AutoCompoundSubmission:
+		return txn.AutoCompoundCommand
 	default:
 		panic(fmt.Sprintf("command %T is not supported", cmd))
 	}
@@ -263,6 +279,22 @@ func (t Tx) GetCmd() interface{} {
 		return cmd.AmendAmm
 	case *commandspb.InputData_CancelAmm:
 		return cmd.CancelAmm
+	case *commandspb.InputData_NewTeamCompetition:
+		return cmd.NewTeamCompetition
+	case *commandspb.InputData_CancelTeamCompetition:
+		return cmd.CancelTeamCompetition
+	case *commandspb.InputData_CreateSubAccount:
+		return cmd.CreateSubAccount
+	case *commandspb.InputData_CancelDelayedWithdrawal:
+		return cmd.CancelDelayedWithdrawal
+	case *commandspb.InputData_DelegateVote:
+		return cmd.DelegateVote
+	case *commandspb.InputData_VetoProposal:
+		return cmd.VetoProposal
+	case *commandspb.InputData_RedelegateSubmission:
+		return cmd.RedelegateSubmission
+	case *commandspb.InputData_AutoCompoundSubmission:
+		return cmd.AutoCompoundSubmission
 	case *commandspb.InputData_DelayedTransactionsWrapper:
 		return cmd.DelayedTransactionsWrapper
 	default:
@@ -494,6 +526,54 @@ func (t Tx) Unmarshal(i interface{}) error {
 			return errors.New("failed to unmarshall to CancelAMM")
 		}
 		*underlyingCmd = *cmd.CancelAmm
+	case *commandspb.InputData_NewTeamCompetition:
+		underlyingCmd, ok := i.(*commandspb.NewTeamCompetition)
+		if !ok {
+			return errors.New("failed to unmarshall to NewTeamCompetition")
+		}
+		*underlyingCmd = *cmd.NewTeamCompetition
+	case *commandspb.InputData_CancelTeamCompetition:
+		underlyingCmd, ok := i.(*commandspb.CancelTeamCompetition)
+		if !ok {
+			return errors.New("failed to unmarshall to CancelTeamCompetition")
+		}
+		*underlyingCmd = *cmd.CancelTeamCompetition
+	case *commandspb.InputData_CreateSubAccount:
+		underlyingCmd, ok := i.(*commandspb.CreateSubAccount)
+		if !ok {
+			return errors.New("failed to unmarshall to CreateSubAccount")
+		}
+		*underlyingCmd = *cmd.CreateSubAccount
+	case *commandspb.InputData_CancelDelayedWithdrawal:
+		underlyingCmd, ok := i.(*commandspb.CancelDelayedWithdrawal)
+		if !ok {
+			return errors.New("failed to unmarshall to CancelDelayedWithdrawal")
+		}
+		*underlyingCmd = *cmd.CancelDelayedWithdrawal
+	case *commandspb.InputData_DelegateVote:
+		underlyingCmd, ok := i.(*commandspb.DelegateVote)
+		if !ok {
+			return errors.New("failed to unmarshall to DelegateVote")
+		}
+		*underlyingCmd = *cmd.DelegateVote
+	case *commandspb.InputData_VetoProposal:
+		underlyingCmd, ok := i.(*commandspb.VetoProposal)
+		if !ok {
+			return errors.New("failed to unmarshall to VetoProposal")
+		}
+		*underlyingCmd = *cmd.VetoProposal
+	case *commandspb.InputData_RedelegateSubmission:
+		underlyingCmd, ok := i.(*commandspb.RedelegateSubmission)
+		if !ok {
+			return errors.New("failed to unmarshall to RedelegateSubmission")
+		}
+		*underlyingCmd = *cmd.RedelegateSubmission
+	case *commandspb.InputData_AutoCompoundSubmission:
+		underlyingCmd, ok := i.(*commandspb.AutoCompoundSubmission)
+		if !ok {
+			return errors.New("failed to unmarshall to AutoCompoundSubmission")
+		}
+		*underlyingCmd = *cmd.AutoCompoundSubmission
 	case *commandspb.InputData_DelayedTransactionsWrapper:
 		underlyingCmd, ok := i.(*commandspb.DelayedTransactionsWrapper)
 		if !ok {
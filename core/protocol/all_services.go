@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"code.vegaprotocol.io/vega/core/activitystreak"
+	"code.vegaprotocol.io/vega/core/api"
 	"code.vegaprotocol.io/vega/core/assets"
 	"code.vegaprotocol.io/vega/core/banking"
 	"code.vegaprotocol.io/vega/core/blockchain"
@@ -131,6 +132,7 @@ type allServices struct {
 
 	partiesEngine *parties.SnapshottedEngine
 	txCache       *txcache.TxCache
+	stateQueries  *api.StateQueries
 
 	assets                *assets.Service
 	topology              *validators.Topology
@@ -152,11 +154,12 @@ type allServices struct {
 	primaryBridgeView           *bridges.ERC20LogicView
 	primaryMultisig             *erc20multisig.Topology
 
-	secondaryEventForwarderEngine EventForwarderEngine
-	secondaryEthConfirmations     *ethclient.EthereumConfirmations
-	secondaryEthClient            *ethclient.SecondaryClient
-	secondaryBridgeView           *bridges.ERC20LogicView
-	secondaryMultisig             *erc20multisig.Topology
+	// the clients to the EVM chains hosting a collateral bridge, other than
+	// the primary one, only validators are connected to them.
+	evmBridgeClients *ethclient.EVMBridgeClients
+	// chain-id -> what the node uses to interact with the bridge of the chain.
+	evmBridges   map[string]*evmBridgeServices
+	evmMultisigs *erc20multisig.EVMTopologies
 
 	// staking
 	stakingAccounts *staking.Accounting
@@ -184,27 +187,26 @@ func newServices(
 	conf *config.Watcher,
 	nodeWallets *nodewallets.NodeWallets,
 	primaryEthClient *ethclient.PrimaryClient,
-	secondaryEthClient *ethclient.SecondaryClient,
 	primaryEthConfirmations *ethclient.EthereumConfirmations,
-	secondaryEthConfirmations *ethclient.EthereumConfirmations,
+	evmBridgeClients *ethclient.EVMBridgeClients,
 	blockchainClient *blockchain.Client,
 	vegaPaths paths.Paths,
 	stats *stats.Stats,
 	l2Clients *ethclient.L2Clients,
 ) (_ *allServices, err error) {
 	svcs := &allServices{
-		ctx:                       ctx,
-		log:                       log,
-		confWatcher:               conf,
-		conf:                      conf.Get(),
-		primaryEthClient:          primaryEthClient,
-		secondaryEthClient:        secondaryEthClient,
-		l2Clients:                 l2Clients,
-		primaryEthConfirmations:   primaryEthConfirmations,
-		secondaryEthConfirmations: secondaryEthConfirmations,
-		blockchainClient:          blockchainClient,
-		stats:                     stats,
-		vegaPaths:                 vegaPaths,
+		ctx:                     ctx,
+		log:                     log,
+		confWatcher:             conf,
+		conf:                    conf.Get(),
+		primaryEthClient:        primaryEthClient,
+		evmBridgeClients:        evmBridgeClients,
+		evmBridges:              map[string]*evmBridgeServices{},
+		l2Clients:               l2Clients,
+		primaryEthConfirmations: primaryEthConfirmations,
+		blockchainClient:        blockchainClient,
+		stats:                   stats,
+		vegaPaths:               vegaPaths,
 	}
 
 	svcs.broker, err = broker.New(svcs.ctx, svcs.log, svcs.conf.Broker, stats.Blockchain)
@@ -235,14 +237,15 @@ func newServices(
 	svcs.limits = limits.New(svcs.log, svcs.conf.Limits, svcs.timeService, svcs.broker)
 
 	svcs.netParams = netparams.New(svcs.log, svcs.conf.NetworkParameters, svcs.broker)
+	svcs.epochService.NotifyOnEpoch(svcs.netParams.OnEpochEvent, svcs.netParams.OnEpochRestore)
 
 	svcs.primaryMultisig = erc20multisig.NewERC20MultisigTopology(svcs.conf.ERC20MultiSig, svcs.log, nil, svcs.broker, svcs.primaryEthClient, svcs.primaryEthConfirmations, svcs.netParams, "primary")
-	svcs.secondaryMultisig = erc20multisig.NewERC20MultisigTopology(svcs.conf.ERC20MultiSig, svcs.log, nil, svcs.broker, svcs.secondaryEthClient, svcs.secondaryEthConfirmations, svcs.netParams, "secondary")
+	svcs.evmMultisigs = erc20multisig.NewEVMTopologies(svcs.conf.ERC20MultiSig, svcs.log, svcs.broker, svcs.netParams, svcs.evmMultisigClients)
 
 	if svcs.conf.IsValidator() {
-		svcs.topology = validators.NewTopology(svcs.log, svcs.conf.Validators, validators.WrapNodeWallets(nodeWallets), svcs.broker, svcs.conf.IsValidator(), svcs.commander, svcs.primaryMultisig, svcs.secondaryMultisig, svcs.timeService)
+		svcs.topology = validators.NewTopology(svcs.log, svcs.conf.Validators, validators.WrapNodeWallets(nodeWallets), svcs.broker, svcs.conf.IsValidator(), svcs.commander, svcs.primaryMultisig, svcs.evmMultisigs, svcs.timeService)
 	} else {
-		svcs.topology = validators.NewTopology(svcs.log, svcs.conf.Validators, nil, svcs.broker, svcs.conf.IsValidator(), nil, svcs.primaryMultisig, svcs.secondaryMultisig, svcs.timeService)
+		svcs.topology = validators.NewTopology(svcs.log, svcs.conf.Validators, nil, svcs.broker, svcs.conf.IsValidator(), nil, svcs.primaryMultisig, svcs.evmMultisigs, svcs.timeService)
 	}
 
 	svcs.protocolUpgradeEngine = protocolupgrade.New(svcs.log, svcs.conf.ProtocolUpgrade, svcs.broker, svcs.topology, version.Get())
@@ -250,22 +253,30 @@ func newServices(
 
 	// this is done to go around circular deps...
 	svcs.primaryMultisig.SetWitness(svcs.witness)
-	svcs.secondaryMultisig.SetWitness(svcs.witness)
+	svcs.evmMultisigs.SetWitness(svcs.witness)
 	svcs.primaryEventForwarder = evtforward.New(svcs.log, svcs.conf.EvtForward, svcs.commander, svcs.timeService, svcs.topology)
 	svcs.forwarderHeartbeat = evtforward.NewTracker(log, svcs.witness, svcs.timeService)
 
 	if svcs.conf.HaveEthClient() {
-		if len(svcs.conf.EvtForward.EVMBridges) != 1 {
-			return nil, fmt.Errorf("require exactly 1 [[EvtForward.EVMBridges]] in configuration file, got: %d", len(svcs.conf.EvtForward.EVMBridges))
-		}
-
 		svcs.primaryBridgeView = bridges.NewERC20LogicView(primaryEthClient, primaryEthConfirmations)
-		svcs.secondaryBridgeView = bridges.NewERC20LogicView(secondaryEthClient, secondaryEthConfirmations)
 		svcs.primaryEventForwarderEngine = evtforward.NewEngine(svcs.log, svcs.conf.EvtForward.Ethereum)
-		svcs.secondaryEventForwarderEngine = evtforward.NewEngine(svcs.log, svcs.conf.EvtForward.EVMBridges[0])
+
+		for _, chainID := range svcs.evmBridgeClients.ChainIDs() {
+			fwdCfg, ok := evmBridgeForwarderConfig(svcs.conf.EvtForward.EVMBridges, chainID, len(svcs.evmBridgeClients.ChainIDs()))
+			if !ok {
+				return nil, fmt.Errorf("missing [[EvtForward.EVMBridges]] in configuration file for chain-id: %s", chainID)
+			}
+			ethClient, ethConfirmations, _ := svcs.evmBridgeClients.Get(chainID)
+			svcs.evmBridges[chainID] = &evmBridgeServices{
+				ethClient:            ethClient,
+				ethConfirmations:     ethConfirmations,
+				bridgeView:           bridges.NewERC20LogicView(ethClient, ethConfirmations),
+				eventForwarderEngine: evtforward.NewEngine(svcs.log, fwdCfg),
+				forwarderConfig:      fwdCfg,
+			}
+		}
 	} else {
 		svcs.primaryEventForwarderEngine = evtforward.NewNoopEngine(svcs.log, svcs.conf.EvtForward.Ethereum)
-		svcs.secondaryEventForwarderEngine = evtforward.NewNoopEngine(svcs.log, ethereum.NewDefaultConfig())
 	}
 
 	svcs.oracle = spec.NewEngine(svcs.log, svcs.conf.Oracles, svcs.timeService, svcs.broker)
@@ -288,7 +299,7 @@ func newServices(
 
 	// this is done to go around circular deps again..s
 	svcs.primaryMultisig.SetEthereumEventSource(svcs.forwarderHeartbeat)
-	svcs.secondaryMultisig.SetEthereumEventSource(svcs.forwarderHeartbeat)
+	svcs.evmMultisigs.SetEthereumEventSource(svcs.forwarderHeartbeat)
 
 	svcs.stakingAccounts, svcs.stakeVerifier, svcs.stakeCheckpoint = staking.New(
 		svcs.log, svcs.conf.Staking, svcs.timeService, svcs.broker, svcs.witness, svcs.primaryEthClient, svcs.netParams, svcs.primaryEventForwarder, svcs.conf.HaveEthClient(), svcs.primaryEthConfirmations, svcs.forwarderHeartbeat,
@@ -300,6 +311,7 @@ func newServices(
 
 	svcs.partiesEngine = parties.NewSnapshottedEngine(svcs.broker)
 	svcs.txCache = txcache.NewTxCache(svcs.commander)
+	svcs.stateQueries = api.NewStateQueries(svcs.conf.API.Timeout.Get())
 
 	svcs.statevar = statevar.New(svcs.log, svcs.conf.StateVar, svcs.broker, svcs.topology, svcs.commander)
 	svcs.marketActivityTracker = common.NewMarketActivityTracker(svcs.log, svcs.teamsEngine, svcs.stakingAccounts, svcs.broker, svcs.collateral)
@@ -307,12 +319,15 @@ func newServices(
 	svcs.notary = notary.NewWithSnapshot(svcs.log, svcs.conf.Notary, svcs.topology, svcs.broker, svcs.commander)
 
 	if svcs.conf.IsValidator() {
-		svcs.assets, err = assets.New(ctx, svcs.log, svcs.conf.Assets, nodeWallets.Ethereum, svcs.primaryEthClient, svcs.secondaryEthClient, svcs.broker, svcs.primaryBridgeView, svcs.secondaryBridgeView, svcs.notary, svcs.conf.HaveEthClient())
+		svcs.assets, err = assets.New(ctx, svcs.log, svcs.conf.Assets, nodeWallets.Ethereum, svcs.primaryEthClient, svcs.broker, svcs.primaryBridgeView, svcs.notary, svcs.conf.HaveEthClient())
 		if err != nil {
 			return nil, fmt.Errorf("could not initialize assets engine: %w", err)
 		}
+		for chainID, bridge := range svcs.evmBridges {
+			svcs.assets.RegisterEVMBridge(chainID, bridge.ethClient, bridge.bridgeView)
+		}
 	} else {
-		svcs.assets, err = assets.New(ctx, svcs.log, svcs.conf.Assets, nil, nil, nil, svcs.broker, nil, nil, svcs.notary, svcs.conf.HaveEthClient())
+		svcs.assets, err = assets.New(ctx, svcs.log, svcs.conf.Assets, nil, nil, svcs.broker, nil, svcs.notary, svcs.conf.HaveEthClient())
 		if err != nil {
 			return nil, fmt.Errorf("could not initialize assets engine: %w", err)
 		}
@@ -340,7 +355,7 @@ func newServices(
 	svcs.volumeRebate = volumerebate.NewSnapshottedEngine(svcs.broker, svcs.marketActivityTracker)
 	svcs.banking = banking.New(svcs.log, svcs.conf.Banking, svcs.collateral, svcs.witness, svcs.timeService,
 		svcs.assets, svcs.notary, svcs.broker, svcs.topology, svcs.marketActivityTracker, svcs.primaryBridgeView,
-		svcs.secondaryBridgeView, svcs.forwarderHeartbeat, svcs.partiesEngine, svcs.stakingAccounts)
+		svcs.forwarderHeartbeat, svcs.partiesEngine, svcs.stakingAccounts, svcs.oracle)
 
 	// instantiate the execution engine
 	svcs.executionEngine = execution.NewEngine(
@@ -348,6 +363,8 @@ func newServices(
 		svcs.marketActivityTracker, svcs.assets, svcs.referralProgram, svcs.volumeDiscount, svcs.volumeRebate, svcs.banking, svcs.partiesEngine,
 		svcs.txCache,
 	)
+	svcs.banking.SetMarkets(svcs.executionEngine)
+	svcs.collateral.SetCrossAssetCollateralSeller(svcs.executionEngine)
 	svcs.epochService.NotifyOnEpoch(svcs.executionEngine.OnEpochEvent, svcs.executionEngine.OnEpochRestore)
 	svcs.epochService.NotifyOnEpoch(svcs.marketActivityTracker.OnEpochEvent, svcs.marketActivityTracker.OnEpochRestore)
 	svcs.epochService.NotifyOnEpoch(svcs.banking.OnEpoch, svcs.banking.OnEpochRestore)
@@ -363,7 +380,7 @@ func newServices(
 
 		if svcs.conf.HaveEthClient() {
 			svcs.governance = governance.NewEngine(svcs.log, svcs.conf.Governance, svcs.stakingAccounts, svcs.timeService, svcs.broker, svcs.assets, svcs.witness, svcs.executionEngine, svcs.netParams, svcs.banking)
-			svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, svcs.stakingAccounts, svcs.epochService, svcs.timeService)
+			svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, svcs.stakingAccounts, svcs.epochService, svcs.timeService, svcs.banking)
 		} else {
 			stakingLoop := nullchain.NewStakingLoop(svcs.collateral, svcs.assets)
 			svcs.netParams.Watch([]netparams.WatchParam{
@@ -373,7 +390,7 @@ func newServices(
 				},
 			}...)
 			svcs.governance = governance.NewEngine(svcs.log, svcs.conf.Governance, stakingLoop, svcs.timeService, svcs.broker, svcs.assets, svcs.witness, svcs.executionEngine, svcs.netParams, svcs.banking)
-			svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, stakingLoop, svcs.epochService, svcs.timeService)
+			svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, stakingLoop, svcs.epochService, svcs.timeService, svcs.banking)
 		}
 
 		// disable spam protection based on config
@@ -383,7 +400,7 @@ func newServices(
 	} else {
 		svcs.codec = &processor.TxCodec{}
 		svcs.governance = governance.NewEngine(svcs.log, svcs.conf.Governance, svcs.stakingAccounts, svcs.timeService, svcs.broker, svcs.assets, svcs.witness, svcs.executionEngine, svcs.netParams, svcs.banking)
-		svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, svcs.stakingAccounts, svcs.epochService, svcs.timeService)
+		svcs.delegation = delegation.New(svcs.log, svcs.conf.Delegation, svcs.broker, svcs.topology, svcs.stakingAccounts, svcs.epochService, svcs.timeService, svcs.banking)
 	}
 
 	svcs.activityStreak = activitystreak.NewSnapshotEngine(svcs.log, svcs.executionEngine, svcs.broker)
@@ -394,6 +411,7 @@ func newServices(
 
 	svcs.vesting = vesting.NewSnapshotEngine(svcs.log, svcs.collateral, svcs.activityStreak, svcs.broker, svcs.assets, svcs.partiesEngine, svcs.timeService, svcs.stakingAccounts)
 	svcs.timeService.NotifyOnTick(svcs.vesting.OnTick)
+	svcs.vesting.NotifyOnStakingReward(svcs.delegation.OnStakingReward)
 	svcs.rewards = rewards.New(svcs.log, svcs.conf.Rewards, svcs.broker, svcs.delegation, svcs.epochService, svcs.collateral, svcs.timeService, svcs.marketActivityTracker, svcs.topology, svcs.vesting, svcs.banking, svcs.activityStreak)
 
 	// register this after the rewards engine is created to make sure the on epoch is called in the right order.
@@ -438,6 +456,7 @@ func newServices(
 
 	// notify delegation, rewards, and accounting on changes in the validator pub key
 	svcs.topology.NotifyOnKeyChange(svcs.governance.ValidatorKeyChanged)
+	svcs.topology.NotifyOnSlashing(svcs.delegation.Slash)
 
 	svcs.snapshotEngine.AddProviders(
 		svcs.txCache,
@@ -460,7 +479,7 @@ func newServices(
 		svcs.marketActivityTracker,
 		svcs.statevar,
 		svcs.primaryMultisig,
-		erc20multisig.NewEVMTopologies(svcs.secondaryMultisig),
+		svcs.evmMultisigs,
 		svcs.protocolUpgradeEngine,
 		svcs.ethereumOraclesVerifier,
 		svcs.vesting,
@@ -532,7 +551,7 @@ func (svcs *allServices) registerTimeServiceCallbacks() {
 		svcs.builtinOracle.OnTick,
 		svcs.netParams.OnTick,
 		svcs.primaryMultisig.OnTick,
-		svcs.secondaryMultisig.OnTick,
+		svcs.evmMultisigs.OnTick,
 		svcs.witness.OnTick,
 
 		svcs.primaryEventForwarder.OnTick,
@@ -554,7 +573,9 @@ func (svcs *allServices) registerTimeServiceCallbacks() {
 func (svcs *allServices) Stop() {
 	svcs.confWatcher.Unregister(svcs.confListenerIDs)
 	svcs.primaryEventForwarderEngine.Stop()
-	svcs.secondaryEventForwarderEngine.Stop()
+	for _, bridge := range svcs.evmBridges {
+		bridge.eventForwarderEngine.Stop()
+	}
 	svcs.snapshotEngine.Close()
 	svcs.ethCallEngine.Stop()
 }
@@ -566,8 +587,10 @@ func (svcs *allServices) registerConfigWatchers() {
 		func(cfg config.Config) { svcs.primaryEventForwarderEngine.ReloadConf(cfg.EvtForward.Ethereum) },
 		func(cfg config.Config) { svcs.primaryEventForwarder.ReloadConf(cfg.EvtForward) },
 		func(cfg config.Config) {
-			if len(cfg.EvtForward.EVMBridges) > 0 {
-				svcs.secondaryEventForwarderEngine.ReloadConf(cfg.EvtForward.EVMBridges[0])
+			for chainID, bridge := range svcs.evmBridges {
+				if fwdCfg, ok := evmBridgeForwarderConfig(cfg.EvtForward.EVMBridges, chainID, len(svcs.evmBridgeClients.ChainIDs())); ok {
+					bridge.eventForwarderEngine.ReloadConf(fwdCfg)
+				}
 			}
 		},
 		func(cfg config.Config) { svcs.topology.ReloadConf(cfg.Validators) },
@@ -685,10 +708,18 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.SpamProtectionBalanceSnapshotFrequency,
 			Watcher: svcs.collateral.OnBalanceSnapshotFrequencyUpdated,
 		},
+		{
+			Param:   netparams.MarketInsurancePoolTreasurySweepFraction,
+			Watcher: svcs.collateral.OnInsurancePoolTreasurySweepFractionUpdate,
+		},
 		{
 			Param:   netparams.MinEpochsInTeamForMetricRewardEligibility,
 			Watcher: svcs.marketActivityTracker.OnMinEpochsInTeamForRewardEligibilityUpdated,
 		},
+		{
+			Param:   netparams.RewardsQuotingDepthMaxSpread,
+			Watcher: svcs.marketActivityTracker.OnQuotingDepthMaxSpreadUpdate,
+		},
 		{
 			Param:   netparams.MinBlockCapacity,
 			Watcher: svcs.gastimator.OnMinBlockCapacityUpdate,
@@ -733,6 +764,22 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.MinimumEthereumEventsForNewValidator,
 			Watcher: svcs.topology.UpdateMinimumEthereumEventsForNewValidator,
 		},
+		{
+			Param:   netparams.ValidatorsSlashingEnabled,
+			Watcher: svcs.topology.OnSlashingEnabledUpdate,
+		},
+		{
+			Param:   netparams.ValidatorsSlashingMissedHeartbeats,
+			Watcher: svcs.topology.OnSlashingMissedHeartbeatsUpdate,
+		},
+		{
+			Param:   netparams.ValidatorsSlashingDowntimeFraction,
+			Watcher: svcs.topology.OnDowntimeSlashingFractionUpdate,
+		},
+		{
+			Param:   netparams.ValidatorsSlashingDoubleSignFraction,
+			Watcher: svcs.topology.OnDoubleSignSlashingFractionUpdate,
+		},
 		{
 			Param:   netparams.StakingAndDelegationRewardMinimumValidatorStake,
 			Watcher: svcs.topology.UpdateMinimumRequireSelfStake,
@@ -741,6 +788,10 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.DelegationMinAmount,
 			Watcher: svcs.delegation.OnMinAmountChanged,
 		},
+		{
+			Param:   netparams.DelegationMaxRedelegationsPerEpoch,
+			Watcher: svcs.delegation.OnMaxRedelegationsPerEpochUpdate,
+		},
 		{
 			Param:   netparams.RewardAsset,
 			Watcher: dispatch.RewardAssetUpdate(svcs.log, svcs.assets),
@@ -757,6 +808,14 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.MarketMarginScalingFactors,
 			Watcher: svcs.executionEngine.OnMarketMarginScalingFactorsUpdate,
 		},
+		{
+			Param:   netparams.MarketMarginCrossAssetCollateral,
+			Watcher: svcs.collateral.OnCrossAssetCollateralUpdate,
+		},
+		{
+			Param:   netparams.MarketMarginCrossAssetCollateral,
+			Watcher: svcs.executionEngine.OnCrossAssetCollateralUpdate,
+		},
 		{
 			Param:   netparams.MarketFeeFactorsMakerFee,
 			Watcher: svcs.executionEngine.OnMarketFeeFactorsMakerFeeUpdate,
@@ -829,36 +888,34 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 					return fmt.Errorf("invalid secondary ethereum configuration: %w", err)
 				}
 
-				ethCfg := cfgs.Configs[0]
-
-				if err := svcs.secondaryEthClient.UpdateEthereumConfig(ctx, ethCfg); err != nil {
-					return err
+				for _, ethCfg := range cfgs.Configs {
+					svcs.assets.SetBridgeChainID(ethCfg.ChainID(), false)
+
+					bridge := svcs.evmBridgeServicesForChainID(ethCfg.ChainID())
+					if bridge.ethClient != nil {
+						if err := bridge.ethClient.UpdateEthereumConfig(ctx, ethCfg); err != nil {
+							return err
+						}
+
+						if err := bridge.eventForwarderEngine.SetupSecondaryEthereumEngine(
+							bridge.ethClient,
+							svcs.primaryEventForwarder,
+							bridge.forwarderConfig,
+							ethCfg,
+							svcs.assets,
+						); err != nil {
+							return err
+						}
+					}
+
+					svcs.forwarderHeartbeat.RegisterForwarder(
+						bridge.eventForwarderEngine,
+						ethCfg.ChainID(),
+						ethCfg.CollateralBridge().HexAddress(),
+						ethCfg.MultiSigControl().HexAddress(),
+					)
 				}
 
-				svcs.assets.SetBridgeChainID(ethCfg.ChainID(), false)
-
-				var bridgeCfg ethereum.Config
-				if svcs.conf.HaveEthClient() {
-					bridgeCfg = svcs.conf.EvtForward.EVMBridges[0]
-				}
-
-				if err := svcs.secondaryEventForwarderEngine.SetupSecondaryEthereumEngine(
-					svcs.secondaryEthClient,
-					svcs.primaryEventForwarder,
-					bridgeCfg,
-					ethCfg,
-					svcs.assets,
-				); err != nil {
-					return err
-				}
-
-				svcs.forwarderHeartbeat.RegisterForwarder(
-					svcs.secondaryEventForwarderEngine,
-					ethCfg.ChainID(),
-					ethCfg.CollateralBridge().HexAddress(),
-					ethCfg.MultiSigControl().HexAddress(),
-				)
-
 				return nil
 			},
 		},
@@ -1020,6 +1077,22 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.SpamProtectionMinimumWithdrawalQuantumMultiple,
 			Watcher: svcs.banking.OnMinWithdrawQuantumMultiple,
 		},
+		{
+			Param:   netparams.BlockchainsWithdrawalBatchingEnabled,
+			Watcher: svcs.banking.OnWithdrawalBatchingEnabledUpdate,
+		},
+		{
+			Param:   netparams.BlockchainsWithdrawalDelayThresholds,
+			Watcher: svcs.banking.OnWithdrawalDelayThresholdsUpdate,
+		},
+		{
+			Param:   netparams.BlockchainsWithdrawalDelayPeriod,
+			Watcher: svcs.banking.OnWithdrawalDelayPeriodUpdate,
+		},
+		{
+			Param:   netparams.BlockchainsWithdrawalGuardians,
+			Watcher: svcs.banking.OnWithdrawalGuardiansUpdate,
+		},
 		{
 			Param: netparams.BlockchainsPrimaryEthereumConfig,
 			Watcher: func(_ context.Context, cfg interface{}) error {
@@ -1043,12 +1116,16 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 				if !svcs.conf.HaveEthClient() {
 					return nil
 				}
-				ethCfg, err := types.EVMChainConfigFromUntypedProto(cfg)
+				ethCfgs, err := types.EVMChainConfigFromUntypedProto(cfg)
 				if err != nil {
 					return fmt.Errorf("invalid secondary ethereum configuration: %w", err)
 				}
 
-				svcs.secondaryEthConfirmations.UpdateConfirmations(ethCfg.Configs[0].Confirmations())
+				for _, ethCfg := range ethCfgs.Configs {
+					if bridge, ok := svcs.evmBridges[ethCfg.ChainID()]; ok && bridge.ethConfirmations != nil {
+						bridge.ethConfirmations.UpdateConfirmations(ethCfg.Confirmations())
+					}
+				}
 				return nil
 			},
 		},
@@ -1092,9 +1169,15 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 					return fmt.Errorf("invalid secondary ethereum configuration: %w", err)
 				}
 
-				ethCfg := ethCfgs.Configs[0]
-				svcs.banking.OnSecondaryEthChainIDUpdated(ethCfg.ChainID(), ethCfg.CollateralBridge().HexAddress())
-				svcs.witness.SetSecondaryDefaultConfirmations(ethCfg.ChainID(), ethCfg.Confirmations(), ethCfg.BlockTime())
+				for _, ethCfg := range ethCfgs.Configs {
+					// the node can only verify the bridges of the chains it is connected to.
+					var bridgeView banking.ERC20BridgeView
+					if bridge, ok := svcs.evmBridges[ethCfg.ChainID()]; ok && bridge.bridgeView != nil {
+						bridgeView = bridge.bridgeView
+					}
+					svcs.banking.RegisterEVMBridge(ethCfg.ChainID(), ethCfg.CollateralBridge().HexAddress(), bridgeView)
+					svcs.witness.SetSecondaryDefaultConfirmations(ethCfg.ChainID(), ethCfg.Confirmations(), ethCfg.BlockTime())
+				}
 				return nil
 			},
 		},
@@ -1173,6 +1256,14 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 			Param:   netparams.ReferralProgramMaxReferralRewardProportion,
 			Watcher: svcs.referralProgram.OnReferralProgramMaxReferralRewardProportionUpdate,
 		},
+		{
+			Param:   netparams.ReferralProgramMaxReferralDepth,
+			Watcher: svcs.referralProgram.OnReferralProgramMaxReferralDepthUpdate,
+		},
+		{
+			Param:   netparams.ReferralProgramUpstreamReferrerRewardShare,
+			Watcher: svcs.referralProgram.OnReferralProgramUpstreamReferrerRewardShareUpdate,
+		},
 		{
 			Param:   netparams.ReferralProgramMinStakedVegaTokens,
 			Watcher: svcs.referralProgram.OnReferralProgramMinStakedVegaTokensUpdate,
@@ -1229,3 +1320,59 @@ func (svcs *allServices) setupNetParameters(powWatchers []netparams.WatchParam)
 	// now add some watcher for our netparams
 	return svcs.netParams.Watch(watchers...)
 }
+
+// evmBridgeServices holds what the node uses to interact with the collateral
+// bridge of an EVM chain. Only the validators are connected to the chains, the
+// other nodes only run a no-op event forwarder.
+type evmBridgeServices struct {
+	ethClient            *ethclient.SecondaryClient
+	ethConfirmations     *ethclient.EthereumConfirmations
+	bridgeView           *bridges.ERC20LogicView
+	eventForwarderEngine EventForwarderEngine
+	forwarderConfig      ethereum.Config
+}
+
+// evmBridgeServicesForChainID returns the services of the chain, the chains
+// the node isn't connected to get a no-op event forwarder.
+func (svcs *allServices) evmBridgeServicesForChainID(chainID string) *evmBridgeServices {
+	if bridge, ok := svcs.evmBridges[chainID]; ok {
+		return bridge
+	}
+
+	if svcs.conf.HaveEthClient() {
+		svcs.log.Warn("the node is not connected to the EVM chain, its collateral bridge cannot be verified",
+			logging.String("chain-id", chainID),
+		)
+	}
+
+	bridge := &evmBridgeServices{
+		eventForwarderEngine: evtforward.NewNoopEngine(svcs.log, ethereum.NewDefaultConfig()),
+	}
+	svcs.evmBridges[chainID] = bridge
+	return bridge
+}
+
+// evmMultisigClients returns the client used to verify the events of the
+// multisig control of the given chain, if the node is connected to it.
+func (svcs *allServices) evmMultisigClients(chainID string) (erc20multisig.EthereumClient, erc20multisig.EthConfirmations) {
+	ethClient, ethConfirmations, ok := svcs.evmBridgeClients.Get(chainID)
+	if !ok {
+		return nil, nil
+	}
+	return ethClient, ethConfirmations
+}
+
+// evmBridgeForwarderConfig returns the event forwarder configuration of the
+// given chain. A configuration without chain ID is accepted when the node is
+// connected to a single EVM chain, as configured before the bridge registry.
+func evmBridgeForwarderConfig(cfgs []ethereum.Config, chainID string, connectedChains int) (ethereum.Config, bool) {
+	for _, cfg := range cfgs {
+		if cfg.ChainID == chainID {
+			return cfg, true
+		}
+	}
+	if len(cfgs) == 1 && len(cfgs[0].ChainID) == 0 && connectedChains == 1 {
+		return cfgs[0], true
+	}
+	return ethereum.Config{}, false
+}
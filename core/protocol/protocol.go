@@ -20,11 +20,14 @@ import (
 	"fmt"
 
 	"code.vegaprotocol.io/vega/core/api"
+	"code.vegaprotocol.io/vega/core/banking"
 	"code.vegaprotocol.io/vega/core/blockchain"
 	"code.vegaprotocol.io/vega/core/broker"
 	ethclient "code.vegaprotocol.io/vega/core/client/eth"
 	"code.vegaprotocol.io/vega/core/config"
 	"code.vegaprotocol.io/vega/core/evtforward"
+	"code.vegaprotocol.io/vega/core/execution"
+	"code.vegaprotocol.io/vega/core/governance"
 	"code.vegaprotocol.io/vega/core/netparams"
 	"code.vegaprotocol.io/vega/core/nodewallets"
 	"code.vegaprotocol.io/vega/core/processor"
@@ -62,9 +65,8 @@ func New(
 	stopBlockchain func() error,
 	nodewallets *nodewallets.NodeWallets,
 	primaryEthClient *ethclient.PrimaryClient,
-	secondaryEthClient *ethclient.SecondaryClient,
 	primaryEthConfirmation *ethclient.EthereumConfirmations,
-	secondaryEthConfirmation *ethclient.EthereumConfirmations,
+	evmBridgeClients *ethclient.EVMBridgeClients,
 	blockchainClient *blockchain.Client,
 	vegaPaths paths.Paths,
 	stats *stats.Stats,
@@ -90,9 +92,8 @@ func New(
 		confWatcher,
 		nodewallets,
 		primaryEthClient,
-		secondaryEthClient,
 		primaryEthConfirmation,
-		secondaryEthConfirmation,
+		evmBridgeClients,
 		blockchainClient,
 		vegaPaths,
 		stats,
@@ -145,7 +146,7 @@ func New(
 			svcs.volumeRebate,
 			svcs.blockchainClient,
 			svcs.primaryMultisig,
-			svcs.secondaryMultisig,
+			svcs.evmMultisigs,
 			stats.GetVersion(),
 			svcs.protocolUpgradeEngine,
 			svcs.codec,
@@ -154,6 +155,7 @@ func New(
 			svcs.collateral,
 			svcs.partiesEngine,
 			svcs.txCache,
+			svcs.stateQueries,
 		),
 		log:         log,
 		confWatcher: confWatcher,
@@ -231,3 +233,19 @@ func (n *Protocol) GetSpamEngine() *spam.Engine {
 func (n *Protocol) GetPowEngine() processor.PoWEngine {
 	return n.services.pow
 }
+
+func (n *Protocol) GetStateQueries() *api.StateQueries {
+	return n.services.stateQueries
+}
+
+func (n *Protocol) GetExecutionEngine() *execution.Engine {
+	return n.services.executionEngine
+}
+
+func (n *Protocol) GetBankingEngine() *banking.Engine {
+	return n.services.banking
+}
+
+func (n *Protocol) GetGovernanceEngine() *governance.Engine {
+	return n.services.governance
+}
@@ -16,6 +16,8 @@
 package protocolupgrade
 
 import (
+	"time"
+
 	"code.vegaprotocol.io/vega/libs/config/encoding"
 	"code.vegaprotocol.io/vega/logging"
 )
@@ -26,7 +28,19 @@ const namedLogger = "protocolupgrade"
 
 // Config represent the configuration of the collateral engine.
 type Config struct {
-	Level encoding.LogLevel `long:"log-level"`
+	Level     encoding.LogLevel `long:"log-level"`
+	Preflight PreflightConfig   `group:"Preflight" namespace:"preflight"`
+}
+
+// PreflightConfig is the configuration of the pre-flight checks of a candidate binary
+// for a protocol upgrade. The arguments given to the candidate binary can use the
+// placeholders {home}, {passphrase-file}, {snapshot-height}, {genesis-file} and {replay-file}.
+// The passphrase file is a pipe, the node wallet passphrase is never written to disk.
+type PreflightConfig struct {
+	ReplayBlocks uint64            `description:"Number of blocks following the latest snapshot to replay"           long:"replay-blocks"`
+	Timeout      encoding.Duration `description:"Maximum time given to the candidate binary to replay the blocks"     long:"timeout"`
+	SandboxDir   string            `description:"Directory to create the sandboxes in (default: temporary directory)" long:"sandbox-dir"`
+	Args         []string          `description:"Arguments given to the candidate binary"                            long:"args"`
 }
 
 // NewDefaultConfig creates an instance of the package specific configuration, given a
@@ -34,5 +48,20 @@ type Config struct {
 func NewDefaultConfig() Config {
 	return Config{
 		Level: encoding.LogLevel{Level: logging.InfoLevel},
+		Preflight: PreflightConfig{
+			ReplayBlocks: 100,
+			Timeout:      encoding.Duration{Duration: 10 * time.Minute},
+			Args: []string{
+				"start",
+				"--home={home}",
+				"--nodewallet-passphrase-file={passphrase-file}",
+				"--snapshot.load-from-block-height={snapshot-height}",
+				"--blockchain.chain-provider=nullchain",
+				"--blockchain.nullchain.genesis-file={genesis-file}",
+				"--blockchain.nullchain.replay",
+				"--blockchain.nullchain.replay-file={replay-file}",
+				"--blockchain.nullchain.exit-after-replay",
+			},
+		},
 	}
 }
@@ -27,6 +27,7 @@ import (
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 
 	"github.com/blang/semver"
@@ -38,6 +39,8 @@ type protocolUpgradeProposal struct {
 	blockHeight    uint64
 	vegaReleaseTag string
 	accepted       map[string]struct{}
+	// readiness holds the outcome of the pre-flight checks reported by the approvers that ran them.
+	readiness map[string]*commandspb.ProtocolUpgradeReadiness
 }
 
 func protocolUpgradeProposalID(upgradeBlockHeight uint64, vegaReleaseTag string) string {
@@ -67,6 +70,19 @@ func (p *protocolUpgradeProposal) approvers() []string {
 	return accepted
 }
 
+func (p *protocolUpgradeProposal) approversReadiness() []*eventspb.ProtocolUpgradeApproverReadiness {
+	readiness := make([]*eventspb.ProtocolUpgradeApproverReadiness, 0, len(p.readiness))
+	for _, approver := range p.approvers() {
+		if r, ok := p.readiness[approver]; ok {
+			readiness = append(readiness, &eventspb.ProtocolUpgradeApproverReadiness{
+				Approver:  approver,
+				Readiness: r,
+			})
+		}
+	}
+	return readiness
+}
+
 type ValidatorTopology interface {
 	IsTendermintValidator(pubkey string) bool
 	IsSelfTendermintValidator() bool
@@ -149,8 +165,9 @@ func (e *Engine) IsValidProposal(_ context.Context, pk string, upgradeBlockHeigh
 	return nil
 }
 
-// UpgradeProposal records the intention of a validator to upgrade the protocol to a release tag at block height.
-func (e *Engine) UpgradeProposal(ctx context.Context, pk string, upgradeBlockHeight uint64, vegaReleaseTag string) error {
+// UpgradeProposal records the intention of a validator to upgrade the protocol to a release tag at block height,
+// along with the outcome of the pre-flight checks of the candidate binary if the validator ran them.
+func (e *Engine) UpgradeProposal(ctx context.Context, pk string, upgradeBlockHeight uint64, vegaReleaseTag string, readiness *commandspb.ProtocolUpgradeReadiness) error {
 	e.lock.RLock()
 	defer e.lock.RUnlock()
 
@@ -176,11 +193,18 @@ func (e *Engine) UpgradeProposal(ctx context.Context, pk string, upgradeBlockHei
 				blockHeight:    upgradeBlockHeight,
 				vegaReleaseTag: vegaReleaseTag,
 				accepted:       map[string]struct{}{},
+				readiness:      map[string]*commandspb.ProtocolUpgradeReadiness{},
 			}
 		}
 
 		active := e.activeProposals[ID]
 		active.accepted[pk] = struct{}{}
+		// the latest submission of the validator replaces the outcome of the pre-flight checks it reported before
+		if readiness != nil {
+			active.readiness[pk] = readiness
+		} else {
+			delete(active.readiness, pk)
+		}
 		e.sendAndKeepEvent(ctx, ID, active)
 
 		e.log.Debug("Successfully added protocol upgrade proposal",
@@ -205,6 +229,7 @@ func (e *Engine) UpgradeProposal(ctx context.Context, pk string, upgradeBlockHei
 		// if there is a vote for another proposal from the pk, remove it and send an update
 		if _, ok := activeProposal.accepted[pk]; ok {
 			delete(activeProposal.accepted, pk)
+			delete(activeProposal.readiness, pk)
 			e.sendAndKeepEvent(ctx, activeID, activeProposal)
 
 			e.log.Debug("Removed validator vote from previous proposal",
@@ -233,7 +258,7 @@ func (e *Engine) sendAndKeepEvent(ctx context.Context, ID string, activeProposal
 	if len(activeProposal.approvers()) == 0 {
 		status = eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_REJECTED
 	}
-	evt := events.NewProtocolUpgradeProposalEvent(ctx, activeProposal.blockHeight, activeProposal.vegaReleaseTag, activeProposal.approvers(), status)
+	evt := events.NewProtocolUpgradeProposalEvent(ctx, activeProposal.blockHeight, activeProposal.vegaReleaseTag, activeProposal.approvers(), status, activeProposal.approversReadiness())
 	evtProto := evt.Proto()
 	e.events[ID] = &evtProto
 	e.broker.Send(evt)
@@ -292,7 +317,7 @@ func (e *Engine) BeginBlock(ctx context.Context, blockHeight uint64) {
 				delete(e.activeProposals, ID)
 				delete(e.events, ID)
 				e.log.Info("protocol upgrade rejected", logging.String("vega-release-tag", pup.vegaReleaseTag), logging.Uint64("upgrade-block-height", pup.blockHeight))
-				e.broker.Send(events.NewProtocolUpgradeProposalEvent(ctx, pup.blockHeight, pup.vegaReleaseTag, pup.approvers(), eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_REJECTED))
+				e.broker.Send(events.NewProtocolUpgradeProposalEvent(ctx, pup.blockHeight, pup.vegaReleaseTag, pup.approvers(), eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_REJECTED, pup.approversReadiness()))
 			}
 		}
 	}
@@ -323,7 +348,7 @@ func (e *Engine) Cleanup(ctx context.Context) {
 			status = eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_REJECTED
 		}
 
-		e.broker.Send(events.NewProtocolUpgradeProposalEvent(ctx, pup.blockHeight, pup.vegaReleaseTag, pup.approvers(), status))
+		e.broker.Send(events.NewProtocolUpgradeProposalEvent(ctx, pup.blockHeight, pup.vegaReleaseTag, pup.approvers(), status, pup.approversReadiness()))
 		delete(e.activeProposals, ID)
 		delete(e.events, ID)
 	}
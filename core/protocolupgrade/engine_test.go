@@ -26,13 +26,17 @@ import (
 	"code.vegaprotocol.io/vega/core/protocolupgrade"
 	snp "code.vegaprotocol.io/vega/core/snapshot"
 	"code.vegaprotocol.io/vega/core/stats"
+	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/num"
 	vgtest "code.vegaprotocol.io/vega/libs/test"
 	"code.vegaprotocol.io/vega/logging"
 	"code.vegaprotocol.io/vega/paths"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -72,6 +76,7 @@ func Test(t *testing.T) {
 	t.Run("Snapshot roundtrip test", testSnapshotRoundTrip)
 	t.Run("Revert a proposal", testRevertProposal)
 	t.Run("Downgrade is not allowed", testDowngradeVersionNotAllowed)
+	t.Run("Readiness of the approvers is kept on the proposal", testReadinessKeptOnProposal)
 }
 
 func testDowngradeVersionNotAllowed(t *testing.T) {
@@ -81,7 +86,7 @@ func testDowngradeVersionNotAllowed(t *testing.T) {
 		evts = append(evts, event)
 	}).AnyTimes()
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.EqualError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "0.53.0"), "upgrade version is too old (0.54.0 > 0.53.0)")
+	require.EqualError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "0.53.0", nil), "upgrade version is too old (0.54.0 > 0.53.0)")
 }
 
 func testRevertProposal(t *testing.T) {
@@ -91,12 +96,12 @@ func testRevertProposal(t *testing.T) {
 		evts = append(evts, event)
 	}).AnyTimes()
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", nil))
 	require.Equal(t, eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_PENDING, evts[0].StreamMessage().GetProtocolUpgradeEvent().Status)
 	require.Equal(t, 1, len(evts[0].StreamMessage().GetProtocolUpgradeEvent().Approvers))
 
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "0.54.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "0.54.0", nil))
 
 	require.Equal(t, eventspb.ProtocolUpgradeProposalStatus_PROTOCOL_UPGRADE_PROPOSAL_STATUS_REJECTED, evts[1].StreamMessage().GetProtocolUpgradeEvent().Status)
 
@@ -111,11 +116,11 @@ func testUpgradeProposalRejected(t *testing.T) {
 	}).AnyTimes()
 
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", nil))
 	// validator2 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0", nil))
 	// validator3 proposed an upgrade to v2 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.2"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.2", nil))
 
 	// we reached block 100 and only 50% (<66%) of the voting power agreed so the proposal is rejected
 	testTopology.totalVotingPower = 40
@@ -151,11 +156,11 @@ func testProposalApproved(t *testing.T) {
 	}).AnyTimes()
 
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", nil))
 	// validator2 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0", nil))
 	// validator3 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0", nil))
 
 	// full house
 	testTopology.totalVotingPower = 30
@@ -196,28 +201,28 @@ func testMultiProposalApproved(t *testing.T) {
 	testTopology.totalVotingPower = 20
 
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", nil))
 	// validator2 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0", nil))
 	// validator3 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0", nil))
 
 	require.Equal(t, 3, len(evts[2].StreamMessage().GetProtocolUpgradeEvent().Approvers))
 
 	// validator1 also proposed an upgrade to v1 at block height 90
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 90, "1.0.1"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 90, "1.0.1", nil))
 
 	// the new proposal from pk1 voids their approval of the former proposal
 	require.Equal(t, 2, len(evts[4].StreamMessage().GetProtocolUpgradeEvent().Approvers))
 
 	// validator2 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 90, "1.0.1"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 90, "1.0.1", nil))
 
 	// the new proposal from pk1 voids their approval of the former proposal
 	require.Equal(t, 1, len(evts[6].StreamMessage().GetProtocolUpgradeEvent().Approvers))
 
 	// validator3 agrees
-	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 90, "1.0.1"))
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 90, "1.0.1", nil))
 
 	// at this point there are no votes for the proposal for 1.0.0 so it gets removed
 
@@ -254,18 +259,18 @@ func testSnapshotRoundTrip(t *testing.T) {
 	puEngine1.BeginBlock(ctx, 50)
 
 	// validator1 proposed an upgrade to v1 at block height 100
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", nil))
 	// validator2 agrees
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0", nil))
 	// validator3 agrees
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0", nil))
 
 	// validator1 also proposed an upgrade to v1 at block height 90
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk1", 90, "1.0.1"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk1", 90, "1.0.1", nil))
 	// validator2 agrees
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk2", 90, "1.0.1"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk2", 90, "1.0.1", nil))
 	// validator3 agrees
-	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk3", 90, "1.0.1"))
+	require.NoError(t, puEngine1.UpgradeProposal(context.Background(), "pk3", 90, "1.0.1", nil))
 
 	// take a snapshot
 	hash1, err := snapshotEngine1.SnapshotNow(ctx)
@@ -307,3 +312,62 @@ func testSnapshotRoundTrip(t *testing.T) {
 		assert.Equalf(t, state1[key], state2[key], "Key %q does not have the same data", key)
 	}
 }
+
+func testReadinessKeptOnProposal(t *testing.T) {
+	e, _, broker, _ := testEngine(t, paths.New(t.TempDir()))
+	var evts []events.Event
+	broker.EXPECT().Send(gomock.Any()).DoAndReturn(func(event events.Event) {
+		evts = append(evts, event)
+	}).AnyTimes()
+
+	readiness := &commandspb.ProtocolUpgradeReadiness{SnapshotHeight: 80, BlocksReplayed: 10}
+
+	// validator1 ran the pre-flight checks before proposing the upgrade
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk1", 100, "1.0.0", readiness))
+	// validator2 agrees without running them
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk2", 100, "1.0.0", nil))
+	// validator3 ran them too
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 100, "1.0.0", readiness))
+
+	evt := evts[2].StreamMessage().GetProtocolUpgradeEvent()
+	require.Equal(t, []string{"pk1", "pk2", "pk3"}, evt.Approvers)
+	require.Len(t, evt.Readiness, 2)
+	require.Equal(t, "pk1", evt.Readiness[0].Approver)
+	require.Equal(t, uint64(80), evt.Readiness[0].Readiness.SnapshotHeight)
+	require.Equal(t, uint64(10), evt.Readiness[0].Readiness.BlocksReplayed)
+	require.Equal(t, "pk3", evt.Readiness[1].Approver)
+
+	// validator3 moves its vote to another proposal, its readiness goes with it
+	require.NoError(t, e.UpgradeProposal(context.Background(), "pk3", 90, "1.0.1", nil))
+	require.Empty(t, evts[3].StreamMessage().GetProtocolUpgradeEvent().Readiness)
+	evt = evts[4].StreamMessage().GetProtocolUpgradeEvent()
+	require.Len(t, evt.Readiness, 1)
+	require.Equal(t, "pk1", evt.Readiness[0].Approver)
+
+	// the readiness is restored from the snapshot
+	state1, _, err := e.GetState(e.Keys()[0])
+	require.NoError(t, err)
+
+	ppayload := &snapshotpb.Payload{}
+	require.NoError(t, proto.Unmarshal(state1, ppayload))
+
+	e2, _, broker2, _ := testEngine(t, paths.New(t.TempDir()))
+	var evts2 []events.Event
+	broker2.EXPECT().Send(gomock.Any()).DoAndReturn(func(event events.Event) {
+		evts2 = append(evts2, event)
+	}).AnyTimes()
+	_, err = e2.LoadState(context.Background(), types.PayloadFromProto(ppayload))
+	require.NoError(t, err)
+
+	state2, _, err := e2.GetState(e2.Keys()[0])
+	require.NoError(t, err)
+	require.Equal(t, state1, state2)
+
+	e2.Cleanup(context.Background())
+	require.Len(t, evts2, 2)
+	evt = evts2[0].StreamMessage().GetProtocolUpgradeEvent()
+	require.Equal(t, "1.0.0", evt.VegaReleaseTag)
+	require.Len(t, evt.Readiness, 1)
+	require.Equal(t, "pk1", evt.Readiness[0].Approver)
+	require.Equal(t, uint64(80), evt.Readiness[0].Readiness.SnapshotHeight)
+}
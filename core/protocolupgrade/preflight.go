@@ -0,0 +1,337 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package protocolupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.vegaprotocol.io/vega/logging"
+	"code.vegaprotocol.io/vega/paths"
+)
+
+// preflightPassphraseFile is the file the candidate binary reads the node wallet passphrase
+// from. It is the read end of a pipe given to the candidate so the passphrase is never
+// written to disk.
+const preflightPassphraseFile = "/dev/fd/3"
+
+var (
+	ErrPreflightNoSnapshot    = errors.New("no local snapshot to run the pre-flight checks against")
+	ErrPreflightMissingBlocks = errors.New("no block following the latest snapshot to replay")
+)
+
+// PreflightBlock is a block replayed by the candidate binary. It is written
+// to the replay file in the format read by the nullchain replayer.
+type PreflightBlock struct {
+	Height   int64    `json:"height"`
+	Time     int64    `json:"time"`
+	Hash     []byte   `json:"hash"`
+	Proposer []byte   `json:"proposer"`
+	Txs      [][]byte `json:"txns"`
+	AppHash  []byte   `json:"appHash"`
+}
+
+// PreflightChain gives access to the blocks the candidate binary replays.
+type PreflightChain interface {
+	// Height returns the height of the last committed block.
+	Height(ctx context.Context) (int64, error)
+	// Block returns the block at the given height along with the app hash resulting from it.
+	Block(ctx context.Context, height int64) (*PreflightBlock, error)
+	// Genesis returns the genesis document of the chain.
+	Genesis(ctx context.Context) ([]byte, error)
+}
+
+// PreflightSnapshots gives access to the local snapshots.
+type PreflightSnapshots interface {
+	// LatestSnapshotHeight returns the block height of the latest snapshot in the given vega home.
+	LatestSnapshotHeight(vegaPaths paths.Paths) (uint64, error)
+}
+
+// PreflightReport is the outcome of running a candidate binary against the latest local snapshot.
+type PreflightReport struct {
+	VegaReleaseTag string `json:"vegaReleaseTag"`
+	SnapshotHeight uint64 `json:"snapshotHeight"`
+	BlocksReplayed uint64 `json:"blocksReplayed"`
+	Ready          bool   `json:"ready"`
+	Error          string `json:"error,omitempty"`
+}
+
+// Preflight checks a candidate binary can restore the state from the latest local snapshot
+// and replay the blocks following it with the same app hashes as the current binary.
+// The candidate binary runs against a copy of the snapshots in a sandbox directory.
+type Preflight struct {
+	log       *logging.Logger
+	config    PreflightConfig
+	vegaPaths paths.Paths
+	chain     PreflightChain
+	snapshots PreflightSnapshots
+
+	// execute runs the candidate binary, it is replaceable for testing.
+	execute func(ctx context.Context, binary string, args []string, passphrase string) error
+}
+
+func NewPreflight(log *logging.Logger, config PreflightConfig, vegaPaths paths.Paths, chain PreflightChain, snapshots PreflightSnapshots) *Preflight {
+	return &Preflight{
+		log:       log.Named("preflight"),
+		config:    config,
+		vegaPaths: vegaPaths,
+		chain:     chain,
+		snapshots: snapshots,
+		execute:   runBinary,
+	}
+}
+
+// Run runs the pre-flight checks of the candidate binary for the given release tag.
+func (p *Preflight) Run(ctx context.Context, vegaReleaseTag, binary, passphrase string) *PreflightReport {
+	report := &PreflightReport{
+		VegaReleaseTag: vegaReleaseTag,
+	}
+	if err := p.check(ctx, binary, passphrase, report); err != nil {
+		report.Error = err.Error()
+		p.log.Error("candidate binary failed the pre-flight checks",
+			logging.String("vega-release-tag", vegaReleaseTag),
+			logging.String("binary", binary),
+			logging.Error(err),
+		)
+		return report
+	}
+	report.Ready = true
+	return report
+}
+
+func (p *Preflight) check(ctx context.Context, binary, passphrase string, report *PreflightReport) error {
+	sandbox, err := os.MkdirTemp(p.config.SandboxDir, "vega-preflight-")
+	if err != nil {
+		return fmt.Errorf("couldn't create the sandbox: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(sandbox); err != nil {
+			p.log.Warn("couldn't remove the sandbox", logging.String("sandbox", sandbox), logging.Error(err))
+		}
+	}()
+
+	sandboxHome := filepath.Join(sandbox, "home")
+	sandboxPaths := paths.New(sandboxHome)
+	if err := p.prepareHome(sandboxPaths); err != nil {
+		return err
+	}
+
+	snapshotHeight, err := p.snapshots.LatestSnapshotHeight(sandboxPaths)
+	if err != nil {
+		return fmt.Errorf("couldn't get the latest snapshot: %w", err)
+	}
+	if snapshotHeight == 0 {
+		return ErrPreflightNoSnapshot
+	}
+	report.SnapshotHeight = snapshotHeight
+
+	replayFile := filepath.Join(sandbox, "replay.json")
+	replayed, err := p.writeReplayFile(ctx, replayFile, int64(snapshotHeight))
+	if err != nil {
+		return err
+	}
+
+	genesisFile := filepath.Join(sandbox, "genesis.json")
+	genesis, err := p.chain.Genesis(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't get the genesis document: %w", err)
+	}
+	if err := os.WriteFile(genesisFile, genesis, 0o600); err != nil {
+		return fmt.Errorf("couldn't write the genesis file: %w", err)
+	}
+
+	args := p.candidateArgs(map[string]string{
+		"{home}":            sandboxHome,
+		"{passphrase-file}": preflightPassphraseFile,
+		"{snapshot-height}": strconv.FormatUint(snapshotHeight, 10),
+		"{genesis-file}":    genesisFile,
+		"{replay-file}":     replayFile,
+	})
+
+	p.log.Info("running candidate binary against the latest snapshot",
+		logging.String("binary", binary),
+		logging.Uint64("snapshot-height", snapshotHeight),
+		logging.Uint64("blocks", replayed),
+	)
+
+	runCtx, cancel := context.WithTimeout(ctx, p.config.Timeout.Get())
+	defer cancel()
+	if err := p.execute(runCtx, binary, args, passphrase); err != nil {
+		return fmt.Errorf("candidate binary couldn't replay the blocks from the snapshot: %w", err)
+	}
+	report.BlocksReplayed = replayed
+	return nil
+}
+
+// prepareHome copies the snapshots into the sandbox. The node configuration and wallets
+// are only read by the candidate binary, so they are linked rather than copied.
+func (p *Preflight) prepareHome(sandboxPaths paths.Paths) error {
+	links := []struct {
+		from, to string
+	}{
+		{p.vegaPaths.ConfigPathFor(paths.NodeConfigHome), sandboxPaths.ConfigPathFor(paths.NodeConfigHome)},
+		{p.vegaPaths.DataPathFor(paths.NodeWalletsDataHome), sandboxPaths.DataPathFor(paths.NodeWalletsDataHome)},
+	}
+	for _, l := range links {
+		if err := os.MkdirAll(filepath.Dir(l.to), 0o700); err != nil {
+			return fmt.Errorf("couldn't create the sandbox directory for %s: %w", l.from, err)
+		}
+		if err := os.Symlink(l.from, l.to); err != nil {
+			return fmt.Errorf("couldn't link %s in the sandbox: %w", l.from, err)
+		}
+	}
+
+	snapshots := p.vegaPaths.StatePathFor(paths.SnapshotStateHome)
+	if err := copyDir(snapshots, sandboxPaths.StatePathFor(paths.SnapshotStateHome)); err != nil {
+		return fmt.Errorf("couldn't copy %s to the sandbox: %w", snapshots, err)
+	}
+	return nil
+}
+
+// writeReplayFile writes the blocks following the snapshot to the replay file, and returns
+// the number of blocks written.
+func (p *Preflight) writeReplayFile(ctx context.Context, path string, snapshotHeight int64) (uint64, error) {
+	height, err := p.chain.Height(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get the current block height: %w", err)
+	}
+
+	// the app hash of a block is only known once the next block is committed
+	last := snapshotHeight + int64(p.config.ReplayBlocks)
+	if last > height-1 {
+		last = height - 1
+	}
+	if last <= snapshotHeight {
+		return 0, ErrPreflightMissingBlocks
+	}
+
+	var buf bytes.Buffer
+	for h := snapshotHeight + 1; h <= last; h++ {
+		block, err := p.chain.Block(ctx, h)
+		if err != nil {
+			return 0, fmt.Errorf("couldn't get the block at height %d: %w", h, err)
+		}
+		b, err := json.Marshal(block)
+		if err != nil {
+			return 0, err
+		}
+		buf.Write(b)
+		buf.WriteString("\n")
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return 0, fmt.Errorf("couldn't write the replay file: %w", err)
+	}
+	return uint64(last - snapshotHeight), nil
+}
+
+func (p *Preflight) candidateArgs(values map[string]string) []string {
+	oldnew := make([]string, 0, len(values)*2)
+	for k, v := range values {
+		oldnew = append(oldnew, k, v)
+	}
+	r := strings.NewReplacer(oldnew...)
+
+	args := make([]string, 0, len(p.config.Args))
+	for _, a := range p.config.Args {
+		args = append(args, r.Replace(a))
+	}
+	return args
+}
+
+func runBinary(ctx context.Context, binary string, args []string, passphrase string) error {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("couldn't create the passphrase pipe: %w", err)
+	}
+	defer r.Close()
+	// the passphrase fits in the pipe buffer, so it is written before the candidate starts
+	if _, err := w.WriteString(passphrase); err != nil {
+		w.Close()
+		return fmt.Errorf("couldn't write the passphrase to the pipe: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("couldn't close the passphrase pipe: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, binary, args...)
+	// the first extra file is the file descriptor 3 of the candidate
+	cmd.ExtraFiles = []*os.File{r}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, lastLines(out.String(), 20))
+	}
+	return nil
+}
+
+func lastLines(s string, n int) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func copyDir(from, to string) error {
+	return filepath.WalkDir(from, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(to, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		// the lock of the database is held by the running node
+		if d.Name() == "LOCK" {
+			return nil
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(from, to string) error {
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(to, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
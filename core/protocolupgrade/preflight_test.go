@@ -0,0 +1,201 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package protocolupgrade
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"code.vegaprotocol.io/vega/logging"
+	"code.vegaprotocol.io/vega/paths"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakePreflightChain struct {
+	height int64
+}
+
+func (c fakePreflightChain) Height(_ context.Context) (int64, error) {
+	return c.height, nil
+}
+
+func (c fakePreflightChain) Block(_ context.Context, height int64) (*PreflightBlock, error) {
+	return &PreflightBlock{Height: height, Txs: [][]byte{[]byte("tx")}, AppHash: []byte("hash")}, nil
+}
+
+func (c fakePreflightChain) Genesis(_ context.Context) ([]byte, error) {
+	return []byte(`{"chain_id":"test"}`), nil
+}
+
+type fakePreflightSnapshots struct {
+	height uint64
+	files  []string
+}
+
+func (s *fakePreflightSnapshots) LatestSnapshotHeight(vegaPaths paths.Paths) (uint64, error) {
+	entries, err := os.ReadDir(vegaPaths.StatePathFor(paths.SnapshotStateHome))
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		s.files = append(s.files, e.Name())
+	}
+	return s.height, nil
+}
+
+func getTestPreflight(t *testing.T, chainHeight int64, snapshotHeight uint64) (*Preflight, *fakePreflightSnapshots) {
+	t.Helper()
+	vegaPaths := paths.New(t.TempDir())
+	snapshotDir, err := vegaPaths.CreateStateDirFor(paths.SnapshotStateHome)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, "000001.ldb"), []byte("data"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, "LOCK"), nil, 0o600))
+	_, err = vegaPaths.CreateConfigPathFor(paths.NodeDefaultConfigFile)
+	require.NoError(t, err)
+	_, err = vegaPaths.CreateDataDirFor(paths.VegaNodeWalletsDataHome)
+	require.NoError(t, err)
+	_, err = vegaPaths.CreateDataDirFor(paths.JoinDataPath(paths.NodeDataHome, "other"))
+	require.NoError(t, err)
+
+	cfg := NewDefaultConfig().Preflight
+	cfg.ReplayBlocks = 3
+	cfg.SandboxDir = t.TempDir()
+	snapshots := &fakePreflightSnapshots{height: snapshotHeight}
+	return NewPreflight(logging.NewTestLogger(), cfg, vegaPaths, fakePreflightChain{height: chainHeight}, snapshots), snapshots
+}
+
+func TestPreflight(t *testing.T) {
+	t.Run("candidate replays the blocks following the snapshot", testPreflightReady)
+	t.Run("candidate fails to replay the blocks", testPreflightCandidateFails)
+	t.Run("no snapshot to run against", testPreflightNoSnapshot)
+	t.Run("no block to replay", testPreflightNoBlocks)
+	t.Run("passphrase is given through a pipe", testPreflightPassphrasePipe)
+}
+
+func testPreflightReady(t *testing.T) {
+	p, snapshots := getTestPreflight(t, 20, 10)
+
+	var sandbox, home string
+	p.execute = func(_ context.Context, binary string, args []string, passphrase string) error {
+		require.Equal(t, "vega-candidate", binary)
+		require.Equal(t, "passphrase", passphrase)
+		require.Contains(t, args, "--snapshot.load-from-block-height=10")
+		require.Contains(t, args, "--nodewallet-passphrase-file="+preflightPassphraseFile)
+
+		var replayFile string
+		for _, a := range args {
+			if strings.HasPrefix(a, "--home=") {
+				home = strings.TrimPrefix(a, "--home=")
+				sandbox = filepath.Dir(home)
+			}
+			if strings.HasPrefix(a, "--blockchain.nullchain.replay-file=") {
+				replayFile = strings.TrimPrefix(a, "--blockchain.nullchain.replay-file=")
+			}
+		}
+
+		// the blocks following the snapshot are in the replay file
+		f, err := os.Open(replayFile)
+		require.NoError(t, err)
+		defer f.Close()
+		heights := []int64{}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			b := &PreflightBlock{}
+			require.NoError(t, json.Unmarshal(s.Bytes(), b))
+			heights = append(heights, b.Height)
+		}
+		require.Equal(t, []int64{11, 12, 13}, heights)
+
+		// the node configuration and wallets are linked, the other node data isn't copied
+		sandboxPaths := paths.New(home)
+		for _, path := range []string{sandboxPaths.ConfigPathFor(paths.NodeConfigHome), sandboxPaths.DataPathFor(paths.NodeWalletsDataHome)} {
+			info, err := os.Lstat(path)
+			require.NoError(t, err)
+			require.NotZero(t, info.Mode()&os.ModeSymlink)
+		}
+		_, err = os.Stat(sandboxPaths.DataPathFor(paths.JoinDataPath(paths.NodeDataHome, "other")))
+		require.True(t, os.IsNotExist(err))
+		return nil
+	}
+
+	report := p.Run(context.Background(), "v0.74.0", "vega-candidate", "passphrase")
+	require.Equal(t, &PreflightReport{
+		VegaReleaseTag: "v0.74.0",
+		SnapshotHeight: 10,
+		BlocksReplayed: 3,
+		Ready:          true,
+	}, report)
+
+	// the snapshots are copied in the sandbox, without the database lock
+	require.Equal(t, []string{"000001.ldb"}, snapshots.files)
+	// the sandbox is removed once done
+	_, err := os.Stat(sandbox)
+	require.True(t, os.IsNotExist(err))
+}
+
+func testPreflightCandidateFails(t *testing.T) {
+	p, _ := getTestPreflight(t, 20, 10)
+	p.execute = func(_ context.Context, _ string, _ []string, _ string) error {
+		return errors.New("appHash mismatch on replay")
+	}
+
+	report := p.Run(context.Background(), "v0.74.0", "vega-candidate", "passphrase")
+	require.False(t, report.Ready)
+	require.Equal(t, uint64(10), report.SnapshotHeight)
+	require.Equal(t, uint64(0), report.BlocksReplayed)
+	require.Contains(t, report.Error, "appHash mismatch on replay")
+}
+
+func testPreflightNoSnapshot(t *testing.T) {
+	p, _ := getTestPreflight(t, 20, 0)
+	p.execute = func(_ context.Context, _ string, _ []string, _ string) error {
+		t.Fatal("the candidate should not run")
+		return nil
+	}
+
+	report := p.Run(context.Background(), "v0.74.0", "vega-candidate", "passphrase")
+	require.False(t, report.Ready)
+	require.Equal(t, ErrPreflightNoSnapshot.Error(), report.Error)
+}
+
+func testPreflightNoBlocks(t *testing.T) {
+	// the app hash of the block following the snapshot isn't known yet
+	p, _ := getTestPreflight(t, 11, 10)
+	p.execute = func(_ context.Context, _ string, _ []string, _ string) error {
+		t.Fatal("the candidate should not run")
+		return nil
+	}
+
+	report := p.Run(context.Background(), "v0.74.0", "vega-candidate", "passphrase")
+	require.False(t, report.Ready)
+	require.Equal(t, ErrPreflightMissingBlocks.Error(), report.Error)
+}
+
+func testPreflightPassphrasePipe(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "out")
+	require.NoError(t, runBinary(context.Background(), "sh", []string{"-c", "cat " + preflightPassphraseFile + " > " + out}, "passphrase"))
+
+	got, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, "passphrase", string(got))
+}
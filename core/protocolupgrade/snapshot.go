@@ -20,6 +20,7 @@ import (
 	"sort"
 
 	"code.vegaprotocol.io/vega/core/types"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
 	snappb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 
@@ -100,10 +101,14 @@ func (e *Engine) LoadState(ctx context.Context, p *types.Payload) ([]types.State
 			vegaReleaseTag: evt.VegaReleaseTag,
 			blockHeight:    evt.UpgradeBlockHeight,
 			accepted:       make(map[string]struct{}, len(evt.Approvers)),
+			readiness:      make(map[string]*commandspb.ProtocolUpgradeReadiness, len(evt.Readiness)),
 		}
 		for _, approver := range evt.Approvers {
 			e.activeProposals[ID].accepted[approver] = struct{}{}
 		}
+		for _, r := range evt.Readiness {
+			e.activeProposals[ID].readiness[r.Approver] = r.Readiness
+		}
 	}
 
 	if pl.Proposals.AcceptedProposal != nil {
@@ -59,6 +59,10 @@ var (
 		return fmt.Errorf("party %q does not own the referral set", party)
 	}
 
+	ErrReferralChainCycle = func(party types.PartyID, setID types.ReferralSetID) error {
+		return fmt.Errorf("party %q cannot join referral set %q as it is upstream of its own set", party, setID)
+	}
+
 	ErrUnknownSetID = errors.New("unknown set ID")
 )
 
@@ -91,6 +95,15 @@ type Engine struct {
 	// to create/update referral program.
 	minBalanceForReferralSet *num.Uint
 
+	// maxReferralDepth is the number of referrers up the referral chain of a
+	// referee who earn a share of its referrer reward. With a depth of 1, only
+	// the direct referrer is rewarded, and a referrer cannot be a referee.
+	maxReferralDepth uint64
+
+	// upstreamReferrerRewardShare is the fraction of the reward earned at a
+	// level of the referral chain that is passed on to the referrer above it.
+	upstreamReferrerRewardShare num.Decimal
+
 	// latestProgramVersion tracks the latest version of the program. It used to
 	// value any new program that comes in. It starts at 1.
 	// It's incremented every time an update is received. Therefore, if, during
@@ -165,7 +178,7 @@ func (e *Engine) CreateReferralSet(ctx context.Context, party types.PartyID, det
 	if _, ok := e.referrers[party]; ok {
 		return ErrIsAlreadyAReferrer(party)
 	}
-	if _, ok := e.referees[party]; ok {
+	if _, ok := e.referees[party]; ok && !e.allowsReferralChains() {
 		return ErrIsAlreadyAReferee(party)
 	}
 
@@ -198,7 +211,8 @@ func (e *Engine) CreateReferralSet(ctx context.Context, party types.PartyID, det
 }
 
 func (e *Engine) ApplyReferralCode(ctx context.Context, party types.PartyID, setID types.ReferralSetID) error {
-	if _, ok := e.referrers[party]; ok {
+	ownSet, isReferrer := e.referrers[party]
+	if isReferrer && !e.allowsReferralChains() {
 		return ErrIsAlreadyAReferrer(party)
 	}
 
@@ -219,6 +233,10 @@ func (e *Engine) ApplyReferralCode(ctx context.Context, party types.PartyID, set
 		return ErrUnknownReferralCode(setID)
 	}
 
+	if isReferrer && e.isUpstreamOf(ownSet, setID) {
+		return ErrReferralChainCycle(party, setID)
+	}
+
 	now := e.timeSvc.GetTimeNow()
 
 	set.UpdatedAt = now
@@ -255,6 +273,73 @@ func (e *Engine) removeFromSet(party types.PartyID, prevSet types.ReferralSetID)
 	set.Referees = append(set.Referees[:idx], set.Referees[idx+1:]...)
 }
 
+// allowsReferralChains tells if referrers can be referred, and referees can
+// refer, building referral chains.
+func (e *Engine) allowsReferralChains() bool {
+	return e.maxReferralDepth > 1
+}
+
+// isUpstreamOf tells if the set `upstream` is found when walking up the
+// referral chain starting from the set `setID`.
+func (e *Engine) isUpstreamOf(upstream, setID types.ReferralSetID) bool {
+	for {
+		if setID == upstream {
+			return true
+		}
+		parent, ok := e.referees[e.sets[setID].Referrer.PartyID]
+		if !ok {
+			return false
+		}
+		setID = parent
+	}
+}
+
+// referrerChain returns the referrers up the referral chain of the referee,
+// starting with its direct referrer, within the maximum referral depth.
+func (e *Engine) referrerChain(referee types.PartyID) []types.PartyID {
+	chain := []types.PartyID{}
+	party := referee
+	for uint64(len(chain)) < e.maxReferralDepth {
+		setID, ok := e.referees[party]
+		if !ok {
+			break
+		}
+		party = e.sets[setID].Referrer.PartyID
+		chain = append(chain, party)
+	}
+	return chain
+}
+
+// SplitReferrerReward splits the referrer reward paid by the referee between
+// the referrers up its referral chain. Each referrer passes on the upstream
+// share of what it receives to its own referrer, up to the maximum referral
+// depth. The referrer at the top of the chain keeps what it receives.
+func (e *Engine) SplitReferrerReward(referee types.PartyID, reward *num.Uint) []*types.ReferrerRewardShare {
+	chain := e.referrerChain(referee)
+	if len(chain) == 0 || reward.IsZero() {
+		return nil
+	}
+
+	shares := make([]*types.ReferrerRewardShare, 0, len(chain))
+	remaining := reward.Clone()
+	for i, referrer := range chain {
+		amount := remaining.Clone()
+		if i < len(chain)-1 {
+			upstream, _ := num.UintFromDecimal(remaining.ToDecimal().Mul(e.upstreamReferrerRewardShare).Floor())
+			amount.Sub(amount, upstream)
+			remaining = upstream
+		}
+		if !amount.IsZero() {
+			shares = append(shares, &types.ReferrerRewardShare{
+				Referrer: referrer,
+				Level:    uint64(i + 1),
+				Amount:   amount,
+			})
+		}
+	}
+	return shares
+}
+
 func (e *Engine) UpdateProgram(newProgram *types.ReferralProgram) {
 	e.latestProgramVersion += 1
 	e.newProgram = newProgram
@@ -326,6 +411,16 @@ func (e *Engine) OnReferralProgramMaxReferralRewardProportionUpdate(_ context.Co
 	return nil
 }
 
+func (e *Engine) OnReferralProgramMaxReferralDepthUpdate(_ context.Context, value *num.Uint) error {
+	e.maxReferralDepth = value.Uint64()
+	return nil
+}
+
+func (e *Engine) OnReferralProgramUpstreamReferrerRewardShareUpdate(_ context.Context, value num.Decimal) error {
+	e.upstreamReferrerRewardShare = value
+	return nil
+}
+
 func (e *Engine) OnReferralProgramMinStakedVegaTokensUpdate(_ context.Context, value *num.Uint) error {
 	e.referralProgramMinStakedVegaTokens = value
 	return nil
@@ -552,6 +647,10 @@ func (e *Engine) computeFactorsByReferee(ctx context.Context, epoch uint64, take
 			RewardsFactorsMultiplier: types.EmptyFactors,
 		}
 
+		if upstreamSetID, ok := e.referees[set.Referrer.PartyID]; ok {
+			setStats.UpstreamSetID = upstreamSetID
+		}
+
 		setStats.ReferralSetRunningVolume = e.referralSetsNotionalVolumes.RunningSetVolumeForWindow(setID, e.currentProgram.WindowLength)
 
 		stakingBalance, _ := e.staking.GetAvailableBalance(set.Referrer.PartyID.String())
@@ -699,6 +798,9 @@ func NewEngine(broker Broker, timeSvc TimeService, mat MarketActivityTracker, st
 
 		referralProgramMinStakedVegaTokens: num.UintZero(),
 
+		maxReferralDepth:            1,
+		upstreamReferrerRewardShare: num.DecimalZero(),
+
 		sets:      map[types.ReferralSetID]*types.ReferralSet{},
 		referrers: map[types.PartyID]types.ReferralSetID{},
 		referees:  map[types.PartyID]types.ReferralSetID{},
@@ -674,3 +674,80 @@ func TestGettingRewardAndDiscountFactors(t *testing.T) {
 	assert.Equal(t, num.DecimalZero().String(), te.engine.ReferralDiscountFactorsForParty(referee3).Infra.String())
 	assert.Equal(t, num.DecimalZero().String(), te.engine.ReferralDiscountFactorsForParty(referee2).Infra.String())
 }
+
+func TestReferralChains(t *testing.T) {
+	te := newEngine(t)
+
+	ctx := vgtest.VegaContext(vgrand.RandomStr(5), vgtest.RandomI64())
+
+	topSet, midSet, lowSet := newSetID(t), newSetID(t), newSetID(t)
+	top, mid, low := newPartyID(t), newPartyID(t), newPartyID(t)
+	referee := newPartyID(t)
+
+	te.staking.EXPECT().GetAvailableBalance(gomock.Any()).Return(num.NewUint(10001), nil).AnyTimes()
+	te.broker.EXPECT().Send(gomock.Any()).AnyTimes()
+	te.timeSvc.EXPECT().GetTimeNow().AnyTimes()
+
+	require.NoError(t, te.engine.CreateReferralSet(ctx, top, topSet))
+	require.NoError(t, te.engine.CreateReferralSet(ctx, mid, midSet))
+
+	t.Run("a referrer cannot be referred with a referral depth of 1", func(t *testing.T) {
+		assert.EqualError(t, te.engine.ApplyReferralCode(ctx, mid, topSet),
+			referral.ErrIsAlreadyAReferrer(mid).Error(),
+		)
+	})
+
+	require.NoError(t, te.engine.OnReferralProgramMaxReferralDepthUpdate(ctx, num.NewUint(3)))
+	require.NoError(t, te.engine.OnReferralProgramUpstreamReferrerRewardShareUpdate(ctx, num.DecimalFromFloat(0.5)))
+
+	t.Run("a referrer can be referred with a referral depth above 1", func(t *testing.T) {
+		require.NoError(t, te.engine.ApplyReferralCode(ctx, mid, topSet))
+		referrer, err := te.engine.GetReferrer(mid)
+		require.NoError(t, err)
+		assert.Equal(t, top, referrer)
+	})
+
+	t.Run("a referrer cannot join a set downstream of its own", func(t *testing.T) {
+		assert.EqualError(t, te.engine.ApplyReferralCode(ctx, top, midSet),
+			referral.ErrReferralChainCycle(top, midSet).Error(),
+		)
+	})
+
+	t.Run("a referee can create a set with a referral depth above 1", func(t *testing.T) {
+		require.NoError(t, te.engine.ApplyReferralCode(ctx, low, midSet))
+		require.NoError(t, te.engine.CreateReferralSet(ctx, low, lowSet))
+		require.NoError(t, te.engine.ApplyReferralCode(ctx, referee, lowSet))
+	})
+
+	t.Run("the reward is split up the referral chain", func(t *testing.T) {
+		shares := te.engine.SplitReferrerReward(referee, num.NewUint(1000))
+		require.Len(t, shares, 3)
+		expected := []struct {
+			referrer types.PartyID
+			amount   uint64
+		}{{low, 500}, {mid, 250}, {top, 250}}
+		for i, e := range expected {
+			assert.Equal(t, e.referrer, shares[i].Referrer)
+			assert.Equal(t, uint64(i+1), shares[i].Level)
+			assert.Equal(t, e.amount, shares[i].Amount.Uint64())
+		}
+	})
+
+	t.Run("the split stops at the maximum referral depth", func(t *testing.T) {
+		require.NoError(t, te.engine.OnReferralProgramMaxReferralDepthUpdate(ctx, num.NewUint(2)))
+		shares := te.engine.SplitReferrerReward(referee, num.NewUint(1001))
+		require.Len(t, shares, 2)
+		assert.Equal(t, low, shares[0].Referrer)
+		assert.Equal(t, uint64(501), shares[0].Amount.Uint64())
+		assert.Equal(t, mid, shares[1].Referrer)
+		assert.Equal(t, uint64(500), shares[1].Amount.Uint64())
+	})
+
+	t.Run("the direct referrer gets everything without upstream share", func(t *testing.T) {
+		require.NoError(t, te.engine.OnReferralProgramUpstreamReferrerRewardShareUpdate(ctx, num.DecimalZero()))
+		shares := te.engine.SplitReferrerReward(referee, num.NewUint(1000))
+		require.Len(t, shares, 1)
+		assert.Equal(t, low, shares[0].Referrer)
+		assert.Equal(t, uint64(1000), shares[0].Amount.Uint64())
+	})
+}
@@ -81,6 +81,7 @@ type Topology interface {
 
 type Transfers interface {
 	GetDispatchStrategy(string) *proto.DispatchStrategy
+	GetVestingSchedule(string) *types.VestingSchedule
 }
 
 type Teams interface {
@@ -90,6 +91,7 @@ type Teams interface {
 
 type Vesting interface {
 	AddReward(ctx context.Context, party, asset string, amount *num.Uint, lockedForEpochs uint64)
+	AddScheduledReward(ctx context.Context, party, asset string, amount *num.Uint, lockedForEpochs uint64, schedule *types.VestingSchedule)
 	GetSingleAndSummedRewardBonusMultipliers(party string) (vesting.MultiplierAndQuantBalance, vesting.MultiplierAndQuantBalance)
 }
 
@@ -138,6 +140,7 @@ type payout struct {
 	gameID           *string
 	lockedForEpochs  uint64
 	lockedUntilEpoch string
+	vestingSchedule  *types.VestingSchedule
 }
 
 // New instantiate a new rewards engine.
@@ -323,6 +326,7 @@ func (e *Engine) calculateRewardPayouts(ctx context.Context, epoch types.Epoch)
 					po.rewardType = rewardType
 					if account.MarketID != "!" {
 						po.gameID = &account.MarketID
+						po.vestingSchedule = e.transfers.GetVestingSchedule(account.MarketID)
 					}
 					po.timestamp = now.UnixNano()
 					payouts = append(payouts, po)
@@ -492,6 +496,10 @@ func (e *Engine) distributePayout(ctx context.Context, po *payout) {
 	if po.rewardType != types.AccountTypeFeesInfrastructure {
 		for _, party := range partyIDs {
 			amt := po.partyToAmount[party]
+			if po.vestingSchedule != nil {
+				e.vesting.AddScheduledReward(ctx, party, po.asset, amt, po.lockedForEpochs, po.vestingSchedule)
+				continue
+			}
 			e.vesting.AddReward(ctx, party, po.asset, amt, po.lockedForEpochs)
 		}
 	}
@@ -288,6 +288,20 @@ func (mr *MockTransfersMockRecorder) GetDispatchStrategy(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispatchStrategy", reflect.TypeOf((*MockTransfers)(nil).GetDispatchStrategy), arg0)
 }
 
+// GetVestingSchedule mocks base method.
+func (m *MockTransfers) GetVestingSchedule(arg0 string) *types.VestingSchedule {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVestingSchedule", arg0)
+	ret0, _ := ret[0].(*types.VestingSchedule)
+	return ret0
+}
+
+// GetVestingSchedule indicates an expected call of GetVestingSchedule.
+func (mr *MockTransfersMockRecorder) GetVestingSchedule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVestingSchedule", reflect.TypeOf((*MockTransfers)(nil).GetVestingSchedule), arg0)
+}
+
 // MockTeams is a mock of Teams interface.
 type MockTeams struct {
 	ctrl     *gomock.Controller
@@ -374,6 +388,18 @@ func (mr *MockVestingMockRecorder) AddReward(arg0, arg1, arg2, arg3, arg4 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReward", reflect.TypeOf((*MockVesting)(nil).AddReward), arg0, arg1, arg2, arg3, arg4)
 }
 
+// AddScheduledReward mocks base method.
+func (m *MockVesting) AddScheduledReward(arg0 context.Context, arg1, arg2 string, arg3 *num.Uint, arg4 uint64, arg5 *types.VestingSchedule) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddScheduledReward", arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// AddScheduledReward indicates an expected call of AddScheduledReward.
+func (mr *MockVestingMockRecorder) AddScheduledReward(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddScheduledReward", reflect.TypeOf((*MockVesting)(nil).AddScheduledReward), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // GetSingleAndSummedRewardBonusMultipliers mocks base method.
 func (m *MockVesting) GetSingleAndSummedRewardBonusMultipliers(arg0 string) (vesting.MultiplierAndQuantBalance, vesting.MultiplierAndQuantBalance) {
 	m.ctrl.T.Helper()
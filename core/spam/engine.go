@@ -137,6 +137,8 @@ func New(log *logging.Logger, config Config, epochEngine EpochEngine, accounting
 	e.transactionTypeToPolicy[txn.AnnounceNodeCommand] = valJoinPolicy
 	e.transactionTypeToPolicy[txn.DelegateCommand] = delegationPolicy
 	e.transactionTypeToPolicy[txn.UndelegateCommand] = delegationPolicy
+	e.transactionTypeToPolicy[txn.RedelegateCommand] = delegationPolicy
+	e.transactionTypeToPolicy[txn.AutoCompoundCommand] = delegationPolicy
 	e.transactionTypeToPolicy[txn.TransferFundsCommand] = transferPolicy
 	e.transactionTypeToPolicy[txn.CancelTransferFundsCommand] = transferPolicy
 	e.transactionTypeToPolicy[txn.IssueSignatures] = issuesSignaturesPolicy
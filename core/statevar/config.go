@@ -27,12 +27,20 @@ const namedLogger = "statevar"
 // Config represent the configuration of the collateral engine.
 type Config struct {
 	Level encoding.LogLevel `long:"log-level"`
+	// MaxValuesPerProposal is the number of floating point values above which
+	// a result is sent in chunks over several blocks.
+	MaxValuesPerProposal int `long:"max-values-per-proposal"`
+	// MedianAggregation lists the names of the state variables whose value is the
+	// median of the results of the validators rather than the first agreed one.
+	// It must be the same on all the nodes of the network.
+	MedianAggregation []string `long:"median-aggregation"`
 }
 
 // NewDefaultConfig creates an instance of the package specific configuration, given a
 // pointer to a logger instance to be used for logging within the package.
 func NewDefaultConfig() Config {
 	return Config{
-		Level: encoding.LogLevel{Level: logging.InfoLevel},
+		Level:                encoding.LogLevel{Level: logging.InfoLevel},
+		MaxValuesPerProposal: 1000,
 	}
 }
@@ -33,6 +33,7 @@ import (
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/protobuf/proto"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -250,6 +251,7 @@ func (e *Engine) RegisterStateVariable(asset, market, name string, converter sta
 
 	sv := NewStateVar(e.log, e.broker, e.top, e.cmd, e.currentTime, ID, asset, market, converter, startCalculation, trigger, result)
 	sv.currentTime = e.currentTime
+	sv.maxValuesPerProposal = e.config.MaxValuesPerProposal
 	e.stateVars[ID] = sv
 	for _, t := range trigger {
 		if _, ok := e.eventTypeToStateVar[t]; !ok {
@@ -257,6 +259,21 @@ func (e *Engine) RegisterStateVariable(asset, market, name string, converter sta
 		}
 		e.eventTypeToStateVar[t] = append(e.eventTypeToStateVar[t], sv)
 	}
+	if slices.Contains(e.config.MedianAggregation, name) {
+		return e.SetAggregationMode(asset, market, name, statevar.AggregationModeMedian)
+	}
+	return nil
+}
+
+// SetAggregationMode sets how the results of the validators become the value of the state variable.
+func (e *Engine) SetAggregationMode(asset, market, name string, mode statevar.AggregationMode) error {
+	sv, ok := e.stateVars[e.generateID(asset, market, name)]
+	if !ok {
+		return ErrUnknownStateVar
+	}
+	sv.lock.Lock()
+	sv.aggregation = mode
+	sv.lock.Unlock()
 	return nil
 }
 
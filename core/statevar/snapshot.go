@@ -19,6 +19,8 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"code.vegaprotocol.io/vega/core/types"
@@ -34,6 +36,9 @@ var (
 	hashKeys                   = []string{key}
 )
 
+// chunkResultSeparator separates the node and the index of a chunk in the ID of a validator result.
+const chunkResultSeparator = "/"
+
 type snapshotState struct {
 	serialised []byte
 }
@@ -87,12 +92,27 @@ func mapToResults(m map[string]*statevar.KeyValueBundle) []*snapshot.FloatingPoi
 	return res
 }
 
+// chunkResultID is the ID under which a chunk of the result of a validator is saved
+// along the complete results.
+func chunkResultID(node string, index int) string {
+	return node + chunkResultSeparator + strconv.Itoa(index)
+}
+
 func (sv *StateVariable) serialise() *snapshot.StateVarInternalState {
+	results := mapToResults(sv.validatorResults)
+	if len(sv.chunks) > 0 {
+		for node, chunks := range sv.chunks {
+			for index, kvb := range chunks {
+				results = append(results, &snapshot.FloatingPointValidatorResult{Id: chunkResultID(node, index), Bundle: kvb.ToProto()})
+			}
+		}
+		sort.Slice(results, func(i, j int) bool { return results[i].Id < results[j].Id })
+	}
 	return &snapshot.StateVarInternalState{
 		Id:                          sv.ID,
 		EventId:                     sv.eventID,
 		State:                       int32(sv.state),
-		ValidatorsResults:           mapToResults(sv.validatorResults),
+		ValidatorsResults:           results,
 		RoundsSinceMeaningfulUpdate: int32(sv.roundsSinceMeaningfulUpdate),
 	}
 }
@@ -173,6 +193,20 @@ func (e *Engine) postRestore(stateVariablesInternalState []*snapshot.StateVarInt
 			if err != nil {
 				e.log.Panic("restoring malformed statevar kvb", logging.String("id", fpvr.Id), logging.Error(err))
 			}
+			if node, index, ok := strings.Cut(fpvr.Id, chunkResultSeparator); ok {
+				i, err := strconv.Atoi(index)
+				if err != nil {
+					e.log.Panic("restoring malformed statevar chunk", logging.String("id", fpvr.Id), logging.Error(err))
+				}
+				if sv.chunks == nil {
+					sv.chunks = map[string]map[int]*statevar.KeyValueBundle{}
+				}
+				if _, ok := sv.chunks[node]; !ok {
+					sv.chunks[node] = map[int]*statevar.KeyValueBundle{}
+				}
+				sv.chunks[node][i] = kvb
+				continue
+			}
 			sv.validatorResults[fpvr.Id] = kvb
 		}
 	}
@@ -26,12 +26,16 @@ import (
 	"code.vegaprotocol.io/vega/core/events"
 	"code.vegaprotocol.io/vega/core/statevar"
 	"code.vegaprotocol.io/vega/core/statevar/mocks"
+	"code.vegaprotocol.io/vega/core/txn"
 	types "code.vegaprotocol.io/vega/core/types/statevar"
 	"code.vegaprotocol.io/vega/libs/num"
 	"code.vegaprotocol.io/vega/logging"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
 
+	"github.com/cenkalti/backoff"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
 )
 
 type testEngine struct {
@@ -154,6 +158,11 @@ func TestStateVar(t *testing.T) {
 	t.Run("perfect match through quorum", testBundleReceivedPerfectMatchOfQuorum)
 	t.Run("reach consensus through random selection of one that is within reach of 2/3+1 of the others", testBundleReceivedReachingConsensusSuccessfuly)
 	t.Run("no consensus can be reached", testBundleReceivedReachingConsensusNotSuccessful)
+	t.Run("reach consensus through the median of the results", testBundleReceivedReachingConsensusThroughMedian)
+	t.Run("results received in chunks", testBundleReceivedInChunks)
+	t.Run("own result sent in chunks over blocks", testResultSentInChunksOverBlocks)
+	t.Run("missing chunks of own result are resent", testMissingChunksResent)
+	t.Run("median aggregation set from the config", testMedianAggregationFromConfig)
 	t.Run("time based trigger", testTimeBasedEvent)
 }
 
@@ -640,3 +649,221 @@ func testTimeBasedEvent(t *testing.T) {
 	brokerEvents = []events.Event{}
 	require.Equal(t, 0, len(brokerEvents))
 }
+
+func testBundleReceivedReachingConsensusThroughMedian(t *testing.T) {
+	// none of the results are within tolerance of each other, the median of the results received until the quorum is accepted
+	validatorResults := []*sampleParams{
+		{param1: num.DecimalFromFloat(10), param2: []num.Decimal{num.DecimalFromFloat(1), num.DecimalFromFloat(5)}},
+		{param1: num.DecimalFromFloat(20), param2: []num.Decimal{num.DecimalFromFloat(2), num.DecimalFromFloat(6)}},
+		{param1: num.DecimalFromFloat(40), param2: []num.Decimal{num.DecimalFromFloat(3), num.DecimalFromFloat(7)}},
+		{param1: num.DecimalFromFloat(80), param2: []num.Decimal{num.DecimalFromFloat(4), num.DecimalFromFloat(100)}},
+		{param1: num.DecimalFromFloat(160), param2: []num.Decimal{num.DecimalFromFloat(5), num.DecimalFromFloat(200)}},
+	}
+
+	counter := 0
+	resultCallback := func(_ context.Context, r types.StateVariableResult) error {
+		counter++
+		res := r.(*sampleParams)
+		require.Equal(t, "30", res.param1.String())
+		require.Equal(t, "2.5", res.param2[0].String())
+		require.Equal(t, "6.5", res.param2[1].String())
+		return nil
+	}
+
+	validators := setupValidators(t, 5, defaultStartCalc(), resultCallback)
+	for _, v := range validators {
+		v.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+		require.NoError(t, v.engine.SetAggregationMode("asset", "market", "name", types.AggregationModeMedian))
+		v.engine.NewEvent("asset", "market", types.EventTypeMarketEnactment)
+	}
+
+	c := converter{}
+	for i := 0; i < len(validators); i++ {
+		for j := 0; j < len(validators); j++ {
+			validators[j].engine.ProposedValueReceived(context.Background(), "asset_market_name", strconv.Itoa(i), "asset_market_8SQcDlWbkRMBvCoawjhbLStINMoO9wwo", c.InterfaceToBundle(validatorResults[i]))
+		}
+	}
+	require.Equal(t, 5, counter)
+	require.ErrorIs(t, validators[0].engine.SetAggregationMode("asset", "market", "unknown", types.AggregationModeMedian), statevar.ErrUnknownStateVar)
+}
+
+func testBundleReceivedInChunks(t *testing.T) {
+	result := &sampleParams{param1: num.DecimalFromFloat(1.23456), param2: []num.Decimal{num.DecimalFromFloat(1.1), num.DecimalFromFloat(2.2), num.DecimalFromFloat(3.3), num.DecimalFromFloat(4.4)}}
+
+	counter := 0
+	resultCallback := func(_ context.Context, r types.StateVariableResult) error {
+		counter++
+		require.Equal(t, result, r)
+		return nil
+	}
+
+	validators := setupValidators(t, 5, defaultStartCalc(), resultCallback)
+	for _, v := range validators {
+		v.broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+		v.engine.NewEvent("asset", "market", types.EventTypeMarketEnactment)
+	}
+
+	c := converter{}
+	chunks := c.InterfaceToBundle(result).Split(2)
+	require.Len(t, chunks, 3)
+
+	// all the validators send their chunks, one per block
+	for _, chunk := range chunks {
+		// the result is only complete once the last chunk is received
+		require.Equal(t, 0, counter)
+		for i := 0; i < len(validators); i++ {
+			for j := 0; j < len(validators); j++ {
+				validators[j].engine.ProposedValueReceived(context.Background(), "asset_market_name", strconv.Itoa(i), "asset_market_8SQcDlWbkRMBvCoawjhbLStINMoO9wwo", chunk)
+			}
+		}
+	}
+	require.Equal(t, 5, counter)
+}
+
+func testResultSentInChunksOverBlocks(t *testing.T) {
+	conf := statevar.NewDefaultConfig()
+	conf.MaxValuesPerProposal = 2
+	ctrl := gomock.NewController(t)
+	broker := bmocks.NewMockBroker(ctrl)
+	topology := mocks.NewMockTopology(ctrl)
+	commander := mocks.NewMockCommander(ctrl)
+	engine := statevar.New(logging.NewTestLogger(), conf, broker, topology, commander)
+	engine.OnTick(context.Background(), now)
+
+	broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	topology.EXPECT().IsValidator().Return(true).AnyTimes()
+
+	result := &sampleParams{param1: num.DecimalFromFloat(1.23456), param2: []num.Decimal{num.DecimalFromFloat(1.1), num.DecimalFromFloat(2.2), num.DecimalFromFloat(3.3), num.DecimalFromFloat(4.4)}}
+	startCalc := func(eventID string, f types.FinaliseCalculation) {
+		f.CalculationFinished(eventID, result, nil)
+	}
+	require.NoError(t, engine.RegisterStateVariable("asset", "market", "name", converter{}, startCalc, []types.EventType{types.EventTypeMarketEnactment}, defaultResultBack()))
+
+	sent := []*types.KeyValueBundle{}
+	commander.EXPECT().Command(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Do(
+		func(_ context.Context, _ txn.Command, payload protoiface.MessageV1, _ func(string, error), _ *backoff.ExponentialBackOff) {
+			kvb, err := types.KeyValueBundleFromProto(payload.(*commandspb.StateVariableProposal).Proposal.Kvb)
+			require.NoError(t, err)
+			sent = append(sent, kvb)
+		})
+
+	// the first chunk is sent as soon as the result is calculated, the others on the next blocks
+	engine.NewEvent("asset", "market", types.EventTypeMarketEnactment)
+	require.Len(t, sent, 1)
+	for i := 1; i <= 3; i++ {
+		engine.OnBlockEnd(context.Background())
+		engine.OnTick(context.Background(), now.Add(time.Duration(i)*time.Second))
+	}
+	require.Len(t, sent, 3)
+
+	for i, kvb := range sent {
+		index, total, ok := kvb.ChunkInfo()
+		require.True(t, ok)
+		require.Equal(t, i, index)
+		require.Equal(t, 3, total)
+	}
+	require.Equal(t, result, converter{}.BundleToInterface(types.MergeChunks(sent)))
+}
+
+func getSingleValidatorEngine(t *testing.T, conf statevar.Config) (*statevar.Engine, *mocks.MockCommander) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	broker := bmocks.NewMockBroker(ctrl)
+	topology := mocks.NewMockTopology(ctrl)
+	commander := mocks.NewMockCommander(ctrl)
+	engine := statevar.New(logging.NewTestLogger(), conf, broker, topology, commander)
+	engine.OnDefaultValidatorsVoteRequiredUpdate(context.Background(), num.DecimalFromFloat(0.67))
+	engine.OnTick(context.Background(), now)
+
+	votingPower := map[string]int64{"0": 10, "1": 20, "2": 30, "3": 40, "4": 50}
+	broker.EXPECT().SendBatch(gomock.Any()).AnyTimes()
+	topology.EXPECT().IsValidator().Return(true).AnyTimes()
+	topology.EXPECT().SelfNodeID().Return("0").AnyTimes()
+	topology.EXPECT().IsValidatorVegaPubKey(gomock.Any()).DoAndReturn(func(nodeID string) bool {
+		_, ok := votingPower[nodeID]
+		return ok
+	}).AnyTimes()
+	topology.EXPECT().AllNodeIDs().Return([]string{"0", "1", "2", "3", "4"}).AnyTimes()
+	topology.EXPECT().GetVotingPower(gomock.Any()).DoAndReturn(func(nodeID string) int64 {
+		return votingPower[nodeID]
+	}).AnyTimes()
+	topology.EXPECT().GetTotalVotingPower().Return(int64(150)).AnyTimes()
+	return engine, commander
+}
+
+func testMissingChunksResent(t *testing.T) {
+	conf := statevar.NewDefaultConfig()
+	conf.MaxValuesPerProposal = 2
+	engine, commander := getSingleValidatorEngine(t, conf)
+
+	result := &sampleParams{param1: num.DecimalFromFloat(1.23456), param2: []num.Decimal{num.DecimalFromFloat(1.1), num.DecimalFromFloat(2.2), num.DecimalFromFloat(3.3), num.DecimalFromFloat(4.4)}}
+	startCalc := func(eventID string, f types.FinaliseCalculation) {
+		f.CalculationFinished(eventID, result, nil)
+	}
+	require.NoError(t, engine.RegisterStateVariable("asset", "market", "name", converter{}, startCalc, []types.EventType{types.EventTypeMarketEnactment}, defaultResultBack()))
+
+	sent := []*commandspb.StateVariableProposal{}
+	commander.EXPECT().Command(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Do(
+		func(_ context.Context, _ txn.Command, payload protoiface.MessageV1, _ func(string, error), _ *backoff.ExponentialBackOff) {
+			sent = append(sent, payload.(*commandspb.StateVariableProposal))
+		})
+
+	// all the chunks are sent over blocks.
+	engine.NewEvent("asset", "market", types.EventTypeMarketEnactment)
+	for i := 1; i <= 2; i++ {
+		engine.OnBlockEnd(context.Background())
+		engine.OnTick(context.Background(), now.Add(time.Duration(i)*time.Second))
+	}
+	require.Len(t, sent, 3)
+	chunks := make([]*commandspb.StateVariableProposal, len(sent))
+	copy(chunks, sent)
+	eventID := chunks[0].Proposal.EventId
+
+	receive := func(svp *commandspb.StateVariableProposal) {
+		kvb, err := types.KeyValueBundleFromProto(svp.Proposal.Kvb)
+		require.NoError(t, err)
+		engine.ProposedValueReceived(context.Background(), svp.Proposal.StateVarId, "0", eventID, kvb)
+	}
+
+	// only the last two chunks made it to a block, the first one is sent again after the timeout.
+	receive(chunks[1])
+	receive(chunks[2])
+	sent = sent[:0]
+	engine.OnBlockEnd(context.Background())
+	engine.OnTick(context.Background(), now.Add(8*time.Second))
+	require.Len(t, sent, 1)
+	require.Equal(t, chunks[0], sent[0])
+
+	// once all the chunks are received back, nothing is sent anymore.
+	receive(chunks[0])
+	sent = sent[:0]
+	engine.OnBlockEnd(context.Background())
+	engine.OnTick(context.Background(), now.Add(20*time.Second))
+	require.Empty(t, sent)
+}
+
+func testMedianAggregationFromConfig(t *testing.T) {
+	conf := statevar.NewDefaultConfig()
+	conf.MedianAggregation = []string{"name"}
+	engine, commander := getSingleValidatorEngine(t, conf)
+	commander.EXPECT().Command(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	var accepted *sampleParams
+	require.NoError(t, engine.RegisterStateVariable("asset", "market", "name", converter{}, defaultStartCalc(), []types.EventType{types.EventTypeMarketEnactment}, func(_ context.Context, r types.StateVariableResult) error {
+		accepted = r.(*sampleParams)
+		return nil
+	}))
+	engine.NewEvent("asset", "market", types.EventTypeMarketEnactment)
+	eventID := "asset_market_8SQcDlWbkRMBvCoawjhbLStINMoO9wwo"
+
+	// the results are too far apart to agree on any of them.
+	c := converter{}
+	for i, v := range []float64{10, 20, 40, 80, 160} {
+		engine.ProposedValueReceived(context.Background(), "asset_market_name", strconv.Itoa(i), eventID, c.InterfaceToBundle(&sampleParams{
+			param1: num.DecimalFromFloat(v),
+			param2: []num.Decimal{num.DecimalFromFloat(v)},
+		}))
+	}
+	require.NotNil(t, accepted)
+	require.Equal(t, "40", accepted.param1.String())
+}
@@ -75,6 +75,13 @@ type StateVariable struct {
 	pendingEvents               []pendingEvent
 	lock                        sync.Mutex
 
+	aggregation          statevar.AggregationMode                    // how the results of the validators become the value
+	maxValuesPerProposal int                                         // the number of values above which our result is sent in chunks
+	chunks               map[string]map[int]*statevar.KeyValueBundle // the chunks received so far from validators, by node and index
+	selfProposals        []*commandspb.StateVariableProposal         // the chunks of our result, sent one per block
+	sentSelfProposals    int                                         // the number of chunks of our result sent so far
+	receivedSelfChunks   map[int]struct{}                            // the chunks of our result received back, by index
+
 	currentTime time.Time
 
 	// use retries to workaround transactions go missing in tendermint
@@ -145,16 +152,51 @@ func (sv *StateVariable) startBlock(t time.Time) {
 	sv.currentTime = t
 
 	// if we have an active event, and we sent the bundle and we're 5 seconds after sending the bundle and haven't received our self bundle
-	// that means the transaction may have gone missing, let's retry sending it.
-	needsResend := false
+	// that means the transaction may have gone missing, let's retry sending it, and every chunk of our result we haven't received back.
+	var resend []*commandspb.StateVariableProposal
 	if sv.eventID != "" && sv.lastSentSelfBundle != nil && t.After(sv.lastSentSelfBundleTime.Add(5*time.Second)) {
 		sv.lastSentSelfBundleTime = t
-		needsResend = true
+		resend = sv.missingSelfProposalsLocked()
+	}
+
+	// send the next chunk of our result, if any.
+	var next *commandspb.StateVariableProposal
+	if sv.eventID != "" && sv.sentSelfProposals < len(sv.selfProposals) {
+		next = sv.selfProposals[sv.sentSelfProposals]
+		sv.sentSelfProposals++
+		sv.lastSentSelfBundle = next
+		sv.lastSentSelfBundleTime = t
 	}
 	sv.lock.Unlock()
-	if needsResend {
-		sv.logAndRetry(errors.New("consensus not reached - timeout expired"), sv.lastSentSelfBundle)
+	for _, svp := range resend {
+		sv.logAndRetry(errors.New("consensus not reached - timeout expired"), svp)
+	}
+	if next != nil {
+		sv.cmd.Command(context.Background(), txn.StateVariableProposalCommand, next, func(_ string, err error) { sv.logAndRetry(err, next) }, nil)
+	}
+}
+
+// missingSelfProposalsLocked returns the chunks of our result already sent but not received back yet.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) missingSelfProposalsLocked() []*commandspb.StateVariableProposal {
+	if len(sv.selfProposals) == 0 {
+		return []*commandspb.StateVariableProposal{sv.lastSentSelfBundle}
+	}
+	missing := []*commandspb.StateVariableProposal{}
+	for i, svp := range sv.selfProposals[:sv.sentSelfProposals] {
+		if _, ok := sv.receivedSelfChunks[i]; !ok {
+			missing = append(missing, svp)
+		}
 	}
+	return missing
+}
+
+// resetSelfProposalsLocked forgets about the chunks of our result.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) resetSelfProposalsLocked() {
+	sv.selfProposals = nil
+	sv.sentSelfProposals = 0
+	sv.receivedSelfChunks = nil
 }
 
 // calculation is required for the state variable for the given event id.
@@ -190,6 +232,8 @@ func (sv *StateVariable) eventTriggered(eventID string) {
 	// reset any existing state
 	sv.eventID = eventID
 	sv.validatorResults = map[string]*statevar.KeyValueBundle{}
+	sv.chunks = nil
+	sv.resetSelfProposalsLocked()
 	sv.state = ConsensusStateCalculationStarted
 	sv.addEventLocked()
 
@@ -222,19 +266,30 @@ func (sv *StateVariable) CalculationFinished(eventID string, result statevar.Sta
 	}
 
 	// save our result and send the result to vega to be updated by other nodes.
-	kvb := sv.converter.InterfaceToBundle(result).ToProto()
-
-	// this is a test feature that adds noise up to the tolerance to the state variable
-	// it should be excluded by build tag for production
-	kvb = sv.AddNoise(kvb)
-
-	svp := &commandspb.StateVariableProposal{
-		Proposal: &vegapb.StateValueProposal{
-			StateVarId: sv.ID,
-			EventId:    sv.eventID,
-			Kvb:        kvb,
-		},
+	// large results are sent in chunks, the first one now and the others one per block.
+	chunks := sv.converter.InterfaceToBundle(result).Split(sv.maxValuesPerProposal)
+	proposals := make([]*commandspb.StateVariableProposal, 0, len(chunks))
+	for _, chunk := range chunks {
+		kvb := chunk.ToProto()
+		// this is a test feature that adds noise up to the tolerance to the state variable
+		// it should be excluded by build tag for production
+		if _, _, ok := chunk.ChunkInfo(); ok {
+			sv.AddNoise(kvb[1:])
+		} else {
+			kvb = sv.AddNoise(kvb)
+		}
+		proposals = append(proposals, &commandspb.StateVariableProposal{
+			Proposal: &vegapb.StateValueProposal{
+				StateVarId: sv.ID,
+				EventId:    sv.eventID,
+				Kvb:        kvb,
+			},
+		})
 	}
+	svp := proposals[0]
+	sv.selfProposals = proposals
+	sv.sentSelfProposals = 1
+	sv.receivedSelfChunks = map[int]struct{}{}
 
 	// set the bundle and the time
 	sv.lastSentSelfBundle = svp
@@ -283,7 +338,8 @@ func (sv *StateVariable) bundleReceived(ctx context.Context, node, eventID strin
 		return
 	}
 
-	if sv.top.SelfNodeID() == node {
+	chunkIndex, chunks, isChunk := bundle.ChunkInfo()
+	if sv.top.SelfNodeID() == node && sv.selfResultReceivedLocked(chunkIndex) {
 		sv.lastSentSelfBundle = nil
 		sv.lastSentSelfBundleTime = time.Time{}
 		sv.log.Debug("state var bundle received self vote", logging.String("from-validator", node), logging.String("state-var", sv.ID), logging.String("eventID", eventID))
@@ -298,6 +354,13 @@ func (sv *StateVariable) bundleReceived(ctx context.Context, node, eventID strin
 		return
 	}
 
+	// wait for all the chunks of the result of the validator
+	if isChunk {
+		if bundle = sv.addChunkLocked(node, chunkIndex, chunks, bundle); bundle == nil {
+			return
+		}
+	}
+
 	// save the result from the validator and check if we have a quorum
 	sv.validatorResults[node] = bundle
 
@@ -323,7 +386,7 @@ func (sv *StateVariable) bundleReceived(ctx context.Context, node, eventID strin
 
 	// if we're already in seeking consensus state, no point in checking if all match - suffice checking if there's a majority with matching within tolerance
 	if sv.state == ConsensusStateSeekingConsensus {
-		sv.tryConsensusLocked(ctx, rng, requiredVotingPower)
+		sv.seekConsensusLocked(ctx, rng, requiredVotingPower)
 		return
 	}
 
@@ -345,7 +408,7 @@ func (sv *StateVariable) bundleReceived(ctx context.Context, node, eventID strin
 
 			// initiate a round of voting
 			sv.state = ConsensusStateSeekingConsensus
-			sv.tryConsensusLocked(ctx, rng, requiredVotingPower)
+			sv.seekConsensusLocked(ctx, rng, requiredVotingPower)
 			return
 		}
 	}
@@ -359,6 +422,103 @@ func (sv *StateVariable) bundleReceived(ctx context.Context, node, eventID strin
 	sv.consensusReachedLocked(ctx, result)
 }
 
+// selfResultReceivedLocked records the chunk of our result has been received back and returns true
+// once all the chunks of our result have been.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) selfResultReceivedLocked(chunkIndex int) bool {
+	if sv.receivedSelfChunks == nil {
+		sv.receivedSelfChunks = map[int]struct{}{}
+	}
+	sv.receivedSelfChunks[chunkIndex] = struct{}{}
+	return len(sv.receivedSelfChunks) >= len(sv.selfProposals)
+}
+
+// addChunkLocked saves a chunk of the result of a validator and returns the whole result once all its chunks are received.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) addChunkLocked(node string, index, total int, chunk *statevar.KeyValueBundle) *statevar.KeyValueBundle {
+	if sv.chunks == nil {
+		sv.chunks = map[string]map[int]*statevar.KeyValueBundle{}
+	}
+	if _, ok := sv.chunks[node]; !ok {
+		sv.chunks[node] = map[int]*statevar.KeyValueBundle{}
+	}
+	sv.chunks[node][index] = chunk
+
+	ordered := make([]*statevar.KeyValueBundle, 0, total)
+	for i := 0; i < total; i++ {
+		c, ok := sv.chunks[node][i]
+		if !ok {
+			return nil
+		}
+		if _, n, _ := c.ChunkInfo(); n != total {
+			return nil
+		}
+		ordered = append(ordered, c)
+	}
+	delete(sv.chunks, node)
+	return statevar.MergeChunks(ordered)
+}
+
+// seekConsensusLocked aggregates the results of the validators according to the aggregation mode of the state variable.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) seekConsensusLocked(ctx context.Context, rng *rand.Rand, requiredVotingPower num.Decimal) {
+	if sv.aggregation == statevar.AggregationModeMedian {
+		sv.medianConsensusLocked(ctx, requiredVotingPower)
+		return
+	}
+	sv.tryConsensusLocked(ctx, rng, requiredVotingPower)
+}
+
+// medianConsensusLocked accepts the element-wise median of the largest, by voting power, group of results having the same shape,
+// if the group holds the required voting power.
+// NB: assumes lock has already been acquired.
+func (sv *StateVariable) medianConsensusLocked(ctx context.Context, requiredVotingPower num.Decimal) {
+	// sort the node IDs for determinism
+	nodeIDs := make([]string, 0, len(sv.validatorResults))
+	for nodeID := range sv.validatorResults {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Strings(nodeIDs)
+
+	var bestGroup []string
+	bestVotingPower := num.DecimalZero()
+	grouped := map[string]struct{}{}
+	for i, nodeID := range nodeIDs {
+		if _, ok := grouped[nodeID]; ok {
+			continue
+		}
+		group := []string{nodeID}
+		votingPower := num.DecimalFromInt64(sv.top.GetVotingPower(nodeID))
+		for _, other := range nodeIDs[i+1:] {
+			if _, ok := grouped[other]; ok {
+				continue
+			}
+			if sv.validatorResults[other].SameShape(sv.validatorResults[nodeID]) {
+				grouped[other] = struct{}{}
+				group = append(group, other)
+				votingPower = votingPower.Add(num.DecimalFromInt64(sv.top.GetVotingPower(other)))
+			}
+		}
+		if votingPower.GreaterThan(bestVotingPower) {
+			bestGroup, bestVotingPower = group, votingPower
+		}
+	}
+
+	if bestVotingPower.LessThan(requiredVotingPower) {
+		if sv.log.GetLevel() <= logging.DebugLevel {
+			sv.log.Debug("state var consensus NOT reached through median, results don't have the same shape", logging.String("state-var", sv.ID), logging.String("event-id", sv.eventID), logging.Int("num-results", len(sv.validatorResults)))
+		}
+		return
+	}
+
+	bundles := make([]*statevar.KeyValueBundle, 0, len(bestGroup))
+	for _, nodeID := range bestGroup {
+		bundles = append(bundles, sv.validatorResults[nodeID])
+	}
+	sv.state = ConsensusStateconsensusReachedLocked
+	sv.consensusReachedLocked(ctx, statevar.MedianBundle(bundles))
+}
+
 // if the bundles are not all equal to each other, choose one at random and verify that all others are within tolerance.
 // NB: assumes lock has already been acquired.
 func (sv *StateVariable) tryConsensusLocked(ctx context.Context, rng *rand.Rand, requiredVotingPower num.Decimal) {
@@ -413,6 +573,8 @@ func (sv *StateVariable) consensusReachedLocked(ctx context.Context, acceptedVal
 	// reset the state
 	sv.eventID = ""
 	sv.validatorResults = nil
+	sv.chunks = nil
+	sv.resetSelfProposalsLocked()
 	sv.roundsSinceMeaningfulUpdate = 0
 }
 
@@ -96,6 +96,22 @@ const (
 	CancelAMMCommand Command = 0x66
 	// DelayedTransactionsWrapper ...
 	DelayedTransactionsWrapper Command = 0x67
+	// NewTeamCompetitionCommand ...
+	NewTeamCompetitionCommand Command = 0x68
+	// CancelTeamCompetitionCommand ...
+	CancelTeamCompetitionCommand Command = 0x69
+	// CreateSubAccountCommand ...
+	CreateSubAccountCommand Command = 0x6a
+	// CancelDelayedWithdrawalCommand ...
+	CancelDelayedWithdrawalCommand Command = 0x6b
+	// DelegateVoteCommand ...
+	DelegateVoteCommand Command = 0x6c
+	// VetoProposalCommand ...
+	VetoProposalCommand Command = 0x6d
+	// RedelegateCommand ...
+	RedelegateCommand Command = 0x6e
+	// AutoCompoundCommand ...
+	AutoCompoundCommand Command = 0x6f
 )
 
 var commandName = map[Command]string{
@@ -137,6 +153,14 @@ var commandName = map[Command]string{
 	AmendAMMCommand:                    "Amend AMM",
 	CancelAMMCommand:                   "Cancel AMM",
 	DelayedTransactionsWrapper:         "Delayed Transactions Wrapper",
+	NewTeamCompetitionCommand:          "New Team Competition",
+	CancelTeamCompetitionCommand:       "Cancel Team Competition",
+	CreateSubAccountCommand:            "Create Sub Account",
+	CancelDelayedWithdrawalCommand:     "Cancel Delayed Withdrawal",
+	DelegateVoteCommand:                "Delegate Vote",
+	VetoProposalCommand:                "Veto Proposal",
+	RedelegateCommand:                  "Redelegate",
+	AutoCompoundCommand:                "Auto Compound",
 }
 
 func (cmd Command) IsValidatorCommand() bool {
@@ -314,6 +314,7 @@ func (a AssetDetailsErc20) IntoProto() *vegapb.AssetDetails_Erc20 {
 			ChainId:           a.ERC20.ChainID,
 			LifetimeLimit:     lifetimeLimit,
 			WithdrawThreshold: withdrawThreshold,
+			AdditionalChains:  a.ERC20.additionalChainsIntoProto(),
 		},
 	}
 }
@@ -337,6 +338,11 @@ func (a AssetDetailsErc20) Validate() (ProposalError, error) {
 	if len(a.ERC20.ContractAddress) <= 0 {
 		return ProposalErrorMissingErc20ContractAddress, ErrMissingERC20ContractAddress
 	}
+	for _, c := range a.ERC20.AdditionalChains {
+		if len(c.ContractAddress) <= 0 {
+			return ProposalErrorMissingErc20ContractAddress, ErrMissingERC20ContractAddress
+		}
+	}
 
 	return ProposalErrorUnspecified, nil
 }
@@ -359,12 +365,23 @@ func AssetDetailsERC20FromProto(p *vegapb.AssetDetails_Erc20) (*AssetDetailsErc2
 			return nil, errors.New("invalid withdraw threshold")
 		}
 	}
+	var additionalChains []*ERC20ChainContract
+	if len(p.Erc20.AdditionalChains) > 0 {
+		additionalChains = make([]*ERC20ChainContract, 0, len(p.Erc20.AdditionalChains))
+		for _, c := range p.Erc20.AdditionalChains {
+			additionalChains = append(additionalChains, &ERC20ChainContract{
+				ChainID:         c.ChainId,
+				ContractAddress: crypto.EthereumChecksumAddress(c.ContractAddress),
+			})
+		}
+	}
 	return &AssetDetailsErc20{
 		ERC20: &ERC20{
 			ChainID:           p.Erc20.ChainId,
 			ContractAddress:   crypto.EthereumChecksumAddress(p.Erc20.ContractAddress),
 			LifetimeLimit:     lifetimeLimit,
 			WithdrawThreshold: withdrawThreshold,
+			AdditionalChains:  additionalChains,
 		},
 	}, nil
 }
@@ -377,6 +394,53 @@ type ERC20 struct {
 	ContractAddress   string
 	LifetimeLimit     *num.Uint
 	WithdrawThreshold *num.Uint
+
+	// AdditionalChains are the other EVM chains the asset is bridged to.
+	AdditionalChains []*ERC20ChainContract
+}
+
+// ERC20ChainContract is the contract of an ERC20 asset on an EVM chain.
+type ERC20ChainContract struct {
+	ChainID         string
+	ContractAddress string
+}
+
+// ChainIDs returns the IDs of all the chains the asset lives on, starting
+// with the one it originated from.
+func (e ERC20) ChainIDs() []string {
+	chainIDs := make([]string, 0, len(e.AdditionalChains)+1)
+	chainIDs = append(chainIDs, e.ChainID)
+	for _, c := range e.AdditionalChains {
+		chainIDs = append(chainIDs, c.ChainID)
+	}
+	return chainIDs
+}
+
+// ContractAddressOnChain returns the address of the token on the given chain.
+func (e ERC20) ContractAddressOnChain(chainID string) (string, bool) {
+	if e.ChainID == chainID {
+		return e.ContractAddress, true
+	}
+	for _, c := range e.AdditionalChains {
+		if c.ChainID == chainID {
+			return c.ContractAddress, true
+		}
+	}
+	return "", false
+}
+
+func (e ERC20) additionalChainsIntoProto() []*vegapb.ERC20ChainContract {
+	if len(e.AdditionalChains) == 0 {
+		return nil
+	}
+	out := make([]*vegapb.ERC20ChainContract, 0, len(e.AdditionalChains))
+	for _, c := range e.AdditionalChains {
+		out = append(out, &vegapb.ERC20ChainContract{
+			ChainId:         c.ChainID,
+			ContractAddress: c.ContractAddress,
+		})
+	}
+	return out
 }
 
 func (e ERC20) DeepClone() *ERC20 {
@@ -384,6 +448,15 @@ func (e ERC20) DeepClone() *ERC20 {
 		ContractAddress: e.ContractAddress,
 		ChainID:         e.ChainID,
 	}
+	if len(e.AdditionalChains) > 0 {
+		cpy.AdditionalChains = make([]*ERC20ChainContract, 0, len(e.AdditionalChains))
+		for _, c := range e.AdditionalChains {
+			cpy.AdditionalChains = append(cpy.AdditionalChains, &ERC20ChainContract{
+				ChainID:         c.ChainID,
+				ContractAddress: c.ContractAddress,
+			})
+		}
+	}
 	if e.LifetimeLimit != nil {
 		cpy.LifetimeLimit = e.LifetimeLimit.Clone()
 	} else {
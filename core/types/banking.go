@@ -114,7 +114,8 @@ func (t *TransferBase) IsValid() error {
 			return ErrInvalidFromDerivedKey
 		}
 
-		if t.FromAccountType != AccountTypeVestedRewards {
+		// either vested rewards of a derived key, or the general account of a sub-account.
+		if t.FromAccountType != AccountTypeVestedRewards && t.FromAccountType != AccountTypeGeneral {
 			return ErrUnsupportedFromAccountType
 		}
 
@@ -137,7 +138,8 @@ func (t *TransferBase) IsValid() error {
 			return ErrInvalidToForRewardAccountType
 		}
 	case AccountTypeGeneral, AccountTypeLPFeeReward, AccountTypeMakerReceivedFeeReward, AccountTypeMakerPaidFeeReward, AccountTypeMarketProposerReward,
-		AccountTypeAverageNotionalReward, AccountTypeRelativeReturnReward, AccountTypeValidatorRankingReward, AccountTypeReturnVolatilityReward, AccountTypeRealisedReturnReward, AccountTypeEligibleEntitiesReward, AccountTypeBuyBackFees, AccountTypeLockedForStaking:
+		AccountTypeAverageNotionalReward, AccountTypeRelativeReturnReward, AccountTypeValidatorRankingReward, AccountTypeReturnVolatilityReward, AccountTypeRealisedReturnReward, AccountTypeEligibleEntitiesReward, AccountTypeBuyBackFees, AccountTypeLockedForStaking,
+		AccountTypeMakerFeeRebates:
 		break
 	default:
 		return ErrUnsupportedToAccountType
@@ -227,6 +229,8 @@ func (g *GovernanceTransfer) IntoEvent(amount *num.Uint, reason, gameID *string)
 type OneOffTransfer struct {
 	*TransferBase
 	DeliverOn *time.Time
+	// Condition, if set, holds the transfer in escrow until the condition is met.
+	Condition *TransferCondition
 }
 
 func (o *OneOffTransfer) IsValid() error {
@@ -234,6 +238,19 @@ func (o *OneOffTransfer) IsValid() error {
 		return err
 	}
 
+	if o.Condition != nil {
+		if o.DeliverOn != nil {
+			return ErrConditionalTransferWithDeliverOn
+		}
+		// the funds are refunded to the general account of the sender if the transfer is cancelled.
+		if o.FromAccountType != AccountTypeGeneral || o.FromDerivedKey != nil {
+			return ErrConditionalTransferFromGeneralOnly
+		}
+		if err := o.Condition.IsValid(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -252,6 +269,14 @@ func OneOffTransferFromEvent(p *eventspb.Transfer) *OneOffTransfer {
 		panic("invalid transfer amount")
 	}
 
+	var condition *TransferCondition
+	if c := p.GetOneOff().GetCondition(); c != nil {
+		var err error
+		if condition, err = TransferConditionFromProto(c); err != nil {
+			panic("invalid transfer condition")
+		}
+	}
+
 	return &OneOffTransfer{
 		TransferBase: &TransferBase{
 			ID:              p.Id,
@@ -266,6 +291,7 @@ func OneOffTransferFromEvent(p *eventspb.Transfer) *OneOffTransfer {
 			Timestamp:       time.Unix(0, p.Timestamp),
 		},
 		DeliverOn: deliverOn,
+		Condition: condition,
 	}
 }
 
@@ -285,11 +311,16 @@ func (o *OneOffTransfer) IntoEvent(reason *string) *eventspb.Transfer {
 	}
 
 	out.Kind = &eventspb.Transfer_OneOff{}
-	if o.DeliverOn != nil {
+	if o.DeliverOn != nil || o.Condition != nil {
+		oneoff := &eventspb.OneOffTransfer{}
+		if o.DeliverOn != nil {
+			oneoff.DeliverOn = o.DeliverOn.UnixNano()
+		}
+		if o.Condition != nil {
+			oneoff.Condition = o.Condition.IntoProto()
+		}
 		out.Kind = &eventspb.Transfer_OneOff{
-			OneOff: &eventspb.OneOffTransfer{
-				DeliverOn: o.DeliverOn.UnixNano(),
-			},
+			OneOff: oneoff,
 		}
 	}
 
@@ -302,6 +333,9 @@ type RecurringTransfer struct {
 	EndEpoch         *uint64
 	Factor           num.Decimal
 	DispatchStrategy *vegapb.DispatchStrategy
+	// VestingSchedule is the vesting applied to the rewards funded by the transfer,
+	// instead of the network-wide base rate.
+	VestingSchedule *VestingSchedule
 }
 
 func (r *RecurringTransfer) IsValid() error {
@@ -324,6 +358,13 @@ func (r *RecurringTransfer) IsValid() error {
 		return ErrInvalidFactor
 	}
 
+	if r.VestingSchedule != nil {
+		if r.DispatchStrategy == nil {
+			return ErrVestingScheduleWithoutDispatch
+		}
+		return r.VestingSchedule.IsValid()
+	}
+
 	return nil
 }
 
@@ -352,6 +393,7 @@ func (r *RecurringTransfer) IntoEvent(reason *string, gameID *string) *eventspb.
 				EndEpoch:         endEpoch,
 				Factor:           r.Factor.String(),
 				DispatchStrategy: r.DispatchStrategy,
+				VestingSchedule:  r.VestingSchedule.IntoProto(),
 			},
 		},
 	}
@@ -423,11 +465,20 @@ func newOneOffTransfer(base *TransferBase, tf *commandspb.Transfer) (*TransferFu
 		t = &tmpt
 	}
 
+	var condition *TransferCondition
+	if c := tf.GetOneOff().GetCondition(); c != nil {
+		var err error
+		if condition, err = TransferConditionFromProto(c); err != nil {
+			return nil, err
+		}
+	}
+
 	return &TransferFunds{
 		Kind: TransferCommandKindOneOff,
 		OneOff: &OneOffTransfer{
 			TransferBase: base,
 			DeliverOn:    t,
+			Condition:    condition,
 		},
 	}, nil
 }
@@ -442,6 +493,10 @@ func newRecurringTransfer(base *TransferBase, tf *commandspb.Transfer) (*Transfe
 		ee := tf.GetRecurring().GetEndEpoch()
 		endEpoch = &ee
 	}
+	vestingSchedule, err := VestingScheduleFromProto(tf.GetRecurring().VestingSchedule)
+	if err != nil {
+		return nil, err
+	}
 
 	return &TransferFunds{
 		Kind: TransferCommandKindRecurring,
@@ -451,6 +506,7 @@ func newRecurringTransfer(base *TransferBase, tf *commandspb.Transfer) (*Transfe
 			EndEpoch:         endEpoch,
 			Factor:           factor,
 			DispatchStrategy: tf.GetRecurring().DispatchStrategy,
+			VestingSchedule:  vestingSchedule,
 		},
 	}, nil
 }
@@ -475,6 +531,11 @@ func RecurringTransferFromEvent(p *eventspb.Transfer) *RecurringTransfer {
 		panic("invalid transfer amount")
 	}
 
+	vestingSchedule, err := VestingScheduleFromProto(p.GetRecurring().VestingSchedule)
+	if err != nil {
+		panic("invalid vesting schedule, should never happen")
+	}
+
 	return &RecurringTransfer{
 		TransferBase: &TransferBase{
 			ID:              p.Id,
@@ -492,6 +553,7 @@ func RecurringTransferFromEvent(p *eventspb.Transfer) *RecurringTransfer {
 		EndEpoch:         endEpoch,
 		Factor:           factor,
 		DispatchStrategy: p.GetRecurring().DispatchStrategy,
+		VestingSchedule:  vestingSchedule,
 	}
 }
 
@@ -134,29 +134,57 @@ func (*PayloadBankingWithdrawals) Namespace() SnapshotNamespace {
 }
 
 type BankingWithdrawals struct {
-	Withdrawals []*RWithdrawal
+	Withdrawals        []*RWithdrawal
+	DelayedWithdrawals []*DelayedWithdrawal
 }
 
 func (b BankingWithdrawals) IntoProto() *snapshot.BankingWithdrawals {
 	ret := snapshot.BankingWithdrawals{
-		Withdrawals: make([]*snapshot.Withdrawal, 0, len(b.Withdrawals)),
+		Withdrawals:        make([]*snapshot.Withdrawal, 0, len(b.Withdrawals)),
+		DelayedWithdrawals: make([]*snapshot.DelayedWithdrawal, 0, len(b.DelayedWithdrawals)),
 	}
 	for _, w := range b.Withdrawals {
 		ret.Withdrawals = append(ret.Withdrawals, w.IntoProto())
 	}
+	for _, d := range b.DelayedWithdrawals {
+		ret.DelayedWithdrawals = append(ret.DelayedWithdrawals, d.IntoProto())
+	}
 	return &ret
 }
 
 func BankingWithdrawalsFromProto(bw *snapshot.BankingWithdrawals) *BankingWithdrawals {
 	ret := &BankingWithdrawals{
-		Withdrawals: make([]*RWithdrawal, 0, len(bw.Withdrawals)),
+		Withdrawals:        make([]*RWithdrawal, 0, len(bw.Withdrawals)),
+		DelayedWithdrawals: make([]*DelayedWithdrawal, 0, len(bw.DelayedWithdrawals)),
 	}
 	for _, w := range bw.Withdrawals {
 		ret.Withdrawals = append(ret.Withdrawals, RWithdrawalFromProto(w))
 	}
+	for _, d := range bw.DelayedWithdrawals {
+		ret.DelayedWithdrawals = append(ret.DelayedWithdrawals, DelayedWithdrawalFromProto(d))
+	}
 	return ret
 }
 
+type DelayedWithdrawal struct {
+	WithdrawalID string
+	ReleaseAt    int64
+}
+
+func (d DelayedWithdrawal) IntoProto() *snapshot.DelayedWithdrawal {
+	return &snapshot.DelayedWithdrawal{
+		WithdrawalId: d.WithdrawalID,
+		ReleaseAt:    d.ReleaseAt,
+	}
+}
+
+func DelayedWithdrawalFromProto(d *snapshot.DelayedWithdrawal) *DelayedWithdrawal {
+	return &DelayedWithdrawal{
+		WithdrawalID: d.WithdrawalId,
+		ReleaseAt:    d.ReleaseAt,
+	}
+}
+
 type RWithdrawal struct {
 	Ref        string
 	Withdrawal *Withdrawal
@@ -601,3 +629,93 @@ func PayloadBankingRecurringTransfersFromProto(pbd *snapshot.Payload_BankingRecu
 		NextMetricUpdate:          time.Unix(0, pbd.BankingRecurringTransfers.NextMetricUpdate),
 	}
 }
+
+type PayloadBankingTeamCompetitions struct {
+	BankingTeamCompetitions *snapshot.BankingTeamCompetitions
+}
+
+func (p PayloadBankingTeamCompetitions) IntoProto() *snapshot.Payload_BankingTeamCompetitions {
+	return &snapshot.Payload_BankingTeamCompetitions{
+		BankingTeamCompetitions: p.BankingTeamCompetitions,
+	}
+}
+
+func (*PayloadBankingTeamCompetitions) isPayload() {}
+
+func (p *PayloadBankingTeamCompetitions) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadBankingTeamCompetitions) Key() string {
+	return "teamCompetitions"
+}
+
+func (*PayloadBankingTeamCompetitions) Namespace() SnapshotNamespace {
+	return BankingSnapshot
+}
+
+func PayloadBankingTeamCompetitionsFromProto(p *snapshot.Payload_BankingTeamCompetitions) *PayloadBankingTeamCompetitions {
+	return &PayloadBankingTeamCompetitions{
+		BankingTeamCompetitions: p.BankingTeamCompetitions,
+	}
+}
+
+type PayloadBankingConditionalTransfers struct {
+	BankingConditionalTransfers *snapshot.BankingConditionalTransfers
+}
+
+func (p PayloadBankingConditionalTransfers) IntoProto() *snapshot.Payload_BankingConditionalTransfers {
+	return &snapshot.Payload_BankingConditionalTransfers{
+		BankingConditionalTransfers: p.BankingConditionalTransfers,
+	}
+}
+
+func (*PayloadBankingConditionalTransfers) isPayload() {}
+
+func (p *PayloadBankingConditionalTransfers) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadBankingConditionalTransfers) Key() string {
+	return "conditionalTransfers"
+}
+
+func (*PayloadBankingConditionalTransfers) Namespace() SnapshotNamespace {
+	return BankingSnapshot
+}
+
+func PayloadBankingConditionalTransfersFromProto(p *snapshot.Payload_BankingConditionalTransfers) *PayloadBankingConditionalTransfers {
+	return &PayloadBankingConditionalTransfers{
+		BankingConditionalTransfers: p.BankingConditionalTransfers,
+	}
+}
+
+type PayloadBankingWithdrawalBatches struct {
+	BankingWithdrawalBatches *snapshot.BankingWithdrawalBatches
+}
+
+func (p PayloadBankingWithdrawalBatches) IntoProto() *snapshot.Payload_BankingWithdrawalBatches {
+	return &snapshot.Payload_BankingWithdrawalBatches{
+		BankingWithdrawalBatches: p.BankingWithdrawalBatches,
+	}
+}
+
+func (*PayloadBankingWithdrawalBatches) isPayload() {}
+
+func (p *PayloadBankingWithdrawalBatches) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadBankingWithdrawalBatches) Key() string {
+	return "withdrawalBatches"
+}
+
+func (*PayloadBankingWithdrawalBatches) Namespace() SnapshotNamespace {
+	return BankingSnapshot
+}
+
+func PayloadBankingWithdrawalBatchesFromProto(p *snapshot.Payload_BankingWithdrawalBatches) *PayloadBankingWithdrawalBatches {
+	return &PayloadBankingWithdrawalBatches{
+		BankingWithdrawalBatches: p.BankingWithdrawalBatches,
+	}
+}
@@ -86,10 +86,16 @@ type DelegationEntry struct {
 	EpochSeq   uint64
 }
 
+type SlashedStake struct {
+	Party  string
+	Amount *num.Uint
+}
+
 type DelegateCP struct {
 	Active  []*DelegationEntry
 	Pending []*DelegationEntry
 	Auto    []string
+	Slashed []*SlashedStake
 }
 
 func NewCheckpointStateFromProto(ps *checkpoint.CheckpointState) *CheckpointState {
@@ -331,11 +337,27 @@ func (d DelegationEntry) IntoProto() *checkpoint.DelegateEntry {
 	}
 }
 
+func NewSlashedStakeFromProto(ss *checkpoint.SlashedStake) *SlashedStake {
+	amt, _ := num.UintFromString(ss.Amount, 10)
+	return &SlashedStake{
+		Party:  ss.Party,
+		Amount: amt,
+	}
+}
+
+func (s SlashedStake) IntoProto() *checkpoint.SlashedStake {
+	return &checkpoint.SlashedStake{
+		Party:  s.Party,
+		Amount: s.Amount.String(),
+	}
+}
+
 func NewDelegationCPFromProto(sd *checkpoint.Delegate) *DelegateCP {
 	r := &DelegateCP{
 		Active:  make([]*DelegationEntry, 0, len(sd.Active)),
 		Pending: make([]*DelegationEntry, 0, len(sd.Pending)),
 		Auto:    sd.AutoDelegation[:],
+		Slashed: make([]*SlashedStake, 0, len(sd.SlashedStakes)),
 	}
 	for _, a := range sd.Active {
 		r.Active = append(r.Active, NewDelegationEntryFromProto(a))
@@ -343,6 +365,9 @@ func NewDelegationCPFromProto(sd *checkpoint.Delegate) *DelegateCP {
 	for _, p := range sd.Pending {
 		r.Pending = append(r.Pending, NewDelegationEntryFromProto(p))
 	}
+	for _, s := range sd.SlashedStakes {
+		r.Slashed = append(r.Slashed, NewSlashedStakeFromProto(s))
+	}
 	return r
 }
 
@@ -351,6 +376,7 @@ func (d DelegateCP) IntoProto() *checkpoint.Delegate {
 		Active:         make([]*checkpoint.DelegateEntry, 0, len(d.Active)),
 		Pending:        make([]*checkpoint.DelegateEntry, 0, len(d.Pending)),
 		AutoDelegation: d.Auto[:],
+		SlashedStakes:  make([]*checkpoint.SlashedStake, 0, len(d.Slashed)),
 	}
 	for _, a := range d.Active {
 		s.Active = append(s.Active, a.IntoProto())
@@ -358,6 +384,9 @@ func (d DelegateCP) IntoProto() *checkpoint.Delegate {
 	for _, p := range d.Pending {
 		s.Pending = append(s.Pending, p.IntoProto())
 	}
+	for _, ss := range d.Slashed {
+		s.SlashedStakes = append(s.SlashedStakes, ss.IntoProto())
+	}
 	return s
 }
 
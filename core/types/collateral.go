@@ -326,4 +326,6 @@ const (
 	// Account for eligible entities rewards.
 	AccountTypeEligibleEntitiesReward = proto.AccountType_ACCOUNT_TYPE_REWARD_ELIGIBLE_ENTITIES
 	AccountTypeLockedForStaking       = proto.AccountType_ACCOUNT_TYPE_LOCKED_FOR_STAKING
+	// Account funding the negative maker fees of the market maker fee tiers.
+	AccountTypeMakerFeeRebates AccountType = proto.AccountType_ACCOUNT_TYPE_MAKER_FEE_REBATES
 )
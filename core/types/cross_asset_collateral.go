@@ -0,0 +1,155 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/core/datasource"
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	ErrCrossAssetCollateralMissingAsset        = errors.New("missing asset")
+	ErrCrossAssetCollateralSameAsset           = errors.New("asset and settlement asset must be different")
+	ErrCrossAssetCollateralInvalidHaircut      = errors.New("haircut must be in [0, 1)")
+	ErrCrossAssetCollateralMissingPriceOracle  = errors.New("missing price oracle")
+	ErrCrossAssetCollateralInternalPriceOracle = errors.New("price oracle must be an external data source")
+	ErrCrossAssetCollateralMissingPriceBinding = errors.New("missing price oracle spec binding")
+	ErrCrossAssetCollateralMissingLiquidation  = errors.New("missing liquidation market")
+	ErrCrossAssetCollateralDuplicate           = errors.New("asset approved twice for the same settlement asset")
+)
+
+// CrossAssetCollateral is an asset whose general account balance counts towards
+// the margin of the markets settled in another asset.
+type CrossAssetCollateral struct {
+	Asset           string
+	SettlementAsset string
+	// Haircut is the fraction of the value of the asset that isn't counted.
+	Haircut num.Decimal
+	// PriceOracle is the data source giving the price of one unit of the asset
+	// in units of the settlement asset.
+	PriceOracle        *datasource.Spec
+	PriceOracleBinding *datasource.SpecBindingForCompositePrice
+	// LiquidationMarket is the spot market, trading the asset against the
+	// settlement asset, through which the collateral pledged by a party is sold
+	// when its margin doesn't cover its losses.
+	LiquidationMarket string
+}
+
+// CrossAssetCollateralPrice is the last price of an asset approved as collateral,
+// for one unit of the asset in units of the settlement asset.
+type CrossAssetCollateralPrice struct {
+	Asset           string
+	SettlementAsset string
+	Price           num.Decimal
+}
+
+type crossAssetCollateralJSON struct {
+	Asset           string `json:"asset"`
+	SettlementAsset string `json:"settlement_asset"`
+	Haircut         string `json:"haircut"`
+	// PriceOracle is a data source definition, in its protobuf JSON form.
+	PriceOracle            json.RawMessage                       `json:"price_oracle"`
+	PriceOracleSpecBinding *crossAssetCollateralPriceBindingJSON `json:"price_oracle_spec_binding"`
+	LiquidationMarket      string                                `json:"liquidation_market"`
+}
+
+type crossAssetCollateralPriceBindingJSON struct {
+	PriceSourceProperty string `json:"price_source_property"`
+}
+
+// ParseCrossAssetCollateral parses the value of the network parameter listing the
+// approved cross-asset collateral.
+func ParseCrossAssetCollateral(v string) ([]*CrossAssetCollateral, error) {
+	raw := []crossAssetCollateralJSON{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("invalid cross-asset collateral: %w", err)
+	}
+
+	seen := map[[2]string]struct{}{}
+	out := make([]*CrossAssetCollateral, 0, len(raw))
+	for i, r := range raw {
+		c, err := crossAssetCollateralFromJSON(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cross-asset collateral %d: %w", i, err)
+		}
+		key := [2]string{c.Asset, c.SettlementAsset}
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("invalid cross-asset collateral %d: %w", i, ErrCrossAssetCollateralDuplicate)
+		}
+		seen[key] = struct{}{}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+// CheckCrossAssetCollateral is the rule of the network parameter listing the
+// approved cross-asset collateral.
+func CheckCrossAssetCollateral(v string) error {
+	_, err := ParseCrossAssetCollateral(v)
+	return err
+}
+
+func crossAssetCollateralFromJSON(r crossAssetCollateralJSON) (*CrossAssetCollateral, error) {
+	if len(r.Asset) == 0 || len(r.SettlementAsset) == 0 {
+		return nil, ErrCrossAssetCollateralMissingAsset
+	}
+	if r.Asset == r.SettlementAsset {
+		return nil, ErrCrossAssetCollateralSameAsset
+	}
+	haircut, err := num.DecimalFromString(r.Haircut)
+	if err != nil || haircut.IsNegative() || haircut.GreaterThanOrEqual(num.DecimalOne()) {
+		return nil, ErrCrossAssetCollateralInvalidHaircut
+	}
+	if len(r.PriceOracle) == 0 {
+		return nil, ErrCrossAssetCollateralMissingPriceOracle
+	}
+	pb := &vegapb.DataSourceDefinition{}
+	if err := protojson.Unmarshal(r.PriceOracle, pb); err != nil {
+		return nil, fmt.Errorf("invalid price oracle: %w", err)
+	}
+	def, err := datasource.DefinitionFromProto(pb)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price oracle: %w", err)
+	}
+	priceOracle := datasource.SpecFromDefinition(*datasource.NewDefinitionWith(def))
+	if external, err := priceOracle.Data.IsExternal(); err != nil || !external {
+		return nil, ErrCrossAssetCollateralInternalPriceOracle
+	}
+	if r.PriceOracleSpecBinding == nil || len(r.PriceOracleSpecBinding.PriceSourceProperty) == 0 {
+		return nil, ErrCrossAssetCollateralMissingPriceBinding
+	}
+	if len(r.LiquidationMarket) == 0 {
+		return nil, ErrCrossAssetCollateralMissingLiquidation
+	}
+
+	return &CrossAssetCollateral{
+		Asset:           r.Asset,
+		SettlementAsset: r.SettlementAsset,
+		Haircut:         haircut,
+		PriceOracle:     priceOracle,
+		PriceOracleBinding: &datasource.SpecBindingForCompositePrice{
+			PriceSourceProperty: r.PriceOracleSpecBinding.PriceSourceProperty,
+		},
+		LiquidationMarket: r.LiquidationMarket,
+	}, nil
+}
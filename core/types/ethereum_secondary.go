@@ -125,6 +125,7 @@ func CheckEVMChainConfig(cfgs *proto.EVMBridgeConfigs) error {
 		return errors.New("missing EVM configurations")
 	}
 
+	chainIDs := map[string]struct{}{}
 	for _, cfgProto := range cfgs.Configs {
 		if len(cfgProto.NetworkId) == 0 {
 			return ErrMissingNetworkID
@@ -134,6 +135,12 @@ func CheckEVMChainConfig(cfgs *proto.EVMBridgeConfigs) error {
 			return ErrMissingChainID
 		}
 
+		// each chain has its own bridge
+		if _, ok := chainIDs[cfgProto.ChainId]; ok {
+			return ErrDuplicateChainID
+		}
+		chainIDs[cfgProto.ChainId] = struct{}{}
+
 		if cfgProto.Confirmations == 0 {
 			return ErrConfirmationsMustBeHigherThan0
 		}
@@ -35,6 +35,7 @@ func TestEthereumConfig(t *testing.T) {
 	t.Run("Basic checks on fields of the EVM config", testEVMConfigBasic)
 	t.Run("Check that a network cannot appear twice in the config", testEVMConfigRejectDuplicateFields)
 	t.Run("Check that an EVM config can not be removed", testEVMAmendOrAppendOnly)
+	t.Run("Check that a chain cannot have more than one bridge", testEVMBridgeConfigsRejectDuplicateChainID)
 }
 
 func testValidEthereumConfigSucceeds(t *testing.T) {
@@ -410,3 +411,27 @@ func validEVMConfigs() *proto.EthereumL2Configs {
 		},
 	}
 }
+
+func testEVMBridgeConfigsRejectDuplicateChainID(t *testing.T) {
+	bridge := func(chainID string) *proto.EVMBridgeConfig {
+		return &proto.EVMBridgeConfig{
+			NetworkId:                chainID,
+			ChainId:                  chainID,
+			Confirmations:            3,
+			CollateralBridgeContract: &proto.EthereumContractConfig{Address: "0x1234"},
+			MultisigControlContract:  &proto.EthereumContractConfig{Address: "0x5678"},
+		}
+	}
+
+	cfgs := &proto.EVMBridgeConfigs{
+		Configs: []*proto.EVMBridgeConfig{bridge("100"), bridge("42161"), bridge("10")},
+	}
+	require.NoError(t, types.CheckEVMChainConfig(cfgs))
+
+	typed, err := types.SecondaryConfigFromProto(cfgs)
+	require.NoError(t, err)
+	require.Len(t, typed.Configs, 3)
+
+	cfgs.Configs = append(cfgs.Configs, bridge("42161"))
+	require.ErrorIs(t, types.CheckEVMChainConfig(cfgs), types.ErrDuplicateChainID)
+}
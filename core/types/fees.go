@@ -93,25 +93,113 @@ func (f FeeFactors) String() string {
 	)
 }
 
+// MakerFeeTier is a market specific maker fee factor applied to makers whose share of the
+// maker volume is at least MinimumMakerVolumeFraction. A negative maker fee is a rebate paid to the maker.
+type MakerFeeTier struct {
+	MinimumMakerVolumeFraction num.Decimal
+	MakerFee                   num.Decimal
+}
+
+func MakerFeeTierFromProto(t *proto.MakerFeeTier) (*MakerFeeTier, error) {
+	minFraction, err := num.DecimalFromString(t.MinimumMakerVolumeFraction)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minimum maker volume fraction %q: %w", t.MinimumMakerVolumeFraction, err)
+	}
+	mf, err := num.DecimalFromString(t.MakerFee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maker fee %q: %w", t.MakerFee, err)
+	}
+	return &MakerFeeTier{
+		MinimumMakerVolumeFraction: minFraction,
+		MakerFee:                   mf,
+	}, nil
+}
+
+func (t MakerFeeTier) IntoProto() *proto.MakerFeeTier {
+	return &proto.MakerFeeTier{
+		MinimumMakerVolumeFraction: t.MinimumMakerVolumeFraction.String(),
+		MakerFee:                   t.MakerFee.String(),
+	}
+}
+
+func (t MakerFeeTier) DeepClone() *MakerFeeTier {
+	return &MakerFeeTier{
+		MinimumMakerVolumeFraction: t.MinimumMakerVolumeFraction,
+		MakerFee:                   t.MakerFee,
+	}
+}
+
+func (t MakerFeeTier) String() string {
+	return fmt.Sprintf(
+		"minimumMakerVolumeFraction(%s) makerFee(%s)",
+		t.MinimumMakerVolumeFraction.String(),
+		t.MakerFee.String(),
+	)
+}
+
+func MakerFeeTiersFromProto(tiers []*proto.MakerFeeTier) ([]*MakerFeeTier, error) {
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+	ret := make([]*MakerFeeTier, 0, len(tiers))
+	for i, t := range tiers {
+		tier, err := MakerFeeTierFromProto(t)
+		if err != nil {
+			return nil, fmt.Errorf("maker fee tier %d: %w", i, err)
+		}
+		ret = append(ret, tier)
+	}
+	return ret, nil
+}
+
+func MakerFeeTiersIntoProto(tiers []*MakerFeeTier) []*proto.MakerFeeTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+	ret := make([]*proto.MakerFeeTier, 0, len(tiers))
+	for _, t := range tiers {
+		ret = append(ret, t.IntoProto())
+	}
+	return ret
+}
+
+func MakerFeeTiersDeepClone(tiers []*MakerFeeTier) []*MakerFeeTier {
+	if tiers == nil {
+		return nil
+	}
+	cpy := make([]*MakerFeeTier, 0, len(tiers))
+	for _, t := range tiers {
+		cpy = append(cpy, t.DeepClone())
+	}
+	return cpy
+}
+
 type Fees struct {
 	Factors              *FeeFactors
 	LiquidityFeeSettings *LiquidityFeeSettings
+	MakerFeeTiers        []*MakerFeeTier
 }
 
-func FeesFromProto(f *proto.Fees) *Fees {
+func FeesFromProto(f *proto.Fees) (*Fees, error) {
 	if f == nil {
-		return nil
+		return nil, nil
+	}
+	makerFeeTiers, err := MakerFeeTiersFromProto(f.MakerFeeTiers)
+	if err != nil {
+		return nil, err
 	}
 	return &Fees{
 		Factors:              FeeFactorsFromProto(f.Factors),
 		LiquidityFeeSettings: LiquidityFeeSettingsFromProto(f.LiquidityFeeSettings),
-	}
+		MakerFeeTiers:        makerFeeTiers,
+	}, nil
 }
 
 func (f Fees) IntoProto() *proto.Fees {
 	return &proto.Fees{
 		Factors:              f.Factors.IntoProto(),
 		LiquidityFeeSettings: f.LiquidityFeeSettings.IntoProto(),
+		MakerFeeTiers:        MakerFeeTiersIntoProto(f.MakerFeeTiers),
 	}
 }
 
@@ -119,6 +207,7 @@ func (f Fees) DeepClone() *Fees {
 	return &Fees{
 		Factors:              f.Factors.DeepClone(),
 		LiquidityFeeSettings: f.LiquidityFeeSettings.DeepClone(),
+		MakerFeeTiers:        MakerFeeTiersDeepClone(f.MakerFeeTiers),
 	}
 }
 
@@ -259,13 +348,40 @@ type ReferrerReward struct {
 	MakerFeeReferrerReward          *num.Uint
 	InfrastructureFeeReferrerReward *num.Uint
 	LiquidityFeeReferrerReward      *num.Uint
+	// Shares is how the total reward is split between the referrers
+	// up the referral chain of the referee.
+	Shares []*ReferrerRewardShare
 }
 
 func (rf ReferrerReward) Clone() *ReferrerReward {
+	var shares []*ReferrerRewardShare
+	if rf.Shares != nil {
+		shares = make([]*ReferrerRewardShare, 0, len(rf.Shares))
+		for _, s := range rf.Shares {
+			shares = append(shares, s.Clone())
+		}
+	}
 	return &ReferrerReward{
 		MakerFeeReferrerReward:          rf.MakerFeeReferrerReward.Clone(),
 		InfrastructureFeeReferrerReward: rf.InfrastructureFeeReferrerReward.Clone(),
 		LiquidityFeeReferrerReward:      rf.LiquidityFeeReferrerReward.Clone(),
+		Shares:                          shares,
+	}
+}
+
+// ReferrerRewardShare is the part of a referrer reward going to one referrer of
+// the referral chain of a referee. Level 1 is the direct referrer of the referee.
+type ReferrerRewardShare struct {
+	Referrer PartyID
+	Level    uint64
+	Amount   *num.Uint
+}
+
+func (s ReferrerRewardShare) Clone() *ReferrerRewardShare {
+	return &ReferrerRewardShare{
+		Referrer: s.Referrer,
+		Level:    s.Level,
+		Amount:   s.Amount.Clone(),
 	}
 }
 
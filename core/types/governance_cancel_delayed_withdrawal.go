@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package types
+
+import (
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/stringer"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+)
+
+type ProposalTermsCancelDelayedWithdrawal struct {
+	CancelDelayedWithdrawal *CancelDelayedWithdrawal
+}
+
+func (a ProposalTermsCancelDelayedWithdrawal) String() string {
+	return fmt.Sprintf(
+		"cancelDelayedWithdrawal(%s)",
+		stringer.PtrToString(a.CancelDelayedWithdrawal),
+	)
+}
+
+func (a ProposalTermsCancelDelayedWithdrawal) isPTerm() {}
+
+func (a ProposalTermsCancelDelayedWithdrawal) oneOfSingleProto() vegapb.ProposalOneOffTermChangeType {
+	return &vegapb.ProposalTerms_CancelDelayedWithdrawal{
+		CancelDelayedWithdrawal: a.CancelDelayedWithdrawal.IntoProto(),
+	}
+}
+
+func (a ProposalTermsCancelDelayedWithdrawal) oneOfBatchProto() vegapb.ProposalOneOffTermBatchChangeType {
+	return nil
+}
+
+func (a ProposalTermsCancelDelayedWithdrawal) GetTermType() ProposalTermsType {
+	return ProposalTermsTypeCancelDelayedWithdrawal
+}
+
+func (a ProposalTermsCancelDelayedWithdrawal) DeepClone() ProposalTerm {
+	if a.CancelDelayedWithdrawal == nil {
+		return &ProposalTermsCancelDelayedWithdrawal{}
+	}
+	return &ProposalTermsCancelDelayedWithdrawal{
+		CancelDelayedWithdrawal: a.CancelDelayedWithdrawal.DeepClone(),
+	}
+}
+
+func NewCancelDelayedWithdrawalFromProto(cancelProto *vegapb.CancelDelayedWithdrawal) (*ProposalTermsCancelDelayedWithdrawal, error) {
+	var cancel *CancelDelayedWithdrawal
+	if cancelProto != nil {
+		cancel = &CancelDelayedWithdrawal{}
+
+		if cancelProto.Changes != nil {
+			cancel.Changes = &CancelDelayedWithdrawalConfiguration{
+				WithdrawalID: cancelProto.Changes.WithdrawalId,
+			}
+		}
+	}
+
+	return &ProposalTermsCancelDelayedWithdrawal{
+		CancelDelayedWithdrawal: cancel,
+	}, nil
+}
+
+type CancelDelayedWithdrawal struct {
+	Changes *CancelDelayedWithdrawalConfiguration
+}
+
+func (c CancelDelayedWithdrawal) IntoProto() *vegapb.CancelDelayedWithdrawal {
+	return &vegapb.CancelDelayedWithdrawal{
+		Changes: &vegapb.CancelDelayedWithdrawalConfiguration{
+			WithdrawalId: c.Changes.WithdrawalID,
+		},
+	}
+}
+
+func (c CancelDelayedWithdrawal) String() string {
+	return fmt.Sprintf(
+		"changes(%s)",
+		stringer.PtrToString(c.Changes),
+	)
+}
+
+func (c CancelDelayedWithdrawal) DeepClone() *CancelDelayedWithdrawal {
+	return &CancelDelayedWithdrawal{
+		Changes: &CancelDelayedWithdrawalConfiguration{
+			WithdrawalID: c.Changes.WithdrawalID,
+		},
+	}
+}
+
+type CancelDelayedWithdrawalConfiguration struct {
+	WithdrawalID string
+}
+
+func (c CancelDelayedWithdrawalConfiguration) String() string {
+	return fmt.Sprintf("withdrawalID(%s)", c.WithdrawalID)
+}
@@ -392,6 +392,7 @@ type NewMarketConfiguration struct {
 	LiquidityMonitoringParameters *LiquidityMonitoringParameters
 	LiquiditySLAParameters        *LiquiditySLAParams
 	LiquidityFeeSettings          *LiquidityFeeSettings
+	MakerFeeTiers                 []*MakerFeeTier
 
 	RiskParameters          newRiskParams
 	LinearSlippageFactor    num.Decimal
@@ -415,6 +416,9 @@ type NewMarketConfiguration struct {
 	EnableTxReordering     bool
 	AllowedEmptyAmmLevels  *uint64
 	AllowedSellers         []string
+	// NetworkParameterOverrides are the values of network parameters that
+	// apply to this market only.
+	NetworkParameterOverrides []*NetworkParameter
 }
 
 func (n NewMarketConfiguration) IntoProto() *vegapb.NewMarketConfiguration {
@@ -464,6 +468,8 @@ func (n NewMarketConfiguration) IntoProto() *vegapb.NewMarketConfiguration {
 		TickSize:                      n.TickSize.String(),
 		EnableTransactionReordering:   n.EnableTxReordering,
 		AllowedEmptyAmmLevels:         n.AllowedEmptyAmmLevels,
+		MakerFeeTiers:                 MakerFeeTiersIntoProto(n.MakerFeeTiers),
+		NetworkParameterOverrides:     NetworkParameterOverridesIntoProto(n.NetworkParameterOverrides),
 	}
 	if n.Successor != nil {
 		r.Successor = n.Successor.IntoProto()
@@ -488,6 +494,9 @@ func (n NewMarketConfiguration) DeepClone() *NewMarketConfiguration {
 		EnableTxReordering:      n.EnableTxReordering,
 		AllowedEmptyAmmLevels:   n.AllowedEmptyAmmLevels,
 		AllowedSellers:          append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:           MakerFeeTiersDeepClone(n.MakerFeeTiers),
+
+		NetworkParameterOverrides: NetworkParametersDeepClone(n.NetworkParameterOverrides),
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -623,6 +632,11 @@ func NewMarketConfigurationFromProto(p *vegapb.NewMarketConfiguration) (*NewMark
 		tickSize, _ = num.UintFromString(p.TickSize, 10)
 	}
 
+	makerFeeTiers, err := MakerFeeTiersFromProto(p.MakerFeeTiers)
+	if err != nil {
+		return nil, fmt.Errorf("error getting maker fee tiers from proto: %w", err)
+	}
+
 	r := &NewMarketConfiguration{
 		Instrument:                    instrument,
 		DecimalPlaces:                 p.DecimalPlaces,
@@ -639,6 +653,8 @@ func NewMarketConfigurationFromProto(p *vegapb.NewMarketConfiguration) (*NewMark
 		TickSize:                      tickSize,
 		EnableTxReordering:            p.EnableTransactionReordering,
 		AllowedEmptyAmmLevels:         p.AllowedEmptyAmmLevels,
+		MakerFeeTiers:                 makerFeeTiers,
+		NetworkParameterOverrides:     NetworkParameterOverridesFromProto(p.NetworkParameterOverrides),
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
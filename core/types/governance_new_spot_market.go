@@ -122,6 +122,7 @@ type NewSpotMarketConfiguration struct {
 	TickSize                  *num.Uint
 	EnableTxReordering        bool
 	AllowedSellers            []string
+	MakerFeeTiers             []*MakerFeeTier
 
 	// New market risk model parameters
 	//
@@ -167,6 +168,7 @@ func (n NewSpotMarketConfiguration) IntoProto() *vegapb.NewSpotMarketConfigurati
 		TickSize:                    n.TickSize.String(),
 		EnableTransactionReordering: n.EnableTxReordering,
 		AllowedSellers:              append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:               MakerFeeTiersIntoProto(n.MakerFeeTiers),
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.NewSpotMarketConfiguration_Simple:
@@ -186,6 +188,7 @@ func (n NewSpotMarketConfiguration) DeepClone() *NewSpotMarketConfiguration {
 		TickSize:           n.TickSize.Clone(),
 		EnableTxReordering: n.EnableTxReordering,
 		AllowedSellers:     append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:      MakerFeeTiersDeepClone(n.MakerFeeTiers),
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -253,6 +256,11 @@ func NewSpotMarketConfigurationFromProto(p *vegapb.NewSpotMarketConfiguration) (
 
 	tickSize, _ := num.UintFromString(p.TickSize, 10)
 
+	makerFeeTiers, err := MakerFeeTiersFromProto(p.MakerFeeTiers)
+	if err != nil {
+		return nil, fmt.Errorf("error getting maker fee tiers from proto: %w", err)
+	}
+
 	r := &NewSpotMarketConfiguration{
 		Instrument:                instrument,
 		PriceDecimalPlaces:        p.PriceDecimalPlaces,
@@ -265,6 +273,7 @@ func NewSpotMarketConfigurationFromProto(p *vegapb.NewSpotMarketConfiguration) (
 		TickSize:                  tickSize,
 		EnableTxReordering:        p.EnableTransactionReordering,
 		AllowedSellers:            append([]string{}, p.AllowedSellers...),
+		MakerFeeTiers:             makerFeeTiers,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
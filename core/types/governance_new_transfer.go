@@ -23,6 +23,8 @@ import (
 	"code.vegaprotocol.io/vega/libs/stringer"
 	"code.vegaprotocol.io/vega/protos/vega"
 	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"google.golang.org/protobuf/proto"
 )
 
 type ProposalTermsNewTransfer struct {
@@ -130,6 +132,8 @@ type NewTransferConfiguration struct {
 	Kind                    TransferKind
 	OneOffTransferConfig    *vega.OneOffTransfer
 	RecurringTransferConfig *vega.RecurringTransfer
+	// VestingSchedule is the vesting applied to the rewards funded by a recurring transfer.
+	VestingSchedule *VestingSchedule
 }
 
 func (n NewTransferConfiguration) IntoProto() *vegapb.NewTransferConfiguration {
@@ -148,8 +152,13 @@ func (n NewTransferConfiguration) IntoProto() *vegapb.NewTransferConfiguration {
 			OneOff: n.OneOffTransferConfig,
 		}
 	} else {
+		recurring := n.RecurringTransferConfig
+		if recurring != nil {
+			recurring = proto.Clone(recurring).(*vegapb.RecurringTransfer)
+			recurring.VestingSchedule = n.VestingSchedule.IntoProto()
+		}
 		c.Kind = &vegapb.NewTransferConfiguration_Recurring{
-			Recurring: n.RecurringTransferConfig,
+			Recurring: recurring,
 		}
 	}
 	return c
@@ -168,6 +177,7 @@ func (n NewTransferConfiguration) DeepClone() *NewTransferConfiguration {
 		Kind:                    n.Kind,
 		OneOffTransferConfig:    n.OneOffTransferConfig,
 		RecurringTransferConfig: n.RecurringTransferConfig,
+		VestingSchedule:         n.VestingSchedule.DeepClone(),
 	}
 }
 
@@ -198,8 +208,12 @@ func NewTransferConfigurationFromProto(p *vegapb.NewTransferConfiguration) (*New
 	oneOff := p.GetOneOff()
 	recurring := p.GetRecurring()
 	kind := TransferKindOneOff
+	var vestingSchedule *VestingSchedule
 	if recurring != nil {
 		kind = TransferKindRecurring
+		if vestingSchedule, err = VestingScheduleFromProto(recurring.VestingSchedule); err != nil {
+			return nil, err
+		}
 	}
 
 	return &NewTransferConfiguration{
@@ -214,5 +228,6 @@ func NewTransferConfigurationFromProto(p *vegapb.NewTransferConfiguration) (*New
 		OneOffTransferConfig:    oneOff,
 		RecurringTransferConfig: recurring,
 		Kind:                    kind,
+		VestingSchedule:         vestingSchedule,
 	}, nil
 }
@@ -135,6 +135,8 @@ const (
 	ProposalErrorInvalidVolumeRebateProgram ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_INVALID_VOLUME_REBATE_PROGRAM
 	// ProposalErrorInvalidAutomatedPurchase is returned when the automated purchase proposal in invalid.
 	ProposalErrorInvalidAutomatedPurchase ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_INVALID_PROTOCOL_AUTOMATED_PURCHASE
+	// ProposalErrorFailedDelayedWithdrawalCancel is returned when the delayed withdrawal cancellation is invalid.
+	ProposalErrorFailedDelayedWithdrawalCancel ProposalError = vegapb.ProposalError_PROPOSAL_ERROR_CANCEL_DELAYED_WITHDRAWAL_INVALID
 )
 
 type ProposalState = vegapb.Proposal_State
@@ -156,6 +158,8 @@ const (
 	ProposalStateEnacted ProposalState = vegapb.Proposal_STATE_ENACTED
 	// ProposalStateWaitingForNodeVote Waiting for node validation of the proposal.
 	ProposalStateWaitingForNodeVote ProposalState = vegapb.Proposal_STATE_WAITING_FOR_NODE_VOTE
+	// ProposalStateVetoed Proposal passed but was vetoed before its enactment.
+	ProposalStateVetoed ProposalState = vegapb.Proposal_STATE_VETOED
 )
 
 type ProposalTermsType int
@@ -176,8 +180,41 @@ const (
 	ProposalTermsTypeUpdateVolumeDiscountProgram
 	ProposalTermsTypeUpdateVolumeRebateProgram
 	ProposalTermsTypeNewProtocolAutomatedPurchase
+	ProposalTermsTypeCancelDelayedWithdrawal
 )
 
+var proposalTermsTypeToProto = map[ProposalTermsType]vegapb.ProposalType{
+	ProposalTermsTypeUpdateMarket:                 vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET,
+	ProposalTermsTypeNewMarket:                    vegapb.ProposalType_PROPOSAL_TYPE_NEW_MARKET,
+	ProposalTermsTypeUpdateNetworkParameter:       vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_NETWORK_PARAMETER,
+	ProposalTermsTypeNewAsset:                     vegapb.ProposalType_PROPOSAL_TYPE_NEW_ASSET,
+	ProposalTermsTypeNewFreeform:                  vegapb.ProposalType_PROPOSAL_TYPE_NEW_FREEFORM,
+	ProposalTermsTypeUpdateAsset:                  vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_ASSET,
+	ProposalTermsTypeNewTransfer:                  vegapb.ProposalType_PROPOSAL_TYPE_NEW_TRANSFER,
+	ProposalTermsTypeNewSpotMarket:                vegapb.ProposalType_PROPOSAL_TYPE_NEW_SPOT_MARKET,
+	ProposalTermsTypeUpdateSpotMarket:             vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_SPOT_MARKET,
+	ProposalTermsTypeCancelTransfer:               vegapb.ProposalType_PROPOSAL_TYPE_CANCEL_TRANSFER,
+	ProposalTermsTypeUpdateMarketState:            vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_MARKET_STATE,
+	ProposalTermsTypeUpdateReferralProgram:        vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_REFERRAL_PROGRAM,
+	ProposalTermsTypeUpdateVolumeDiscountProgram:  vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_VOLUME_DISCOUNT_PROGRAM,
+	ProposalTermsTypeUpdateVolumeRebateProgram:    vegapb.ProposalType_PROPOSAL_TYPE_UPDATE_VOLUME_REBATE_PROGRAM,
+	ProposalTermsTypeNewProtocolAutomatedPurchase: vegapb.ProposalType_PROPOSAL_TYPE_NEW_PROTOCOL_AUTOMATED_PURCHASE,
+	ProposalTermsTypeCancelDelayedWithdrawal:      vegapb.ProposalType_PROPOSAL_TYPE_CANCEL_DELAYED_WITHDRAWAL,
+}
+
+func (t ProposalTermsType) IntoProto() vegapb.ProposalType {
+	return proposalTermsTypeToProto[t]
+}
+
+func ProposalTermsTypeFromProto(p vegapb.ProposalType) (ProposalTermsType, error) {
+	for t, pt := range proposalTermsTypeToProto {
+		if pt == p {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported proposal type: %v", p)
+}
+
 type ProposalSubmission struct {
 	// Proposal reference
 	Reference string
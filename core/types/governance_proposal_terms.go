@@ -179,6 +179,8 @@ func (p ProposalTerms) IntoProto() *vegapb.ProposalTerms {
 		terms.Change = ch
 	case *vegapb.ProposalTerms_CancelTransfer:
 		terms.Change = ch
+	case *vegapb.ProposalTerms_CancelDelayedWithdrawal:
+		terms.Change = ch
 	case *vegapb.ProposalTerms_NewSpotMarket:
 		terms.Change = ch
 	case *vegapb.ProposalTerms_UpdateSpotMarket:
@@ -232,6 +234,15 @@ func (p *ProposalTerms) GetCancelTransfer() *CancelTransfer {
 	}
 }
 
+func (p *ProposalTerms) GetCancelDelayedWithdrawal() *CancelDelayedWithdrawal {
+	switch c := p.Change.(type) {
+	case *ProposalTermsCancelDelayedWithdrawal:
+		return c.CancelDelayedWithdrawal
+	default:
+		return nil
+	}
+}
+
 func (p *ProposalTerms) GetMarketStateUpdate() *UpdateMarketState {
 	switch c := p.Change.(type) {
 	case *ProposalTermsUpdateMarketState:
@@ -375,6 +386,8 @@ func ProposalTermsFromProto(p *vegapb.ProposalTerms) (*ProposalTerms, error) {
 		change, err = NewNewTransferFromProto(ch.NewTransfer)
 	case *vegapb.ProposalTerms_CancelTransfer:
 		change, err = NewCancelGovernanceTransferFromProto(ch.CancelTransfer)
+	case *vegapb.ProposalTerms_CancelDelayedWithdrawal:
+		change, err = NewCancelDelayedWithdrawalFromProto(ch.CancelDelayedWithdrawal)
 	case *vegapb.ProposalTerms_UpdateMarketState:
 		change, err = NewTerminateMarketFromProto(ch.UpdateMarketState)
 	case *vegapb.ProposalTerms_UpdateReferralProgram:
@@ -135,12 +135,16 @@ type UpdateMarketConfiguration struct {
 	LinearSlippageFactor          num.Decimal
 	QuadraticSlippageFactor       num.Decimal
 	LiquidityFeeSettings          *LiquidityFeeSettings
+	MakerFeeTiers                 []*MakerFeeTier
 	LiquidationStrategy           *LiquidationStrategy
 	MarkPriceConfiguration        *CompositePriceConfiguration
 	TickSize                      *num.Uint
 	EnableTxReordering            bool
 	AllowedEmptyAmmLevels         *uint64
 	AllowedSellers                []string
+	// NetworkParameterOverrides replace the overrides of network parameters
+	// of the market.
+	NetworkParameterOverrides []*NetworkParameter
 }
 
 func (n UpdateMarketConfiguration) String() string {
@@ -168,6 +172,9 @@ func (n UpdateMarketConfiguration) DeepClone() *UpdateMarketConfiguration {
 		EnableTxReordering:      n.EnableTxReordering,
 		AllowedEmptyAmmLevels:   n.AllowedEmptyAmmLevels,
 		AllowedSellers:          append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:           MakerFeeTiersDeepClone(n.MakerFeeTiers),
+
+		NetworkParameterOverrides: NetworkParametersDeepClone(n.NetworkParameterOverrides),
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.Instrument != nil {
@@ -241,6 +248,8 @@ func (n UpdateMarketConfiguration) IntoProto() *vegapb.UpdateMarketConfiguration
 		TickSize:                      n.TickSize.String(),
 		EnableTransactionReordering:   n.EnableTxReordering,
 		AllowedEmptyAmmLevels:         n.AllowedEmptyAmmLevels,
+		MakerFeeTiers:                 MakerFeeTiersIntoProto(n.MakerFeeTiers),
+		NetworkParameterOverrides:     NetworkParameterOverridesIntoProto(n.NetworkParameterOverrides),
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.UpdateMarketConfiguration_Simple:
@@ -329,6 +338,11 @@ func UpdateMarketConfigurationFromProto(p *vegapb.UpdateMarketConfiguration) (*U
 		tickSize, _ = num.UintFromString(p.TickSize, 10)
 	}
 
+	makerFeeTiers, err := MakerFeeTiersFromProto(p.MakerFeeTiers)
+	if err != nil {
+		return nil, fmt.Errorf("error getting maker fee tiers from proto: %w", err)
+	}
+
 	r := &UpdateMarketConfiguration{
 		Instrument:                    instrument,
 		Metadata:                      md,
@@ -343,6 +357,8 @@ func UpdateMarketConfigurationFromProto(p *vegapb.UpdateMarketConfiguration) (*U
 		TickSize:                      tickSize,
 		EnableTxReordering:            p.EnableTransactionReordering,
 		AllowedEmptyAmmLevels:         p.AllowedEmptyAmmLevels,
+		MakerFeeTiers:                 makerFeeTiers,
+		NetworkParameterOverrides:     NetworkParameterOverridesFromProto(p.NetworkParameterOverrides),
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
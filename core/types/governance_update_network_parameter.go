@@ -76,6 +76,9 @@ func NewUpdateNetworkParameterFromProto(
 		if updateNetworkParamProto.Changes != nil {
 			updateNP.Changes = NetworkParameterFromProto(updateNetworkParamProto.Changes)
 		}
+		if updateNetworkParamProto.Schedule != nil {
+			updateNP.Schedule = NetworkParameterScheduleFromProto(updateNetworkParamProto.Schedule)
+		}
 	}
 
 	return &ProposalTermsUpdateNetworkParameter{
@@ -85,18 +88,26 @@ func NewUpdateNetworkParameterFromProto(
 
 type UpdateNetworkParameter struct {
 	Changes *NetworkParameter
+	// Schedule rolls out the change over time, the change is applied
+	// at once on enactment when not set.
+	Schedule *NetworkParameterSchedule
 }
 
 func (n UpdateNetworkParameter) IntoProto() *vegapb.UpdateNetworkParameter {
-	return &vegapb.UpdateNetworkParameter{
+	p := &vegapb.UpdateNetworkParameter{
 		Changes: n.Changes.IntoProto(),
 	}
+	if n.Schedule != nil {
+		p.Schedule = n.Schedule.IntoProto()
+	}
+	return p
 }
 
 func (n UpdateNetworkParameter) String() string {
 	return fmt.Sprintf(
-		"changes(%s)",
+		"changes(%s) schedule(%s)",
 		stringer.PtrToString(n.Changes),
+		stringer.PtrToString(n.Schedule),
 	)
 }
 
@@ -104,7 +115,11 @@ func (n UpdateNetworkParameter) DeepClone() *UpdateNetworkParameter {
 	if n.Changes == nil {
 		return &UpdateNetworkParameter{}
 	}
-	return &UpdateNetworkParameter{
+	cpy := &UpdateNetworkParameter{
 		Changes: n.Changes.DeepClone(),
 	}
+	if n.Schedule != nil {
+		cpy.Schedule = n.Schedule.DeepClone()
+	}
+	return cpy
 }
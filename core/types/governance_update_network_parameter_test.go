@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package types_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateNetworkParameterScheduleRoundTrip(t *testing.T) {
+	pb := &vegapb.UpdateNetworkParameter{
+		Changes: &vegapb.NetworkParameter{
+			Key:   "delegation.minAmount",
+			Value: "5",
+		},
+		Schedule: &vegapb.NetworkParameterSchedule{
+			Type:   vegapb.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_RAMP,
+			Epochs: 4,
+		},
+	}
+
+	unp := types.NewUpdateNetworkParameterFromProto(pb).UpdateNetworkParameter
+	require.Equal(t, &types.NetworkParameterSchedule{Type: types.NetworkParameterScheduleTypeRamp, Epochs: 4}, unp.Schedule)
+	require.Equal(t, pb.String(), unp.IntoProto().String())
+
+	// no schedule means the change is applied at once.
+	pb.Schedule = nil
+	unp = types.NewUpdateNetworkParameterFromProto(pb).UpdateNetworkParameter
+	require.Nil(t, unp.Schedule)
+	require.Nil(t, unp.IntoProto().Schedule)
+}
@@ -132,6 +132,7 @@ type UpdateSpotMarketConfiguration struct {
 	LiquidityFeeSettings      *LiquidityFeeSettings
 	EnableTxReordering        bool
 	AllowedSellers            []string
+	MakerFeeTiers             []*MakerFeeTier
 }
 
 func (n UpdateSpotMarketConfiguration) String() string {
@@ -158,6 +159,7 @@ func (n UpdateSpotMarketConfiguration) DeepClone() *UpdateSpotMarketConfiguratio
 		},
 		EnableTxReordering: n.EnableTxReordering,
 		AllowedSellers:     append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:      MakerFeeTiersDeepClone(n.MakerFeeTiers),
 	}
 	cpy.Metadata = append(cpy.Metadata, n.Metadata...)
 	if n.PriceMonitoringParameters != nil {
@@ -205,6 +207,7 @@ func (n UpdateSpotMarketConfiguration) IntoProto() *vegapb.UpdateSpotMarketConfi
 		LiquidityFeeSettings:        liquidityFeeSettings,
 		EnableTransactionReordering: n.EnableTxReordering,
 		AllowedSellers:              append([]string{}, n.AllowedSellers...),
+		MakerFeeTiers:               MakerFeeTiersIntoProto(n.MakerFeeTiers),
 	}
 	switch rp := riskParams.(type) {
 	case *vegapb.UpdateSpotMarketConfiguration_Simple:
@@ -231,6 +234,11 @@ func UpdateSpotMarketConfigurationFromProto(p *vegapb.UpdateSpotMarketConfigurat
 
 	tickSize, _ := num.UintFromString(p.TickSize, 10)
 
+	makerFeeTiers, err := MakerFeeTiersFromProto(p.MakerFeeTiers)
+	if err != nil {
+		return nil, fmt.Errorf("error getting maker fee tiers from proto: %w", err)
+	}
+
 	r := &UpdateSpotMarketConfiguration{
 		Metadata:                  md,
 		PriceMonitoringParameters: priceMonitoring,
@@ -244,6 +252,7 @@ func UpdateSpotMarketConfigurationFromProto(p *vegapb.UpdateSpotMarketConfigurat
 		},
 		EnableTxReordering: p.EnableTransactionReordering,
 		AllowedSellers:     append([]string{}, p.AllowedSellers...),
+		MakerFeeTiers:      makerFeeTiers,
 	}
 	if p.RiskParameters != nil {
 		switch rp := p.RiskParameters.(type) {
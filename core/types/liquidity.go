@@ -103,6 +103,18 @@ func (l LiquiditySLAParams) DeepClone() *LiquiditySLAParams {
 	}
 }
 
+// LiquidityProviderSLAForecast is the projection of a liquidity provider's SLA penalties
+// at the end of the current epoch, assuming their current order placement is maintained.
+type LiquidityProviderSLAForecast struct {
+	Party                              string
+	CurrentEpochFractionOfTimeOnBook   num.Decimal
+	ProjectedEpochFractionOfTimeOnBook num.Decimal
+	MeetsCommitment                    bool
+	ProjectedFeePenalty                num.Decimal
+	ProjectedBondPenalty               num.Decimal
+	OrdersOutsidePriceRange            []string
+}
+
 type TargetStakeParameters struct {
 	TimeWindow    int64
 	ScalingFactor num.Decimal
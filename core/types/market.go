@@ -1115,6 +1115,10 @@ type Market struct {
 	EnableTxReordering     bool
 	AllowedEmptyAmmLevels  uint64
 	AllowedSellers         []string
+
+	// NetworkParameterOverrides are the values of network parameters that
+	// apply to this market instead of the network wide ones, sorted by key.
+	NetworkParameterOverrides []*NetworkParameter
 }
 
 func MarketFromProto(mkt *vegapb.Market) (*Market, error) {
@@ -1161,12 +1165,17 @@ func MarketFromProto(mkt *vegapb.Market) (*Market, error) {
 		}
 	}
 
+	fees, err := FeesFromProto(mkt.Fees)
+	if err != nil {
+		return nil, err
+	}
+
 	m := &Market{
 		ID:                            mkt.Id,
 		TradableInstrument:            TradableInstrumentFromProto(mkt.TradableInstrument),
 		DecimalPlaces:                 mkt.DecimalPlaces,
 		PositionDecimalPlaces:         mkt.PositionDecimalPlaces,
-		Fees:                          FeesFromProto(mkt.Fees),
+		Fees:                          fees,
 		OpeningAuction:                AuctionDurationFromProto(mkt.OpeningAuction),
 		PriceMonitoringSettings:       PriceMonitoringSettingsFromProto(mkt.PriceMonitoringSettings),
 		LiquiditySLAParams:            LiquiditySLAParamsFromProto(mkt.LiquiditySlaParams),
@@ -1184,6 +1193,7 @@ func MarketFromProto(mkt *vegapb.Market) (*Market, error) {
 		EnableTxReordering:            mkt.EnableTransactionReordering,
 		AllowedEmptyAmmLevels:         mkt.AllowedEmptyAmmLevels,
 		AllowedSellers:                mkt.AllowedSellers,
+		NetworkParameterOverrides:     NetworkParameterOverridesFromProto(mkt.NetworkParameterOverrides),
 	}
 
 	if mkt.LiquiditySlaParams != nil {
@@ -1259,6 +1269,7 @@ func (m Market) IntoProto() *vegapb.Market {
 		EnableTransactionReordering:   m.EnableTxReordering,
 		AllowedEmptyAmmLevels:         m.AllowedEmptyAmmLevels,
 		AllowedSellers:                m.AllowedSellers,
+		NetworkParameterOverrides:     NetworkParameterOverridesIntoProto(m.NetworkParameterOverrides),
 	}
 	return r
 }
@@ -1300,6 +1311,17 @@ func (m Market) MarketType() MarketType {
 	return MarketTypeUnspecified
 }
 
+// NetworkParameterOverride returns the value of the network parameter for this market,
+// if it is overridden.
+func (m Market) NetworkParameterOverride(key string) (string, bool) {
+	for _, p := range m.NetworkParameterOverrides {
+		if p.Key == key {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
 func (m Market) DeepClone() *Market {
 	cpy := &Market{
 		ID:                      m.ID,
@@ -1315,6 +1337,8 @@ func (m Market) DeepClone() *Market {
 		EnableTxReordering:      m.EnableTxReordering,
 		AllowedEmptyAmmLevels:   m.AllowedEmptyAmmLevels,
 		AllowedSellers:          append([]string{}, m.AllowedSellers...),
+
+		NetworkParameterOverrides: NetworkParametersDeepClone(m.NetworkParameterOverrides),
 	}
 
 	if m.LiquiditySLAParams != nil {
@@ -180,10 +180,17 @@ func TestMarketFromIntoProto(t *testing.T) {
 		},
 		TickSize:                    "1",
 		EnableTransactionReordering: true,
+		NetworkParameterOverrides: []*vegapb.NetworkParameterOverride{
+			{Key: "market.auction.minimumDuration", Value: "5m"},
+			{Key: "market.fee.factors.makerFee", Value: "0.001"},
+		},
 	}
 
 	domain, err := types.MarketFromProto(pMarket)
 	require.NoError(t, err)
+	v, ok := domain.NetworkParameterOverride("market.fee.factors.makerFee")
+	require.True(t, ok)
+	require.Equal(t, "0.001", v)
 
 	// we can check equality of individual fields, but perhaps this is the easiest way:
 	got := domain.IntoProto()
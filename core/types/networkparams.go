@@ -53,3 +53,91 @@ func (n NetworkParameter) DeepClone() *NetworkParameter {
 		Value: n.Value,
 	}
 }
+
+func NetworkParametersDeepClone(params []*NetworkParameter) []*NetworkParameter {
+	if params == nil {
+		return nil
+	}
+	cpy := make([]*NetworkParameter, 0, len(params))
+	for _, p := range params {
+		cpy = append(cpy, p.DeepClone())
+	}
+	return cpy
+}
+
+// NetworkParameterOverridesFromProto returns the network parameters a market overrides.
+func NetworkParameterOverridesFromProto(overrides []*proto.NetworkParameterOverride) []*NetworkParameter {
+	if len(overrides) == 0 {
+		return nil
+	}
+	ret := make([]*NetworkParameter, 0, len(overrides))
+	for _, o := range overrides {
+		ret = append(ret, &NetworkParameter{
+			Key:   o.Key,
+			Value: o.Value,
+		})
+	}
+	return ret
+}
+
+func NetworkParameterOverridesIntoProto(params []*NetworkParameter) []*proto.NetworkParameterOverride {
+	if len(params) == 0 {
+		return nil
+	}
+	ret := make([]*proto.NetworkParameterOverride, 0, len(params))
+	for _, p := range params {
+		ret = append(ret, &proto.NetworkParameterOverride{
+			Key:   p.Key,
+			Value: p.Value,
+		})
+	}
+	return ret
+}
+
+type NetworkParameterScheduleType = proto.NetworkParameterScheduleType
+
+const (
+	// NetworkParameterScheduleTypeUnspecified is the default value, always invalid.
+	NetworkParameterScheduleTypeUnspecified NetworkParameterScheduleType = proto.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_UNSPECIFIED
+	// NetworkParameterScheduleTypeRamp moves the value linearly to the new one over a number of epochs.
+	NetworkParameterScheduleTypeRamp NetworkParameterScheduleType = proto.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_RAMP
+	// NetworkParameterScheduleTypeAtHeight sets the new value at a block height.
+	NetworkParameterScheduleTypeAtHeight NetworkParameterScheduleType = proto.NetworkParameterScheduleType_NETWORK_PARAMETER_SCHEDULE_TYPE_AT_HEIGHT
+)
+
+// NetworkParameterSchedule is how a network parameter change is rolled out
+// instead of being applied at once on enactment.
+type NetworkParameterSchedule struct {
+	Type   NetworkParameterScheduleType
+	Epochs uint64
+	Height uint64
+}
+
+func NetworkParameterScheduleFromProto(p *proto.NetworkParameterSchedule) *NetworkParameterSchedule {
+	return &NetworkParameterSchedule{
+		Type:   p.Type,
+		Epochs: p.Epochs,
+		Height: p.Height,
+	}
+}
+
+func (s NetworkParameterSchedule) IntoProto() *proto.NetworkParameterSchedule {
+	return &proto.NetworkParameterSchedule{
+		Type:   s.Type,
+		Epochs: s.Epochs,
+		Height: s.Height,
+	}
+}
+
+func (s NetworkParameterSchedule) String() string {
+	return fmt.Sprintf(
+		"type(%s) epochs(%d) height(%d)",
+		s.Type.String(),
+		s.Epochs,
+		s.Height,
+	)
+}
+
+func (s NetworkParameterSchedule) DeepClone() *NetworkParameterSchedule {
+	return &s
+}
@@ -47,6 +47,8 @@ type ReferralSetStats struct {
 	RewardFactors            Factors
 	RewardsMultiplier        num.Decimal
 	RewardsFactorsMultiplier Factors
+	// UpstreamSetID is the set the referrer of this set is a referee of, if any.
+	UpstreamSetID ReferralSetID
 }
 
 type RefereeStats struct {
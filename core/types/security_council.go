@@ -0,0 +1,77 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/crypto"
+)
+
+var (
+	ErrInvalidSecurityCouncilMember    = errors.New("security council member is not a valid public key")
+	ErrDuplicateSecurityCouncilMember  = errors.New("security council member listed twice")
+	ErrInvalidSecurityCouncilThreshold = errors.New("security council threshold must be between 1 and the number of members")
+)
+
+// SecurityCouncil is the set of keys which can veto a passed proposal before it
+// is enacted, Threshold of them are required to do so. A council without members
+// is disabled.
+type SecurityCouncil struct {
+	Members   map[string]struct{}
+	Threshold int
+}
+
+type securityCouncilJSON struct {
+	Members   []string `json:"members"`
+	Threshold int      `json:"threshold"`
+}
+
+// ParseSecurityCouncil parses the value of the network parameter configuring the
+// security council.
+func ParseSecurityCouncil(v string) (*SecurityCouncil, error) {
+	raw := securityCouncilJSON{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("invalid security council: %w", err)
+	}
+
+	council := &SecurityCouncil{
+		Members:   make(map[string]struct{}, len(raw.Members)),
+		Threshold: raw.Threshold,
+	}
+	for _, member := range raw.Members {
+		if !crypto.IsValidVegaPubKey(member) {
+			return nil, fmt.Errorf("%s: %w", member, ErrInvalidSecurityCouncilMember)
+		}
+		if _, ok := council.Members[member]; ok {
+			return nil, fmt.Errorf("%s: %w", member, ErrDuplicateSecurityCouncilMember)
+		}
+		council.Members[member] = struct{}{}
+	}
+	if len(council.Members) > 0 && (council.Threshold < 1 || council.Threshold > len(council.Members)) {
+		return nil, ErrInvalidSecurityCouncilThreshold
+	}
+	return council, nil
+}
+
+// CheckSecurityCouncil is the rule of the network parameter configuring the
+// security council.
+func CheckSecurityCouncil(v string) error {
+	_, err := ParseSecurityCouncil(v)
+	return err
+}
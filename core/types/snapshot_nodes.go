@@ -561,6 +561,7 @@ type CollateralAccounts struct {
 	Accounts            []*Account
 	Earmarked           map[string]*num.Uint
 	NextBalanceSnapshot time.Time
+	CrossAssetPrices    []*CrossAssetCollateralPrice
 }
 
 type CollateralAssets struct {
@@ -578,7 +579,8 @@ type AppState struct {
 }
 
 type NetParams struct {
-	Params []*NetworkParameter
+	Params    []*NetworkParameter
+	Schedules []*snapshot.NetworkParameterScheduleState
 }
 
 type DelegationActive struct {
@@ -856,6 +858,22 @@ func PayloadFromProto(p *snapshot.Payload) *Payload {
 		ret.Data = PayloadBankingScheduledGovernanceTransfersFromProto(dt)
 	case *snapshot.Payload_BankingTransferFeeDiscounts:
 		ret.Data = PayloadBankingTransferFeeDiscountsFromProto(dt)
+	case *snapshot.Payload_BankingTeamCompetitions:
+		ret.Data = PayloadBankingTeamCompetitionsFromProto(dt)
+	case *snapshot.Payload_BankingConditionalTransfers:
+		ret.Data = PayloadBankingConditionalTransfersFromProto(dt)
+	case *snapshot.Payload_BankingWithdrawalBatches:
+		ret.Data = PayloadBankingWithdrawalBatchesFromProto(dt)
+	case *snapshot.Payload_GovernanceVoteDelegations:
+		ret.Data = PayloadGovernanceVoteDelegationsFromProto(dt)
+	case *snapshot.Payload_GovernanceProposalVetoes:
+		ret.Data = PayloadGovernanceProposalVetoesFromProto(dt)
+	case *snapshot.Payload_DelegationSlashed:
+		ret.Data = PayloadDelegationSlashedFromProto(dt)
+	case *snapshot.Payload_DelegationRedelegations:
+		ret.Data = PayloadDelegationRedelegationsFromProto(dt)
+	case *snapshot.Payload_DelegationAutoCompound:
+		ret.Data = PayloadDelegationAutoCompoundFromProto(dt)
 	case *snapshot.Payload_Erc20MultisigTopologyPending:
 		ret.Data = PayloadERC20MultiSigTopologyPendingFromProto(dt)
 	case *snapshot.Payload_Erc20MultisigTopologyVerified:
@@ -1051,6 +1069,22 @@ func (p Payload) IntoProto() *snapshot.Payload {
 		ret.Data = dt
 	case *snapshot.Payload_BankingTransferFeeDiscounts:
 		ret.Data = dt
+	case *snapshot.Payload_BankingTeamCompetitions:
+		ret.Data = dt
+	case *snapshot.Payload_BankingConditionalTransfers:
+		ret.Data = dt
+	case *snapshot.Payload_BankingWithdrawalBatches:
+		ret.Data = dt
+	case *snapshot.Payload_GovernanceVoteDelegations:
+		ret.Data = dt
+	case *snapshot.Payload_GovernanceProposalVetoes:
+		ret.Data = dt
+	case *snapshot.Payload_DelegationSlashed:
+		ret.Data = dt
+	case *snapshot.Payload_DelegationRedelegations:
+		ret.Data = dt
+	case *snapshot.Payload_DelegationAutoCompound:
+		ret.Data = dt
 	case *snapshot.Payload_Erc20MultisigTopologyPending:
 		ret.Data = dt
 	case *snapshot.Payload_Erc20MultisigTopologyVerified:
@@ -2152,6 +2186,15 @@ func CollateralAccountsFromProto(ca *snapshot.CollateralAccounts) *CollateralAcc
 	for _, earmarked := range ca.EarmarkedBalances {
 		ret.Earmarked[earmarked.AccountId], _ = num.UintFromString(earmarked.EarmarkedBalance, 10)
 	}
+
+	ret.CrossAssetPrices = make([]*CrossAssetCollateralPrice, 0, len(ca.CrossAssetCollateralPrices))
+	for _, p := range ca.CrossAssetCollateralPrices {
+		ret.CrossAssetPrices = append(ret.CrossAssetPrices, &CrossAssetCollateralPrice{
+			Asset:           p.Asset,
+			SettlementAsset: p.SettlementAsset,
+			Price:           num.MustDecimalFromString(p.Price),
+		})
+	}
 	return &ret
 }
 
@@ -2169,10 +2212,20 @@ func (c CollateralAccounts) IntoProto() *snapshot.CollateralAccounts {
 		return earmarked[i].AccountId < earmarked[j].AccountId
 	})
 
+	prices := make([]*snapshot.CrossAssetCollateralPrice, 0, len(c.CrossAssetPrices))
+	for _, p := range c.CrossAssetPrices {
+		prices = append(prices, &snapshot.CrossAssetCollateralPrice{
+			Asset:           p.Asset,
+			SettlementAsset: p.SettlementAsset,
+			Price:           p.Price.String(),
+		})
+	}
+
 	return &snapshot.CollateralAccounts{
-		Accounts:            accs.IntoProto(),
-		NextBalanceSnapshot: c.NextBalanceSnapshot.UnixNano(),
-		EarmarkedBalances:   earmarked,
+		Accounts:                   accs.IntoProto(),
+		NextBalanceSnapshot:        c.NextBalanceSnapshot.UnixNano(),
+		EarmarkedBalances:          earmarked,
+		CrossAssetCollateralPrices: prices,
 	}
 }
 
@@ -2232,12 +2285,14 @@ func NetParamsFromProto(np *snapshot.NetParams) *NetParams {
 	for _, p := range np.Params {
 		ret.Params = append(ret.Params, NetworkParameterFromProto(p))
 	}
+	ret.Schedules = np.Schedules
 	return &ret
 }
 
 func (n NetParams) IntoProto() *snapshot.NetParams {
 	ret := snapshot.NetParams{
-		Params: make([]*vega.NetworkParameter, 0, len(n.Params)),
+		Params:    make([]*vega.NetworkParameter, 0, len(n.Params)),
+		Schedules: n.Schedules,
 	}
 	for _, p := range n.Params {
 		ret.Params = append(ret.Params, p.IntoProto())
@@ -4410,3 +4465,153 @@ func PayloadEVMFwdHeartbeatsFromProto(pl *snapshot.Payload_EvmFwdHeartbeats) *Pa
 		EVMFwdHeartbeats: pl.EvmFwdHeartbeats,
 	}
 }
+
+type PayloadGovernanceVoteDelegations struct {
+	GovernanceVoteDelegations *snapshot.GovernanceVoteDelegations
+}
+
+func (p PayloadGovernanceVoteDelegations) IntoProto() *snapshot.Payload_GovernanceVoteDelegations {
+	return &snapshot.Payload_GovernanceVoteDelegations{
+		GovernanceVoteDelegations: p.GovernanceVoteDelegations,
+	}
+}
+
+func (*PayloadGovernanceVoteDelegations) isPayload() {}
+
+func (p *PayloadGovernanceVoteDelegations) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadGovernanceVoteDelegations) Key() string {
+	return "vote_delegations"
+}
+
+func (*PayloadGovernanceVoteDelegations) Namespace() SnapshotNamespace {
+	return GovernanceSnapshot
+}
+
+func PayloadGovernanceVoteDelegationsFromProto(p *snapshot.Payload_GovernanceVoteDelegations) *PayloadGovernanceVoteDelegations {
+	return &PayloadGovernanceVoteDelegations{
+		GovernanceVoteDelegations: p.GovernanceVoteDelegations,
+	}
+}
+
+type PayloadGovernanceProposalVetoes struct {
+	GovernanceProposalVetoes *snapshot.GovernanceProposalVetoes
+}
+
+func (p PayloadGovernanceProposalVetoes) IntoProto() *snapshot.Payload_GovernanceProposalVetoes {
+	return &snapshot.Payload_GovernanceProposalVetoes{
+		GovernanceProposalVetoes: p.GovernanceProposalVetoes,
+	}
+}
+
+func (*PayloadGovernanceProposalVetoes) isPayload() {}
+
+func (p *PayloadGovernanceProposalVetoes) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadGovernanceProposalVetoes) Key() string {
+	return "proposal_vetoes"
+}
+
+func (*PayloadGovernanceProposalVetoes) Namespace() SnapshotNamespace {
+	return GovernanceSnapshot
+}
+
+func PayloadGovernanceProposalVetoesFromProto(p *snapshot.Payload_GovernanceProposalVetoes) *PayloadGovernanceProposalVetoes {
+	return &PayloadGovernanceProposalVetoes{
+		GovernanceProposalVetoes: p.GovernanceProposalVetoes,
+	}
+}
+
+type PayloadDelegationSlashed struct {
+	DelegationSlashed *snapshot.DelegationSlashed
+}
+
+func (p PayloadDelegationSlashed) IntoProto() *snapshot.Payload_DelegationSlashed {
+	return &snapshot.Payload_DelegationSlashed{
+		DelegationSlashed: p.DelegationSlashed,
+	}
+}
+
+func (*PayloadDelegationSlashed) isPayload() {}
+
+func (p *PayloadDelegationSlashed) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadDelegationSlashed) Key() string {
+	return "slashed"
+}
+
+func (*PayloadDelegationSlashed) Namespace() SnapshotNamespace {
+	return DelegationSnapshot
+}
+
+func PayloadDelegationSlashedFromProto(p *snapshot.Payload_DelegationSlashed) *PayloadDelegationSlashed {
+	return &PayloadDelegationSlashed{
+		DelegationSlashed: p.DelegationSlashed,
+	}
+}
+
+type PayloadDelegationRedelegations struct {
+	DelegationRedelegations *snapshot.DelegationRedelegations
+}
+
+func (p PayloadDelegationRedelegations) IntoProto() *snapshot.Payload_DelegationRedelegations {
+	return &snapshot.Payload_DelegationRedelegations{
+		DelegationRedelegations: p.DelegationRedelegations,
+	}
+}
+
+func (*PayloadDelegationRedelegations) isPayload() {}
+
+func (p *PayloadDelegationRedelegations) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadDelegationRedelegations) Key() string {
+	return "redelegations"
+}
+
+func (*PayloadDelegationRedelegations) Namespace() SnapshotNamespace {
+	return DelegationSnapshot
+}
+
+func PayloadDelegationRedelegationsFromProto(p *snapshot.Payload_DelegationRedelegations) *PayloadDelegationRedelegations {
+	return &PayloadDelegationRedelegations{
+		DelegationRedelegations: p.DelegationRedelegations,
+	}
+}
+
+type PayloadDelegationAutoCompound struct {
+	DelegationAutoCompound *snapshot.DelegationAutoCompound
+}
+
+func (p PayloadDelegationAutoCompound) IntoProto() *snapshot.Payload_DelegationAutoCompound {
+	return &snapshot.Payload_DelegationAutoCompound{
+		DelegationAutoCompound: p.DelegationAutoCompound,
+	}
+}
+
+func (*PayloadDelegationAutoCompound) isPayload() {}
+
+func (p *PayloadDelegationAutoCompound) plToProto() interface{} {
+	return p.IntoProto()
+}
+
+func (*PayloadDelegationAutoCompound) Key() string {
+	return "autoCompound"
+}
+
+func (*PayloadDelegationAutoCompound) Namespace() SnapshotNamespace {
+	return DelegationSnapshot
+}
+
+func PayloadDelegationAutoCompoundFromProto(p *snapshot.Payload_DelegationAutoCompound) *PayloadDelegationAutoCompound {
+	return &PayloadDelegationAutoCompound{
+		DelegationAutoCompound: p.DelegationAutoCompound,
+	}
+}
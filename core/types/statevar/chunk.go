@@ -0,0 +1,168 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statevar
+
+import (
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// ChunkKey is the key of the first entry of a bundle which is a chunk of a larger bundle,
+// its value is the vector [index, number of chunks].
+const ChunkKey = "statevar.chunk"
+
+// Split splits the bundle into chunks holding at most maxValues floating point values each, vectors being
+// split into sub vectors and matrices into groups of rows. The bundle is returned as is if it doesn't need
+// to be split.
+func (kvb *KeyValueBundle) Split(maxValues int) []*KeyValueBundle {
+	if maxValues <= 0 || kvb.size() <= maxValues {
+		return []*KeyValueBundle{kvb}
+	}
+
+	chunks := []*KeyValueBundle{}
+	current := &KeyValueBundle{}
+	room := maxValues
+	next := func() {
+		chunks = append(chunks, current)
+		current = &KeyValueBundle{}
+		room = maxValues
+	}
+
+	for _, kvt := range kvb.KVT {
+		switch v := kvt.Val.(type) {
+		case *DecimalVector:
+			values := v.Val
+			for {
+				if room == 0 {
+					next()
+				}
+				n := num.MinV(room, len(values))
+				current.KVT = append(current.KVT, KeyValueTol{Key: kvt.Key, Tolerance: kvt.Tolerance, Val: &DecimalVector{Val: values[:n]}})
+				room -= n
+				values = values[n:]
+				if len(values) == 0 {
+					break
+				}
+			}
+		case *DecimalMatrix:
+			rows := v.Val
+			for {
+				// take as many rows as the chunk can hold
+				n, size := 0, 0
+				for n < len(rows) && size+len(rows[n]) <= room {
+					size += len(rows[n])
+					n++
+				}
+				if n == 0 && len(rows) > 0 {
+					if len(current.KVT) > 0 {
+						next()
+						continue
+					}
+					// the row is larger than a chunk, it goes on its own.
+					n, size = 1, len(rows[0])
+				}
+				current.KVT = append(current.KVT, KeyValueTol{Key: kvt.Key, Tolerance: kvt.Tolerance, Val: &DecimalMatrix{Val: rows[:n]}})
+				room = num.MaxV(room-size, 0)
+				rows = rows[n:]
+				if len(rows) == 0 {
+					break
+				}
+				next()
+			}
+		default:
+			if room == 0 {
+				next()
+			}
+			current.KVT = append(current.KVT, kvt)
+			room--
+		}
+	}
+	if len(current.KVT) > 0 {
+		chunks = append(chunks, current)
+	}
+
+	total := num.DecimalFromInt64(int64(len(chunks)))
+	for i, c := range chunks {
+		header := KeyValueTol{
+			Key:       ChunkKey,
+			Tolerance: num.DecimalZero(),
+			Val:       &DecimalVector{Val: []num.Decimal{num.DecimalFromInt64(int64(i)), total}},
+		}
+		c.KVT = append([]KeyValueTol{header}, c.KVT...)
+	}
+	return chunks
+}
+
+// ChunkInfo returns the index of the chunk and the number of chunks if the bundle is a chunk of a larger bundle.
+func (kvb *KeyValueBundle) ChunkInfo() (int, int, bool) {
+	if len(kvb.KVT) == 0 || kvb.KVT[0].Key != ChunkKey {
+		return 0, 0, false
+	}
+	v, ok := kvb.KVT[0].Val.(*DecimalVector)
+	if !ok || len(v.Val) != 2 {
+		return 0, 0, false
+	}
+	index, total := int(v.Val[0].IntPart()), int(v.Val[1].IntPart())
+	if index < 0 || index >= total {
+		return 0, 0, false
+	}
+	return index, total, true
+}
+
+// MergeChunks rebuilds the bundle from all its chunks, given in order.
+func MergeChunks(chunks []*KeyValueBundle) *KeyValueBundle {
+	merged := &KeyValueBundle{}
+	for _, c := range chunks {
+		for _, kvt := range c.KVT[1:] {
+			last := len(merged.KVT) - 1
+			if last < 0 || merged.KVT[last].Key != kvt.Key {
+				merged.KVT = append(merged.KVT, kvt)
+				continue
+			}
+			switch v := kvt.Val.(type) {
+			case *DecimalVector:
+				if prev, ok := merged.KVT[last].Val.(*DecimalVector); ok {
+					merged.KVT[last].Val = &DecimalVector{Val: append(append([]num.Decimal{}, prev.Val...), v.Val...)}
+					continue
+				}
+			case *DecimalMatrix:
+				if prev, ok := merged.KVT[last].Val.(*DecimalMatrix); ok {
+					merged.KVT[last].Val = &DecimalMatrix{Val: append(append([][]num.Decimal{}, prev.Val...), v.Val...)}
+					continue
+				}
+			}
+			merged.KVT = append(merged.KVT, kvt)
+		}
+	}
+	return merged
+}
+
+// size returns the number of floating point values in the bundle.
+func (kvb *KeyValueBundle) size() int {
+	size := 0
+	for _, kvt := range kvb.KVT {
+		switch v := kvt.Val.(type) {
+		case *DecimalVector:
+			size += len(v.Val)
+		case *DecimalMatrix:
+			for _, row := range v.Val {
+				size += len(row)
+			}
+		default:
+			size++
+		}
+	}
+	return size
+}
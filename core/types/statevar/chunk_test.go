@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statevar_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types/statevar"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decimals(values ...float64) []num.Decimal {
+	res := make([]num.Decimal, 0, len(values))
+	for _, v := range values {
+		res = append(res, num.DecimalFromFloat(v))
+	}
+	return res
+}
+
+func largeBundle() *statevar.KeyValueBundle {
+	return &statevar.KeyValueBundle{
+		KVT: []statevar.KeyValueTol{
+			{Key: "scalar", Val: &statevar.DecimalScalar{Val: num.DecimalFromFloat(0.5)}, Tolerance: num.DecimalFromFloat(0.1)},
+			{Key: "vector", Val: &statevar.DecimalVector{Val: decimals(1, 2, 3, 4, 5)}, Tolerance: num.DecimalFromFloat(0.1)},
+			{Key: "matrix", Val: &statevar.DecimalMatrix{Val: [][]num.Decimal{decimals(1, 0.5), decimals(0.5, 1), decimals(0.2, 0.3)}}, Tolerance: num.DecimalFromFloat(0.01)},
+		},
+	}
+}
+
+func TestChunks(t *testing.T) {
+	t.Run("small bundles are not split", testSmallBundleNotSplit)
+	t.Run("split and merge round trip", testSplitMergeRoundTrip)
+	t.Run("rows larger than a chunk", testSplitLargeRows)
+}
+
+func testSmallBundleNotSplit(t *testing.T) {
+	kvb := largeBundle()
+	chunks := kvb.Split(12)
+	require.Len(t, chunks, 1)
+	require.Same(t, kvb, chunks[0])
+	_, _, ok := chunks[0].ChunkInfo()
+	require.False(t, ok)
+
+	// 0 disables the chunks
+	require.Len(t, kvb.Split(0), 1)
+}
+
+func testSplitMergeRoundTrip(t *testing.T) {
+	kvb := largeBundle()
+	chunks := kvb.Split(4)
+	// 1 + 5 + 6 values, in chunks of 4 values with matrices split by rows.
+	require.Len(t, chunks, 3)
+
+	for i, c := range chunks {
+		index, total, ok := c.ChunkInfo()
+		require.True(t, ok)
+		require.Equal(t, i, index)
+		require.Equal(t, len(chunks), total)
+	}
+
+	merged := statevar.MergeChunks(chunks)
+	require.True(t, kvb.Equals(merged))
+	require.True(t, kvb.SameShape(merged))
+}
+
+func testSplitLargeRows(t *testing.T) {
+	kvb := &statevar.KeyValueBundle{
+		KVT: []statevar.KeyValueTol{
+			{Key: "matrix", Val: &statevar.DecimalMatrix{Val: [][]num.Decimal{decimals(1, 2, 3), decimals(4, 5, 6)}}, Tolerance: num.DecimalFromFloat(0.01)},
+		},
+	}
+	chunks := kvb.Split(2)
+	require.Len(t, chunks, 2)
+	require.True(t, kvb.Equals(statevar.MergeChunks(chunks)))
+}
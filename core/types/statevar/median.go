@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statevar
+
+import (
+	"sort"
+
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+// AggregationMode is how the results of the validators become the value of a state variable.
+type AggregationMode int
+
+const (
+	// AggregationModeFirstAgreement accepts a result within tolerance of the results of enough validators.
+	AggregationModeFirstAgreement AggregationMode = iota
+	// AggregationModeMedian accepts the element-wise median of the results of the same shape.
+	AggregationModeMedian
+)
+
+// SameShape returns true if the two bundles have the same keys and tolerances in the same order,
+// and values of the same type and dimensions.
+func (kvb *KeyValueBundle) SameShape(other *KeyValueBundle) bool {
+	if len(kvb.KVT) != len(other.KVT) {
+		return false
+	}
+	for i, kv := range kvb.KVT {
+		okv := other.KVT[i]
+		if kv.Key != okv.Key || !kv.Tolerance.Equal(okv.Tolerance) {
+			return false
+		}
+		switch v := kv.Val.(type) {
+		case *DecimalScalar:
+			if _, ok := okv.Val.(*DecimalScalar); !ok {
+				return false
+			}
+		case *DecimalVector:
+			ov, ok := okv.Val.(*DecimalVector)
+			if !ok || len(v.Val) != len(ov.Val) {
+				return false
+			}
+		case *DecimalMatrix:
+			om, ok := okv.Val.(*DecimalMatrix)
+			if !ok || len(v.Val) != len(om.Val) {
+				return false
+			}
+			for j := range v.Val {
+				if len(v.Val[j]) != len(om.Val[j]) {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// MedianBundle returns the bundle whose values are the element-wise median of the values of the bundles,
+// which are all expected to have the same shape.
+func MedianBundle(bundles []*KeyValueBundle) *KeyValueBundle {
+	if len(bundles) == 0 {
+		return nil
+	}
+	median := &KeyValueBundle{KVT: make([]KeyValueTol, 0, len(bundles[0].KVT))}
+	for i, kvt := range bundles[0].KVT {
+		var val value
+		switch v := kvt.Val.(type) {
+		case *DecimalScalar:
+			val = &DecimalScalar{Val: medianAt(bundles, func(b *KeyValueBundle) num.Decimal {
+				return b.KVT[i].Val.(*DecimalScalar).Val
+			})}
+		case *DecimalVector:
+			vec := make([]num.Decimal, 0, len(v.Val))
+			for j := range v.Val {
+				vec = append(vec, medianAt(bundles, func(b *KeyValueBundle) num.Decimal {
+					return b.KVT[i].Val.(*DecimalVector).Val[j]
+				}))
+			}
+			val = &DecimalVector{Val: vec}
+		case *DecimalMatrix:
+			mat := make([][]num.Decimal, 0, len(v.Val))
+			for j := range v.Val {
+				row := make([]num.Decimal, 0, len(v.Val[j]))
+				for k := range v.Val[j] {
+					row = append(row, medianAt(bundles, func(b *KeyValueBundle) num.Decimal {
+						return b.KVT[i].Val.(*DecimalMatrix).Val[j][k]
+					}))
+				}
+				mat = append(mat, row)
+			}
+			val = &DecimalMatrix{Val: mat}
+		}
+		median.KVT = append(median.KVT, KeyValueTol{Key: kvt.Key, Tolerance: kvt.Tolerance, Val: val})
+	}
+	return median
+}
+
+// medianAt returns the median of the values at the same position in all bundles, the mean of
+// the two middle values for an even number of bundles.
+func medianAt(bundles []*KeyValueBundle, at func(*KeyValueBundle) num.Decimal) num.Decimal {
+	values := make([]num.Decimal, 0, len(bundles))
+	for _, b := range bundles {
+		values = append(values, at(b))
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].LessThan(values[j]) })
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+	return values[mid-1].Add(values[mid]).Div(num.DecimalFromInt64(2))
+}
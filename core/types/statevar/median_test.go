@@ -0,0 +1,74 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statevar_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types/statevar"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/require"
+)
+
+func bundleOf(scalar float64, vector []float64, matrix [][]float64) *statevar.KeyValueBundle {
+	rows := make([][]num.Decimal, 0, len(matrix))
+	for _, r := range matrix {
+		rows = append(rows, decimals(r...))
+	}
+	return &statevar.KeyValueBundle{
+		KVT: []statevar.KeyValueTol{
+			{Key: "scalar", Val: &statevar.DecimalScalar{Val: num.DecimalFromFloat(scalar)}, Tolerance: num.DecimalFromFloat(0.1)},
+			{Key: "vector", Val: &statevar.DecimalVector{Val: decimals(vector...)}, Tolerance: num.DecimalFromFloat(0.1)},
+			{Key: "matrix", Val: &statevar.DecimalMatrix{Val: rows}, Tolerance: num.DecimalFromFloat(0.1)},
+		},
+	}
+}
+
+func TestMedianBundle(t *testing.T) {
+	t.Run("same shape", testSameShape)
+	t.Run("median of an odd number of bundles", testMedianOdd)
+	t.Run("median of an even number of bundles", testMedianEven)
+}
+
+func testSameShape(t *testing.T) {
+	b1 := bundleOf(1, []float64{1, 2}, [][]float64{{1, 2}})
+	b2 := bundleOf(5, []float64{3, 4}, [][]float64{{5, 6}})
+	require.True(t, b1.SameShape(b2))
+
+	require.False(t, b1.SameShape(bundleOf(1, []float64{1, 2, 3}, [][]float64{{1, 2}})))
+	require.False(t, b1.SameShape(bundleOf(1, []float64{1, 2}, [][]float64{{1, 2}, {3, 4}})))
+	require.False(t, b1.SameShape(bundleOf(1, []float64{1, 2}, [][]float64{{1}})))
+}
+
+func testMedianOdd(t *testing.T) {
+	median := statevar.MedianBundle([]*statevar.KeyValueBundle{
+		bundleOf(3, []float64{1, 9}, [][]float64{{1, 100}}),
+		bundleOf(1, []float64{2, 8}, [][]float64{{2, 200}}),
+		bundleOf(2, []float64{100, 7}, [][]float64{{3, 300}}),
+	})
+	require.True(t, bundleOf(2, []float64{2, 8}, [][]float64{{2, 200}}).Equals(median))
+}
+
+func testMedianEven(t *testing.T) {
+	median := statevar.MedianBundle([]*statevar.KeyValueBundle{
+		bundleOf(1, []float64{1}, [][]float64{{1}}),
+		bundleOf(2, []float64{2}, [][]float64{{2}}),
+		bundleOf(4, []float64{3}, [][]float64{{10}}),
+		bundleOf(100, []float64{4}, [][]float64{{20}}),
+	})
+	require.True(t, bundleOf(3, []float64{2.5}, [][]float64{{6}}).Equals(median))
+}
@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+// MaxTeamCompetitionEpochs is the longest a competition can run for, as the
+// metrics are only tracked over a limited window.
+const MaxTeamCompetitionEpochs = 100
+
+var (
+	ErrTeamCompetitionMissingID         = errors.New("missing competition ID")
+	ErrTeamCompetitionMissingCreator    = errors.New("missing competition creator")
+	ErrTeamCompetitionMissingAsset      = errors.New("missing competition asset")
+	ErrTeamCompetitionMissingPrizePool  = errors.New("prize pool must be positive")
+	ErrTeamCompetitionInvalidEpochs     = errors.New("end epoch must be greater than or equal to start epoch")
+	ErrTeamCompetitionTooLong           = fmt.Errorf("competition cannot run for more than %d epochs", MaxTeamCompetitionEpochs)
+	ErrTeamCompetitionMissingRankTable  = errors.New("missing competition rank table")
+	ErrTeamCompetitionUnsupportedMetric = func(metric vegapb.DispatchMetric) error {
+		return fmt.Errorf("metric %s is not supported for team competitions", metric.String())
+	}
+)
+
+type TeamCompetitionStatus = vegapb.TeamCompetition_Status
+
+const (
+	// TeamCompetitionStatusUnspecified default value, always invalid.
+	TeamCompetitionStatusUnspecified TeamCompetitionStatus = vegapb.TeamCompetition_STATUS_UNSPECIFIED
+	// TeamCompetitionStatusPending the competition has been created, but has not started yet.
+	TeamCompetitionStatusPending TeamCompetitionStatus = vegapb.TeamCompetition_STATUS_PENDING
+	// TeamCompetitionStatusActive the competition is running.
+	TeamCompetitionStatusActive TeamCompetitionStatus = vegapb.TeamCompetition_STATUS_ACTIVE
+	// TeamCompetitionStatusEnded the competition has ended, and the prize pool has been paid out.
+	TeamCompetitionStatusEnded TeamCompetitionStatus = vegapb.TeamCompetition_STATUS_ENDED
+	// TeamCompetitionStatusCancelled the competition was cancelled before it started, and the prize pool refunded.
+	TeamCompetitionStatusCancelled TeamCompetitionStatus = vegapb.TeamCompetition_STATUS_CANCELLED
+)
+
+// TeamCompetition is a trading competition between teams, ranked on a dispatch
+// metric over a range of epochs. The prize pool is held in escrow from the
+// creation of the competition until it's paid out to the winning teams.
+type TeamCompetition struct {
+	ID string
+	// Creator is the party funding the competition, or the network if it was
+	// created through governance.
+	Creator string
+	Asset   string
+	// PrizePool is the amount escrowed to be split between the winning teams.
+	PrizePool *num.Uint
+	// StartEpoch and EndEpoch are the first and last epochs, inclusive, the
+	// teams are ranked on.
+	StartEpoch uint64
+	EndEpoch   uint64
+	// Markets in scope of the competition, all markets settling in the asset
+	// if empty.
+	Markets []string
+	Metric  vegapb.DispatchMetric
+	// RankTable gives the share ratio of the prize pool for each rank.
+	RankTable []*vegapb.Rank
+	Status    TeamCompetitionStatus
+	// GameID is the ID the scores of the teams are published under.
+	GameID string
+	// Results are set once the competition has ended, ordered by rank.
+	Results []*TeamCompetitionResult
+}
+
+// TeamCompetitionResult is the final standing of a team in a competition.
+type TeamCompetitionResult struct {
+	Team    TeamID
+	Rank    uint64
+	Score   num.Decimal
+	Payout  *num.Uint
+	Members []*TeamCompetitionPayout
+}
+
+// TeamCompetitionPayout is the part of the payout of a team paid to one of its members.
+type TeamCompetitionPayout struct {
+	Party  PartyID
+	Amount *num.Uint
+}
+
+func (c *TeamCompetition) IsValid() error {
+	if len(c.ID) == 0 {
+		return ErrTeamCompetitionMissingID
+	}
+	if len(c.Creator) == 0 {
+		return ErrTeamCompetitionMissingCreator
+	}
+	if len(c.Asset) == 0 {
+		return ErrTeamCompetitionMissingAsset
+	}
+	if c.PrizePool == nil || c.PrizePool.IsZero() {
+		return ErrTeamCompetitionMissingPrizePool
+	}
+	if c.EndEpoch < c.StartEpoch {
+		return ErrTeamCompetitionInvalidEpochs
+	}
+	if c.EndEpoch-c.StartEpoch+1 > MaxTeamCompetitionEpochs {
+		return ErrTeamCompetitionTooLong
+	}
+	if len(c.RankTable) == 0 {
+		return ErrTeamCompetitionMissingRankTable
+	}
+	switch c.Metric {
+	case vegapb.DispatchMetric_DISPATCH_METRIC_UNSPECIFIED,
+		vegapb.DispatchMetric_DISPATCH_METRIC_MARKET_VALUE,
+		vegapb.DispatchMetric_DISPATCH_METRIC_VALIDATOR_RANKING,
+		vegapb.DispatchMetric_DISPATCH_METRIC_ELIGIBLE_ENTITIES:
+		return ErrTeamCompetitionUnsupportedMetric(c.Metric)
+	}
+	return nil
+}
+
+// IsOngoing returns true if the competition hasn't ended nor been cancelled yet,
+// meaning its prize pool is still held in escrow.
+func (c *TeamCompetition) IsOngoing() bool {
+	return c.Status == TeamCompetitionStatusPending || c.Status == TeamCompetitionStatusActive
+}
+
+// DispatchStrategy returns the dispatch strategy the teams are scored with,
+// over the whole duration of the competition.
+func (c *TeamCompetition) DispatchStrategy() *vegapb.DispatchStrategy {
+	return &vegapb.DispatchStrategy{
+		AssetForMetric:       c.Asset,
+		Metric:               c.Metric,
+		Markets:              c.Markets,
+		EntityScope:          vegapb.EntityScope_ENTITY_SCOPE_TEAMS,
+		NTopPerformers:       "1",
+		WindowLength:         c.EndEpoch - c.StartEpoch + 1,
+		DistributionStrategy: vegapb.DistributionStrategy_DISTRIBUTION_STRATEGY_RANK,
+		RankTable:            c.RankTable,
+	}
+}
+
+// NewTeamCompetitionFromProto creates a competition funded by the given creator
+// from the command submitted.
+func NewTeamCompetitionFromProto(id, creator string, cmd *commandspb.NewTeamCompetition) (*TeamCompetition, error) {
+	prizePool, overflowed := num.UintFromString(cmd.PrizePool, 10)
+	if overflowed {
+		return nil, errors.New("invalid prize pool amount")
+	}
+	return &TeamCompetition{
+		ID:         id,
+		Creator:    creator,
+		Asset:      cmd.Asset,
+		PrizePool:  prizePool,
+		StartEpoch: cmd.StartEpoch,
+		EndEpoch:   cmd.EndEpoch,
+		Markets:    cmd.Markets,
+		Metric:     cmd.Metric,
+		RankTable:  cmd.RankTable,
+	}, nil
+}
+
+func TeamCompetitionFromProto(c *vegapb.TeamCompetition) *TeamCompetition {
+	prizePool, _ := num.UintFromString(c.PrizePool, 10)
+	var results []*TeamCompetitionResult
+	for _, r := range c.Results {
+		results = append(results, TeamCompetitionResultFromProto(r))
+	}
+	return &TeamCompetition{
+		ID:         c.Id,
+		Creator:    c.Creator,
+		Asset:      c.Asset,
+		PrizePool:  prizePool,
+		StartEpoch: c.StartEpoch,
+		EndEpoch:   c.EndEpoch,
+		Markets:    c.Markets,
+		Metric:     c.Metric,
+		RankTable:  c.RankTable,
+		Status:     c.Status,
+		GameID:     c.GameId,
+		Results:    results,
+	}
+}
+
+func (c *TeamCompetition) IntoProto() *vegapb.TeamCompetition {
+	results := make([]*vegapb.TeamCompetitionResult, 0, len(c.Results))
+	for _, r := range c.Results {
+		results = append(results, r.IntoProto())
+	}
+	return &vegapb.TeamCompetition{
+		Id:         c.ID,
+		Creator:    c.Creator,
+		Asset:      c.Asset,
+		PrizePool:  c.PrizePool.String(),
+		StartEpoch: c.StartEpoch,
+		EndEpoch:   c.EndEpoch,
+		Markets:    c.Markets,
+		Metric:     c.Metric,
+		RankTable:  c.RankTable,
+		Status:     c.Status,
+		GameId:     c.GameID,
+		Results:    results,
+	}
+}
+
+func TeamCompetitionResultFromProto(r *vegapb.TeamCompetitionResult) *TeamCompetitionResult {
+	score, _ := num.DecimalFromString(r.Score)
+	payout, _ := num.UintFromString(r.Payout, 10)
+	members := make([]*TeamCompetitionPayout, 0, len(r.Members))
+	for _, m := range r.Members {
+		amount, _ := num.UintFromString(m.Amount, 10)
+		members = append(members, &TeamCompetitionPayout{
+			Party:  PartyID(m.PartyId),
+			Amount: amount,
+		})
+	}
+	return &TeamCompetitionResult{
+		Team:    TeamID(r.TeamId),
+		Rank:    r.Rank,
+		Score:   score,
+		Payout:  payout,
+		Members: members,
+	}
+}
+
+func (r *TeamCompetitionResult) IntoProto() *vegapb.TeamCompetitionResult {
+	members := make([]*vegapb.TeamCompetitionPayout, 0, len(r.Members))
+	for _, m := range r.Members {
+		members = append(members, &vegapb.TeamCompetitionPayout{
+			PartyId: string(m.Party),
+			Amount:  m.Amount.String(),
+		})
+	}
+	return &vegapb.TeamCompetitionResult{
+		TeamId:  string(r.Team),
+		Rank:    r.Rank,
+		Score:   r.Score.String(),
+		Payout:  r.Payout.String(),
+		Members: members,
+	}
+}
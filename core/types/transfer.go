@@ -229,4 +229,6 @@ const (
 	TransferTypeHighMakerRebatePay TransferType = proto.TransferType_TRANSFER_TYPE_HIGH_MAKER_FEE_REBATE_PAY
 	// Receive high maker rebate.
 	TransferTypeHighMakerRebateReceive TransferType = proto.TransferType_TRANSFER_TYPE_HIGH_MAKER_FEE_REBATE_RECEIVE
+	// Receive the negative maker fee of a maker fee tier.
+	TransferTypeMakerFeeTierRebate TransferType = proto.TransferType_TRANSFER_TYPE_MAKER_FEE_TIER_REBATE
 )
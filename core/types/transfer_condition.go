@@ -0,0 +1,168 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+
+	"code.vegaprotocol.io/vega/core/datasource"
+	"code.vegaprotocol.io/vega/libs/num"
+	commandspb "code.vegaprotocol.io/vega/protos/vega/commands/v1"
+)
+
+var (
+	ErrTransferConditionMissingDataSource  = errors.New("transfer condition is missing a data source")
+	ErrTransferConditionMissingMarket      = errors.New("transfer condition is missing a market")
+	ErrTransferConditionMissingMarketState = errors.New("transfer condition is missing a market state")
+	ErrTransferConditionMissingParty       = errors.New("transfer condition is missing a party")
+	ErrTransferConditionMissingAsset       = errors.New("transfer condition is missing an asset")
+	ErrTransferConditionMissingThreshold   = errors.New("transfer condition is missing a balance threshold")
+	ErrTransferConditionInvalidThreshold   = errors.New("transfer condition has an invalid balance threshold")
+	ErrUnsupportedTransferConditionType    = errors.New("unsupported transfer condition type")
+	ErrConditionalTransferWithDeliverOn    = errors.New("a transfer cannot be both conditional and scheduled")
+	ErrConditionalTransferFromGeneralOnly  = errors.New("a conditional transfer can only be made from a general account")
+)
+
+type TransferConditionType int
+
+const (
+	// TransferConditionTypeDataSource the transfer executes when data matching the data source is received.
+	TransferConditionTypeDataSource TransferConditionType = iota
+	// TransferConditionTypeMarketState the transfer executes once the market is in the given state.
+	TransferConditionTypeMarketState
+	// TransferConditionTypeBalance the transfer executes once the general account balance of a party crosses a threshold.
+	TransferConditionTypeBalance
+)
+
+// TransferCondition holds the condition a one-off transfer waits for before
+// its funds, escrowed in the pending transfers account, are delivered.
+type TransferCondition struct {
+	Type TransferConditionType
+
+	// DataSource is used by TransferConditionTypeDataSource, the filters of the
+	// data source are the condition.
+	DataSource *datasource.Spec
+
+	// Market and MarketState are used by TransferConditionTypeMarketState.
+	Market      string
+	MarketState MarketState
+
+	// Party, Asset, Threshold and Below are used by TransferConditionTypeBalance.
+	// The condition holds when the balance is greater than or equal to the threshold,
+	// or less than or equal to it if Below is set.
+	Party     string
+	Asset     string
+	Threshold *num.Uint
+	Below     bool
+}
+
+func TransferConditionFromProto(p *commandspb.TransferCondition) (*TransferCondition, error) {
+	switch c := p.Condition.(type) {
+	case *commandspb.TransferCondition_DataSource:
+		def, err := datasource.DefinitionFromProto(c.DataSource)
+		if err != nil {
+			return nil, err
+		}
+		return &TransferCondition{
+			Type:       TransferConditionTypeDataSource,
+			DataSource: datasource.SpecFromDefinition(*datasource.NewDefinitionWith(def)),
+		}, nil
+	case *commandspb.TransferCondition_MarketState:
+		return &TransferCondition{
+			Type:        TransferConditionTypeMarketState,
+			Market:      c.MarketState.MarketId,
+			MarketState: c.MarketState.State,
+		}, nil
+	case *commandspb.TransferCondition_Balance:
+		threshold, overflow := num.UintFromString(c.Balance.Threshold, 10)
+		if overflow {
+			return nil, ErrTransferConditionInvalidThreshold
+		}
+		return &TransferCondition{
+			Type:      TransferConditionTypeBalance,
+			Party:     c.Balance.Party,
+			Asset:     c.Balance.Asset,
+			Threshold: threshold,
+			Below:     c.Balance.Below,
+		}, nil
+	default:
+		return nil, ErrUnsupportedTransferConditionType
+	}
+}
+
+func (c *TransferCondition) IntoProto() *commandspb.TransferCondition {
+	out := &commandspb.TransferCondition{}
+	switch c.Type {
+	case TransferConditionTypeDataSource:
+		out.Condition = &commandspb.TransferCondition_DataSource{
+			DataSource: c.DataSource.Data.IntoProto(),
+		}
+	case TransferConditionTypeMarketState:
+		out.Condition = &commandspb.TransferCondition_MarketState{
+			MarketState: &commandspb.MarketStateTransferCondition{
+				MarketId: c.Market,
+				State:    c.MarketState,
+			},
+		}
+	case TransferConditionTypeBalance:
+		out.Condition = &commandspb.TransferCondition_Balance{
+			Balance: &commandspb.BalanceTransferCondition{
+				Party:     c.Party,
+				Asset:     c.Asset,
+				Threshold: c.Threshold.String(),
+				Below:     c.Below,
+			},
+		}
+	}
+	return out
+}
+
+func (c *TransferCondition) IsValid() error {
+	switch c.Type {
+	case TransferConditionTypeDataSource:
+		if c.DataSource == nil || c.DataSource.Data == nil {
+			return ErrTransferConditionMissingDataSource
+		}
+	case TransferConditionTypeMarketState:
+		if len(c.Market) == 0 {
+			return ErrTransferConditionMissingMarket
+		}
+		if c.MarketState == MarketStateUnspecified {
+			return ErrTransferConditionMissingMarketState
+		}
+	case TransferConditionTypeBalance:
+		if len(c.Party) == 0 {
+			return ErrTransferConditionMissingParty
+		}
+		if len(c.Asset) == 0 {
+			return ErrTransferConditionMissingAsset
+		}
+		if c.Threshold == nil {
+			return ErrTransferConditionMissingThreshold
+		}
+	default:
+		return ErrUnsupportedTransferConditionType
+	}
+	return nil
+}
+
+// BalanceReached returns true if the balance satisfies a balance condition.
+func (c *TransferCondition) BalanceReached(balance *num.Uint) bool {
+	if c.Below {
+		return balance.LTE(c.Threshold)
+	}
+	return balance.GTE(c.Threshold)
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+)
+
+// MaxVestingScheduleEpochs is the maximum number of epochs a vesting schedule can span.
+const MaxVestingScheduleEpochs = 1000
+
+var (
+	ErrVestingScheduleInvalidType      = errors.New("invalid vesting schedule type")
+	ErrVestingScheduleTooLong          = errors.New("vesting schedule spans too many epochs")
+	ErrVestingScheduleInvalidDuration  = errors.New("vesting schedule duration must be positive")
+	ErrVestingScheduleInvalidDecayRate = errors.New("vesting schedule decay rate must be between 0 excluded and 1")
+	ErrVestingScheduleInvalidSteps     = errors.New("vesting schedule steps must be in increasing order of epochs and fractions")
+	ErrVestingScheduleIncompleteSteps  = errors.New("vesting schedule last step must vest the full amount")
+	ErrVestingScheduleWithoutDispatch  = errors.New("vesting schedule requires a dispatch strategy")
+	ErrVestingScheduleMismatch         = errors.New("transfers with the same dispatch strategy must use the same vesting schedule")
+)
+
+type VestingScheduleType = vegapb.VestingSchedule_Type
+
+const (
+	// VestingScheduleTypeUnspecified is the default value, always invalid.
+	VestingScheduleTypeUnspecified VestingScheduleType = vegapb.VestingSchedule_TYPE_UNSPECIFIED
+	// VestingScheduleTypeLinear vests nothing until the end of the cliff, then
+	// linearly over the duration.
+	VestingScheduleTypeLinear VestingScheduleType = vegapb.VestingSchedule_TYPE_LINEAR
+	// VestingScheduleTypeExponential vests, after the cliff, the decay rate of the
+	// remaining amount every epoch, and what remains at the end of the duration.
+	VestingScheduleTypeExponential VestingScheduleType = vegapb.VestingSchedule_TYPE_EXPONENTIAL
+	// VestingScheduleTypeStep vests the fraction of each step once its number of
+	// epochs has elapsed.
+	VestingScheduleTypeStep VestingScheduleType = vegapb.VestingSchedule_TYPE_STEP
+)
+
+// VestingStep is the cumulative fraction of the reward vested once the number of
+// epochs has elapsed.
+type VestingStep struct {
+	Epochs   uint64
+	Fraction num.Decimal
+}
+
+// VestingSchedule is the vesting curve applied to a reward, in place of the
+// network-wide base rate, from the epoch it is paid.
+type VestingSchedule struct {
+	Type           VestingScheduleType
+	CliffEpochs    uint64
+	DurationEpochs uint64
+	DecayRate      num.Decimal
+	Steps          []*VestingStep
+}
+
+func VestingScheduleFromProto(p *vegapb.VestingSchedule) (*VestingSchedule, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	s := &VestingSchedule{
+		Type:           p.Type,
+		CliffEpochs:    p.CliffEpochs,
+		DurationEpochs: p.DurationEpochs,
+		DecayRate:      num.DecimalZero(),
+		Steps:          make([]*VestingStep, 0, len(p.Steps)),
+	}
+	if len(p.DecayRate) > 0 {
+		decayRate, err := num.DecimalFromString(p.DecayRate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vesting schedule decay rate: %w", err)
+		}
+		s.DecayRate = decayRate
+	}
+	for _, step := range p.Steps {
+		fraction, err := num.DecimalFromString(step.Fraction)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vesting schedule step fraction: %w", err)
+		}
+		s.Steps = append(s.Steps, &VestingStep{Epochs: step.Epochs, Fraction: fraction})
+	}
+	return s, nil
+}
+
+func (s *VestingSchedule) IntoProto() *vegapb.VestingSchedule {
+	if s == nil {
+		return nil
+	}
+
+	p := &vegapb.VestingSchedule{
+		Type:           s.Type,
+		CliffEpochs:    s.CliffEpochs,
+		DurationEpochs: s.DurationEpochs,
+		Steps:          make([]*vegapb.VestingStep, 0, len(s.Steps)),
+	}
+	if s.Type == VestingScheduleTypeExponential {
+		p.DecayRate = s.DecayRate.String()
+	}
+	for _, step := range s.Steps {
+		p.Steps = append(p.Steps, &vegapb.VestingStep{Epochs: step.Epochs, Fraction: step.Fraction.String()})
+	}
+	return p
+}
+
+func (s *VestingSchedule) IsValid() error {
+	switch s.Type {
+	case VestingScheduleTypeLinear, VestingScheduleTypeExponential:
+		if s.DurationEpochs == 0 {
+			return ErrVestingScheduleInvalidDuration
+		}
+		if s.Type == VestingScheduleTypeExponential && (!s.DecayRate.IsPositive() || s.DecayRate.GreaterThan(num.DecimalOne())) {
+			return ErrVestingScheduleInvalidDecayRate
+		}
+	case VestingScheduleTypeStep:
+		if len(s.Steps) == 0 {
+			return ErrVestingScheduleIncompleteSteps
+		}
+		previous := &VestingStep{Fraction: num.DecimalZero()}
+		for i, step := range s.Steps {
+			if (i > 0 && step.Epochs <= previous.Epochs) || step.Fraction.LessThanOrEqual(previous.Fraction) {
+				return ErrVestingScheduleInvalidSteps
+			}
+			previous = step
+		}
+		if !previous.Fraction.Equal(num.DecimalOne()) {
+			return ErrVestingScheduleIncompleteSteps
+		}
+	default:
+		return ErrVestingScheduleInvalidType
+	}
+
+	if s.Epochs() > MaxVestingScheduleEpochs {
+		return ErrVestingScheduleTooLong
+	}
+	return nil
+}
+
+// Epochs returns the number of epochs after which the reward is fully vested.
+func (s *VestingSchedule) Epochs() uint64 {
+	if s.Type == VestingScheduleTypeStep {
+		return s.Steps[len(s.Steps)-1].Epochs
+	}
+	return s.CliffEpochs + s.DurationEpochs
+}
+
+// VestedFraction returns the cumulative fraction of the reward vested once the
+// number of epochs has elapsed.
+func (s *VestingSchedule) VestedFraction(epochs uint64) num.Decimal {
+	if epochs >= s.Epochs() {
+		return num.DecimalOne()
+	}
+
+	switch s.Type {
+	case VestingScheduleTypeLinear:
+		if epochs <= s.CliffEpochs {
+			return num.DecimalZero()
+		}
+		return num.DecimalFromInt64(int64(epochs - s.CliffEpochs)).Div(num.DecimalFromInt64(int64(s.DurationEpochs)))
+	case VestingScheduleTypeExponential:
+		if epochs <= s.CliffEpochs {
+			return num.DecimalZero()
+		}
+		remaining := num.DecimalOne().Sub(s.DecayRate).Pow(num.DecimalFromInt64(int64(epochs - s.CliffEpochs)))
+		return num.DecimalOne().Sub(remaining)
+	case VestingScheduleTypeStep:
+		fraction := num.DecimalZero()
+		for _, step := range s.Steps {
+			if step.Epochs > epochs {
+				break
+			}
+			fraction = step.Fraction
+		}
+		return fraction
+	}
+	return num.DecimalZero()
+}
+
+func (s *VestingSchedule) Equal(other *VestingSchedule) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.String() == other.String()
+}
+
+func (s *VestingSchedule) DeepClone() *VestingSchedule {
+	if s == nil {
+		return nil
+	}
+	cpy := *s
+	cpy.Steps = make([]*VestingStep, 0, len(s.Steps))
+	for _, step := range s.Steps {
+		cpy.Steps = append(cpy.Steps, &VestingStep{Epochs: step.Epochs, Fraction: step.Fraction})
+	}
+	return &cpy
+}
+
+func (s *VestingSchedule) String() string {
+	steps := make([]string, 0, len(s.Steps))
+	for _, step := range s.Steps {
+		steps = append(steps, fmt.Sprintf("%d:%s", step.Epochs, step.Fraction.String()))
+	}
+	return fmt.Sprintf(
+		"type(%d) cliffEpochs(%d) durationEpochs(%d) decayRate(%s) steps(%s)",
+		s.Type,
+		s.CliffEpochs,
+		s.DurationEpochs,
+		s.DecayRate.String(),
+		strings.Join(steps, ","),
+	)
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVestingScheduleValidation(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule *types.VestingSchedule
+		err      error
+	}{
+		{
+			name:     "linear without duration",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeLinear, CliffEpochs: 2},
+			err:      types.ErrVestingScheduleInvalidDuration,
+		},
+		{
+			name:     "exponential with a decay rate greater than 1",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeExponential, DurationEpochs: 2, DecayRate: num.DecimalFromFloat(1.5)},
+			err:      types.ErrVestingScheduleInvalidDecayRate,
+		},
+		{
+			name:     "linear over too many epochs",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeLinear, CliffEpochs: 1, DurationEpochs: types.MaxVestingScheduleEpochs},
+			err:      types.ErrVestingScheduleTooLong,
+		},
+		{
+			name: "steps out of order",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeStep, Steps: []*types.VestingStep{
+				{Epochs: 2, Fraction: num.DecimalFromFloat(0.5)},
+				{Epochs: 1, Fraction: num.DecimalOne()},
+			}},
+			err: types.ErrVestingScheduleInvalidSteps,
+		},
+		{
+			name: "steps not vesting the full amount",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeStep, Steps: []*types.VestingStep{
+				{Epochs: 1, Fraction: num.DecimalFromFloat(0.5)},
+			}},
+			err: types.ErrVestingScheduleIncompleteSteps,
+		},
+		{
+			name:     "unknown type",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleType(42), DurationEpochs: 1},
+			err:      types.ErrVestingScheduleInvalidType,
+		},
+		{
+			name:     "valid linear",
+			schedule: &types.VestingSchedule{Type: types.VestingScheduleTypeLinear, CliffEpochs: 2, DurationEpochs: 4},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.ErrorIs(t, tc.schedule.IsValid(), tc.err)
+		})
+	}
+}
+
+func TestVestingScheduleVestedFraction(t *testing.T) {
+	t.Run("linear vests nothing during the cliff", func(t *testing.T) {
+		s := &types.VestingSchedule{Type: types.VestingScheduleTypeLinear, CliffEpochs: 2, DurationEpochs: 4}
+		assert.True(t, s.VestedFraction(2).IsZero())
+		assert.Equal(t, "0.25", s.VestedFraction(3).String())
+		assert.Equal(t, "0.75", s.VestedFraction(5).String())
+		assert.Equal(t, "1", s.VestedFraction(6).String())
+	})
+
+	t.Run("exponential vests the decay rate of the remaining amount", func(t *testing.T) {
+		s := &types.VestingSchedule{Type: types.VestingScheduleTypeExponential, DurationEpochs: 3, DecayRate: num.DecimalFromFloat(0.5)}
+		assert.Equal(t, "0.5", s.VestedFraction(1).String())
+		assert.Equal(t, "0.75", s.VestedFraction(2).String())
+		assert.Equal(t, "1", s.VestedFraction(3).String())
+	})
+
+	t.Run("step vests the fraction of the last elapsed step", func(t *testing.T) {
+		s := &types.VestingSchedule{Type: types.VestingScheduleTypeStep, Steps: []*types.VestingStep{
+			{Epochs: 2, Fraction: num.DecimalFromFloat(0.4)},
+			{Epochs: 5, Fraction: num.DecimalOne()},
+		}}
+		assert.True(t, s.VestedFraction(1).IsZero())
+		assert.Equal(t, "0.4", s.VestedFraction(4).String())
+		assert.Equal(t, "1", s.VestedFraction(5).String())
+	})
+}
+
+func TestVestingScheduleProtoRoundTrip(t *testing.T) {
+	schedules := []*types.VestingSchedule{
+		{Type: types.VestingScheduleTypeLinear, CliffEpochs: 2, DurationEpochs: 10, DecayRate: num.DecimalZero()},
+		{Type: types.VestingScheduleTypeExponential, CliffEpochs: 1, DurationEpochs: 5, DecayRate: num.MustDecimalFromString("0.2")},
+		{Type: types.VestingScheduleTypeStep, DecayRate: num.DecimalZero(), Steps: []*types.VestingStep{
+			{Epochs: 2, Fraction: num.MustDecimalFromString("0.5")},
+			{Epochs: 4, Fraction: num.DecimalOne()},
+		}},
+	}
+	for _, schedule := range schedules {
+		restored, err := types.VestingScheduleFromProto(schedule.IntoProto())
+		require.NoError(t, err)
+		assert.True(t, schedule.Equal(restored), schedule.String())
+	}
+
+	restored, err := types.VestingScheduleFromProto(nil)
+	require.NoError(t, err)
+	assert.Nil(t, restored)
+}
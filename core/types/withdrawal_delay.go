@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"code.vegaprotocol.io/vega/libs/crypto"
+	"code.vegaprotocol.io/vega/libs/num"
+)
+
+var (
+	ErrWithdrawalDelayThresholdMissingAsset = errors.New("missing asset")
+	ErrWithdrawalDelayThresholdInvalid      = errors.New("threshold must be a positive integer")
+	ErrWithdrawalDelayThresholdDuplicate    = errors.New("threshold set twice for the same asset")
+	ErrInvalidWithdrawalGuardian            = errors.New("withdrawal guardian is not a valid public key")
+)
+
+type withdrawalDelayThresholdJSON struct {
+	Asset     string `json:"asset"`
+	Threshold string `json:"threshold"`
+}
+
+// ParseWithdrawalDelayThresholds parses the value of the network parameter setting,
+// per asset, the amount above which a withdrawal is delayed.
+func ParseWithdrawalDelayThresholds(v string) (map[string]*num.Uint, error) {
+	raw := []withdrawalDelayThresholdJSON{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("invalid withdrawal delay thresholds: %w", err)
+	}
+
+	out := make(map[string]*num.Uint, len(raw))
+	for i, r := range raw {
+		if len(r.Asset) == 0 {
+			return nil, fmt.Errorf("invalid withdrawal delay threshold %d: %w", i, ErrWithdrawalDelayThresholdMissingAsset)
+		}
+		if _, ok := out[r.Asset]; ok {
+			return nil, fmt.Errorf("invalid withdrawal delay threshold %d: %w", i, ErrWithdrawalDelayThresholdDuplicate)
+		}
+		threshold, overflow := num.UintFromString(r.Threshold, 10)
+		if overflow || threshold.IsZero() {
+			return nil, fmt.Errorf("invalid withdrawal delay threshold %d: %w", i, ErrWithdrawalDelayThresholdInvalid)
+		}
+		out[r.Asset] = threshold
+	}
+	return out, nil
+}
+
+// CheckWithdrawalDelayThresholds is the rule of the network parameter setting the
+// withdrawal delay thresholds.
+func CheckWithdrawalDelayThresholds(v string) error {
+	_, err := ParseWithdrawalDelayThresholds(v)
+	return err
+}
+
+// ParseWithdrawalGuardians parses the value of the network parameter listing the
+// keys allowed to cancel a delayed withdrawal.
+func ParseWithdrawalGuardians(v string) (map[string]struct{}, error) {
+	raw := []string{}
+	if err := json.Unmarshal([]byte(v), &raw); err != nil {
+		return nil, fmt.Errorf("invalid withdrawal guardians: %w", err)
+	}
+
+	out := make(map[string]struct{}, len(raw))
+	for _, key := range raw {
+		if !crypto.IsValidVegaPubKey(key) {
+			return nil, fmt.Errorf("%s: %w", key, ErrInvalidWithdrawalGuardian)
+		}
+		out[key] = struct{}{}
+	}
+	return out, nil
+}
+
+// CheckWithdrawalGuardians is the rule of the network parameter listing the
+// withdrawal guardians.
+func CheckWithdrawalGuardians(v string) error {
+	_, err := ParseWithdrawalGuardians(v)
+	return err
+}
@@ -39,34 +39,18 @@ func NewERC20MultisigTopology(
 	ocv := NewOnChainVerifier(config, log, ethClient, ethConfirmation)
 	top := NewTopology(config, log, witness, ocv, broker, scope)
 
-	if scope == "primary" {
-		_ = netp.Watch(netparams.WatchParam{
-			Param: netparams.BlockchainsPrimaryEthereumConfig,
-			Watcher: func(_ context.Context, cfg interface{}) error {
-				ethCfg, err := types.EthereumConfigFromUntypedProto(cfg)
-				if err != nil {
-					return fmt.Errorf("ERC20 multisig didn't receive a valid Ethereum configuration: %w", err)
-				}
-				ocv.UpdateMultiSigAddress(ethCfg.MultiSigControl().Address(), ethCfg.ChainID())
-				top.SetChainID(ethCfg.ChainID())
-				return nil
-			},
-		})
-	} else {
-		_ = netp.Watch(netparams.WatchParam{
-			Param: netparams.BlockchainsEVMBridgeConfigs,
-			Watcher: func(_ context.Context, cfg interface{}) error {
-				cfgs, err := types.EVMChainConfigFromUntypedProto(cfg)
-				if err != nil {
-					return fmt.Errorf("ERC20 multisig didn't receive a valid Ethereum configuration: %w", err)
-				}
-				ethCfg := cfgs.Configs[0]
-				ocv.UpdateMultiSigAddress(ethCfg.MultiSigControl().Address(), ethCfg.ChainID())
-				top.SetChainID(ethCfg.ChainID())
-				return nil
-			},
-		})
-	}
+	_ = netp.Watch(netparams.WatchParam{
+		Param: netparams.BlockchainsPrimaryEthereumConfig,
+		Watcher: func(_ context.Context, cfg interface{}) error {
+			ethCfg, err := types.EthereumConfigFromUntypedProto(cfg)
+			if err != nil {
+				return fmt.Errorf("ERC20 multisig didn't receive a valid Ethereum configuration: %w", err)
+			}
+			ocv.UpdateMultiSigAddress(ethCfg.MultiSigControl().Address(), ethCfg.ChainID())
+			top.SetChainID(ethCfg.ChainID())
+			return nil
+		},
+	})
 
 	return top
 }
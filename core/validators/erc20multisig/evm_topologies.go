@@ -17,24 +17,187 @@ package erc20multisig
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"code.vegaprotocol.io/vega/core/broker"
+	"code.vegaprotocol.io/vega/core/netparams"
 	"code.vegaprotocol.io/vega/core/types"
 	"code.vegaprotocol.io/vega/libs/proto"
 	"code.vegaprotocol.io/vega/logging"
 	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
 )
 
-// Topology keeps track of all the validators
-// registered in the erc20 bridge.
+var ErrUnknownEVMChain = errors.New("unknown EVM chain")
+
+// EVMClientsFunc returns the client, and its confirmations, used by this node
+// to verify the events of the given chain. They are nil if the node is not
+// connected to the chain.
+type EVMClientsFunc func(chainID string) (EthereumClient, EthConfirmations)
+
+// EVMTopologies keeps track of the validators registered in the
+// multisig control of every EVM chain other than the primary one.
 type EVMTopologies struct {
-	topologies []*Topology
+	config         Config
+	log            *logging.Logger
+	broker         broker.Interface
+	witness        Witness
+	ethEventSource EthereumEventSource
+	clients        EVMClientsFunc
+
+	// chain-id -> topology, the chain IDs are kept in the order the chains
+	// have been added so the topologies are always ticked in the same order.
+	topologies map[string]*Topology
+	ocvs       map[string]*OnChainVerifier
+	chainIDs   []string
 }
 
 func NewEVMTopologies(
-	secondBridge *Topology,
+	config Config,
+	log *logging.Logger,
+	broker broker.Interface,
+	netp *netparams.Store,
+	clients EVMClientsFunc,
 ) *EVMTopologies {
-	return &EVMTopologies{
-		topologies: []*Topology{secondBridge},
+	t := &EVMTopologies{
+		config:     config,
+		log:        log,
+		broker:     broker,
+		clients:    clients,
+		topologies: map[string]*Topology{},
+		ocvs:       map[string]*OnChainVerifier{},
+	}
+
+	_ = netp.Watch(netparams.WatchParam{
+		Param: netparams.BlockchainsEVMBridgeConfigs,
+		Watcher: func(_ context.Context, cfg interface{}) error {
+			cfgs, err := types.EVMChainConfigFromUntypedProto(cfg)
+			if err != nil {
+				return fmt.Errorf("ERC20 multisig didn't receive a valid Ethereum configuration: %w", err)
+			}
+			for _, ethCfg := range cfgs.Configs {
+				t.topologyForChainID(ethCfg.ChainID())
+				t.ocvs[ethCfg.ChainID()].UpdateMultiSigAddress(ethCfg.MultiSigControl().Address(), ethCfg.ChainID())
+			}
+			return nil
+		},
+	})
+
+	return t
+}
+
+// topologyForChainID returns the topology of the chain, creating it if needed.
+// A topology can be restored before its chain is configured.
+func (t *EVMTopologies) topologyForChainID(chainID string) *Topology {
+	if top, ok := t.topologies[chainID]; ok {
+		return top
+	}
+
+	// a topology restored from a payload that isn't associated to a chain ID
+	// belongs to the first chain.
+	if legacy, ok := t.topologies[""]; ok && len(chainID) > 0 && t.chainIDs[0] == "" {
+		delete(t.topologies, "")
+		t.topologies[chainID] = legacy
+		t.ocvs[chainID] = t.ocvs[""]
+		delete(t.ocvs, "")
+		t.chainIDs[0] = chainID
+		legacy.SetChainID(chainID)
+		return legacy
+	}
+
+	var (
+		client        EthereumClient
+		confirmations EthConfirmations
+	)
+	if t.clients != nil {
+		client, confirmations = t.clients(chainID)
+	}
+
+	ocv := NewOnChainVerifier(t.config, t.log, client, confirmations)
+	top := NewTopology(t.config, t.log, t.witness, ocv, t.broker, "evm")
+	top.SetChainID(chainID)
+	top.SetEthereumEventSource(t.ethEventSource)
+
+	t.topologies[chainID] = top
+	t.ocvs[chainID] = ocv
+	t.chainIDs = append(t.chainIDs, chainID)
+	return top
+}
+
+func (t *EVMTopologies) SetWitness(w Witness) {
+	t.witness = w
+	for _, top := range t.topologies {
+		top.SetWitness(w)
+	}
+}
+
+func (t *EVMTopologies) SetEthereumEventSource(e EthereumEventSource) {
+	t.ethEventSource = e
+	for _, top := range t.topologies {
+		top.SetEthereumEventSource(e)
+	}
+}
+
+// ChainIDs returns the IDs of all the EVM chains with a multisig control.
+func (t *EVMTopologies) ChainIDs() []string {
+	chainIDs := make([]string, len(t.chainIDs))
+	copy(chainIDs, t.chainIDs)
+	return chainIDs
+}
+
+// IsSigner returns whether the address is a signer of the multisig control
+// on all the EVM chains.
+func (t *EVMTopologies) IsSigner(address string) bool {
+	for _, chainID := range t.chainIDs {
+		if !t.topologies[chainID].IsSigner(address) {
+			return false
+		}
+	}
+	return true
+}
+
+// ExcessSigners returns whether the multisig control of any EVM chain has a
+// signer which isn't part of the given addresses.
+func (t *EVMTopologies) ExcessSigners(addresses []string) bool {
+	for _, chainID := range t.chainIDs {
+		if t.topologies[chainID].ExcessSigners(addresses) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetThreshold returns the highest threshold of all the EVM chains.
+func (t *EVMTopologies) GetThreshold() uint32 {
+	var threshold uint32
+	for _, chainID := range t.chainIDs {
+		if th := t.topologies[chainID].GetThreshold(); th > threshold {
+			threshold = th
+		}
+	}
+	return threshold
+}
+
+func (t *EVMTopologies) ProcessSignerEvent(event *types.SignerEvent) error {
+	top, ok := t.topologies[event.ChainID]
+	if !ok {
+		return fmt.Errorf("%s: %w", event.ChainID, ErrUnknownEVMChain)
+	}
+	return top.ProcessSignerEvent(event)
+}
+
+func (t *EVMTopologies) ProcessThresholdEvent(event *types.SignerThresholdSetEvent) error {
+	top, ok := t.topologies[event.ChainID]
+	if !ok {
+		return fmt.Errorf("%s: %w", event.ChainID, ErrUnknownEVMChain)
+	}
+	return top.ProcessThresholdEvent(event)
+}
+
+func (t *EVMTopologies) OnTick(ctx context.Context, ct time.Time) {
+	for _, chainID := range t.chainIDs {
+		t.topologies[chainID].OnTick(ctx, ct)
 	}
 }
 
@@ -73,29 +236,33 @@ func (t *EVMTopologies) LoadState(ctx context.Context, payload *types.Payload) (
 	}
 }
 
-// get the serialised form of the given key.
 func (t *EVMTopologies) restore(ctx context.Context, topologies []*snapshotpb.EVMMultisigTopology) error {
-	secondBridge := t.topologies[0]
-	if err := secondBridge.restorePendingState(ctx, topologies[0].Pending); err != nil {
-		return err
-	}
-	if err := secondBridge.restoreVerifiedState(ctx, topologies[0].Verified); err != nil {
-		return err
+	for _, state := range topologies {
+		top := t.topologyForChainID(state.ChainId)
+		if err := top.restorePendingState(ctx, state.Pending); err != nil {
+			return err
+		}
+		if err := top.restoreVerifiedState(ctx, state.Verified); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 // get the serialised form of the given key.
 func (t *EVMTopologies) serialise() ([]byte, error) {
+	topologies := make([]*snapshotpb.EVMMultisigTopology, 0, len(t.chainIDs))
+	for _, chainID := range t.chainIDs {
+		top := t.topologies[chainID]
+		topologies = append(topologies, &snapshotpb.EVMMultisigTopology{
+			Verified: top.constructVerifiedState(),
+			Pending:  top.constructPendingState(),
+			ChainId:  top.chainID,
+		})
+	}
 	payload := types.Payload{
 		Data: &types.PayloadEVMMultisigTopologies{
-			Topologies: []*snapshotpb.EVMMultisigTopology{
-				{
-					Verified: t.topologies[0].constructVerifiedState(),
-					Pending:  t.topologies[0].constructPendingState(),
-					ChainId:  t.topologies[0].chainID,
-				},
-			},
+			Topologies: topologies,
 		},
 	}
 	return proto.Marshal(payload.IntoProto())
@@ -103,13 +270,14 @@ func (t *EVMTopologies) serialise() ([]byte, error) {
 
 func (t *EVMTopologies) OnStateLoaded(ctx context.Context) error {
 	// tell the internal EEF where it got up to so we do not resend events we're already seen
-
-	topology := t.topologies[0]
-	lastSeen := topology.getLastBlockSeen()
-	if lastSeen != 0 {
-		// TODO snapshot migration stuff
-		topology.log.Info("restoring multisig starting block", logging.Uint64("block", lastSeen), logging.String("chain-id", topology.chainID))
-		// topology.ethEventSource.UpdateMultisigControlStartingBlock(topology.getLastBlockSeen())
+	for _, chainID := range t.chainIDs {
+		topology := t.topologies[chainID]
+		lastSeen := topology.getLastBlockSeen()
+		if lastSeen != 0 {
+			// TODO snapshot migration stuff
+			topology.log.Info("restoring multisig starting block", logging.Uint64("block", lastSeen), logging.String("chain-id", topology.chainID))
+			// topology.ethEventSource.UpdateMultisigControlStartingBlock(topology.getLastBlockSeen())
+		}
 	}
 	return nil
 }
@@ -57,6 +57,19 @@ func (v *validatorHeartbeatTracker) recordHeartbeatResult(status bool) {
 	v.expectedNextHash = ""
 }
 
+// missedHeartbeatsStreak returns the number of heartbeats missed in a row, up to
+// the number of results kept.
+func (v *validatorHeartbeatTracker) missedHeartbeatsStreak() int {
+	streak := 0
+	for i := 1; i <= len(v.blockSigs) && i <= v.blockIndex; i++ {
+		if v.blockSigs[(v.blockIndex-i)%len(v.blockSigs)] {
+			break
+		}
+		streak++
+	}
+	return streak
+}
+
 // ProcessValidatorHeartbeat is verifying the signatures from a validator's transaction and records the status.
 func (t *Topology) ProcessValidatorHeartbeat(ctx context.Context, vh *commandspb.ValidatorHeartbeat,
 	verifyVegaSig func(message, signature, pubkey []byte) error,
@@ -78,6 +91,7 @@ func (t *Topology) ProcessValidatorHeartbeat(ctx context.Context, vh *commandspb
 		// the heartbeat came in too late, we're already waiting for another one
 		return ErrHeartbeatHasExpired
 	}
+	defer t.checkMissedHeartbeats(ctx, vh.NodeId)
 
 	vegas, err := hex.DecodeString(vh.GetVegaSignature().Value)
 	if err != nil {
@@ -120,10 +134,12 @@ func (t *Topology) checkHeartbeat(ctx context.Context) {
 }
 
 // checkAndExpireStaleHeartbeats checks if there is a validator with stale heartbeat and records the failure.
-func (t *Topology) checkAndExpireStaleHeartbeats() {
+// It returns the nodes for which a failure has been recorded.
+func (t *Topology) checkAndExpireStaleHeartbeats() []string {
 	// if a node hasn't sent a heartbeat when they were expected, record the failure and reset their state.
 	now := t.timeService.GetTimeNow()
-	for _, v := range t.validators {
+	expired := []string{}
+	for k, v := range t.validators {
 		// if the time since we've expected the heartbeat is too big,
 		// we consider this validator invalid
 		// arbitrary 500 seconds duration for the validator to send a
@@ -131,8 +147,10 @@ func (t *Topology) checkAndExpireStaleHeartbeats() {
 		hbExpired := len(v.heartbeatTracker.expectedNextHash) > 0 && v.heartbeatTracker.expectedNexthashSince.Add(t.timeToSendHeartbeat).Before(now)
 		if hbExpired {
 			v.heartbeatTracker.recordHeartbeatResult(false)
+			expired = append(expired, k)
 		}
 	}
+	return expired
 }
 
 func (t *Topology) getNodesRequiringHB() []string {
@@ -150,7 +168,7 @@ func (t *Topology) getNodesRequiringHB() []string {
 }
 
 func (t *Topology) checkHeartbeatWithBlockHash(ctx context.Context, bhash string) {
-	t.checkAndExpireStaleHeartbeats()
+	t.checkMissedHeartbeatsForNodes(ctx, t.checkAndExpireStaleHeartbeats())
 
 	// check which node
 	validatorNeedResend := t.getNodesRequiringHB()
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: code.vegaprotocol.io/vega/core/validators (interfaces: NodeWallets,TimeService,Commander,ValidatorTopology,Wallet,ValidatorPerformance,Notary,Signatures,MultiSigTopology)
+// Source: code.vegaprotocol.io/vega/core/validators (interfaces: NodeWallets,TimeService,Commander,ValidatorTopology,Wallet,ValidatorPerformance,Notary,Signatures,MultiSigTopology,EVMMultiSigTopologies)
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -762,3 +762,82 @@ func (mr *MockMultiSigTopologyMockRecorder) IsSigner(arg0 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSigner", reflect.TypeOf((*MockMultiSigTopology)(nil).IsSigner), arg0)
 }
+
+// MockEVMMultiSigTopologies is a mock of EVMMultiSigTopologies interface.
+type MockEVMMultiSigTopologies struct {
+	ctrl     *gomock.Controller
+	recorder *MockEVMMultiSigTopologiesMockRecorder
+}
+
+// MockEVMMultiSigTopologiesMockRecorder is the mock recorder for MockEVMMultiSigTopologies.
+type MockEVMMultiSigTopologiesMockRecorder struct {
+	mock *MockEVMMultiSigTopologies
+}
+
+// NewMockEVMMultiSigTopologies creates a new mock instance.
+func NewMockEVMMultiSigTopologies(ctrl *gomock.Controller) *MockEVMMultiSigTopologies {
+	mock := &MockEVMMultiSigTopologies{ctrl: ctrl}
+	mock.recorder = &MockEVMMultiSigTopologiesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEVMMultiSigTopologies) EXPECT() *MockEVMMultiSigTopologiesMockRecorder {
+	return m.recorder
+}
+
+// ChainIDs mocks base method.
+func (m *MockEVMMultiSigTopologies) ChainIDs() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainIDs")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ChainIDs indicates an expected call of ChainIDs.
+func (mr *MockEVMMultiSigTopologiesMockRecorder) ChainIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainIDs", reflect.TypeOf((*MockEVMMultiSigTopologies)(nil).ChainIDs))
+}
+
+// ExcessSigners mocks base method.
+func (m *MockEVMMultiSigTopologies) ExcessSigners(arg0 []string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExcessSigners", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ExcessSigners indicates an expected call of ExcessSigners.
+func (mr *MockEVMMultiSigTopologiesMockRecorder) ExcessSigners(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExcessSigners", reflect.TypeOf((*MockEVMMultiSigTopologies)(nil).ExcessSigners), arg0)
+}
+
+// GetThreshold mocks base method.
+func (m *MockEVMMultiSigTopologies) GetThreshold() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreshold")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// GetThreshold indicates an expected call of GetThreshold.
+func (mr *MockEVMMultiSigTopologiesMockRecorder) GetThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreshold", reflect.TypeOf((*MockEVMMultiSigTopologies)(nil).GetThreshold))
+}
+
+// IsSigner mocks base method.
+func (m *MockEVMMultiSigTopologies) IsSigner(arg0 string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSigner", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSigner indicates an expected call of IsSigner.
+func (mr *MockEVMMultiSigTopologiesMockRecorder) IsSigner(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSigner", reflect.TypeOf((*MockEVMMultiSigTopologies)(nil).IsSigner), arg0)
+}
@@ -81,7 +81,7 @@ type ERC20Signatures struct {
 	notary          Notary
 	primaryMultisig MultiSigTopology
 	// primaryBridge     *bridges.ERC20MultiSigControl
-	secondaryMultisig MultiSigTopology
+	secondaryMultisig EVMMultiSigTopologies
 	// secondaryBridge   *bridges.ERC20MultiSigControl
 	lastNonce        *num.Uint
 	broker           Broker
@@ -96,7 +96,7 @@ type ERC20Signatures struct {
 func NewSignatures(
 	log *logging.Logger,
 	primaryMultisig MultiSigTopology,
-	secondaryMultisig MultiSigTopology,
+	secondaryMultisig EVMMultiSigTopologies,
 	notary Notary,
 	nw NodeWallets,
 	broker Broker,
@@ -133,13 +133,15 @@ type NodeIDAddress struct {
 
 // isBridge returns whether the given chainID corresponds to one of the bridges, and returns if it is the Ethereum bridge.
 func (s *ERC20Signatures) isBridge(chainID string) (isBridge bool) {
-	switch chainID {
-	case s.primaryMultisig.ChainID():
-		isBridge = true
-	case s.secondaryMultisig.ChainID():
-		isBridge = true
+	if chainID == s.primaryMultisig.ChainID() {
+		return true
 	}
-	return
+	for _, evmChainID := range s.secondaryMultisig.ChainIDs() {
+		if chainID == evmChainID {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *ERC20Signatures) OfferSignatures() {
@@ -283,7 +285,9 @@ func (s *ERC20Signatures) PreparePromotionsSignatures(
 		if v.SubmitterAddress != "" {
 			s.log.Debug("sending automatic add signatures", logging.String("submitter", v.SubmitterAddress), logging.String("nodeID", v.NodeID))
 			s.EmitValidatorAddedSignatures(ctx, v.SubmitterAddress, v.NodeID, s.primaryMultisig.ChainID(), currentTime)
-			s.EmitValidatorAddedSignatures(ctx, v.SubmitterAddress, v.NodeID, s.secondaryMultisig.ChainID(), currentTime)
+			for _, chainID := range s.secondaryMultisig.ChainIDs() {
+				s.EmitValidatorAddedSignatures(ctx, v.SubmitterAddress, v.NodeID, chainID, currentTime)
+			}
 		}
 	}
 
@@ -294,7 +298,9 @@ func (s *ERC20Signatures) PreparePromotionsSignatures(
 			if v.SubmitterAddress != "" && v.Status == ValidatorStatusTendermint {
 				s.log.Debug("sending automatic remove signatures", logging.String("submitter", v.SubmitterAddress), logging.String("nodeID", r.NodeID))
 				s.EmitValidatorRemovedSignatures(ctx, v.SubmitterAddress, r.NodeID, s.primaryMultisig.ChainID(), currentTime)
-				s.EmitValidatorRemovedSignatures(ctx, v.SubmitterAddress, r.NodeID, s.secondaryMultisig.ChainID(), currentTime)
+				for _, chainID := range s.secondaryMultisig.ChainIDs() {
+					s.EmitValidatorRemovedSignatures(ctx, v.SubmitterAddress, r.NodeID, chainID, currentTime)
+				}
 			}
 		}
 	}
@@ -44,7 +44,7 @@ type testSignatures struct {
 	broker                    *bmocks.MockBroker
 	signer                    testSigner
 	multisigTopology          *mocks.MockMultiSigTopology
-	secondaryMultisigTopology *mocks.MockMultiSigTopology
+	secondaryMultisigTopology *mocks.MockEVMMultiSigTopologies
 }
 
 func getTestSignatures(t *testing.T) *testSignatures {
@@ -54,7 +54,7 @@ func getTestSignatures(t *testing.T) *testSignatures {
 	broker := bmocks.NewMockBroker(ctrl)
 	nodewallet := mocks.NewMockNodeWallets(ctrl)
 	multisigTopology := mocks.NewMockMultiSigTopology(ctrl)
-	secondaryMultisigTopology := mocks.NewMockMultiSigTopology(ctrl)
+	secondaryMultisigTopology := mocks.NewMockEVMMultiSigTopologies(ctrl)
 	tsigner := testSigner{}
 	nodewallet.EXPECT().GetEthereum().AnyTimes().Return(tsigner)
 
@@ -0,0 +1,127 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package validators
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+)
+
+// SlashingReason is the misbehaviour a validator is slashed for.
+type SlashingReason = eventspb.ValidatorSlashing_Reason
+
+const (
+	SlashingReasonDowntime   SlashingReason = eventspb.ValidatorSlashing_REASON_DOWNTIME
+	SlashingReasonDoubleSign SlashingReason = eventspb.ValidatorSlashing_REASON_DOUBLE_SIGN
+)
+
+func (t *Topology) OnSlashingEnabledUpdate(_ context.Context, enabled *num.Uint) error {
+	t.slashingEnabled = enabled.EQ(num.NewUint(1))
+	return nil
+}
+
+func (t *Topology) OnSlashingMissedHeartbeatsUpdate(_ context.Context, missed *num.Uint) error {
+	t.slashingMissedHeartbeats = int(missed.Uint64())
+	return nil
+}
+
+func (t *Topology) OnDowntimeSlashingFractionUpdate(_ context.Context, fraction num.Decimal) error {
+	t.downtimeSlashingFraction = fraction
+	return nil
+}
+
+func (t *Topology) OnDoubleSignSlashingFractionUpdate(_ context.Context, fraction num.Decimal) error {
+	t.doubleSignSlashingFraction = fraction
+	return nil
+}
+
+// NotifyOnSlashing registers the functions called with the fraction of the stake
+// delegated to a validator to slash when it misbehaves.
+func (t *Topology) NotifyOnSlashing(fns ...func(ctx context.Context, nodeID string, reason SlashingReason, fraction num.Decimal)) {
+	t.slashingListeners = append(t.slashingListeners, fns...)
+}
+
+// ProcessMisbehaviour slashes the validators the consensus layer has evidence of
+// double-signing against.
+func (t *Topology) ProcessMisbehaviour(ctx context.Context, misbehaviour []abcitypes.Misbehavior) {
+	if !t.slashingEnabled || len(misbehaviour) == 0 {
+		return
+	}
+
+	addressToNodeID := make(map[string]string, len(t.validators))
+	for nodeID, v := range t.validators {
+		addressToNodeID[tmPubKeyToAddress(v.data.TmPubKey)] = nodeID
+	}
+
+	for _, m := range misbehaviour {
+		if m.Type != abcitypes.MisbehaviorType_DUPLICATE_VOTE {
+			continue
+		}
+		address := strings.ToLower(hex.EncodeToString(m.Validator.Address))
+		nodeID, ok := addressToNodeID[address]
+		if !ok {
+			t.log.Warn("received double-signing evidence for an unknown validator",
+				logging.String("address", address))
+			continue
+		}
+		t.slash(ctx, nodeID, t.doubleSignSlashingFraction, SlashingReasonDoubleSign)
+	}
+}
+
+// checkMissedHeartbeats slashes the validator when it has just missed the number
+// of heartbeats in a row configured, so a validator is slashed once per streak.
+func (t *Topology) checkMissedHeartbeats(ctx context.Context, nodeID string) {
+	if !t.slashingEnabled || t.slashingMissedHeartbeats == 0 {
+		return
+	}
+	v, ok := t.validators[nodeID]
+	if !ok {
+		return
+	}
+	if v.heartbeatTracker.missedHeartbeatsStreak() == t.slashingMissedHeartbeats {
+		t.slash(ctx, nodeID, t.downtimeSlashingFraction, SlashingReasonDowntime)
+	}
+}
+
+// checkMissedHeartbeatsForNodes runs checkMissedHeartbeats in a deterministic order.
+func (t *Topology) checkMissedHeartbeatsForNodes(ctx context.Context, nodeIDs []string) {
+	sort.Strings(nodeIDs)
+	for _, nodeID := range nodeIDs {
+		t.checkMissedHeartbeats(ctx, nodeID)
+	}
+}
+
+func (t *Topology) slash(ctx context.Context, nodeID string, fraction num.Decimal, reason SlashingReason) {
+	if fraction.IsZero() {
+		return
+	}
+	t.log.Info("slashing validator",
+		logging.String("node-id", nodeID),
+		logging.String("reason", reason.String()),
+		logging.Decimal("fraction", fraction),
+	)
+	for _, f := range t.slashingListeners {
+		f(ctx, nodeID, reason, fraction)
+	}
+}
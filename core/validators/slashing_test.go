@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package validators
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func getSlashingTestTopology(t *testing.T) (*Topology, map[string]num.Decimal, map[string]SlashingReason) {
+	t.Helper()
+	top := getHBTestTopology(t)
+	top.log = logging.NewTestLogger()
+	ctx := context.Background()
+	require.NoError(t, top.OnSlashingEnabledUpdate(ctx, num.NewUint(1)))
+	require.NoError(t, top.OnSlashingMissedHeartbeatsUpdate(ctx, num.NewUint(3)))
+	require.NoError(t, top.OnDowntimeSlashingFractionUpdate(ctx, num.DecimalFromFloat(0.01)))
+	require.NoError(t, top.OnDoubleSignSlashingFractionUpdate(ctx, num.DecimalFromFloat(0.05)))
+
+	slashed := map[string]num.Decimal{}
+	reasons := map[string]SlashingReason{}
+	top.NotifyOnSlashing(func(_ context.Context, nodeID string, reason SlashingReason, fraction num.Decimal) {
+		slashed[nodeID] = slashed[nodeID].Add(fraction)
+		reasons[nodeID] = reason
+	})
+	return top, slashed, reasons
+}
+
+func TestSlashingOnMissedHeartbeats(t *testing.T) {
+	top, slashed, reasons := getSlashingTestTopology(t)
+	tracker := top.validators["node1"].heartbeatTracker
+
+	tracker.recordHeartbeatResult(true)
+	for i := 0; i < 2; i++ {
+		tracker.recordHeartbeatResult(false)
+		top.checkMissedHeartbeats(context.Background(), "node1")
+	}
+	require.Equal(t, 2, tracker.missedHeartbeatsStreak())
+	require.Empty(t, slashed)
+
+	// the third heartbeat missed in a row gets the validator slashed.
+	tracker.recordHeartbeatResult(false)
+	top.checkMissedHeartbeats(context.Background(), "node1")
+	require.Equal(t, "0.01", slashed["node1"].String())
+	require.Equal(t, SlashingReasonDowntime, reasons["node1"])
+
+	// but only once for the same streak.
+	tracker.recordHeartbeatResult(false)
+	top.checkMissedHeartbeats(context.Background(), "node1")
+	require.Equal(t, "0.01", slashed["node1"].String())
+
+	// a successful heartbeat resets the streak.
+	tracker.recordHeartbeatResult(true)
+	require.Equal(t, 0, tracker.missedHeartbeatsStreak())
+}
+
+func TestSlashingOnDoubleSigning(t *testing.T) {
+	top, slashed, reasons := getSlashingTestTopology(t)
+	top.validators["node2"].data.TmPubKey = base64.StdEncoding.EncodeToString(make([]byte, 32))
+	address, err := hex.DecodeString(tmPubKeyToAddress(top.validators["node2"].data.TmPubKey))
+	require.NoError(t, err)
+
+	top.ProcessMisbehaviour(context.Background(), []abcitypes.Misbehavior{
+		{Type: abcitypes.MisbehaviorType_LIGHT_CLIENT_ATTACK, Validator: abcitypes.Validator{Address: address}},
+		{Type: abcitypes.MisbehaviorType_DUPLICATE_VOTE, Validator: abcitypes.Validator{Address: address}},
+	})
+	require.Equal(t, map[string]num.Decimal{"node2": num.DecimalFromFloat(0.05)}, slashed)
+	require.Equal(t, SlashingReasonDoubleSign, reasons["node2"])
+
+	// nothing happens when slashing is disabled.
+	require.NoError(t, top.OnSlashingEnabledUpdate(context.Background(), num.NewUint(0)))
+	top.ProcessMisbehaviour(context.Background(), []abcitypes.Misbehavior{
+		{Type: abcitypes.MisbehaviorType_DUPLICATE_VOTE, Validator: abcitypes.Validator{Address: address}},
+	})
+	require.Equal(t, "0.05", slashed["node2"].String())
+}
@@ -66,6 +66,15 @@ type MultiSigTopology interface {
 	ChainID() string
 }
 
+// EVMMultiSigTopologies keeps track of the signers of the multisig control
+// of every EVM chain other than the primary Ethereum chain.
+type EVMMultiSigTopologies interface {
+	IsSigner(address string) bool
+	ExcessSigners(addresses []string) bool
+	GetThreshold() uint32
+	ChainIDs() []string
+}
+
 type ValidatorPerformance interface {
 	ValidatorPerformanceScore(address string, votingPower, totalPower int64, performanceScalingFactor num.Decimal) num.Decimal
 	BeginBlock(ctx context.Context, proposer string)
@@ -126,7 +135,7 @@ type Topology struct {
 	timeService          TimeService
 	validatorPerformance ValidatorPerformance
 	primaryMultisig      MultiSigTopology
-	secondaryMultisig    MultiSigTopology
+	secondaryMultisig    EVMMultiSigTopologies
 
 	// vega pubkey to validator data
 	validators validators
@@ -183,6 +192,13 @@ type Topology struct {
 	timeToSendHeartbeat       time.Duration
 
 	performanceScalingFactor num.Decimal
+
+	// slashing parameters
+	slashingEnabled            bool
+	slashingMissedHeartbeats   int
+	downtimeSlashingFraction   num.Decimal
+	doubleSignSlashingFraction num.Decimal
+	slashingListeners          []func(ctx context.Context, nodeID string, reason SlashingReason, fraction num.Decimal)
 }
 
 func (t *Topology) OnEpochEvent(ctx context.Context, epoch types.Epoch) {
@@ -225,7 +241,7 @@ func NewTopology(
 	isValidatorSetup bool,
 	cmd Commander,
 	primaryMultisig MultiSigTopology,
-	secondaryMultisig MultiSigTopology,
+	secondaryMultisig EVMMultiSigTopologies,
 	timeService TimeService,
 ) *Topology {
 	log = log.Named(namedLogger)
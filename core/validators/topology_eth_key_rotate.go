@@ -134,7 +134,9 @@ func (t *Topology) ProcessEthereumKeyRotation(
 	if len(kr.SubmitterAddress) != 0 {
 		// we were given an address that will be submitting the multisig changes, we can emit a remove signature for it right now
 		t.signatures.EmitValidatorRemovedSignatures(ctx, kr.SubmitterAddress, node.data.ID, t.primaryMultisig.ChainID(), t.timeService.GetTimeNow())
-		t.signatures.EmitValidatorRemovedSignatures(ctx, kr.SubmitterAddress, node.data.ID, t.secondaryMultisig.ChainID(), t.timeService.GetTimeNow())
+		for _, chainID := range t.secondaryMultisig.ChainIDs() {
+			t.signatures.EmitValidatorRemovedSignatures(ctx, kr.SubmitterAddress, node.data.ID, chainID, t.timeService.GetTimeNow())
+		}
 	}
 
 	return nil
@@ -224,7 +226,9 @@ func (t *Topology) ethereumKeyRotationBeginBlockLocked(ctx context.Context) {
 		if len(r.SubmitterAddress) != 0 {
 			// we were given an address that will be submitting the multisig changes, we can emit signatures for it right now
 			t.signatures.EmitValidatorAddedSignatures(ctx, r.SubmitterAddress, r.NodeID, t.primaryMultisig.ChainID(), t.timeService.GetTimeNow())
-			t.signatures.EmitValidatorAddedSignatures(ctx, r.SubmitterAddress, r.NodeID, t.secondaryMultisig.ChainID(), t.timeService.GetTimeNow())
+			for _, chainID := range t.secondaryMultisig.ChainIDs() {
+				t.signatures.EmitValidatorAddedSignatures(ctx, r.SubmitterAddress, r.NodeID, chainID, t.timeService.GetTimeNow())
+			}
 		}
 
 		// add to unfinalised map so we can wait to see the changes on the contract
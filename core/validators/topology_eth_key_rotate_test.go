@@ -293,7 +293,7 @@ func TestEthereumKeyRotationBeginBlockWithSubmitter(t *testing.T) {
 	submitter := "some-eth-address"
 
 	top.multisigTop.EXPECT().ChainID().Times(1)
-	top.multisigTop2.EXPECT().ChainID().Times(1)
+	top.multisigTop2.EXPECT().ChainIDs().Times(1).Return([]string{"2"})
 	top.signatures.EXPECT().PrepareValidatorSignatures(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(3)
 	top.signatures.EXPECT().EmitValidatorRemovedSignatures(gomock.Any(), submitter, gomock.Any(), gomock.Any(), gomock.Any()).Times(4)
 	top.signatures.EXPECT().EmitValidatorAddedSignatures(gomock.Any(), submitter, gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
@@ -309,7 +309,7 @@ func TestEthereumKeyRotationBeginBlockWithSubmitter(t *testing.T) {
 	top.signatures.EXPECT().SetNonce(now).Times(1)
 	top.timeService.EXPECT().GetTimeNow().Times(6).Return(now)
 	top.multisigTop.EXPECT().ChainID().Times(1)
-	top.multisigTop2.EXPECT().ChainID().Times(1)
+	top.multisigTop2.EXPECT().ChainIDs().Times(1).Return([]string{"2"})
 	top.BeginBlock(ctx, 11, "")
 
 	// then
@@ -329,7 +329,7 @@ func TestEthereumKeyRotationBeginBlockWithSubmitter(t *testing.T) {
 	top.signatures.EXPECT().SetNonce(now).Times(1)
 	top.timeService.EXPECT().GetTimeNow().Times(6).Return(now)
 	top.multisigTop.EXPECT().ChainID().Times(1)
-	top.multisigTop2.EXPECT().ChainID().Times(1)
+	top.multisigTop2.EXPECT().ChainIDs().Times(1).Return([]string{"2"})
 	top.BeginBlock(ctx, 140, "")
 
 	// try to submit again
@@ -71,6 +71,10 @@ func (*DummyMultiSigTopology) ChainID() string {
 	return "12"
 }
 
+func (*DummyMultiSigTopology) ChainIDs() []string {
+	return []string{"12"}
+}
+
 func (*DummyMultiSigTopology) IsSigner(address string) bool {
 	return true
 }
@@ -94,7 +98,7 @@ type testTop struct {
 	broker       *bmocks.MockBroker
 	timeService  *mocks.MockTimeService
 	multisigTop  *mocks.MockMultiSigTopology
-	multisigTop2 *mocks.MockMultiSigTopology
+	multisigTop2 *mocks.MockEVMMultiSigTopologies
 }
 
 func getTestTopologyWithNodeWallet(
@@ -106,7 +110,7 @@ func getTestTopologyWithNodeWallet(
 	timeService := mocks.NewMockTimeService(ctrl)
 	broker.EXPECT().Send(gomock.Any()).AnyTimes()
 	mtop1 := mocks.NewMockMultiSigTopology(ctrl)
-	mtop2 := mocks.NewMockMultiSigTopology(ctrl)
+	mtop2 := mocks.NewMockEVMMultiSigTopologies(ctrl)
 
 	commander := mocks.NewMockCommander(gomock.NewController(t))
 
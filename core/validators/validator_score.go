@@ -193,7 +193,7 @@ func getMultisigScore(
 	rawScores map[string]num.Decimal,
 	perfScore map[string]num.Decimal,
 	primaryMultisig MultiSigTopology,
-	secondaryMultisig MultiSigTopology,
+	secondaryMultisig EVMMultiSigTopologies,
 	numberEthMultisigSigners int,
 	nodeIDToEthAddress map[string]string,
 ) map[string]num.Decimal {
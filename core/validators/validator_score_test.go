@@ -40,6 +40,10 @@ func (t *TestMultisigTopology) ChainID() string {
 	return "12"
 }
 
+func (t *TestMultisigTopology) ChainIDs() []string {
+	return []string{"12"}
+}
+
 func (t *TestMultisigTopology) IsSigner(address string) bool {
 	_, ok := t.validators[address]
 	return ok
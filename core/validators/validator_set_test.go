@@ -1437,6 +1437,10 @@ func (*DummyMultiSigTopology) ChainID() string {
 	return "12"
 }
 
+func (*DummyMultiSigTopology) ChainIDs() []string {
+	return []string{"12"}
+}
+
 func (*DummyMultiSigTopology) IsSigner(address string) bool {
 	return true
 }
@@ -98,6 +98,7 @@ type Engine struct {
 	benefitTiers []*types.VestingBenefitTier
 
 	state                map[string]*PartyRewards
+	scheduled            map[string][]*vestingLot
 	epochSeq             uint64
 	upgradeHackActivated bool
 
@@ -106,8 +107,9 @@ type Engine struct {
 	// cache the reward bonus multiplier and quantum balance
 	rewardBonusMultiplierCache map[string]MultiplierAndQuantBalance
 
-	stakingAsset    string
-	stakeAccounting StakeAccounting
+	stakingAsset           string
+	stakeAccounting        StakeAccounting
+	stakingRewardListeners []func(ctx context.Context, party string, amount *num.Uint)
 
 	t Time
 }
@@ -132,6 +134,7 @@ func New(
 		assets:                     assets,
 		parties:                    parties,
 		state:                      map[string]*PartyRewards{},
+		scheduled:                  map[string][]*vestingLot{},
 		rewardBonusMultiplierCache: map[string]MultiplierAndQuantBalance{},
 		t:                          t,
 		stakeAccounting:            stakeAccounting,
@@ -224,6 +227,18 @@ func (e *Engine) updateStakingAccount(
 	brokerFunc(events.NewStakeLinking(ctx, *stakeLinking))
 }
 
+// NotifyOnStakingReward registers the functions called with the rewards paid
+// in the staking asset as they are credited to the staking account of the party.
+func (e *Engine) NotifyOnStakingReward(fns ...func(ctx context.Context, party string, amount *num.Uint)) {
+	e.stakingRewardListeners = append(e.stakingRewardListeners, fns...)
+}
+
+func (e *Engine) notifyStakingReward(ctx context.Context, party string, amount *num.Uint) {
+	for _, fn := range e.stakingRewardListeners {
+		fn(ctx, party, amount.Clone())
+	}
+}
+
 func (e *Engine) AddReward(
 	ctx context.Context,
 	party, asset string,
@@ -233,6 +248,7 @@ func (e *Engine) AddReward(
 	// send to staking
 	if asset == e.stakingAsset {
 		e.updateStakingAccount(ctx, party, amount.Clone(), 1, e.broker.Send)
+		e.notifyStakingReward(ctx, party, amount)
 	}
 
 	// no locktime, just increase the amount in vesting
@@ -394,6 +410,7 @@ func (e *Engine) distributeVested(ctx context.Context) {
 			transfers = append(transfers, transfer)
 		}
 	}
+	transfers = append(transfers, e.releaseScheduled()...)
 
 	// nothing to be done
 	if len(transfers) == 0 {
@@ -468,8 +485,19 @@ func (e *Engine) broadcastSummary(ctx context.Context, seq uint64) {
 		PartiesVestingSummary: []*eventspb.PartyVestingSummary{},
 	}
 
-	for p, pRewards := range e.state {
-		if len(pRewards.Vesting) == 0 && len(pRewards.Locked) == 0 {
+	parties := maps.Keys(e.state)
+	for p := range e.scheduled {
+		if _, ok := e.state[p]; !ok {
+			parties = append(parties, p)
+		}
+	}
+
+	for _, p := range parties {
+		pRewards, ok := e.state[p]
+		if !ok {
+			pRewards = &PartyRewards{}
+		}
+		if len(pRewards.Vesting) == 0 && len(pRewards.Locked) == 0 && len(e.scheduled[p]) == 0 {
 			continue
 		}
 
@@ -506,8 +534,11 @@ func (e *Engine) broadcastSummary(ctx context.Context, seq uint64) {
 				)
 			}
 		}
+		scheduledLocked, scheduledUnvested := e.scheduledBalances(p, seq)
+		pSummary.PartyLockedBalances = append(pSummary.PartyLockedBalances, scheduledLocked...)
+		pSummary.PartyUnvestedBalances = scheduledUnvested
 
-		sort.Slice(pSummary.PartyLockedBalances, func(i, j int) bool {
+		sort.SliceStable(pSummary.PartyLockedBalances, func(i, j int) bool {
 			if pSummary.PartyLockedBalances[i].Asset == pSummary.PartyLockedBalances[j].Asset {
 				return pSummary.PartyLockedBalances[i].UntilEpoch < pSummary.PartyLockedBalances[j].UntilEpoch
 			}
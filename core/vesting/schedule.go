@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package vesting
+
+import (
+	"context"
+	"sort"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/logging"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+	snapshotpb "code.vegaprotocol.io/vega/protos/vega/snapshot/v1"
+
+	"golang.org/x/exp/maps"
+)
+
+// vestingLot is a reward vesting following its own schedule rather than the
+// network-wide base rate.
+type vestingLot struct {
+	asset           string
+	total           *num.Uint
+	released        *num.Uint
+	lockedForEpochs uint64
+	elapsed         uint64
+	schedule        *types.VestingSchedule
+}
+
+// AddScheduledReward adds a reward that is released from the vesting account
+// following the given schedule once the lock has expired.
+func (e *Engine) AddScheduledReward(
+	ctx context.Context,
+	party, asset string,
+	amount *num.Uint,
+	lockedForEpochs uint64,
+	schedule *types.VestingSchedule,
+) {
+	// send to staking
+	if asset == e.stakingAsset {
+		e.updateStakingAccount(ctx, party, amount.Clone(), 1, e.broker.Send)
+		e.notifyStakingReward(ctx, party, amount)
+	}
+
+	e.scheduled[party] = append(e.scheduled[party], &vestingLot{
+		asset:           asset,
+		total:           amount.Clone(),
+		released:        num.UintZero(),
+		lockedForEpochs: lockedForEpochs,
+		schedule:        schedule.DeepClone(),
+	})
+}
+
+// releaseScheduled moves every lot forward by one epoch and returns the
+// transfers of the amounts their schedule vests this epoch.
+func (e *Engine) releaseScheduled() []*types.Transfer {
+	transfers := []*types.Transfer{}
+	parties := maps.Keys(e.scheduled)
+	sort.Strings(parties)
+	for _, party := range parties {
+		released := map[string]*num.Uint{}
+		remaining := []*vestingLot{}
+		for _, lot := range e.scheduled[party] {
+			lot.elapsed++
+			if lot.elapsed > lot.lockedForEpochs {
+				vested, _ := num.UintFromDecimal(lot.total.ToDecimal().Mul(lot.schedule.VestedFraction(lot.elapsed - lot.lockedForEpochs)))
+				vested = num.Min(vested, lot.total)
+				if vested.GT(lot.released) {
+					amount := num.UintZero().Sub(vested, lot.released)
+					if _, ok := released[lot.asset]; !ok {
+						released[lot.asset] = num.UintZero()
+					}
+					released[lot.asset].AddSum(amount)
+					lot.released = vested
+				}
+			}
+			if lot.released.LT(lot.total) {
+				remaining = append(remaining, lot)
+			}
+		}
+
+		if len(remaining) == 0 {
+			delete(e.scheduled, party)
+		} else {
+			e.scheduled[party] = remaining
+		}
+
+		assets := maps.Keys(released)
+		sort.Strings(assets)
+		for _, asset := range assets {
+			transfers = append(transfers, &types.Transfer{
+				Owner: party,
+				Amount: &types.FinancialAmount{
+					Asset:  asset,
+					Amount: released[asset].Clone(),
+				},
+				Type:      types.TransferTypeRewardsVested,
+				MinAmount: released[asset],
+			})
+		}
+	}
+	return transfers
+}
+
+// scheduledBalances returns the amounts still to be released from the party's
+// lots: the lots under lock are reported as locked until the lock expires, and
+// the others as unvested until the epoch at which they are fully vested.
+func (e *Engine) scheduledBalances(party string, seq uint64) ([]*eventspb.PartyLockedBalance, []*eventspb.PartyUnvestedBalance) {
+	type key struct {
+		asset      string
+		untilEpoch uint64
+	}
+	locked := map[key]*num.Uint{}
+	unvested := map[key]*num.Uint{}
+	for _, lot := range e.scheduled[party] {
+		balances, k := unvested, key{
+			asset:      lot.asset,
+			untilEpoch: seq + lot.lockedForEpochs + lot.schedule.Epochs() - lot.elapsed,
+		}
+		if lot.elapsed <= lot.lockedForEpochs {
+			// we add one here as the lot starts vesting in the epoch after the lock expires.
+			balances, k.untilEpoch = locked, seq+lot.lockedForEpochs-lot.elapsed+1
+		}
+		if _, ok := balances[k]; !ok {
+			balances[k] = num.UintZero()
+		}
+		balances[k].AddSum(num.UintZero().Sub(lot.total, lot.released))
+	}
+
+	outLocked := make([]*eventspb.PartyLockedBalance, 0, len(locked))
+	for k, balance := range locked {
+		outLocked = append(outLocked, &eventspb.PartyLockedBalance{
+			Asset:      k.asset,
+			Balance:    balance.String(),
+			UntilEpoch: k.untilEpoch,
+		})
+	}
+
+	var outUnvested []*eventspb.PartyUnvestedBalance
+	for k, balance := range unvested {
+		outUnvested = append(outUnvested, &eventspb.PartyUnvestedBalance{
+			Asset:      k.asset,
+			Balance:    balance.String(),
+			UntilEpoch: k.untilEpoch,
+		})
+	}
+	sort.Slice(outUnvested, func(i, j int) bool {
+		if outUnvested[i].Asset == outUnvested[j].Asset {
+			return outUnvested[i].UntilEpoch < outUnvested[j].UntilEpoch
+		}
+		return outUnvested[i].Asset < outUnvested[j].Asset
+	})
+	return outLocked, outUnvested
+}
+
+func (e *Engine) serialiseScheduled() []*snapshotpb.PartyVestingLots {
+	parties := maps.Keys(e.scheduled)
+	sort.Strings(parties)
+	out := make([]*snapshotpb.PartyVestingLots, 0, len(parties))
+	for _, party := range parties {
+		partyLots := &snapshotpb.PartyVestingLots{
+			Party: party,
+			Lots:  make([]*snapshotpb.VestingLot, 0, len(e.scheduled[party])),
+		}
+		for _, lot := range e.scheduled[party] {
+			partyLots.Lots = append(partyLots.Lots, &snapshotpb.VestingLot{
+				Asset:           lot.asset,
+				Total:           lot.total.String(),
+				Released:        lot.released.String(),
+				LockedForEpochs: lot.lockedForEpochs,
+				Elapsed:         lot.elapsed,
+				Schedule:        lot.schedule.IntoProto(),
+			})
+		}
+		out = append(out, partyLots)
+	}
+	return out
+}
+
+func (e *Engine) vestingLotFromSnapshot(lot *snapshotpb.VestingLot) *vestingLot {
+	total, underflow := num.UintFromString(lot.Total, 10)
+	if underflow {
+		e.log.Panic("uint256 in snapshot underflow",
+			logging.String("value", lot.Total))
+	}
+	released, underflow := num.UintFromString(lot.Released, 10)
+	if underflow {
+		e.log.Panic("uint256 in snapshot underflow",
+			logging.String("value", lot.Released))
+	}
+	schedule, err := types.VestingScheduleFromProto(lot.Schedule)
+	if err != nil {
+		e.log.Panic("invalid vesting schedule in snapshot", logging.Error(err))
+	}
+	return &vestingLot{
+		asset:           lot.Asset,
+		total:           total,
+		released:        released,
+		lockedForEpochs: lot.LockedForEpochs,
+		elapsed:         lot.Elapsed,
+		schedule:        schedule,
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package vesting_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/libs/num"
+	vegapb "code.vegaprotocol.io/vega/protos/vega"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledRewardVesting(t *testing.T) {
+	v := getTestEngine(t)
+
+	ctx := context.Background()
+
+	var lastSummary eventspb.VestingBalancesSummary
+	v.broker.EXPECT().Send(gomock.Any()).Do(func(evt events.Event) {
+		if e, ok := evt.(*events.VestingBalancesSummary); ok {
+			lastSummary = e.Proto()
+		}
+	}).AnyTimes()
+
+	party := "party1"
+	asset := "USDT"
+	v.col.InitVestedBalance(party, asset, num.UintZero())
+
+	v.AddScheduledReward(ctx, party, asset, num.NewUint(100), 1, &types.VestingSchedule{
+		Type:           types.VestingScheduleTypeLinear,
+		CliffEpochs:    1,
+		DurationEpochs: 2,
+	})
+
+	endEpoch := func(seq uint64) {
+		v.OnEpochEvent(ctx, types.Epoch{
+			Action: vegapb.EpochAction_EPOCH_ACTION_END,
+			Seq:    seq,
+		})
+	}
+	vested := func() *num.Uint {
+		return v.col.vestedAccountAmount[party][asset]
+	}
+
+	t.Run("Nothing is released during the lock and the cliff", func(t *testing.T) {
+		endEpoch(1)
+		assert.True(t, vested().IsZero())
+		require.Len(t, lastSummary.PartiesVestingSummary, 1)
+		assert.Equal(t, []*eventspb.PartyLockedBalance{
+			{Asset: asset, Balance: "100", UntilEpoch: 2},
+		}, lastSummary.PartiesVestingSummary[0].PartyLockedBalances)
+		assert.Empty(t, lastSummary.PartiesVestingSummary[0].PartyUnvestedBalances)
+
+		endEpoch(2)
+		assert.True(t, vested().IsZero())
+		require.Len(t, lastSummary.PartiesVestingSummary, 1)
+		assert.Empty(t, lastSummary.PartiesVestingSummary[0].PartyLockedBalances)
+		assert.Equal(t, []*eventspb.PartyUnvestedBalance{
+			{Asset: asset, Balance: "100", UntilEpoch: 4},
+		}, lastSummary.PartiesVestingSummary[0].PartyUnvestedBalances)
+	})
+
+	t.Run("Half is released after the first epoch of the duration", func(t *testing.T) {
+		endEpoch(3)
+		assert.Equal(t, num.NewUint(50), vested())
+		require.Len(t, lastSummary.PartiesVestingSummary, 1)
+		assert.Empty(t, lastSummary.PartiesVestingSummary[0].PartyLockedBalances)
+		assert.Equal(t, []*eventspb.PartyUnvestedBalance{
+			{Asset: asset, Balance: "50", UntilEpoch: 4},
+		}, lastSummary.PartiesVestingSummary[0].PartyUnvestedBalances)
+	})
+
+	t.Run("The rest is released at the end of the schedule", func(t *testing.T) {
+		endEpoch(4)
+		assert.Equal(t, num.NewUint(100), vested())
+		assert.Empty(t, lastSummary.PartiesVestingSummary)
+	})
+}
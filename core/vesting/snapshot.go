@@ -105,6 +105,12 @@ func (e *SnapshotEngine) loadStateFromSnapshot(ctx context.Context, state *snaps
 		}
 	}
 
+	for _, entry := range state.PartiesVestingLots {
+		for _, lot := range entry.Lots {
+			e.scheduled[entry.Party] = append(e.scheduled[entry.Party], e.vestingLotFromSnapshot(lot))
+		}
+	}
+
 	if vgcontext.InProgressUpgradeFrom(ctx, "v0.78.8") {
 		e.updateStakingOnUpgrade79(ctx)
 	}
@@ -173,6 +179,7 @@ func (e *SnapshotEngine) serialiseAll() ([]byte, error) {
 	}
 
 	sort.Slice(out.PartiesReward, func(i, j int) bool { return out.PartiesReward[i].Party < out.PartiesReward[j].Party })
+	out.PartiesVestingLots = e.serialiseScheduled()
 
 	payload := &snapshotpb.Payload{
 		Data: &snapshotpb.Payload_Vesting{
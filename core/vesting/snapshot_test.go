@@ -61,6 +61,18 @@ func TestSnapshotEngine(t *testing.T) {
 
 	te1.engine.AddReward(context.Background(), "party4", "eth", num.NewUint(100), 1)
 	te1.engine.AddReward(context.Background(), "party5", "doge", num.NewUint(100), 0)
+	te1.engine.AddScheduledReward(context.Background(), "party1", "btc", num.NewUint(300), 1, &types.VestingSchedule{
+		Type:           types.VestingScheduleTypeExponential,
+		DurationEpochs: 4,
+		DecayRate:      num.MustDecimalFromString("0.5"),
+	})
+	te1.engine.AddScheduledReward(context.Background(), "party9", "eth", num.NewUint(100), 0, &types.VestingSchedule{
+		Type: types.VestingScheduleTypeStep,
+		Steps: []*types.VestingStep{
+			{Epochs: 1, Fraction: num.MustDecimalFromString("0.25")},
+			{Epochs: 3, Fraction: num.DecimalOne()},
+		},
+	})
 
 	// Take a snapshot.
 	hash1, err := snapshotEngine1.SnapshotNow(ctx)
@@ -75,6 +87,11 @@ func TestSnapshotEngine(t *testing.T) {
 
 		te.engine.AddReward(context.Background(), "party7", "eth", num.NewUint(100), 2)
 		te.engine.AddReward(context.Background(), "party8", "vega", num.NewUint(100), 10)
+		te.engine.AddScheduledReward(context.Background(), "party8", "vega", num.NewUint(100), 1, &types.VestingSchedule{
+			Type:           types.VestingScheduleTypeLinear,
+			CliffEpochs:    1,
+			DurationEpochs: 2,
+		})
 
 		nextEpoch(ctx, t, te, time.Now())
 	}
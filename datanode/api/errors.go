@@ -237,6 +237,8 @@ var (
 	// LedgerService...
 	ErrLedgerServiceGet    = errors.New("failed to query ledger entries")
 	ErrLedgerServiceExport = errors.New("failed to export ledger entries")
+	// InsurancePoolHistory...
+	ErrInsurancePoolHistoryGet = errors.New("failed to get insurance pool history")
 	// MultiSigService...
 	ErrMultiSigServiceGetAdded   = errors.New("failed to get added multisig events")
 	ErrMultiSigServiceGetRemoved = errors.New("failed to get removed multisig events")
@@ -257,6 +259,8 @@ var (
 	// EthereumKeyRotationService...
 	ErrEthereumKeyRotationServiceGetPerNode = errors.New("failed to get ethereum key rotations for node")
 	ErrEthereumKeyRotationServiceGetAll     = errors.New("failed to get all ethereum key rotations")
+	// ValidatorSlashingService...
+	ErrValidatorSlashingServiceGetPerNode = errors.New("failed to get validator slashings for node")
 	// BlockService...
 	ErrBlockServiceGetLast = errors.New("failed to get last block")
 	// Positions...
@@ -333,6 +337,10 @@ var (
 	ErrListPaidLiquidityFees = errors.New("failed to list paid liquidity fees")
 	// List Games.
 	ErrListGames = errors.New("failed to list games")
+	// ErrMissingGameID signals that a game ID was required but not specified.
+	ErrMissingGameID = newInvalidArgumentError("missing game id")
+	// ErrGetTeamLeaderboard is returned when the team leaderboard of a game cannot be retrieved.
+	ErrGetTeamLeaderboard = errors.New("failed to get team leaderboard")
 
 	// Transfer fee estimates.
 	ErrInvalidTransferAmount = newInvalidArgumentError("invalid transfer amount")
@@ -489,6 +497,8 @@ var errorMap = map[string]int32{
 	// Ledger
 	ErrLedgerServiceGet.Error():    260001,
 	ErrLedgerServiceExport.Error(): 260002,
+	// InsurancePoolHistory
+	ErrInsurancePoolHistoryGet.Error(): 260003,
 	// MultiSig
 	ErrMultiSigServiceGetAdded.Error():   270001,
 	ErrMultiSigServiceGetRemoved.Error(): 270002,
@@ -512,6 +522,8 @@ var errorMap = map[string]int32{
 	ErrEthereumKeyRotationServiceGetAll.Error():     350002,
 	// Block
 	ErrBlockServiceGetLast.Error(): 360001,
+	// Validator Slashing
+	ErrValidatorSlashingServiceGetPerNode.Error(): 370001,
 	// End of mapping
 }
 
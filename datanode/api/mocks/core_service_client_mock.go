@@ -76,6 +76,26 @@ func (mr *MockCoreServiceClientMockRecorder) CheckTransaction(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTransaction", reflect.TypeOf((*MockCoreServiceClient)(nil).CheckTransaction), varargs...)
 }
 
+// GetLiquidityProviderSLAForecast mocks base method.
+func (m *MockCoreServiceClient) GetLiquidityProviderSLAForecast(arg0 context.Context, arg1 *v1.GetLiquidityProviderSLAForecastRequest, arg2 ...grpc.CallOption) (*v1.GetLiquidityProviderSLAForecastResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLiquidityProviderSLAForecast", varargs...)
+	ret0, _ := ret[0].(*v1.GetLiquidityProviderSLAForecastResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLiquidityProviderSLAForecast indicates an expected call of GetLiquidityProviderSLAForecast.
+func (mr *MockCoreServiceClientMockRecorder) GetLiquidityProviderSLAForecast(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiquidityProviderSLAForecast", reflect.TypeOf((*MockCoreServiceClient)(nil).GetLiquidityProviderSLAForecast), varargs...)
+}
+
 // GetSpamStatistics mocks base method.
 func (m *MockCoreServiceClient) GetSpamStatistics(arg0 context.Context, arg1 *v1.GetSpamStatisticsRequest, arg2 ...grpc.CallOption) (*v1.GetSpamStatisticsResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +136,26 @@ func (mr *MockCoreServiceClientMockRecorder) GetVegaTime(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVegaTime", reflect.TypeOf((*MockCoreServiceClient)(nil).GetVegaTime), varargs...)
 }
 
+// GetWithdrawalBatchProof mocks base method.
+func (m *MockCoreServiceClient) GetWithdrawalBatchProof(arg0 context.Context, arg1 *v1.GetWithdrawalBatchProofRequest, arg2 ...grpc.CallOption) (*v1.GetWithdrawalBatchProofResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWithdrawalBatchProof", varargs...)
+	ret0, _ := ret[0].(*v1.GetWithdrawalBatchProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalBatchProof indicates an expected call of GetWithdrawalBatchProof.
+func (mr *MockCoreServiceClientMockRecorder) GetWithdrawalBatchProof(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalBatchProof", reflect.TypeOf((*MockCoreServiceClient)(nil).GetWithdrawalBatchProof), varargs...)
+}
+
 // LastBlockHeight mocks base method.
 func (m *MockCoreServiceClient) LastBlockHeight(arg0 context.Context, arg1 *v1.LastBlockHeightRequest, arg2 ...grpc.CallOption) (*v1.LastBlockHeightResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +176,26 @@ func (mr *MockCoreServiceClientMockRecorder) LastBlockHeight(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastBlockHeight", reflect.TypeOf((*MockCoreServiceClient)(nil).LastBlockHeight), varargs...)
 }
 
+// ListDelayedWithdrawals mocks base method.
+func (m *MockCoreServiceClient) ListDelayedWithdrawals(arg0 context.Context, arg1 *v1.ListDelayedWithdrawalsRequest, arg2 ...grpc.CallOption) (*v1.ListDelayedWithdrawalsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDelayedWithdrawals", varargs...)
+	ret0, _ := ret[0].(*v1.ListDelayedWithdrawalsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelayedWithdrawals indicates an expected call of ListDelayedWithdrawals.
+func (mr *MockCoreServiceClientMockRecorder) ListDelayedWithdrawals(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelayedWithdrawals", reflect.TypeOf((*MockCoreServiceClient)(nil).ListDelayedWithdrawals), varargs...)
+}
+
 // ObserveEventBus mocks base method.
 func (m *MockCoreServiceClient) ObserveEventBus(arg0 context.Context, arg1 ...grpc.CallOption) (v1.CoreService_ObserveEventBusClient, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +236,26 @@ func (mr *MockCoreServiceClientMockRecorder) PropagateChainEvent(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropagateChainEvent", reflect.TypeOf((*MockCoreServiceClient)(nil).PropagateChainEvent), varargs...)
 }
 
+// SimulateProposal mocks base method.
+func (m *MockCoreServiceClient) SimulateProposal(arg0 context.Context, arg1 *v1.SimulateProposalRequest, arg2 ...grpc.CallOption) (*v1.SimulateProposalResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SimulateProposal", varargs...)
+	ret0, _ := ret[0].(*v1.SimulateProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateProposal indicates an expected call of SimulateProposal.
+func (mr *MockCoreServiceClientMockRecorder) SimulateProposal(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateProposal", reflect.TypeOf((*MockCoreServiceClient)(nil).SimulateProposal), varargs...)
+}
+
 // Statistics mocks base method.
 func (m *MockCoreServiceClient) Statistics(arg0 context.Context, arg1 *v1.StatisticsRequest, arg2 ...grpc.CallOption) (*v1.StatisticsResponse, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +224,7 @@ type GRPCServer struct {
 	ammPoolService                      *service.AMMPools
 	volumeRebateStatsService            *service.VolumeRebateStats
 	volumeRebateProgramService          *service.VolumeRebatePrograms
+	validatorSlashingService            *service.ValidatorSlashing
 
 	eventObserver *eventObserver
 
@@ -298,6 +299,7 @@ func NewGRPCServer(
 	ammPoolService *service.AMMPools,
 	volumeRebateStatsService *service.VolumeRebateStats,
 	volumeRebateProgramsService *service.VolumeRebatePrograms,
+	validatorSlashingService *service.ValidatorSlashing,
 ) *GRPCServer {
 	// setup logger
 	log = log.Named(namedLogger)
@@ -371,6 +373,7 @@ func NewGRPCServer(
 		ammPoolService:                      ammPoolService,
 		volumeRebateStatsService:            volumeRebateStatsService,
 		volumeRebateProgramService:          volumeRebateProgramsService,
+		validatorSlashingService:            validatorSlashingService,
 		eventObserver: &eventObserver{
 			log:          log,
 			eventService: eventService,
@@ -622,6 +625,7 @@ func (g *GRPCServer) Start(ctx context.Context, lis net.Listener) error {
 		AMMPoolService:                g.ammPoolService,
 		volumeRebateStatsService:      g.volumeRebateStatsService,
 		volumeRebateProgramService:    g.volumeRebateProgramService,
+		validatorSlashingService:      g.validatorSlashingService,
 		partyDiscountStats:            partyDiscountStats,
 	}
 
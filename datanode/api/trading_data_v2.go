@@ -128,6 +128,7 @@ type TradingDataServiceV2 struct {
 	volumeDiscountProgramService  *service.VolumeDiscountPrograms
 	volumeRebateStatsService      *service.VolumeRebateStats
 	volumeRebateProgramService    *service.VolumeRebatePrograms
+	validatorSlashingService      *service.ValidatorSlashing
 	paidLiquidityFeesStatsService *service.PaidLiquidityFeesStats
 	partyLockedBalances           *service.PartyLockedBalances
 	partyVestingBalances          *service.PartyVestingBalances
@@ -410,6 +411,31 @@ func (t *TradingDataServiceV2) ListGameTeamScores(ctx context.Context, req *v2.L
 	}, nil
 }
 
+func (t *TradingDataServiceV2) GetTeamLeaderboard(ctx context.Context, req *v2.GetTeamLeaderboardRequest) (*v2.GetTeamLeaderboardResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("GetTeamLeaderboard")()
+
+	if len(req.GameId) == 0 {
+		return nil, formatE(ErrMissingGameID)
+	}
+
+	leaderboard, err := t.gameScoreService.TeamLeaderboard(ctx, entities.GameID(req.GameId), req.Epoch)
+	if err != nil {
+		return nil, formatE(ErrGetTeamLeaderboard, err)
+	}
+
+	entries := make([]*v2.TeamLeaderboardEntry, 0, len(leaderboard))
+	for _, entry := range leaderboard {
+		entries = append(entries, &v2.TeamLeaderboardEntry{
+			Rank:  entry.Rank,
+			Score: entry.Score.ToProto(),
+		})
+	}
+
+	return &v2.GetTeamLeaderboardResponse{
+		Entries: entries,
+	}, nil
+}
+
 // ListAccounts lists accounts matching the request.
 func (t *TradingDataServiceV2) ListAccounts(ctx context.Context, req *v2.ListAccountsRequest) (*v2.ListAccountsResponse, error) {
 	defer metrics.StartAPIRequestAndTimeGRPC("ListAccountsV2")()
@@ -633,6 +659,47 @@ func (t *TradingDataServiceV2) ListLedgerEntries(ctx context.Context, req *v2.Li
 	}, nil
 }
 
+// ListInsurancePoolHistory returns the balance history of the insurance pools of an asset.
+func (t *TradingDataServiceV2) ListInsurancePoolHistory(ctx context.Context, req *v2.ListInsurancePoolHistoryRequest) (*v2.ListInsurancePoolHistoryResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListInsurancePoolHistory")()
+
+	if len(req.AssetId) == 0 {
+		return nil, formatE(ErrMissingAssetID)
+	}
+
+	dateRange := entities.DateRangeFromProto(req.DateRange)
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	marketIDs := make([]entities.MarketID, 0, len(req.MarketIds))
+	for _, id := range req.MarketIds {
+		marketIDs = append(marketIDs, entities.MarketID(id))
+	}
+
+	balances, pageInfo, err := t.ledgerService.InsurancePoolHistory(ctx, entities.AssetID(req.AssetId), marketIDs, dateRange, pagination)
+	if err != nil {
+		return nil, formatE(ErrInsurancePoolHistoryGet, err)
+	}
+
+	edges := make([]*v2.InsurancePoolBalanceEdge, 0, len(balances))
+	for _, b := range balances {
+		edge, err := b.ToProtoEdge()
+		if err != nil {
+			return nil, formatE(err)
+		}
+		edges = append(edges, edge)
+	}
+
+	return &v2.ListInsurancePoolHistoryResponse{
+		History: &v2.InsurancePoolHistoryConnection{
+			Edges:    edges,
+			PageInfo: pageInfo.ToProto(),
+		},
+	}, nil
+}
+
 // ExportLedgerEntries returns a list of ledger entries matching the request.
 func (t *TradingDataServiceV2) ExportLedgerEntries(req *v2.ExportLedgerEntriesRequest, stream v2.TradingDataService_ExportLedgerEntriesServer) error {
 	defer metrics.StartAPIRequestAndTimeGRPC("ExportLedgerEntriesV2")()
@@ -3292,14 +3359,33 @@ func (t *TradingDataServiceV2) EstimateFee(ctx context.Context, req *v2.Estimate
 		return nil, formatE(ErrMissingPrice)
 	}
 
-	fee, err := t.estimateFee(ctx, req.MarketId, req.Price, req.Size, req.Party)
+	estimate, err := t.estimateFee(ctx, req.MarketId, req.Price, req.Size, req.Party)
 	if err != nil {
 		return nil, formatE(ErrEstimateFee, err)
 	}
 
-	return &v2.EstimateFeeResponse{
-		Fee: fee,
-	}, nil
+	resp := &v2.EstimateFeeResponse{
+		Fee: estimate.Fee,
+	}
+	if req.Party == nil {
+		// without a party there are no discounts, rewards or rebates to report.
+		resp.Fee.MakerFeeReferrerDiscount = ""
+		resp.Fee.InfrastructureFeeReferrerDiscount = ""
+		resp.Fee.LiquidityFeeReferrerDiscount = ""
+		resp.Fee.MakerFeeVolumeDiscount = ""
+		resp.Fee.InfrastructureFeeVolumeDiscount = ""
+		resp.Fee.LiquidityFeeVolumeDiscount = ""
+		return resp, nil
+	}
+
+	resp.ReferrerReward = &v2.ReferrerRewardEstimate{
+		MakerFee:          estimate.ReferrerReward.MakerFee.String(),
+		InfrastructureFee: estimate.ReferrerReward.InfrastructureFee.String(),
+		LiquidityFee:      estimate.ReferrerReward.LiquidityFee.String(),
+		Total:             estimate.ReferrerReward.Total().String(),
+	}
+	resp.MakerRebate = ptr.From(estimate.MakerRebate.String())
+	return resp, nil
 }
 
 func (t *TradingDataServiceV2) scaleFromMarketToAssetPrice(
@@ -3407,7 +3493,7 @@ func (t *TradingDataServiceV2) estimateFee(
 	market, priceS string,
 	size uint64,
 	party *string,
-) (*vega.Fee, error) {
+) (*service.PartyFeeEstimate, error) {
 	mkt, err := t.MarketsService.GetByID(ctx, market)
 	if err != nil {
 		return nil, err
@@ -3435,28 +3521,7 @@ func (t *TradingDataServiceV2) estimateFee(
 		Pow(num.DecimalFromInt64(int64(mkt.PositionDecimalPlaces)))
 
 	base := num.DecimalFromUint(price.Mul(price, num.NewUint(size))).Div(mdpd)
-	maker, infra, liquidity, bb, treasury, err := t.feeFactors(mkt)
-	if err != nil {
-		return nil, errors.Wrap(err, "getting fee factors")
-	}
-
-	mf, _ := num.UintFromDecimal(base.Mul(num.NewDecimalFromFloat(maker)).Ceil())
-	inf, _ := num.UintFromDecimal(base.Mul(num.NewDecimalFromFloat(infra)).Ceil())
-	lf, _ := num.UintFromDecimal(base.Mul(num.NewDecimalFromFloat(liquidity)).Ceil())
-	treasuryFee, _ := num.UintFromDecimal(base.Mul(num.NewDecimalFromFloat(treasury)).Ceil())
-	buyBackFee, _ := num.UintFromDecimal(base.Mul(num.NewDecimalFromFloat(bb)).Ceil())
-
-	fees := &vega.Fee{
-		MakerFee:          mf.String(),
-		InfrastructureFee: inf.String(),
-		LiquidityFee:      lf.String(),
-		BuyBackFee:        buyBackFee.String(),
-		TreasuryFee:       treasuryFee.String(),
-	}
-
-	var referee *entities.PartyID
-	referrerDiscount := types.EmptyFactors
-	volumeDiscountFactors := types.EmptyFactors
+	benefits := service.PartyFeeBenefits{}
 	if party != nil {
 		epoch, err := t.EpochService.GetCurrent(ctx)
 		if err != nil {
@@ -3465,65 +3530,28 @@ func (t *TradingDataServiceV2) estimateFee(
 		// NB: we need to use the previous epoch because the stats is published at the end of the epoch so we won't have
 		// information for the current epoch only the previous one.
 		atEpoch := uint64(epoch.ID) - 1
-		referee = ptr.From(entities.PartyID(*party))
+		referee := ptr.From(entities.PartyID(*party))
 		stats, _, err := t.ReferralSetsService.GetReferralSetStats(ctx, nil, &atEpoch, referee, entities.DefaultCursorPagination(true))
 		if err == nil && len(stats) > 0 {
-			referrerDiscount = types.FactorsFromDiscountFactorsWithDefault(stats[0].DiscountFactors, "0")
+			benefits.Referral = &stats[0]
 		}
 		vdStats, _, err := t.VolumeDiscountStatsService.Stats(ctx, &atEpoch, party, entities.DefaultCursorPagination(true))
 		if err == nil && len(vdStats) > 0 {
-			volumeDiscountFactors = types.FactorsFromDiscountFactorsWithDefault(vdStats[0].DiscountFactors, "0")
+			benefits.VolumeDiscount = &vdStats[0]
+		}
+		if t.volumeRebateStatsService != nil {
+			vrStats, _, err := t.volumeRebateStatsService.Stats(ctx, &atEpoch, party, entities.DefaultCursorPagination(true))
+			if err == nil && len(vrStats) > 0 {
+				benefits.VolumeRebate = &vrStats[0]
+			}
 		}
-		referralMakerDiscount, _ := num.UintFromDecimal(mf.ToDecimal().Mul(referrerDiscount.Maker).Floor())
-		referralInfDiscount, _ := num.UintFromDecimal(inf.ToDecimal().Mul(referrerDiscount.Infra).Floor())
-		referralLfDiscount, _ := num.UintFromDecimal(lf.ToDecimal().Mul(referrerDiscount.Liquidity).Floor())
-
-		fees.MakerFeeReferrerDiscount = referralMakerDiscount.String()
-		fees.InfrastructureFeeReferrerDiscount = referralInfDiscount.String()
-		fees.LiquidityFeeReferrerDiscount = referralLfDiscount.String()
-
-		// apply referral discounts
-		mf = mf.Sub(mf, referralMakerDiscount)
-		inf = inf.Sub(inf, referralInfDiscount)
-		lf = lf.Sub(lf, referralLfDiscount)
-
-		volumeMakerDiscount, _ := num.UintFromDecimal(mf.ToDecimal().Mul(volumeDiscountFactors.Maker).Floor())
-		volumeInfDiscount, _ := num.UintFromDecimal(inf.ToDecimal().Mul(volumeDiscountFactors.Infra).Floor())
-		volumeLfDiscount, _ := num.UintFromDecimal(lf.ToDecimal().Mul(volumeDiscountFactors.Liquidity).Floor())
-
-		fees.MakerFeeVolumeDiscount = volumeMakerDiscount.String()
-		fees.InfrastructureFeeVolumeDiscount = volumeInfDiscount.String()
-		fees.LiquidityFeeVolumeDiscount = volumeLfDiscount.String()
-
-		mf = mf.Sub(mf, volumeMakerDiscount)
-		inf = inf.Sub(inf, volumeInfDiscount)
-		lf = lf.Sub(lf, volumeLfDiscount)
-
-		fees.MakerFee = mf.String()
-		fees.InfrastructureFee = inf.String()
-		fees.LiquidityFee = lf.String()
 	}
 
-	return fees, nil
-}
-
-func (t *TradingDataServiceV2) feeFactors(mkt entities.Market) (maker, infra, liquidity, bb, treaury float64, err error) {
-	if maker, err = strconv.ParseFloat(mkt.Fees.Factors.MakerFee, 64); err != nil {
-		return
-	}
-	if infra, err = strconv.ParseFloat(mkt.Fees.Factors.InfrastructureFee, 64); err != nil {
-		return
-	}
-	if liquidity, err = strconv.ParseFloat(mkt.Fees.Factors.LiquidityFee, 64); err != nil {
-		return
-	}
-	if bb, err = strconv.ParseFloat(mkt.Fees.Factors.BuyBackFee, 64); err != nil {
-		return
-	}
-	if treaury, err = strconv.ParseFloat(mkt.Fees.Factors.TreasuryFee, 64); err != nil {
-		return
+	estimate, err := service.EstimatePartyFee(mkt, base, benefits)
+	if err != nil {
+		return nil, errors.Wrap(err, "estimating party fee")
 	}
-	return
+	return estimate, nil
 }
 
 // EstimateMargin estimates the margin required for a given order.
@@ -4553,6 +4581,35 @@ func (t *TradingDataServiceV2) ListEthereumKeyRotations(ctx context.Context, req
 	}, nil
 }
 
+// ListValidatorSlashings returns a list of validator slashings.
+func (t *TradingDataServiceV2) ListValidatorSlashings(ctx context.Context, req *v2.ListValidatorSlashingsRequest) (*v2.ListValidatorSlashingsResponse, error) {
+	defer metrics.StartAPIRequestAndTimeGRPC("ListValidatorSlashingsV2")()
+
+	pagination, err := entities.CursorPaginationFromProto(req.Pagination)
+	if err != nil {
+		return nil, formatE(ErrInvalidPagination, err)
+	}
+
+	slashings, pageInfo, err := t.validatorSlashingService.List(ctx, entities.NodeID(req.GetNodeId()), pagination)
+	if err != nil {
+		return nil, formatE(ErrValidatorSlashingServiceGetPerNode, errors.Wrapf(err, "nodeID: %s", req.GetNodeId()))
+	}
+
+	edges, err := makeEdges[*v2.ValidatorSlashingEdge](slashings)
+	if err != nil {
+		return nil, formatE(err)
+	}
+
+	connection := &v2.ValidatorSlashingsConnection{
+		Edges:    edges,
+		PageInfo: pageInfo.ToProto(),
+	}
+
+	return &v2.ListValidatorSlashingsResponse{
+		Slashings: connection,
+	}, nil
+}
+
 // GetVegaTime returns the current vega time.
 func (t *TradingDataServiceV2) GetVegaTime(ctx context.Context, _ *v2.GetVegaTimeRequest) (*v2.GetVegaTimeResponse, error) {
 	defer metrics.StartAPIRequestAndTimeGRPC("GetVegaTimeV2")()
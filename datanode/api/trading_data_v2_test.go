@@ -257,6 +257,8 @@ func TestEstimateFees(t *testing.T) {
 	require.Equal(t, "", estimate.Fee.InfrastructureFeeVolumeDiscount)
 	require.Equal(t, "", estimate.Fee.LiquidityFeeReferrerDiscount)
 	require.Equal(t, "", estimate.Fee.LiquidityFeeVolumeDiscount)
+	require.Nil(t, estimate.ReferrerReward)
+	require.Nil(t, estimate.MakerRebate)
 
 	party := "party"
 	estimate, err = apiService.EstimateFee(ctx, &v2.EstimateFeeRequest{
@@ -293,6 +295,11 @@ func TestEstimateFees(t *testing.T) {
 	// no discount on buy back and treasury
 	require.Equal(t, "40000", estimate.Fee.BuyBackFee)
 	require.Equal(t, "50000", estimate.Fee.TreasuryFee)
+
+	// the referral set has no reward factors, and the party no volume rebate tier
+	require.Equal(t, "0", estimate.ReferrerReward.Total)
+	require.Equal(t, "0", estimate.GetMakerRebate())
+	require.Equal(t, "", estimate.Fee.HighVolumeMakerFee)
 }
 
 func TestEstimatePositionCappedFuture(t *testing.T) {
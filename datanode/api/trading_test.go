@@ -138,6 +138,7 @@ func getTestGRPCServer(t *testing.T, ctx context.Context) (tidy func(), conn *gr
 	sqlMultiSigService := service.NewMultiSig(sqlstore.NewERC20MultiSigSignerEvent(sqlConn))
 	sqlKeyRotationsService := service.NewKeyRotations(sqlstore.NewKeyRotations(sqlConn))
 	sqlEthereumKeyRotationService := service.NewEthereumKeyRotation(sqlstore.NewEthereumKeyRotations(sqlConn), logger)
+	sqlValidatorSlashingService := service.NewValidatorSlashing(sqlstore.NewValidatorSlashings(sqlConn), logger)
 	sqlNodeService := service.NewNode(sqlstore.NewNode(sqlConn))
 	sqlLedgerService := service.NewLedger(sqlstore.NewLedger(sqlConn), logger)
 	sqlProtocolUpgradeService := service.NewProtocolUpgrade(sqlstore.NewProtocolUpgradeProposals(sqlConn), logger)
@@ -229,6 +230,7 @@ func getTestGRPCServer(t *testing.T, ctx context.Context) (tidy func(), conn *gr
 		ammPoolsService,
 		volumeRebateStatsService,
 		volumeRebateProgramssService,
+		sqlValidatorSlashingService,
 	)
 	if g == nil {
 		err = fmt.Errorf("failed to create gRPC server")
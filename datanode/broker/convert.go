@@ -210,6 +210,10 @@ func toEvent(ctx context.Context, be *eventspb.BusEvent) events.Event {
 		return events.VolumeRebateStatsUpdatedEventFromStream(ctx, be)
 	case eventspb.BusEventType_BUS_EVENT_TYPE_AUTOMATED_PURCHASE_ANNOUNCED:
 		return events.AutomatedPurchaseAnnouncedFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_TEAM_COMPETITION:
+		return events.TeamCompetitionEventFromStream(ctx, be)
+	case eventspb.BusEventType_BUS_EVENT_TYPE_VALIDATOR_SLASHING:
+		return events.ValidatorSlashingEventFromStream(ctx, be)
 	}
 
 	return nil
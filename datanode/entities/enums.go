@@ -40,6 +40,7 @@ const (
 	DispatchMetricValidatorRanking                 = DispatchMetric(vega.DispatchMetric_DISPATCH_METRIC_VALIDATOR_RANKING)
 	DispatchMetricRealisedReturn                   = DispatchMetric(vega.DispatchMetric_DISPATCH_METRIC_REALISED_RETURN)
 	DispatchMetricEligibleEntities                 = DispatchMetric(vega.DispatchMetric_DISPATCH_METRIC_ELIGIBLE_ENTITIES)
+	DispatchMetricQuotingDepth                     = DispatchMetric(vega.DispatchMetric_DISPATCH_METRIC_QUOTING_DEPTH)
 )
 
 func (m DispatchMetric) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
@@ -514,6 +515,7 @@ const (
 	ProposalStateDeclined           = ProposalState(vega.Proposal_STATE_DECLINED)
 	ProposalStateEnacted            = ProposalState(vega.Proposal_STATE_ENACTED)
 	ProposalStateWaitingForNodeVote = ProposalState(vega.Proposal_STATE_WAITING_FOR_NODE_VOTE)
+	ProposalStateVetoed             = ProposalState(vega.Proposal_STATE_VETOED)
 )
 
 func (s ProposalState) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
@@ -880,6 +882,25 @@ func (ps *ProtocolUpgradeProposalStatus) DecodeText(_ *pgtype.ConnInfo, src []by
 	return nil
 }
 
+type ValidatorSlashingReason eventspb.ValidatorSlashing_Reason
+
+func (r ValidatorSlashingReason) EncodeText(_ *pgtype.ConnInfo, buf []byte) ([]byte, error) {
+	str, ok := eventspb.ValidatorSlashing_Reason_name[int32(r)]
+	if !ok {
+		return buf, fmt.Errorf("unknown validator slashing reason: %v", r)
+	}
+	return append(buf, []byte(str)...), nil
+}
+
+func (r *ValidatorSlashingReason) DecodeText(_ *pgtype.ConnInfo, src []byte) error {
+	val, ok := eventspb.ValidatorSlashing_Reason_value[string(src)]
+	if !ok {
+		return fmt.Errorf("unknown validator slashing reason: %s", src)
+	}
+	*r = ValidatorSlashingReason(val)
+	return nil
+}
+
 type StopOrderExpiryStrategy vega.StopOrder_ExpiryStrategy
 
 const (
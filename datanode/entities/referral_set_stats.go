@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"code.vegaprotocol.io/vega/libs/ptr"
 	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
 	"code.vegaprotocol.io/vega/protos/vega"
 	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
@@ -36,6 +37,7 @@ type (
 		RewardFactors                         *vega.RewardFactors
 		RewardsMultiplier                     string
 		RewardsFactorsMultiplier              *vega.RewardFactors
+		UpstreamSetID                         *ReferralSetID
 	}
 
 	FlattenReferralSetStats struct {
@@ -51,6 +53,7 @@ type (
 		RewardFactors                         *vega.RewardFactors
 		RewardsMultiplier                     string
 		RewardsFactorsMultiplier              *vega.RewardFactors
+		UpstreamSetID                         *ReferralSetID
 	}
 
 	ReferralSetStatsCursor struct {
@@ -78,6 +81,10 @@ func (s FlattenReferralSetStats) ToProtoEdge(_ ...any) (*v2.ReferralSetStatsEdge
 }
 
 func (s FlattenReferralSetStats) ToProto() *v2.ReferralSetStats {
+	var upstreamSetID *string
+	if s.UpstreamSetID != nil {
+		upstreamSetID = ptr.From(s.UpstreamSetID.String())
+	}
 	return &v2.ReferralSetStats{
 		AtEpoch:                               s.AtEpoch,
 		ReferralSetRunningNotionalTakerVolume: s.ReferralSetRunningNotionalTakerVolume,
@@ -89,6 +96,7 @@ func (s FlattenReferralSetStats) ToProto() *v2.ReferralSetStats {
 		RewardsMultiplier:                     s.RewardsMultiplier,
 		RewardsFactorsMultiplier:              s.RewardsFactorsMultiplier,
 		WasEligible:                           s.WasEligible,
+		UpstreamSetId:                         upstreamSetID,
 	}
 }
 
@@ -105,6 +113,10 @@ func (c *ReferralSetStatsCursor) Parse(cursorString string) error {
 }
 
 func ReferralSetStatsFromProto(proto *eventspb.ReferralSetStatsUpdated, vegaTime time.Time) (*ReferralSetStats, error) {
+	var upstreamSetID *ReferralSetID
+	if proto.UpstreamSetId != nil {
+		upstreamSetID = ptr.From(ReferralSetID(*proto.UpstreamSetId))
+	}
 	return &ReferralSetStats{
 		SetID:                                 ReferralSetID(proto.SetId),
 		AtEpoch:                               proto.AtEpoch,
@@ -116,5 +128,6 @@ func ReferralSetStatsFromProto(proto *eventspb.ReferralSetStatsUpdated, vegaTime
 		RewardFactors:                         proto.RewardFactors,
 		RewardsMultiplier:                     proto.RewardsMultiplier,
 		RewardsFactorsMultiplier:              proto.RewardFactorsMultiplier,
+		UpstreamSetID:                         upstreamSetID,
 	}, nil
 }
@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"code.vegaprotocol.io/vega/libs/num"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+)
+
+type ValidatorSlashing struct {
+	NodeID        NodeID
+	Reason        ValidatorSlashingReason
+	Fraction      num.Decimal
+	EpochSeq      uint64
+	SlashedStakes []*eventspb.SlashedStake
+	TxHash        TxHash
+	VegaTime      time.Time
+	SeqNum        uint64
+}
+
+func ValidatorSlashingFromProto(vs *eventspb.ValidatorSlashing, txHash TxHash, vegaTime time.Time,
+	seqNum uint64,
+) (ValidatorSlashing, error) {
+	fraction, err := num.DecimalFromString(vs.Fraction)
+	if err != nil {
+		return ValidatorSlashing{}, fmt.Errorf("invalid slashing fraction: %w", err)
+	}
+	return ValidatorSlashing{
+		NodeID:        NodeID(vs.NodeId),
+		Reason:        ValidatorSlashingReason(vs.Reason),
+		Fraction:      fraction,
+		EpochSeq:      vs.EpochSeq,
+		SlashedStakes: vs.SlashedStakes,
+		TxHash:        txHash,
+		VegaTime:      vegaTime,
+		SeqNum:        seqNum,
+	}, nil
+}
+
+func (vs ValidatorSlashing) ToProto() *eventspb.ValidatorSlashing {
+	return &eventspb.ValidatorSlashing{
+		NodeId:        vs.NodeID.String(),
+		Reason:        eventspb.ValidatorSlashing_Reason(vs.Reason),
+		Fraction:      vs.Fraction.String(),
+		EpochSeq:      vs.EpochSeq,
+		SlashedStakes: vs.SlashedStakes,
+	}
+}
+
+func (vs ValidatorSlashing) Cursor() *Cursor {
+	cursor := ValidatorSlashingCursor{
+		VegaTime: vs.VegaTime,
+		SeqNum:   vs.SeqNum,
+	}
+	return NewCursor(cursor.String())
+}
+
+func (vs ValidatorSlashing) ToProtoEdge(_ ...any) (*v2.ValidatorSlashingEdge, error) {
+	return &v2.ValidatorSlashingEdge{
+		Node:   vs.ToProto(),
+		Cursor: vs.Cursor().Encode(),
+	}, nil
+}
+
+type ValidatorSlashingCursor struct {
+	VegaTime time.Time `json:"vegaTime"`
+	SeqNum   uint64    `json:"seqNum"`
+}
+
+func (vc ValidatorSlashingCursor) String() string {
+	bs, err := json.Marshal(vc)
+	if err != nil {
+		// This should never happen.
+		panic(fmt.Errorf("couldn't marshal validator slashing cursor: %w", err))
+	}
+	return string(bs)
+}
+
+func (vc *ValidatorSlashingCursor) Parse(cursorString string) error {
+	if cursorString == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(cursorString), vc)
+}
@@ -18,12 +18,15 @@ package gql
 import (
 	"context"
 
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
 	v1 "code.vegaprotocol.io/vega/protos/vega/events/v1"
 )
 
 type (
 	gamePartyScoresResolver VegaResolverRoot
 	gameTeamScoresResolver  VegaResolverRoot
+
+	teamLeaderboardEntryResolver VegaResolverRoot
 )
 
 func (g *gamePartyScoresResolver) EpochID(_ context.Context, obj *v1.GamePartyScore) (int, error) {
@@ -45,3 +48,7 @@ func (g *gamePartyScoresResolver) Rank(_ context.Context, obj *v1.GamePartyScore
 func (g *gameTeamScoresResolver) EpochID(ctx context.Context, obj *v1.GameTeamScore) (int, error) {
 	return int(obj.Epoch), nil
 }
+
+func (t *teamLeaderboardEntryResolver) Rank(_ context.Context, obj *v2.TeamLeaderboardEntry) (int, error) {
+	return int(obj.Rank), nil
+}
@@ -416,6 +416,10 @@ type FeeEstimate struct {
 	Fees *TradeFee `json:"fees"`
 	// The total estimated amount of fees if the order was to trade
 	TotalFeeAmount string `json:"totalFeeAmount"`
+	// The part of the estimated fees that would be paid to the referrers of the party
+	ReferrerReward *string `json:"referrerReward,omitempty"`
+	// The additional rebate the party would receive, based on its volume rebate tier, if it were the maker of the trade
+	MakerRebate *string `json:"makerRebate,omitempty"`
 }
 
 // Filter describes the conditions under which oracle data is considered of
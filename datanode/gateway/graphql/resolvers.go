@@ -697,6 +697,10 @@ func (r *VegaResolverRoot) GameTeamScore() GameTeamScoreResolver {
 	return (*gameTeamScoresResolver)(r)
 }
 
+func (r *VegaResolverRoot) TeamLeaderboardEntry() TeamLeaderboardEntryResolver {
+	return (*teamLeaderboardEntryResolver)(r)
+}
+
 type protocolUpgradeProposalResolver VegaResolverRoot
 
 func (r *protocolUpgradeProposalResolver) UpgradeBlockHeight(_ context.Context, obj *eventspb.ProtocolUpgradeEvent) (string, error) {
@@ -979,6 +983,21 @@ func (r *myQueryResolver) GameTeamScores(ctx context.Context, filter *GameTeamSc
 	return res.TeamScores, nil
 }
 
+func (r *myQueryResolver) TeamLeaderboard(ctx context.Context, gameID string, epoch *int) ([]*v2.TeamLeaderboardEntry, error) {
+	req := v2.GetTeamLeaderboardRequest{
+		GameId: gameID,
+	}
+	if epoch != nil {
+		e := uint64(*epoch)
+		req.Epoch = &e
+	}
+	res, err := r.tradingDataClientV2.GetTeamLeaderboard(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Entries, nil
+}
+
 func (r *myQueryResolver) PartyMarginModes(ctx context.Context, marketID *string, partyID *string, pagination *v2.Pagination) (*v2.PartyMarginModesConnection, error) {
 	req := v2.ListPartyMarginModesRequest{
 		MarketId:   marketID,
@@ -1552,10 +1571,15 @@ func (r *myQueryResolver) EstimateFees(
 		LiquidityFeeVolumeDiscount:        ptr.From(resp.Fee.LiquidityFeeVolumeDiscount),
 	}
 
-	return &FeeEstimate{
+	estimate := &FeeEstimate{
 		Fees:           fees,
 		TotalFeeAmount: decimal.Sum(mfee, ifee, lfee).String(),
-	}, nil
+		MakerRebate:    resp.MakerRebate,
+	}
+	if resp.ReferrerReward != nil {
+		estimate.ReferrerReward = ptr.From(resp.ReferrerReward.Total)
+	}
+	return estimate, nil
 }
 
 func (r *myQueryResolver) EstimatePosition(
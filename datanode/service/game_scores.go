@@ -17,6 +17,7 @@ package service
 
 import (
 	"context"
+	"sort"
 
 	"code.vegaprotocol.io/vega/datanode/entities"
 	"code.vegaprotocol.io/vega/logging"
@@ -74,3 +75,41 @@ func (gs *GameScore) ListTeamScores(
 ) ([]entities.GameTeamScore, entities.PageInfo, error) {
 	return gs.store.ListTeamScores(ctx, gameIDs, teamIDs, epochFromID, epochToID, pagination)
 }
+
+// TeamLeaderboardEntry is the standing of a team in a game.
+type TeamLeaderboardEntry struct {
+	Rank  uint64
+	Score entities.GameTeamScore
+}
+
+// TeamLeaderboard ranks the teams of a game on their latest score, or on their
+// score at the end of the given epoch. Teams with the same score share the same rank.
+func (gs *GameScore) TeamLeaderboard(ctx context.Context, gameID entities.GameID, epoch *uint64) ([]TeamLeaderboardEntry, error) {
+	scores, _, err := gs.store.ListTeamScores(ctx, []entities.GameID{gameID}, nil, epoch, epoch, entities.CursorPagination{})
+	if err != nil {
+		return nil, err
+	}
+	return RankTeamScores(scores), nil
+}
+
+// RankTeamScores orders the team scores from the highest to the lowest and ranks them.
+func RankTeamScores(scores []entities.GameTeamScore) []TeamLeaderboardEntry {
+	sorted := make([]entities.GameTeamScore, len(scores))
+	copy(sorted, scores)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Score.Equal(sorted[j].Score) {
+			return sorted[i].TeamID < sorted[j].TeamID
+		}
+		return sorted[i].Score.GreaterThan(sorted[j].Score)
+	})
+
+	leaderboard := make([]TeamLeaderboardEntry, 0, len(sorted))
+	var rank uint64
+	for i, score := range sorted {
+		if i == 0 || !score.Score.Equal(sorted[i-1].Score) {
+			rank = uint64(i + 1)
+		}
+		leaderboard = append(leaderboard, TeamLeaderboardEntry{Rank: rank, Score: score})
+	}
+	return leaderboard
+}
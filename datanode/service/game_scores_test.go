@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package service_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/service"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankTeamScores(t *testing.T) {
+	scores := []entities.GameTeamScore{
+		{TeamID: "c0", Score: decimal.NewFromFloat(0.2)},
+		{TeamID: "a0", Score: decimal.NewFromFloat(0.5)},
+		{TeamID: "d0", Score: decimal.NewFromFloat(0.1)},
+		{TeamID: "b0", Score: decimal.NewFromFloat(0.2)},
+	}
+
+	leaderboard := service.RankTeamScores(scores)
+	require.Len(t, leaderboard, 4)
+
+	expected := []struct {
+		team entities.TeamID
+		rank uint64
+	}{
+		{"a0", 1},
+		{"b0", 2},
+		{"c0", 2},
+		{"d0", 4},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.team, leaderboard[i].Score.TeamID)
+		require.Equal(t, e.rank, leaderboard[i].Rank)
+	}
+
+	// the input is left untouched.
+	require.Equal(t, entities.TeamID("c0"), scores[0].TeamID)
+}
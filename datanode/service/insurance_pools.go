@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/libs/num"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	"code.vegaprotocol.io/vega/protos/vega"
+)
+
+// networkPartyID is the owner of the system accounts, the insurance pools included.
+const networkPartyID = entities.PartyID("network")
+
+// InsurancePoolAttribution is the cause of a change of the balance of an insurance pool.
+type InsurancePoolAttribution string
+
+const (
+	// InsurancePoolAttributionLiquidation is the margin of closed out parties, and the gains of the
+	// network positions, received by the pool.
+	InsurancePoolAttributionLiquidation InsurancePoolAttribution = "liquidation"
+	// InsurancePoolAttributionLossCovered is the losses of closed out parties, and of the network
+	// positions, paid by the pool.
+	InsurancePoolAttributionLossCovered InsurancePoolAttribution = "loss_covered"
+	// InsurancePoolAttributionFeeSweep is the remaining fees and the liquidity provision penalties
+	// swept into the pool.
+	InsurancePoolAttributionFeeSweep InsurancePoolAttribution = "fee_sweep"
+	// InsurancePoolAttributionMarketClosure is the pool of a closed market swept to the global insurance
+	// pool and the network treasury, or shared with its successor.
+	InsurancePoolAttributionMarketClosure InsurancePoolAttribution = "market_closure"
+	// InsurancePoolAttributionTransfer is the governance transfers from and to the pool.
+	InsurancePoolAttributionTransfer InsurancePoolAttribution = "transfer"
+	InsurancePoolAttributionOther    InsurancePoolAttribution = "other"
+)
+
+var insurancePoolAttributionsToProto = map[InsurancePoolAttribution]v2.InsurancePoolAttribution{
+	InsurancePoolAttributionLiquidation:   v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_LIQUIDATION,
+	InsurancePoolAttributionLossCovered:   v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_LOSS_COVERED,
+	InsurancePoolAttributionFeeSweep:      v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_FEE_SWEEP,
+	InsurancePoolAttributionMarketClosure: v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_MARKET_CLOSURE,
+	InsurancePoolAttributionTransfer:      v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_TRANSFER,
+	InsurancePoolAttributionOther:         v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_OTHER,
+}
+
+func (a InsurancePoolAttribution) IntoProto() v2.InsurancePoolAttribution {
+	return insurancePoolAttributionsToProto[a]
+}
+
+var (
+	insurancePoolSettlementTypes = map[entities.LedgerMovementType]struct{}{
+		entities.LedgerMovementTypeLoss:              {},
+		entities.LedgerMovementTypeWin:               {},
+		entities.LedgerMovementTypeMTMLoss:           {},
+		entities.LedgerMovementTypeMTMWin:            {},
+		entities.LedgerMovementTypeMarginLow:         {},
+		entities.LedgerMovementTypeMarginHigh:        {},
+		entities.LedgerMovementTypeMarginConfiscated: {},
+		entities.LedgerMovementTypePerpFundingLoss:   {},
+		entities.LedgerMovementTypePerpFundingWin:    {},
+	}
+	insurancePoolFeeSweepTypes = map[entities.LedgerMovementType]struct{}{
+		entities.LedgerMovementTypeBondSlashing:                                                   {},
+		entities.LedgerMovementType(vega.TransferType_TRANSFER_TYPE_SLA_PENALTY_BOND_APPLY):       {},
+		entities.LedgerMovementType(vega.TransferType_TRANSFER_TYPE_SLA_PENALTY_LP_FEE_APPLY):     {},
+		entities.LedgerMovementType(vega.TransferType_TRANSFER_TYPE_LIQUIDITY_FEE_UNPAID_COLLECT): {},
+	}
+)
+
+// InsurancePoolBalance is the balance of an insurance pool after a ledger entry.
+type InsurancePoolBalance struct {
+	VegaTime    time.Time
+	AssetID     entities.AssetID
+	AccountType types.AccountType
+	// MarketID is empty for the global insurance pool.
+	MarketID entities.MarketID
+	Balance  num.Decimal
+	// Change is negative when the funds leave the pool.
+	Change       num.Decimal
+	TransferType entities.LedgerMovementType
+	Attribution  InsurancePoolAttribution
+}
+
+func (b InsurancePoolBalance) ToProto() *v2.InsurancePoolBalance {
+	pb := &v2.InsurancePoolBalance{
+		Timestamp:    b.VegaTime.UnixNano(),
+		AssetId:      b.AssetID.String(),
+		AccountType:  b.AccountType,
+		Balance:      b.Balance.String(),
+		Change:       b.Change.String(),
+		TransferType: vega.TransferType(b.TransferType),
+		Attribution:  b.Attribution.IntoProto(),
+	}
+	if marketID := b.MarketID.String(); marketID != "" {
+		pb.MarketId = &marketID
+	}
+	return pb
+}
+
+// Cursor is the cursor of the ledger entry the balance comes from, as the
+// history is paginated on the ledger entries.
+func (b InsurancePoolBalance) Cursor() *entities.Cursor {
+	return entities.NewCursor(entities.AggregatedLedgerEntriesCursor{
+		VegaTime: b.VegaTime,
+	}.String())
+}
+
+func (b InsurancePoolBalance) ToProtoEdge(_ ...any) (*v2.InsurancePoolBalanceEdge, error) {
+	return &v2.InsurancePoolBalanceEdge{
+		Node:   b.ToProto(),
+		Cursor: b.Cursor().Encode(),
+	}, nil
+}
+
+// InsurancePoolHistory returns the balance history of the insurance pools of the asset, with the
+// cause of every change. The history is limited to the pools of the given markets, if any, the
+// global insurance pool being returned when no market is given.
+func (l *Ledger) InsurancePoolHistory(
+	ctx context.Context,
+	assetID entities.AssetID,
+	marketIDs []entities.MarketID,
+	dateRange entities.DateRange,
+	pagination entities.CursorPagination,
+) ([]InsurancePoolBalance, entities.PageInfo, error) {
+	accountTypes := []types.AccountType{types.AccountTypeInsurance}
+	if len(marketIDs) == 0 {
+		accountTypes = append(accountTypes, types.AccountTypeGlobalInsurance)
+	}
+	accountFilter := entities.AccountFilter{
+		AssetID:      assetID,
+		PartyIDs:     []entities.PartyID{networkPartyID},
+		AccountTypes: accountTypes,
+		MarketIDs:    marketIDs,
+	}
+
+	entries, pageInfo, err := l.store.Query(ctx, &entities.LedgerEntryFilter{
+		FromAccountFilter: accountFilter,
+		ToAccountFilter:   accountFilter,
+	}, dateRange, pagination)
+	if err != nil {
+		return nil, pageInfo, err
+	}
+
+	balances := InsurancePoolBalancesFromLedgerEntries(*entries)
+	if len(marketIDs) == 0 {
+		return balances, pageInfo, nil
+	}
+
+	// the other side of an entry can be the pool of another market.
+	markets := make(map[entities.MarketID]struct{}, len(marketIDs))
+	for _, id := range marketIDs {
+		markets[id] = struct{}{}
+	}
+	filtered := make([]InsurancePoolBalance, 0, len(balances))
+	for _, b := range balances {
+		if _, ok := markets[b.MarketID]; ok {
+			filtered = append(filtered, b)
+		}
+	}
+	return filtered, pageInfo, nil
+}
+
+// InsurancePoolBalancesFromLedgerEntries returns the balance of the insurance pools after each of the
+// given ledger entries. An entry between two pools changes both of them.
+func InsurancePoolBalancesFromLedgerEntries(entries []entities.AggregatedLedgerEntry) []InsurancePoolBalance {
+	balances := make([]InsurancePoolBalance, 0, len(entries))
+	for _, e := range entries {
+		if isInsurancePool(e.FromAccountType) {
+			balances = append(balances, InsurancePoolBalance{
+				VegaTime:     e.VegaTime,
+				AssetID:      e.AssetID,
+				AccountType:  e.FromAccountType,
+				MarketID:     e.FromAccountMarketID,
+				Balance:      e.FromAccountBalance,
+				Change:       e.Quantity.Neg(),
+				TransferType: e.TransferType,
+				Attribution:  insurancePoolAttribution(e, false),
+			})
+		}
+		if isInsurancePool(e.ToAccountType) {
+			balances = append(balances, InsurancePoolBalance{
+				VegaTime:     e.VegaTime,
+				AssetID:      e.AssetID,
+				AccountType:  e.ToAccountType,
+				MarketID:     e.ToAccountMarketID,
+				Balance:      e.ToAccountBalance,
+				Change:       e.Quantity,
+				TransferType: e.TransferType,
+				Attribution:  insurancePoolAttribution(e, true),
+			})
+		}
+	}
+	return balances
+}
+
+// InsurancePoolChangesByAttribution returns the net change of the balance of the insurance pools for
+// each attribution.
+func InsurancePoolChangesByAttribution(balances []InsurancePoolBalance) map[InsurancePoolAttribution]num.Decimal {
+	changes := map[InsurancePoolAttribution]num.Decimal{}
+	for _, b := range balances {
+		changes[b.Attribution] = changes[b.Attribution].Add(b.Change)
+	}
+	return changes
+}
+
+func isInsurancePool(accountType types.AccountType) bool {
+	return accountType == types.AccountTypeInsurance || accountType == types.AccountTypeGlobalInsurance
+}
+
+func insurancePoolAttribution(e entities.AggregatedLedgerEntry, inflow bool) InsurancePoolAttribution {
+	transferType := e.TransferType
+	if _, ok := insurancePoolSettlementTypes[transferType]; ok {
+		if inflow {
+			return InsurancePoolAttributionLiquidation
+		}
+		return InsurancePoolAttributionLossCovered
+	}
+	if _, ok := insurancePoolFeeSweepTypes[transferType]; ok {
+		return InsurancePoolAttributionFeeSweep
+	}
+
+	switch transferType {
+	case entities.LedgerMovementTypeClearAccount:
+		// the fee and settlement accounts of a market are cleared into its pool,
+		// and the pool is then cleared itself.
+		if inflow && !isInsurancePool(e.FromAccountType) {
+			return InsurancePoolAttributionFeeSweep
+		}
+		return InsurancePoolAttributionMarketClosure
+	case entities.LedgerMovementType(vega.TransferType_TRANSFER_TYPE_SUCCESSOR_INSURANCE_FRACTION):
+		return InsurancePoolAttributionMarketClosure
+	case entities.LedgerMovementTypeTransferFundsSend, entities.LedgerMovementTypeTransferFundsDistribute:
+		return InsurancePoolAttributionTransfer
+	default:
+		return InsurancePoolAttributionOther
+	}
+}
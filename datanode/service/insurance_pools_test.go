@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"code.vegaprotocol.io/vega/core/types"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/service"
+	"code.vegaprotocol.io/vega/libs/num"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+	"code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsurancePoolBalancesFromLedgerEntries(t *testing.T) {
+	now := time.Unix(1000, 0)
+	entry := func(tt entities.LedgerMovementType, from, to types.AccountType, quantity, fromBalance, toBalance int64) entities.AggregatedLedgerEntry {
+		e := entities.AggregatedLedgerEntry{
+			VegaTime:           now,
+			AssetID:            "asset",
+			TransferType:       tt,
+			Quantity:           num.DecimalFromInt64(quantity),
+			FromAccountType:    from,
+			ToAccountType:      to,
+			FromAccountBalance: num.DecimalFromInt64(fromBalance),
+			ToAccountBalance:   num.DecimalFromInt64(toBalance),
+		}
+		if from == types.AccountTypeInsurance {
+			e.FromAccountMarketID = "market"
+		}
+		if to == types.AccountTypeInsurance {
+			e.ToAccountMarketID = "market"
+		}
+		return e
+	}
+
+	balances := service.InsurancePoolBalancesFromLedgerEntries([]entities.AggregatedLedgerEntry{
+		// a distressed party margin is confiscated.
+		entry(entities.LedgerMovementTypeMarginConfiscated, types.AccountTypeMargin, types.AccountTypeInsurance, 100, 0, 100),
+		// the pool covers the loss of a closed out party.
+		entry(entities.LedgerMovementTypeMTMWin, types.AccountTypeInsurance, types.AccountTypeSettlement, 30, 70, 30),
+		// the liquidity fees left are swept at market closure.
+		entry(entities.LedgerMovementTypeClearAccount, types.AccountTypeFeesLiquidity, types.AccountTypeInsurance, 5, 0, 75),
+		// the penalty of a liquidity provider.
+		entry(entities.LedgerMovementType(vega.TransferType_TRANSFER_TYPE_SLA_PENALTY_BOND_APPLY), types.AccountTypeBond, types.AccountTypeInsurance, 5, 95, 80),
+		// then the pool is swept.
+		entry(entities.LedgerMovementTypeClearAccount, types.AccountTypeInsurance, types.AccountTypeNetworkTreasury, 20, 60, 20),
+		entry(entities.LedgerMovementTypeClearAccount, types.AccountTypeInsurance, types.AccountTypeGlobalInsurance, 60, 0, 1060),
+		// and governance moves funds out of the global insurance pool.
+		entry(entities.LedgerMovementTypeTransferFundsSend, types.AccountTypeGlobalInsurance, types.AccountTypeNetworkTreasury, 10, 1050, 30),
+	})
+	require.Len(t, balances, 8)
+
+	expected := []struct {
+		accountType types.AccountType
+		balance     int64
+		change      int64
+		attribution service.InsurancePoolAttribution
+	}{
+		{types.AccountTypeInsurance, 100, 100, service.InsurancePoolAttributionLiquidation},
+		{types.AccountTypeInsurance, 70, -30, service.InsurancePoolAttributionLossCovered},
+		{types.AccountTypeInsurance, 75, 5, service.InsurancePoolAttributionFeeSweep},
+		{types.AccountTypeInsurance, 80, 5, service.InsurancePoolAttributionFeeSweep},
+		{types.AccountTypeInsurance, 60, -20, service.InsurancePoolAttributionMarketClosure},
+		{types.AccountTypeInsurance, 0, -60, service.InsurancePoolAttributionMarketClosure},
+		{types.AccountTypeGlobalInsurance, 1060, 60, service.InsurancePoolAttributionMarketClosure},
+		{types.AccountTypeGlobalInsurance, 1050, -10, service.InsurancePoolAttributionTransfer},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.accountType, balances[i].AccountType, i)
+		assert.Equal(t, e.balance, balances[i].Balance.IntPart(), i)
+		assert.Equal(t, e.change, balances[i].Change.IntPart(), i)
+		assert.Equal(t, e.attribution, balances[i].Attribution, i)
+		assert.Equal(t, now, balances[i].VegaTime, i)
+	}
+	assert.Equal(t, entities.MarketID("market"), balances[0].MarketID)
+	assert.Equal(t, entities.MarketID(""), balances[6].MarketID)
+
+	pb := balances[1].ToProto()
+	assert.Equal(t, "market", pb.GetMarketId())
+	assert.Equal(t, "-30", pb.Change)
+	assert.Equal(t, v2.InsurancePoolAttribution_INSURANCE_POOL_ATTRIBUTION_LOSS_COVERED, pb.Attribution)
+	assert.Nil(t, balances[6].ToProto().MarketId)
+
+	changes := service.InsurancePoolChangesByAttribution(balances)
+	assert.Equal(t, "100", changes[service.InsurancePoolAttributionLiquidation].String())
+	assert.Equal(t, "-30", changes[service.InsurancePoolAttributionLossCovered].String())
+	assert.Equal(t, "10", changes[service.InsurancePoolAttributionFeeSweep].String())
+	assert.Equal(t, "-20", changes[service.InsurancePoolAttributionMarketClosure].String())
+	assert.Equal(t, "-10", changes[service.InsurancePoolAttributionTransfer].String())
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package service
+
+import (
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/protos/vega"
+)
+
+// PartyFeeBenefits are the stats of a party the fee it pays as an aggressor (and receives as a maker) depends on.
+// Any of them can be nil if the party isn't part of the corresponding program.
+type PartyFeeBenefits struct {
+	Referral       *entities.FlattenReferralSetStats
+	VolumeDiscount *entities.FlattenVolumeDiscountStats
+	VolumeRebate   *entities.FlattenVolumeRebateStats
+}
+
+// PartyFeeEstimate is the breakdown of the fee a party would pay as the aggressor of a trade.
+type PartyFeeEstimate struct {
+	// Fee has the discounts applied, it is what the party pays.
+	Fee *vega.Fee
+	// ReferrerReward is the part of the maker, infrastructure and liquidity fees paid to the referrers of the party.
+	ReferrerReward PartyReferrerReward
+	// MakerRebate is the additional rebate the party would receive had it been the maker of the trade.
+	MakerRebate *num.Uint
+}
+
+// PartyReferrerReward is the referrer reward carved out of each fee.
+type PartyReferrerReward struct {
+	MakerFee          *num.Uint
+	InfrastructureFee *num.Uint
+	LiquidityFee      *num.Uint
+}
+
+// Total returns the sum of the referrer rewards.
+func (r PartyReferrerReward) Total() *num.Uint {
+	return num.Sum(r.MakerFee, r.InfrastructureFee, r.LiquidityFee)
+}
+
+// EstimatePartyFee mirrors the way the core fee engine applies referral discounts, volume discounts,
+// referrer rewards and volume rebates to the fees of a trade with the given value.
+func EstimatePartyFee(mkt entities.Market, tradeValue num.Decimal, benefits PartyFeeBenefits) (*PartyFeeEstimate, error) {
+	maker, infra, liquidity, bb, treasury, err := feeFactors(mkt)
+	if err != nil {
+		return nil, err
+	}
+
+	mf, _ := num.UintFromDecimal(tradeValue.Mul(maker).Ceil())
+	inf, _ := num.UintFromDecimal(tradeValue.Mul(infra).Ceil())
+	lf, _ := num.UintFromDecimal(tradeValue.Mul(liquidity).Ceil())
+	buyBackFee, _ := num.UintFromDecimal(tradeValue.Mul(bb).Ceil())
+	treasuryFee, _ := num.UintFromDecimal(tradeValue.Mul(treasury).Ceil())
+
+	rfDiscount, rfReward, vdDiscount := partyFeeFactors{}, partyFeeFactors{}, partyFeeFactors{}
+	rebate := num.DecimalZero()
+	if benefits.Referral != nil {
+		if rfDiscount, err = discountFeeFactors(benefits.Referral.DiscountFactors); err != nil {
+			return nil, err
+		}
+		// the reward factors with the multiplier applied are what the core actually uses.
+		if rfReward, err = rewardFeeFactors(benefits.Referral.RewardsFactorsMultiplier); err != nil {
+			return nil, err
+		}
+	}
+	if benefits.VolumeDiscount != nil {
+		if vdDiscount, err = discountFeeFactors(benefits.VolumeDiscount.DiscountFactors); err != nil {
+			return nil, err
+		}
+	}
+	if benefits.VolumeRebate != nil && len(benefits.VolumeRebate.AdditionalRebate) > 0 {
+		if rebate, err = num.DecimalFromString(benefits.VolumeRebate.AdditionalRebate); err != nil {
+			return nil, err
+		}
+	}
+
+	// referral discounts first, then volume discounts on what's left.
+	referralMakerDiscount, _ := num.UintFromDecimal(mf.ToDecimal().Mul(rfDiscount.maker).Floor())
+	referralInfDiscount, _ := num.UintFromDecimal(inf.ToDecimal().Mul(rfDiscount.infra).Floor())
+	referralLfDiscount, _ := num.UintFromDecimal(lf.ToDecimal().Mul(rfDiscount.liquidity).Floor())
+	mf.Sub(mf, referralMakerDiscount)
+	inf.Sub(inf, referralInfDiscount)
+	lf.Sub(lf, referralLfDiscount)
+
+	volumeMakerDiscount, _ := num.UintFromDecimal(mf.ToDecimal().Mul(vdDiscount.maker).Floor())
+	volumeInfDiscount, _ := num.UintFromDecimal(inf.ToDecimal().Mul(vdDiscount.infra).Floor())
+	volumeLfDiscount, _ := num.UintFromDecimal(lf.ToDecimal().Mul(vdDiscount.liquidity).Floor())
+	mf.Sub(mf, volumeMakerDiscount)
+	inf.Sub(inf, volumeInfDiscount)
+	lf.Sub(lf, volumeLfDiscount)
+
+	// the referrer reward is a share of the discounted fees, the party still pays them in full.
+	makerReward, _ := num.UintFromDecimal(mf.ToDecimal().Mul(rfReward.maker).Floor())
+	infReward, _ := num.UintFromDecimal(inf.ToDecimal().Mul(rfReward.infra).Floor())
+	lfReward, _ := num.UintFromDecimal(lf.ToDecimal().Mul(rfReward.liquidity).Floor())
+
+	// the rebate is funded by the buy back and treasury fees so it can't exceed them.
+	makerRebate, _ := num.UintFromDecimal(num.MinD(tradeValue.Mul(rebate), num.Sum(buyBackFee, treasuryFee).ToDecimal()))
+
+	return &PartyFeeEstimate{
+		Fee: &vega.Fee{
+			MakerFee:                          mf.String(),
+			InfrastructureFee:                 inf.String(),
+			LiquidityFee:                      lf.String(),
+			BuyBackFee:                        buyBackFee.String(),
+			TreasuryFee:                       treasuryFee.String(),
+			MakerFeeReferrerDiscount:          referralMakerDiscount.String(),
+			InfrastructureFeeReferrerDiscount: referralInfDiscount.String(),
+			LiquidityFeeReferrerDiscount:      referralLfDiscount.String(),
+			MakerFeeVolumeDiscount:            volumeMakerDiscount.String(),
+			InfrastructureFeeVolumeDiscount:   volumeInfDiscount.String(),
+			LiquidityFeeVolumeDiscount:        volumeLfDiscount.String(),
+		},
+		ReferrerReward: PartyReferrerReward{
+			MakerFee:          makerReward,
+			InfrastructureFee: infReward,
+			LiquidityFee:      lfReward,
+		},
+		MakerRebate: makerRebate,
+	}, nil
+}
+
+func discountFeeFactors(factors *vega.DiscountFactors) (partyFeeFactors, error) {
+	ff := partyFeeFactors{}
+	if factors == nil {
+		return ff, nil
+	}
+	var err error
+	if ff.maker, err = decimalOrZero(factors.MakerDiscountFactor); err != nil {
+		return ff, err
+	}
+	if ff.infra, err = decimalOrZero(factors.InfrastructureDiscountFactor); err != nil {
+		return ff, err
+	}
+	ff.liquidity, err = decimalOrZero(factors.LiquidityDiscountFactor)
+	return ff, err
+}
+
+func rewardFeeFactors(factors *vega.RewardFactors) (partyFeeFactors, error) {
+	ff := partyFeeFactors{}
+	if factors == nil {
+		return ff, nil
+	}
+	var err error
+	if ff.maker, err = decimalOrZero(factors.MakerRewardFactor); err != nil {
+		return ff, err
+	}
+	if ff.infra, err = decimalOrZero(factors.InfrastructureRewardFactor); err != nil {
+		return ff, err
+	}
+	ff.liquidity, err = decimalOrZero(factors.LiquidityRewardFactor)
+	return ff, err
+}
+
+func decimalOrZero(s string) (num.Decimal, error) {
+	if len(s) == 0 {
+		return num.DecimalZero(), nil
+	}
+	return num.DecimalFromString(s)
+}
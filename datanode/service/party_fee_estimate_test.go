@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package service_test
+
+import (
+	"testing"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/service"
+	"code.vegaprotocol.io/vega/libs/num"
+	"code.vegaprotocol.io/vega/protos/vega"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimatePartyFee(t *testing.T) {
+	mkt := entities.Market{
+		Fees: entities.Fees{
+			Factors: &entities.FeeFactors{
+				MakerFee:          "0.1",
+				InfrastructureFee: "0.02",
+				LiquidityFee:      "0.03",
+				BuyBackFee:        "0.04",
+				TreasuryFee:       "0.05",
+			},
+		},
+	}
+	tradeValue := num.DecimalFromInt64(1000000)
+
+	t.Run("no benefits", func(t *testing.T) {
+		estimate, err := service.EstimatePartyFee(mkt, tradeValue, service.PartyFeeBenefits{})
+		require.NoError(t, err)
+		require.Equal(t, "100000", estimate.Fee.MakerFee)
+		require.Equal(t, "20000", estimate.Fee.InfrastructureFee)
+		require.Equal(t, "30000", estimate.Fee.LiquidityFee)
+		require.Equal(t, "40000", estimate.Fee.BuyBackFee)
+		require.Equal(t, "50000", estimate.Fee.TreasuryFee)
+		require.Equal(t, "0", estimate.Fee.MakerFeeReferrerDiscount)
+		require.Equal(t, "0", estimate.Fee.MakerFeeVolumeDiscount)
+		require.True(t, estimate.ReferrerReward.Total().IsZero())
+		require.True(t, estimate.MakerRebate.IsZero())
+	})
+
+	t.Run("all benefits", func(t *testing.T) {
+		estimate, err := service.EstimatePartyFee(mkt, tradeValue, service.PartyFeeBenefits{
+			Referral: &entities.FlattenReferralSetStats{
+				DiscountFactors: &vega.DiscountFactors{
+					MakerDiscountFactor:          "0.001",
+					InfrastructureDiscountFactor: "0.002",
+					LiquidityDiscountFactor:      "0.003",
+				},
+				RewardsFactorsMultiplier: &vega.RewardFactors{
+					MakerRewardFactor:          "0.01",
+					InfrastructureRewardFactor: "0.02",
+					LiquidityRewardFactor:      "0.03",
+				},
+			},
+			VolumeDiscount: &entities.FlattenVolumeDiscountStats{
+				DiscountFactors: &vega.DiscountFactors{
+					MakerDiscountFactor:          "0.0001",
+					InfrastructureDiscountFactor: "0.0002",
+					LiquidityDiscountFactor:      "0.0003",
+				},
+			},
+			VolumeRebate: &entities.FlattenVolumeRebateStats{
+				AdditionalRebate: "0.2",
+			},
+		})
+		require.NoError(t, err)
+
+		// maker: 100000 - 100 (referral) - 9 (volume) = 99891, reward = floor(998.91) = 998
+		require.Equal(t, "100", estimate.Fee.MakerFeeReferrerDiscount)
+		require.Equal(t, "9", estimate.Fee.MakerFeeVolumeDiscount)
+		require.Equal(t, "998", estimate.ReferrerReward.MakerFee.String())
+		require.Equal(t, "99891", estimate.Fee.MakerFee)
+
+		// infra: 20000 - 40 - 3 = 19957, reward = floor(399.14) = 399
+		require.Equal(t, "40", estimate.Fee.InfrastructureFeeReferrerDiscount)
+		require.Equal(t, "3", estimate.Fee.InfrastructureFeeVolumeDiscount)
+		require.Equal(t, "399", estimate.ReferrerReward.InfrastructureFee.String())
+		require.Equal(t, "19957", estimate.Fee.InfrastructureFee)
+
+		// liquidity: 30000 - 90 - 8 = 29902, reward = floor(897.06) = 897
+		require.Equal(t, "90", estimate.Fee.LiquidityFeeReferrerDiscount)
+		require.Equal(t, "8", estimate.Fee.LiquidityFeeVolumeDiscount)
+		require.Equal(t, "897", estimate.ReferrerReward.LiquidityFee.String())
+		require.Equal(t, "29902", estimate.Fee.LiquidityFee)
+		require.Equal(t, "2294", estimate.ReferrerReward.Total().String())
+
+		// no discount on buy back and treasury
+		require.Equal(t, "40000", estimate.Fee.BuyBackFee)
+		require.Equal(t, "50000", estimate.Fee.TreasuryFee)
+
+		// the rebate of 200000 is capped at buy back + treasury
+		require.Equal(t, "90000", estimate.MakerRebate.String())
+	})
+}
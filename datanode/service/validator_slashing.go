@@ -0,0 +1,46 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package service
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/logging"
+)
+
+type validatorSlashingsStore interface {
+	Add(context.Context, entities.ValidatorSlashing) error
+	List(context.Context, entities.NodeID, entities.CursorPagination) ([]entities.ValidatorSlashing, entities.PageInfo, error)
+}
+
+type ValidatorSlashing struct {
+	store validatorSlashingsStore
+}
+
+func NewValidatorSlashing(store validatorSlashingsStore, log *logging.Logger) *ValidatorSlashing {
+	return &ValidatorSlashing{store: store}
+}
+
+func (v *ValidatorSlashing) Add(ctx context.Context, vs entities.ValidatorSlashing) error {
+	return v.store.Add(ctx, vs)
+}
+
+func (v *ValidatorSlashing) List(ctx context.Context,
+	nodeID entities.NodeID,
+	pagination entities.CursorPagination,
+) ([]entities.ValidatorSlashing, entities.PageInfo, error) {
+	return v.store.List(ctx, nodeID, pagination)
+}
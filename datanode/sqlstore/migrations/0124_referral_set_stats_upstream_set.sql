@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE referral_set_stats
+  ADD COLUMN IF NOT EXISTS upstream_set_id BYTEA;
+
+-- +goose Down
+ALTER TABLE referral_set_stats
+  DROP COLUMN IF EXISTS upstream_set_id;
@@ -0,0 +1,3 @@
+-- +goose Up
+
+ALTER TYPE proposal_error ADD VALUE IF NOT EXISTS 'PROPOSAL_ERROR_CANCEL_DELAYED_WITHDRAWAL_INVALID';
@@ -0,0 +1,3 @@
+-- +goose Up
+
+ALTER TYPE proposal_state ADD VALUE IF NOT EXISTS 'STATE_VETOED';
@@ -0,0 +1,25 @@
+-- +goose Up
+
+create type validator_slashing_reason as enum('REASON_UNSPECIFIED', 'REASON_DOWNTIME', 'REASON_DOUBLE_SIGN');
+
+create table if not exists validator_slashings (
+  node_id bytea not null,
+  reason validator_slashing_reason not null,
+  fraction numeric not null,
+  epoch_seq bigint not null,
+  slashed_stakes jsonb not null,
+  tx_hash bytea not null,
+  vega_time timestamp with time zone not null,
+  seq_num bigint not null,
+  primary key (seq_num, vega_time)
+);
+
+create index validator_slashings_node_id_idx on validator_slashings(node_id);
+create index validator_slashings_tx_hash_idx on validator_slashings(tx_hash);
+
+-- +goose Down
+
+drop index if exists validator_slashings_tx_hash_idx;
+drop index if exists validator_slashings_node_id_idx;
+drop table if exists validator_slashings;
+drop type if exists validator_slashing_reason;
@@ -159,8 +159,9 @@ func (rs *ReferralSets) AddReferralSetStats(ctx context.Context, stats *entities
 			   vega_time,
 			   reward_factors,
 			   rewards_multiplier,
-			   rewards_factors_multiplier)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			   rewards_factors_multiplier,
+			   upstream_set_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
 		stats.SetID,
 		stats.AtEpoch,
 		stats.WasEligible,
@@ -171,6 +172,7 @@ func (rs *ReferralSets) AddReferralSetStats(ctx context.Context, stats *entities
 		stats.RewardFactors,
 		stats.RewardsMultiplier,
 		stats.RewardsFactorsMultiplier,
+		stats.UpstreamSetID,
 	)
 
 	return err
@@ -197,6 +199,7 @@ func (rs *ReferralSets) GetReferralSetStats(ctx context.Context, setID *entities
 		RewardFactors                         *vega.RewardFactors
 		RewardsMultiplier                     string
 		RewardsFactorsMultiplier              *vega.RewardFactors
+		UpstreamSetID                         *entities.ReferralSetID
 	}{}
 
 	query = `SELECT set_id,
@@ -210,7 +213,8 @@ func (rs *ReferralSets) GetReferralSetStats(ctx context.Context, setID *entities
        				referee_stats->>'discount_factors' AS discount_factors,
        				referee_stats->>'epoch_notional_taker_volume' AS epoch_notional_taker_volume,
 					rewards_multiplier,
-    				rewards_factors_multiplier
+    				rewards_factors_multiplier,
+    				upstream_set_id
 			  FROM referral_set_stats, JSONB_ARRAY_ELEMENTS(referees_stats) AS referee_stats`
 
 	whereClauses := []string{}
@@ -267,6 +271,7 @@ func (rs *ReferralSets) GetReferralSetStats(ctx context.Context, setID *entities
 			RewardFactors:                         stat.RewardFactors,
 			RewardsMultiplier:                     stat.RewardsMultiplier,
 			RewardsFactorsMultiplier:              stat.RewardsFactorsMultiplier,
+			UpstreamSetID:                         stat.UpstreamSetID,
 		})
 	}
 
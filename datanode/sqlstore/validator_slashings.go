@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package sqlstore
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/metrics"
+	v2 "code.vegaprotocol.io/vega/protos/data-node/api/v2"
+
+	"github.com/georgysavva/scany/pgxscan"
+)
+
+var validatorSlashingOrdering = TableOrdering{
+	ColumnOrdering{Name: "vega_time", Sorting: ASC},
+	ColumnOrdering{Name: "seq_num", Sorting: ASC},
+}
+
+type ValidatorSlashings struct {
+	*ConnectionSource
+}
+
+func NewValidatorSlashings(connectionSource *ConnectionSource) *ValidatorSlashings {
+	return &ValidatorSlashings{
+		ConnectionSource: connectionSource,
+	}
+}
+
+func (store *ValidatorSlashings) Add(ctx context.Context, vs entities.ValidatorSlashing) error {
+	defer metrics.StartSQLQuery("ValidatorSlashings", "Add")()
+	_, err := store.Exec(ctx, `
+		INSERT INTO validator_slashings(node_id, reason, fraction, epoch_seq, slashed_stakes, tx_hash, vega_time, seq_num)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, vs.NodeID, vs.Reason, vs.Fraction, vs.EpochSeq, vs.SlashedStakes, vs.TxHash, vs.VegaTime, vs.SeqNum)
+
+	return err
+}
+
+func (store *ValidatorSlashings) List(ctx context.Context,
+	nodeID entities.NodeID,
+	pagination entities.CursorPagination,
+) ([]entities.ValidatorSlashing, entities.PageInfo, error) {
+	defer metrics.StartSQLQuery("ValidatorSlashings", "List")()
+
+	args := []interface{}{}
+	whereClause := ""
+
+	if nodeID.String() != "" {
+		whereClause = `WHERE node_id = $1`
+		args = append(args, nodeID)
+	}
+
+	query := `SELECT * FROM validator_slashings ` + whereClause
+
+	query, args, err := PaginateQuery[entities.ValidatorSlashingCursor](query, args, validatorSlashingOrdering, pagination)
+	if err != nil {
+		return nil, entities.PageInfo{}, err
+	}
+
+	slashings := []entities.ValidatorSlashing{}
+	if err = pgxscan.Select(ctx, store.ConnectionSource, &slashings, query, args...); err != nil {
+		return nil, entities.PageInfo{}, err
+	}
+
+	paged, pageInfo := entities.PageEntities[*v2.ValidatorSlashingEdge](slashings, pagination)
+	return paged, pageInfo, nil
+}
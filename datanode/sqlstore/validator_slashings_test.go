@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package sqlstore_test
+
+import (
+	"context"
+	"testing"
+
+	"code.vegaprotocol.io/vega/datanode/entities"
+	"code.vegaprotocol.io/vega/datanode/sqlstore"
+	"code.vegaprotocol.io/vega/libs/num"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addTestValidatorSlashing(t *testing.T,
+	ctx context.Context,
+	store *sqlstore.ValidatorSlashings,
+	block entities.Block,
+	nodeID entities.NodeID,
+	seqNum uint64,
+) entities.ValidatorSlashing {
+	t.Helper()
+	vs := entities.ValidatorSlashing{
+		NodeID:   nodeID,
+		Reason:   entities.ValidatorSlashingReason(eventspb.ValidatorSlashing_REASON_DOWNTIME),
+		Fraction: num.DecimalFromFloat(0.1),
+		EpochSeq: 3,
+		SlashedStakes: []*eventspb.SlashedStake{
+			{PartyId: "deadbeef", Amount: "100", SeizedAmount: "40"},
+		},
+		TxHash:   generateTxHash(),
+		VegaTime: block.VegaTime,
+		SeqNum:   seqNum,
+	}
+	require.NoError(t, store.Add(ctx, vs))
+	return vs
+}
+
+func TestValidatorSlashings(t *testing.T) {
+	ctx := tempTransaction(t)
+
+	blockStore := sqlstore.NewBlocks(connectionSource)
+	block := addTestBlock(t, ctx, blockStore)
+
+	store := sqlstore.NewValidatorSlashings(connectionSource)
+
+	var vs entities.ValidatorSlashing
+	t.Run("adding", func(t *testing.T) {
+		vs = addTestValidatorSlashing(t, ctx, store, block, entities.NodeID("beef"), 0)
+		addTestValidatorSlashing(t, ctx, store, block, entities.NodeID("cafe"), 1)
+	})
+
+	t.Run("fetching all", func(t *testing.T) {
+		fetched, _, err := store.List(ctx, entities.NodeID(""), entities.CursorPagination{})
+		require.NoError(t, err)
+		require.Len(t, fetched, 2)
+	})
+
+	t.Run("fetching by node", func(t *testing.T) {
+		fetched, _, err := store.List(ctx, vs.NodeID, entities.CursorPagination{})
+		require.NoError(t, err)
+		require.Len(t, fetched, 1)
+		assert.Equal(t, vs.NodeID, fetched[0].NodeID)
+		assert.Equal(t, vs.Reason, fetched[0].Reason)
+		assert.True(t, vs.Fraction.Equal(fetched[0].Fraction))
+		assert.Equal(t, vs.ToProto().SlashedStakes[0].String(), fetched[0].ToProto().SlashedStakes[0].String())
+	})
+
+	t.Run("with pagination", func(t *testing.T) {
+		one := int32(1)
+		pagination, err := entities.NewCursorPagination(&one, nil, nil, nil, true)
+		require.NoError(t, err)
+
+		fetched, pageInfo, err := store.List(ctx, entities.NodeID(""), pagination)
+		require.NoError(t, err)
+		require.Len(t, fetched, 1)
+		require.True(t, pageInfo.HasNextPage)
+		assert.Equal(t, vs.NodeID, fetched[0].NodeID)
+	})
+}
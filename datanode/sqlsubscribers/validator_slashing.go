@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Gobalsky Labs Limited
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package sqlsubscribers
+
+import (
+	"context"
+
+	"code.vegaprotocol.io/vega/core/events"
+	"code.vegaprotocol.io/vega/datanode/entities"
+	eventspb "code.vegaprotocol.io/vega/protos/vega/events/v1"
+
+	"github.com/pkg/errors"
+)
+
+type ValidatorSlashingEvent interface {
+	events.Event
+	ValidatorSlashing() *eventspb.ValidatorSlashing
+}
+
+type ValidatorSlashingService interface {
+	Add(context.Context, entities.ValidatorSlashing) error
+}
+
+type ValidatorSlashing struct {
+	subscriber
+	service ValidatorSlashingService
+}
+
+func NewValidatorSlashing(service ValidatorSlashingService) *ValidatorSlashing {
+	return &ValidatorSlashing{
+		service: service,
+	}
+}
+
+func (vs *ValidatorSlashing) Types() []events.Type {
+	return []events.Type{events.ValidatorSlashingEvent}
+}
+
+func (vs *ValidatorSlashing) Push(ctx context.Context, evt events.Event) error {
+	return vs.consume(ctx, evt.(ValidatorSlashingEvent))
+}
+
+func (vs *ValidatorSlashing) consume(ctx context.Context, event ValidatorSlashingEvent) error {
+	record, err := entities.ValidatorSlashingFromProto(event.ValidatorSlashing(), entities.TxHash(event.TxHash()), vs.vegaTime,
+		event.Sequence())
+	if err != nil {
+		return errors.Wrap(err, "converting validator slashing proto to database entity failed")
+	}
+
+	return errors.Wrap(vs.service.Add(ctx, record), "inserting validator slashing to SQL store failed")
+}
+
+func (vs *ValidatorSlashing) Name() string {
+	return "ValidatorSlashing"
+}
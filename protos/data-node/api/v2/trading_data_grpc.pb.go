@@ -70,6 +70,10 @@ type TradingDataServiceClient interface {
 	//
 	// Get a list of party scores for the given filters
 	ListGamePartyScores(ctx context.Context, in *ListGamePartyScoresRequest, opts ...grpc.CallOption) (*ListGamePartyScoresResponse, error)
+	// Get team leaderboard
+	//
+	// Get the teams of a game ranked on their latest score, or on their score at the end of the given epoch
+	GetTeamLeaderboard(ctx context.Context, in *GetTeamLeaderboardRequest, opts ...grpc.CallOption) (*GetTeamLeaderboardResponse, error)
 	// Deprecated: Do not use.
 	// Deprecated: List positions
 	//
@@ -99,13 +103,11 @@ type TradingDataServiceClient interface {
 	//   - receiving account (market ID, asset ID, account type)
 	//   - sending AND receiving account
 	//   - transfer type either in addition to the above filters or as a standalone option
-	//
 	// Note: The date range is restricted to any 5 days.
-	//
-	//	If no start or end date is provided, only ledger entries from the last 5 days will be returned.
-	//	If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
-	//	If a start date is provided but no end date, the end date will be set to 5 days after the start date.
-	//	If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
+	//   If no start or end date is provided, only ledger entries from the last 5 days will be returned.
+	//   If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
+	//   If a start date is provided but no end date, the end date will be set to 5 days after the start date.
+	//   If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
 	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
 	// Export ledger entries
 	//
@@ -115,10 +117,15 @@ type TradingDataServiceClient interface {
 	// Ledger entries can be exported by:
 	//   - export ledger entries for a single party for a given asset within a given time range
 	//   - export ledger entries for a single party for a given asset for all times
-	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExportLedgerEntries(ctx context.Context, in *ExportLedgerEntriesRequest, opts ...grpc.CallOption) (TradingDataService_ExportLedgerEntriesClient, error)
+	// List insurance pool history
+	//
+	// Get the balance history of the insurance pools of an asset, with the cause of every change.
+	// The history is restricted to the pools of the given markets, if any. The global insurance pool
+	// is included when no market is given.
+	ListInsurancePoolHistory(ctx context.Context, in *ListInsurancePoolHistoryRequest, opts ...grpc.CallOption) (*ListInsurancePoolHistoryResponse, error)
 	// List balance changes
 	//
 	// Get a list of the changes in account balances over a period of time.
@@ -423,6 +430,10 @@ type TradingDataServiceClient interface {
 	//
 	// Get a list of information about Ethereum key rotations that have been performed by validator nodes
 	ListEthereumKeyRotations(ctx context.Context, in *ListEthereumKeyRotationsRequest, opts ...grpc.CallOption) (*ListEthereumKeyRotationsResponse, error)
+	// List validator slashings
+	//
+	// Get a list of the slashings of validator nodes, with the stake slashed from each of their delegators
+	ListValidatorSlashings(ctx context.Context, in *ListValidatorSlashingsRequest, opts ...grpc.CallOption) (*ListValidatorSlashingsResponse, error)
 	// Get Vega time
 	//
 	// Get the current time of the network in Unix nanoseconds
@@ -636,9 +647,9 @@ type TradingDataServiceClient interface {
 	// file will contain multiple CSV files, with a potentially different set of headers. The
 	// 'version' number of the database schema is part of the in the CSV filename:
 	//
-	//	`[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
+	//  `[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
 	//
-	// # For example, a zip file might be called mainnet-sometable-000001-003000.zip
+	// For example, a zip file might be called mainnet-sometable-000001-003000.zip
 	//
 	// And contain two CSV files: `mainnet-sometable-1-000001-002000.csv`:
 	//
@@ -814,6 +825,15 @@ func (c *tradingDataServiceClient) ListGamePartyScores(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *tradingDataServiceClient) GetTeamLeaderboard(ctx context.Context, in *GetTeamLeaderboardRequest, opts ...grpc.CallOption) (*GetTeamLeaderboardResponse, error) {
+	out := new(GetTeamLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/GetTeamLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *tradingDataServiceClient) ListPositions(ctx context.Context, in *ListPositionsRequest, opts ...grpc.CallOption) (*ListPositionsResponse, error) {
 	out := new(ListPositionsResponse)
@@ -906,6 +926,15 @@ func (x *tradingDataServiceExportLedgerEntriesClient) Recv() (*httpbody.HttpBody
 	return m, nil
 }
 
+func (c *tradingDataServiceClient) ListInsurancePoolHistory(ctx context.Context, in *ListInsurancePoolHistoryRequest, opts ...grpc.CallOption) (*ListInsurancePoolHistoryResponse, error) {
+	out := new(ListInsurancePoolHistoryResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListInsurancePoolHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingDataServiceClient) ListBalanceChanges(ctx context.Context, in *ListBalanceChangesRequest, opts ...grpc.CallOption) (*ListBalanceChangesResponse, error) {
 	out := new(ListBalanceChangesResponse)
 	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListBalanceChanges", in, out, opts...)
@@ -1790,6 +1819,15 @@ func (c *tradingDataServiceClient) ListEthereumKeyRotations(ctx context.Context,
 	return out, nil
 }
 
+func (c *tradingDataServiceClient) ListValidatorSlashings(ctx context.Context, in *ListValidatorSlashingsRequest, opts ...grpc.CallOption) (*ListValidatorSlashingsResponse, error) {
+	out := new(ListValidatorSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/ListValidatorSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tradingDataServiceClient) GetVegaTime(ctx context.Context, in *GetVegaTimeRequest, opts ...grpc.CallOption) (*GetVegaTimeResponse, error) {
 	out := new(GetVegaTimeResponse)
 	err := c.cc.Invoke(ctx, "/datanode.api.v2.TradingDataService/GetVegaTime", in, out, opts...)
@@ -2265,6 +2303,10 @@ type TradingDataServiceServer interface {
 	//
 	// Get a list of party scores for the given filters
 	ListGamePartyScores(context.Context, *ListGamePartyScoresRequest) (*ListGamePartyScoresResponse, error)
+	// Get team leaderboard
+	//
+	// Get the teams of a game ranked on their latest score, or on their score at the end of the given epoch
+	GetTeamLeaderboard(context.Context, *GetTeamLeaderboardRequest) (*GetTeamLeaderboardResponse, error)
 	// Deprecated: Do not use.
 	// Deprecated: List positions
 	//
@@ -2294,13 +2336,11 @@ type TradingDataServiceServer interface {
 	//   - receiving account (market ID, asset ID, account type)
 	//   - sending AND receiving account
 	//   - transfer type either in addition to the above filters or as a standalone option
-	//
 	// Note: The date range is restricted to any 5 days.
-	//
-	//	If no start or end date is provided, only ledger entries from the last 5 days will be returned.
-	//	If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
-	//	If a start date is provided but no end date, the end date will be set to 5 days after the start date.
-	//	If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
+	//   If no start or end date is provided, only ledger entries from the last 5 days will be returned.
+	//   If a start and end date are provided, but the end date is more than 5 days after the start date, only data up to 5 days after the start date will be returned.
+	//   If a start date is provided but no end date, the end date will be set to 5 days after the start date.
+	//   If no start date is provided, but the end date is, the start date will be set to 5 days before the end date.
 	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
 	// Export ledger entries
 	//
@@ -2310,10 +2350,15 @@ type TradingDataServiceServer interface {
 	// Ledger entries can be exported by:
 	//   - export ledger entries for a single party for a given asset within a given time range
 	//   - export ledger entries for a single party for a given asset for all times
-	//
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ExportLedgerEntries(*ExportLedgerEntriesRequest, TradingDataService_ExportLedgerEntriesServer) error
+	// List insurance pool history
+	//
+	// Get the balance history of the insurance pools of an asset, with the cause of every change.
+	// The history is restricted to the pools of the given markets, if any. The global insurance pool
+	// is included when no market is given.
+	ListInsurancePoolHistory(context.Context, *ListInsurancePoolHistoryRequest) (*ListInsurancePoolHistoryResponse, error)
 	// List balance changes
 	//
 	// Get a list of the changes in account balances over a period of time.
@@ -2618,6 +2663,10 @@ type TradingDataServiceServer interface {
 	//
 	// Get a list of information about Ethereum key rotations that have been performed by validator nodes
 	ListEthereumKeyRotations(context.Context, *ListEthereumKeyRotationsRequest) (*ListEthereumKeyRotationsResponse, error)
+	// List validator slashings
+	//
+	// Get a list of the slashings of validator nodes, with the stake slashed from each of their delegators
+	ListValidatorSlashings(context.Context, *ListValidatorSlashingsRequest) (*ListValidatorSlashingsResponse, error)
 	// Get Vega time
 	//
 	// Get the current time of the network in Unix nanoseconds
@@ -2831,9 +2880,9 @@ type TradingDataServiceServer interface {
 	// file will contain multiple CSV files, with a potentially different set of headers. The
 	// 'version' number of the database schema is part of the in the CSV filename:
 	//
-	//	`[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
+	//  `[chain id]-[table name]-[schema version]-[start block]-[end block].zip`
 	//
-	// # For example, a zip file might be called mainnet-sometable-000001-003000.zip
+	// For example, a zip file might be called mainnet-sometable-000001-003000.zip
 	//
 	// And contain two CSV files: `mainnet-sometable-1-000001-002000.csv`:
 	//
@@ -2894,6 +2943,9 @@ func (UnimplementedTradingDataServiceServer) ListGameTeamScores(context.Context,
 func (UnimplementedTradingDataServiceServer) ListGamePartyScores(context.Context, *ListGamePartyScoresRequest) (*ListGamePartyScoresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGamePartyScores not implemented")
 }
+func (UnimplementedTradingDataServiceServer) GetTeamLeaderboard(context.Context, *GetTeamLeaderboardRequest) (*GetTeamLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamLeaderboard not implemented")
+}
 func (UnimplementedTradingDataServiceServer) ListPositions(context.Context, *ListPositionsRequest) (*ListPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPositions not implemented")
 }
@@ -2909,6 +2961,9 @@ func (UnimplementedTradingDataServiceServer) ListLedgerEntries(context.Context,
 func (UnimplementedTradingDataServiceServer) ExportLedgerEntries(*ExportLedgerEntriesRequest, TradingDataService_ExportLedgerEntriesServer) error {
 	return status.Errorf(codes.Unimplemented, "method ExportLedgerEntries not implemented")
 }
+func (UnimplementedTradingDataServiceServer) ListInsurancePoolHistory(context.Context, *ListInsurancePoolHistoryRequest) (*ListInsurancePoolHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInsurancePoolHistory not implemented")
+}
 func (UnimplementedTradingDataServiceServer) ListBalanceChanges(context.Context, *ListBalanceChangesRequest) (*ListBalanceChangesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceChanges not implemented")
 }
@@ -3119,6 +3174,9 @@ func (UnimplementedTradingDataServiceServer) ListKeyRotations(context.Context, *
 func (UnimplementedTradingDataServiceServer) ListEthereumKeyRotations(context.Context, *ListEthereumKeyRotationsRequest) (*ListEthereumKeyRotationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEthereumKeyRotations not implemented")
 }
+func (UnimplementedTradingDataServiceServer) ListValidatorSlashings(context.Context, *ListValidatorSlashingsRequest) (*ListValidatorSlashingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatorSlashings not implemented")
+}
 func (UnimplementedTradingDataServiceServer) GetVegaTime(context.Context, *GetVegaTimeRequest) (*GetVegaTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVegaTime not implemented")
 }
@@ -3462,6 +3520,24 @@ func _TradingDataService_ListGamePartyScores_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingDataService_GetTeamLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).GetTeamLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/GetTeamLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).GetTeamLeaderboard(ctx, req.(*GetTeamLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingDataService_ListPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPositionsRequest)
 	if err := dec(in); err != nil {
@@ -3558,6 +3634,24 @@ func (x *tradingDataServiceExportLedgerEntriesServer) Send(m *httpbody.HttpBody)
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TradingDataService_ListInsurancePoolHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInsurancePoolHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListInsurancePoolHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListInsurancePoolHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListInsurancePoolHistory(ctx, req.(*ListInsurancePoolHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingDataService_ListBalanceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBalanceChangesRequest)
 	if err := dec(in); err != nil {
@@ -4856,6 +4950,24 @@ func _TradingDataService_ListEthereumKeyRotations_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TradingDataService_ListValidatorSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorSlashingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingDataServiceServer).ListValidatorSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datanode.api.v2.TradingDataService/ListValidatorSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingDataServiceServer).ListValidatorSlashings(ctx, req.(*ListValidatorSlashingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TradingDataService_GetVegaTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVegaTimeRequest)
 	if err := dec(in); err != nil {
@@ -5661,6 +5773,10 @@ var TradingDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGamePartyScores",
 			Handler:    _TradingDataService_ListGamePartyScores_Handler,
 		},
+		{
+			MethodName: "GetTeamLeaderboard",
+			Handler:    _TradingDataService_GetTeamLeaderboard_Handler,
+		},
 		{
 			MethodName: "ListPositions",
 			Handler:    _TradingDataService_ListPositions_Handler,
@@ -5673,6 +5789,10 @@ var TradingDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLedgerEntries",
 			Handler:    _TradingDataService_ListLedgerEntries_Handler,
 		},
+		{
+			MethodName: "ListInsurancePoolHistory",
+			Handler:    _TradingDataService_ListInsurancePoolHistory_Handler,
+		},
 		{
 			MethodName: "ListBalanceChanges",
 			Handler:    _TradingDataService_ListBalanceChanges_Handler,
@@ -5909,6 +6029,10 @@ var TradingDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEthereumKeyRotations",
 			Handler:    _TradingDataService_ListEthereumKeyRotations_Handler,
 		},
+		{
+			MethodName: "ListValidatorSlashings",
+			Handler:    _TradingDataService_ListValidatorSlashings_Handler,
+		},
 		{
 			MethodName: "GetVegaTime",
 			Handler:    _TradingDataService_GetVegaTime_Handler,
@@ -66,6 +66,27 @@ type CoreServiceClient interface {
 	//
 	// Get the spam statistics for a given party.
 	GetSpamStatistics(ctx context.Context, in *GetSpamStatisticsRequest, opts ...grpc.CallOption) (*GetSpamStatisticsResponse, error)
+	// Get liquidity provider SLA forecast
+	//
+	// Get the projected end of epoch SLA penalties of a liquidity provider in a market,
+	// assuming their current order placement is maintained until the end of the epoch.
+	GetLiquidityProviderSLAForecast(ctx context.Context, in *GetLiquidityProviderSLAForecastRequest, opts ...grpc.CallOption) (*GetLiquidityProviderSLAForecastResponse, error)
+	// Get withdrawal batch proof
+	//
+	// Get the Merkle proof, and the signatures of the batch bundle, required to claim
+	// a batched ERC20 withdrawal on the bridge.
+	GetWithdrawalBatchProof(ctx context.Context, in *GetWithdrawalBatchProofRequest, opts ...grpc.CallOption) (*GetWithdrawalBatchProofResponse, error)
+	// List delayed withdrawals
+	//
+	// List the withdrawals in the withdrawal delay queue, with the time at which they are released.
+	// Until then, a withdrawal guardian or a governance proposal can cancel them.
+	ListDelayedWithdrawals(ctx context.Context, in *ListDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*ListDelayedWithdrawalsResponse, error)
+	// Simulate proposal
+	//
+	// Run the validation of the terms of a proposal as if they were submitted now, without submitting them.
+	// Returns why the proposal would be rejected, what it would need to pass and what would be enacted.
+	// For market updates, returns the effect on the margin of the open positions.
+	SimulateProposal(ctx context.Context, in *SimulateProposalRequest, opts ...grpc.CallOption) (*SimulateProposalResponse, error)
 }
 
 type coreServiceClient struct {
@@ -188,6 +209,42 @@ func (c *coreServiceClient) GetSpamStatistics(ctx context.Context, in *GetSpamSt
 	return out, nil
 }
 
+func (c *coreServiceClient) GetLiquidityProviderSLAForecast(ctx context.Context, in *GetLiquidityProviderSLAForecastRequest, opts ...grpc.CallOption) (*GetLiquidityProviderSLAForecastResponse, error) {
+	out := new(GetLiquidityProviderSLAForecastResponse)
+	err := c.cc.Invoke(ctx, "/vega.api.v1.CoreService/GetLiquidityProviderSLAForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) GetWithdrawalBatchProof(ctx context.Context, in *GetWithdrawalBatchProofRequest, opts ...grpc.CallOption) (*GetWithdrawalBatchProofResponse, error) {
+	out := new(GetWithdrawalBatchProofResponse)
+	err := c.cc.Invoke(ctx, "/vega.api.v1.CoreService/GetWithdrawalBatchProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) ListDelayedWithdrawals(ctx context.Context, in *ListDelayedWithdrawalsRequest, opts ...grpc.CallOption) (*ListDelayedWithdrawalsResponse, error) {
+	out := new(ListDelayedWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/vega.api.v1.CoreService/ListDelayedWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreServiceClient) SimulateProposal(ctx context.Context, in *SimulateProposalRequest, opts ...grpc.CallOption) (*SimulateProposalResponse, error) {
+	out := new(SimulateProposalResponse)
+	err := c.cc.Invoke(ctx, "/vega.api.v1.CoreService/SimulateProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CoreServiceServer is the server API for CoreService service.
 // All implementations must embed UnimplementedCoreServiceServer
 // for forward compatibility
@@ -236,6 +293,27 @@ type CoreServiceServer interface {
 	//
 	// Get the spam statistics for a given party.
 	GetSpamStatistics(context.Context, *GetSpamStatisticsRequest) (*GetSpamStatisticsResponse, error)
+	// Get liquidity provider SLA forecast
+	//
+	// Get the projected end of epoch SLA penalties of a liquidity provider in a market,
+	// assuming their current order placement is maintained until the end of the epoch.
+	GetLiquidityProviderSLAForecast(context.Context, *GetLiquidityProviderSLAForecastRequest) (*GetLiquidityProviderSLAForecastResponse, error)
+	// Get withdrawal batch proof
+	//
+	// Get the Merkle proof, and the signatures of the batch bundle, required to claim
+	// a batched ERC20 withdrawal on the bridge.
+	GetWithdrawalBatchProof(context.Context, *GetWithdrawalBatchProofRequest) (*GetWithdrawalBatchProofResponse, error)
+	// List delayed withdrawals
+	//
+	// List the withdrawals in the withdrawal delay queue, with the time at which they are released.
+	// Until then, a withdrawal guardian or a governance proposal can cancel them.
+	ListDelayedWithdrawals(context.Context, *ListDelayedWithdrawalsRequest) (*ListDelayedWithdrawalsResponse, error)
+	// Simulate proposal
+	//
+	// Run the validation of the terms of a proposal as if they were submitted now, without submitting them.
+	// Returns why the proposal would be rejected, what it would need to pass and what would be enacted.
+	// For market updates, returns the effect on the margin of the open positions.
+	SimulateProposal(context.Context, *SimulateProposalRequest) (*SimulateProposalResponse, error)
 	mustEmbedUnimplementedCoreServiceServer()
 }
 
@@ -273,6 +351,18 @@ func (UnimplementedCoreServiceServer) CheckRawTransaction(context.Context, *Chec
 func (UnimplementedCoreServiceServer) GetSpamStatistics(context.Context, *GetSpamStatisticsRequest) (*GetSpamStatisticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSpamStatistics not implemented")
 }
+func (UnimplementedCoreServiceServer) GetLiquidityProviderSLAForecast(context.Context, *GetLiquidityProviderSLAForecastRequest) (*GetLiquidityProviderSLAForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderSLAForecast not implemented")
+}
+func (UnimplementedCoreServiceServer) GetWithdrawalBatchProof(context.Context, *GetWithdrawalBatchProofRequest) (*GetWithdrawalBatchProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawalBatchProof not implemented")
+}
+func (UnimplementedCoreServiceServer) ListDelayedWithdrawals(context.Context, *ListDelayedWithdrawalsRequest) (*ListDelayedWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelayedWithdrawals not implemented")
+}
+func (UnimplementedCoreServiceServer) SimulateProposal(context.Context, *SimulateProposalRequest) (*SimulateProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposal not implemented")
+}
 func (UnimplementedCoreServiceServer) mustEmbedUnimplementedCoreServiceServer() {}
 
 // UnsafeCoreServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -474,6 +564,78 @@ func _CoreService_GetSpamStatistics_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreService_GetLiquidityProviderSLAForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidityProviderSLAForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).GetLiquidityProviderSLAForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vega.api.v1.CoreService/GetLiquidityProviderSLAForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).GetLiquidityProviderSLAForecast(ctx, req.(*GetLiquidityProviderSLAForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_GetWithdrawalBatchProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawalBatchProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).GetWithdrawalBatchProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vega.api.v1.CoreService/GetWithdrawalBatchProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).GetWithdrawalBatchProof(ctx, req.(*GetWithdrawalBatchProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_ListDelayedWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelayedWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).ListDelayedWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vega.api.v1.CoreService/ListDelayedWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).ListDelayedWithdrawals(ctx, req.(*ListDelayedWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreService_SimulateProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).SimulateProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vega.api.v1.CoreService/SimulateProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).SimulateProposal(ctx, req.(*SimulateProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CoreService_ServiceDesc is the grpc.ServiceDesc for CoreService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -517,6 +679,22 @@ var CoreService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSpamStatistics",
 			Handler:    _CoreService_GetSpamStatistics_Handler,
 		},
+		{
+			MethodName: "GetLiquidityProviderSLAForecast",
+			Handler:    _CoreService_GetLiquidityProviderSLAForecast_Handler,
+		},
+		{
+			MethodName: "GetWithdrawalBatchProof",
+			Handler:    _CoreService_GetWithdrawalBatchProof_Handler,
+		},
+		{
+			MethodName: "ListDelayedWithdrawals",
+			Handler:    _CoreService_ListDelayedWithdrawals_Handler,
+		},
+		{
+			MethodName: "SimulateProposal",
+			Handler:    _CoreService_SimulateProposal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
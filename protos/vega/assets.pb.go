@@ -335,6 +335,8 @@ type ERC20 struct {
 	WithdrawThreshold string `protobuf:"bytes,3,opt,name=withdraw_threshold,json=withdrawThreshold,proto3" json:"withdraw_threshold,omitempty"`
 	// Chain ID the asset originated from.
 	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Other EVM chains the asset is bridged to.
+	AdditionalChains []*ERC20ChainContract `protobuf:"bytes,5,rep,name=additional_chains,json=additionalChains,proto3" json:"additional_chains,omitempty"`
 }
 
 func (x *ERC20) Reset() {
@@ -397,6 +399,71 @@ func (x *ERC20) GetChainId() string {
 	return ""
 }
 
+func (x *ERC20) GetAdditionalChains() []*ERC20ChainContract {
+	if x != nil {
+		return x.AdditionalChains
+	}
+	return nil
+}
+
+// Contract of an ERC20 asset on an EVM chain.
+type ERC20ChainContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Chain ID of the EVM chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Address of the contract for the token on the chain.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (x *ERC20ChainContract) Reset() {
+	*x = ERC20ChainContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_assets_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ERC20ChainContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ERC20ChainContract) ProtoMessage() {}
+
+func (x *ERC20ChainContract) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_assets_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ERC20ChainContract.ProtoReflect.Descriptor instead.
+func (*ERC20ChainContract) Descriptor() ([]byte, []int) {
+	return file_vega_assets_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ERC20ChainContract) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ERC20ChainContract) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
 // Changes to apply on an existing asset.
 type AssetDetailsUpdate struct {
 	state         protoimpl.MessageState
@@ -416,7 +483,7 @@ type AssetDetailsUpdate struct {
 func (x *AssetDetailsUpdate) Reset() {
 	*x = AssetDetailsUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_assets_proto_msgTypes[4]
+		mi := &file_vega_assets_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +496,7 @@ func (x *AssetDetailsUpdate) String() string {
 func (*AssetDetailsUpdate) ProtoMessage() {}
 
 func (x *AssetDetailsUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_assets_proto_msgTypes[4]
+	mi := &file_vega_assets_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +509,7 @@ func (x *AssetDetailsUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetDetailsUpdate.ProtoReflect.Descriptor instead.
 func (*AssetDetailsUpdate) Descriptor() ([]byte, []int) {
-	return file_vega_assets_proto_rawDescGZIP(), []int{4}
+	return file_vega_assets_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AssetDetailsUpdate) GetQuantum() string {
@@ -495,7 +562,7 @@ type ERC20Update struct {
 func (x *ERC20Update) Reset() {
 	*x = ERC20Update{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_assets_proto_msgTypes[5]
+		mi := &file_vega_assets_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -508,7 +575,7 @@ func (x *ERC20Update) String() string {
 func (*ERC20Update) ProtoMessage() {}
 
 func (x *ERC20Update) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_assets_proto_msgTypes[5]
+	mi := &file_vega_assets_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +588,7 @@ func (x *ERC20Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ERC20Update.ProtoReflect.Descriptor instead.
 func (*ERC20Update) Descriptor() ([]byte, []int) {
-	return file_vega_assets_proto_rawDescGZIP(), []int{5}
+	return file_vega_assets_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ERC20Update) GetLifetimeLimit() string {
@@ -576,7 +643,7 @@ var file_vega_assets_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x75, 0x63, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
 	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x61,
 	0x78, 0x46, 0x61, 0x75, 0x63, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e,
-	0x74, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x45, 0x52, 0x43, 0x32, 0x30, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x74, 0x22, 0xea, 0x01, 0x0a, 0x05, 0x45, 0x52, 0x43, 0x32, 0x30, 0x12, 0x29, 0x0a, 0x10, 0x63,
 	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
@@ -586,24 +653,34 @@ var file_vega_assets_proto_rawDesc = []byte{
 	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64,
 	0x72, 0x61, 0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x19, 0x0a, 0x08,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72, 0x63, 0x32, 0x30,
-	0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45, 0x52,
-	0x43, 0x32, 0x30, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x63,
-	0x32, 0x30, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4a, 0x04, 0x08, 0x01,
-	0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04,
-	0x08, 0x04, 0x10, 0x05, 0x22, 0x63, 0x0a, 0x0b, 0x45, 0x52, 0x43, 0x32, 0x30, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x66,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x27, 0x5a, 0x25, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x69,
-	0x6f, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x76, 0x65,
-	0x67, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x10, 0x61, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x5a,
+	0x0a, 0x12, 0x45, 0x52, 0x43, 0x32, 0x30, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x7b, 0x0a, 0x12, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x65, 0x67, 0x61,
+	0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x63, 0x32, 0x30, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4a,
+	0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x63, 0x0a, 0x0b, 0x45, 0x52, 0x43, 0x32, 0x30,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x27, 0x5a, 0x25,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x76, 0x65, 0x67, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -619,27 +696,29 @@ func file_vega_assets_proto_rawDescGZIP() []byte {
 }
 
 var file_vega_assets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_vega_assets_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_vega_assets_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_vega_assets_proto_goTypes = []interface{}{
 	(Asset_Status)(0),          // 0: vega.Asset.Status
 	(*Asset)(nil),              // 1: vega.Asset
 	(*AssetDetails)(nil),       // 2: vega.AssetDetails
 	(*BuiltinAsset)(nil),       // 3: vega.BuiltinAsset
 	(*ERC20)(nil),              // 4: vega.ERC20
-	(*AssetDetailsUpdate)(nil), // 5: vega.AssetDetailsUpdate
-	(*ERC20Update)(nil),        // 6: vega.ERC20Update
+	(*ERC20ChainContract)(nil), // 5: vega.ERC20ChainContract
+	(*AssetDetailsUpdate)(nil), // 6: vega.AssetDetailsUpdate
+	(*ERC20Update)(nil),        // 7: vega.ERC20Update
 }
 var file_vega_assets_proto_depIdxs = []int32{
 	2, // 0: vega.Asset.details:type_name -> vega.AssetDetails
 	0, // 1: vega.Asset.status:type_name -> vega.Asset.Status
 	3, // 2: vega.AssetDetails.builtin_asset:type_name -> vega.BuiltinAsset
 	4, // 3: vega.AssetDetails.erc20:type_name -> vega.ERC20
-	6, // 4: vega.AssetDetailsUpdate.erc20:type_name -> vega.ERC20Update
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	5, // 4: vega.ERC20.additional_chains:type_name -> vega.ERC20ChainContract
+	7, // 5: vega.AssetDetailsUpdate.erc20:type_name -> vega.ERC20Update
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_vega_assets_proto_init() }
@@ -697,7 +776,7 @@ func file_vega_assets_proto_init() {
 			}
 		}
 		file_vega_assets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetDetailsUpdate); i {
+			switch v := v.(*ERC20ChainContract); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -709,6 +788,18 @@ func file_vega_assets_proto_init() {
 			}
 		}
 		file_vega_assets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetDetailsUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_assets_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ERC20Update); i {
 			case 0:
 				return &v.state
@@ -725,7 +816,7 @@ func file_vega_assets_proto_init() {
 		(*AssetDetails_BuiltinAsset)(nil),
 		(*AssetDetails_Erc20)(nil),
 	}
-	file_vega_assets_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_vega_assets_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*AssetDetailsUpdate_Erc20)(nil),
 	}
 	type x struct{}
@@ -734,7 +825,7 @@ func file_vega_assets_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vega_assets_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
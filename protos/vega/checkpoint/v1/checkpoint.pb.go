@@ -640,6 +640,7 @@ type Delegate struct {
 	Active         []*DelegateEntry `protobuf:"bytes,1,rep,name=active,proto3" json:"active,omitempty"`
 	Pending        []*DelegateEntry `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending,omitempty"`
 	AutoDelegation []string         `protobuf:"bytes,3,rep,name=auto_delegation,json=autoDelegation,proto3" json:"auto_delegation,omitempty"`
+	SlashedStakes  []*SlashedStake  `protobuf:"bytes,4,rep,name=slashed_stakes,json=slashedStakes,proto3" json:"slashed_stakes,omitempty"`
 }
 
 func (x *Delegate) Reset() {
@@ -695,6 +696,69 @@ func (x *Delegate) GetAutoDelegation() []string {
 	return nil
 }
 
+func (x *Delegate) GetSlashedStakes() []*SlashedStake {
+	if x != nil {
+		return x.SlashedStakes
+	}
+	return nil
+}
+
+// Stake of a party slashed and locked out of delegation.
+type SlashedStake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party  string `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *SlashedStake) Reset() {
+	*x = SlashedStake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashedStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashedStake) ProtoMessage() {}
+
+func (x *SlashedStake) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashedStake.ProtoReflect.Descriptor instead.
+func (*SlashedStake) Descriptor() ([]byte, []int) {
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SlashedStake) GetParty() string {
+	if x != nil {
+		return x.Party
+	}
+	return ""
+}
+
+func (x *SlashedStake) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 // Block message contains data related to block at which the checkpoint
 // was created i.e. the block height
 type Block struct {
@@ -708,7 +772,7 @@ type Block struct {
 func (x *Block) Reset() {
 	*x = Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[10]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -721,7 +785,7 @@ func (x *Block) String() string {
 func (*Block) ProtoMessage() {}
 
 func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[10]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +798,7 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Block.ProtoReflect.Descriptor instead.
 func (*Block) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{10}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Block) GetHeight() int64 {
@@ -755,7 +819,7 @@ type Rewards struct {
 func (x *Rewards) Reset() {
 	*x = Rewards{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[11]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -768,7 +832,7 @@ func (x *Rewards) String() string {
 func (*Rewards) ProtoMessage() {}
 
 func (x *Rewards) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[11]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +845,7 @@ func (x *Rewards) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rewards.ProtoReflect.Descriptor instead.
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{11}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Rewards) GetRewards() []*RewardPayout {
@@ -803,7 +867,7 @@ type RewardPayout struct {
 func (x *RewardPayout) Reset() {
 	*x = RewardPayout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[12]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -816,7 +880,7 @@ func (x *RewardPayout) String() string {
 func (*RewardPayout) ProtoMessage() {}
 
 func (x *RewardPayout) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[12]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +893,7 @@ func (x *RewardPayout) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RewardPayout.ProtoReflect.Descriptor instead.
 func (*RewardPayout) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{12}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RewardPayout) GetPayoutTime() int64 {
@@ -862,7 +926,7 @@ type PendingRewardPayout struct {
 func (x *PendingRewardPayout) Reset() {
 	*x = PendingRewardPayout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[13]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +939,7 @@ func (x *PendingRewardPayout) String() string {
 func (*PendingRewardPayout) ProtoMessage() {}
 
 func (x *PendingRewardPayout) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[13]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +952,7 @@ func (x *PendingRewardPayout) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingRewardPayout.ProtoReflect.Descriptor instead.
 func (*PendingRewardPayout) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{13}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PendingRewardPayout) GetFromAccount() string {
@@ -945,7 +1009,7 @@ type PartyAmount struct {
 func (x *PartyAmount) Reset() {
 	*x = PartyAmount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[14]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -958,7 +1022,7 @@ func (x *PartyAmount) String() string {
 func (*PartyAmount) ProtoMessage() {}
 
 func (x *PartyAmount) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[14]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1035,7 @@ func (x *PartyAmount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyAmount.ProtoReflect.Descriptor instead.
 func (*PartyAmount) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{14}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PartyAmount) GetParty() string {
@@ -1004,7 +1068,7 @@ type PendingKeyRotation struct {
 func (x *PendingKeyRotation) Reset() {
 	*x = PendingKeyRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[15]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +1081,7 @@ func (x *PendingKeyRotation) String() string {
 func (*PendingKeyRotation) ProtoMessage() {}
 
 func (x *PendingKeyRotation) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[15]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1094,7 @@ func (x *PendingKeyRotation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingKeyRotation.ProtoReflect.Descriptor instead.
 func (*PendingKeyRotation) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{15}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PendingKeyRotation) GetRelativeTargetBlockHeight() uint64 {
@@ -1076,7 +1140,7 @@ type PendingEthereumKeyRotation struct {
 func (x *PendingEthereumKeyRotation) Reset() {
 	*x = PendingEthereumKeyRotation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[16]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1089,7 +1153,7 @@ func (x *PendingEthereumKeyRotation) String() string {
 func (*PendingEthereumKeyRotation) ProtoMessage() {}
 
 func (x *PendingEthereumKeyRotation) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[16]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +1166,7 @@ func (x *PendingEthereumKeyRotation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingEthereumKeyRotation.ProtoReflect.Descriptor instead.
 func (*PendingEthereumKeyRotation) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{16}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PendingEthereumKeyRotation) GetRelativeTargetBlockHeight() uint64 {
@@ -1140,7 +1204,7 @@ type ScheduledTransfer struct {
 func (x *ScheduledTransfer) Reset() {
 	*x = ScheduledTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[17]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1153,7 +1217,7 @@ func (x *ScheduledTransfer) String() string {
 func (*ScheduledTransfer) ProtoMessage() {}
 
 func (x *ScheduledTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[17]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1230,7 @@ func (x *ScheduledTransfer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledTransfer.ProtoReflect.Descriptor instead.
 func (*ScheduledTransfer) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{17}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ScheduledTransfer) GetTransfer() *vega.Transfer {
@@ -1197,6 +1261,232 @@ func (x *ScheduledTransfer) GetOneoffTransfer() *v1.Transfer {
 	return nil
 }
 
+// One-off transfer held until its condition is met.
+type ConditionalTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *ScheduledTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// Set once data matching the data source of the condition has been received.
+	Triggered bool `protobuf:"varint,2,opt,name=triggered,proto3" json:"triggered,omitempty"`
+}
+
+func (x *ConditionalTransfer) Reset() {
+	*x = ConditionalTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionalTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionalTransfer) ProtoMessage() {}
+
+func (x *ConditionalTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionalTransfer.ProtoReflect.Descriptor instead.
+func (*ConditionalTransfer) Descriptor() ([]byte, []int) {
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConditionalTransfer) GetTransfer() *ScheduledTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *ConditionalTransfer) GetTriggered() bool {
+	if x != nil {
+		return x.Triggered
+	}
+	return false
+}
+
+// Finalised withdrawal, which is not signed yet.
+type Withdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref        string           `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	Withdrawal *vega.Withdrawal `protobuf:"bytes,2,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+}
+
+func (x *Withdrawal) Reset() {
+	*x = Withdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Withdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Withdrawal) ProtoMessage() {}
+
+func (x *Withdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Withdrawal.ProtoReflect.Descriptor instead.
+func (*Withdrawal) Descriptor() ([]byte, []int) {
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Withdrawal) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Withdrawal) GetWithdrawal() *vega.Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+// Withdrawal in the withdrawal delay queue.
+type DelayedWithdrawal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Withdrawal *Withdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	// Time as Unix time in nanoseconds at which the withdrawal leaves the delay queue.
+	ReleaseAt int64 `protobuf:"varint,2,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`
+}
+
+func (x *DelayedWithdrawal) Reset() {
+	*x = DelayedWithdrawal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayedWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayedWithdrawal) ProtoMessage() {}
+
+func (x *DelayedWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayedWithdrawal.ProtoReflect.Descriptor instead.
+func (*DelayedWithdrawal) Descriptor() ([]byte, []int) {
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DelayedWithdrawal) GetWithdrawal() *Withdrawal {
+	if x != nil {
+		return x.Withdrawal
+	}
+	return nil
+}
+
+func (x *DelayedWithdrawal) GetReleaseAt() int64 {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return 0
+}
+
+// Withdrawals of a bridge waiting for the end of the epoch to be batched.
+type PendingWithdrawalBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId     string        `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Withdrawals []*Withdrawal `protobuf:"bytes,2,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+}
+
+func (x *PendingWithdrawalBatch) Reset() {
+	*x = PendingWithdrawalBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingWithdrawalBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingWithdrawalBatch) ProtoMessage() {}
+
+func (x *PendingWithdrawalBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingWithdrawalBatch.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawalBatch) Descriptor() ([]byte, []int) {
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PendingWithdrawalBatch) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *PendingWithdrawalBatch) GetWithdrawals() []*Withdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
 type ScheduledTransferAtTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1209,7 +1499,7 @@ type ScheduledTransferAtTime struct {
 func (x *ScheduledTransferAtTime) Reset() {
 	*x = ScheduledTransferAtTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[18]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1222,7 +1512,7 @@ func (x *ScheduledTransferAtTime) String() string {
 func (*ScheduledTransferAtTime) ProtoMessage() {}
 
 func (x *ScheduledTransferAtTime) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[18]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1235,7 +1525,7 @@ func (x *ScheduledTransferAtTime) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduledTransferAtTime.ProtoReflect.Descriptor instead.
 func (*ScheduledTransferAtTime) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{18}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ScheduledTransferAtTime) GetDeliverOn() int64 {
@@ -1264,7 +1554,7 @@ type RecurringTransfers struct {
 func (x *RecurringTransfers) Reset() {
 	*x = RecurringTransfers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[19]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1277,7 +1567,7 @@ func (x *RecurringTransfers) String() string {
 func (*RecurringTransfers) ProtoMessage() {}
 
 func (x *RecurringTransfers) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[19]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1580,7 @@ func (x *RecurringTransfers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecurringTransfers.ProtoReflect.Descriptor instead.
 func (*RecurringTransfers) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{19}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RecurringTransfers) GetRecurringTransfers() []*v1.Transfer {
@@ -1322,7 +1612,7 @@ type GovernanceTransfer struct {
 func (x *GovernanceTransfer) Reset() {
 	*x = GovernanceTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[20]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1335,7 +1625,7 @@ func (x *GovernanceTransfer) String() string {
 func (*GovernanceTransfer) ProtoMessage() {}
 
 func (x *GovernanceTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[20]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +1638,7 @@ func (x *GovernanceTransfer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GovernanceTransfer.ProtoReflect.Descriptor instead.
 func (*GovernanceTransfer) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{20}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GovernanceTransfer) GetId() string {
@@ -1398,7 +1688,7 @@ type ScheduledGovernanceTransferAtTime struct {
 func (x *ScheduledGovernanceTransferAtTime) Reset() {
 	*x = ScheduledGovernanceTransferAtTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[21]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1411,7 +1701,7 @@ func (x *ScheduledGovernanceTransferAtTime) String() string {
 func (*ScheduledGovernanceTransferAtTime) ProtoMessage() {}
 
 func (x *ScheduledGovernanceTransferAtTime) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[21]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1424,7 +1714,7 @@ func (x *ScheduledGovernanceTransferAtTime) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ScheduledGovernanceTransferAtTime.ProtoReflect.Descriptor instead.
 func (*ScheduledGovernanceTransferAtTime) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{21}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ScheduledGovernanceTransferAtTime) GetDeliverOn() int64 {
@@ -1456,12 +1746,17 @@ type Banking struct {
 	RecurringGovernanceTransfers []*GovernanceTransfer                `protobuf:"bytes,8,rep,name=recurring_governance_transfers,json=recurringGovernanceTransfers,proto3" json:"recurring_governance_transfers,omitempty"`
 	SecondaryBridgeState         *BridgeState                         `protobuf:"bytes,9,opt,name=secondary_bridge_state,json=secondaryBridgeState,proto3" json:"secondary_bridge_state,omitempty"`
 	LastSeenSecondaryEthBlock    uint64                               `protobuf:"varint,10,opt,name=last_seen_secondary_eth_block,json=lastSeenSecondaryEthBlock,proto3" json:"last_seen_secondary_eth_block,omitempty"`
+	TeamCompetitions             []*vega.TeamCompetition              `protobuf:"bytes,11,rep,name=team_competitions,json=teamCompetitions,proto3" json:"team_competitions,omitempty"`
+	EvmBridgeStates              []*BridgeState                       `protobuf:"bytes,12,rep,name=evm_bridge_states,json=evmBridgeStates,proto3" json:"evm_bridge_states,omitempty"`
+	ConditionalTransfers         []*ConditionalTransfer               `protobuf:"bytes,13,rep,name=conditional_transfers,json=conditionalTransfers,proto3" json:"conditional_transfers,omitempty"`
+	PendingWithdrawalBatches     []*PendingWithdrawalBatch            `protobuf:"bytes,14,rep,name=pending_withdrawal_batches,json=pendingWithdrawalBatches,proto3" json:"pending_withdrawal_batches,omitempty"`
+	DelayedWithdrawals           []*DelayedWithdrawal                 `protobuf:"bytes,15,rep,name=delayed_withdrawals,json=delayedWithdrawals,proto3" json:"delayed_withdrawals,omitempty"`
 }
 
 func (x *Banking) Reset() {
 	*x = Banking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[22]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1474,7 +1769,7 @@ func (x *Banking) String() string {
 func (*Banking) ProtoMessage() {}
 
 func (x *Banking) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[22]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,7 +1782,7 @@ func (x *Banking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Banking.ProtoReflect.Descriptor instead.
 func (*Banking) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{22}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Banking) GetTransfersAtTime() []*ScheduledTransferAtTime {
@@ -1560,6 +1855,41 @@ func (x *Banking) GetLastSeenSecondaryEthBlock() uint64 {
 	return 0
 }
 
+func (x *Banking) GetTeamCompetitions() []*vega.TeamCompetition {
+	if x != nil {
+		return x.TeamCompetitions
+	}
+	return nil
+}
+
+func (x *Banking) GetEvmBridgeStates() []*BridgeState {
+	if x != nil {
+		return x.EvmBridgeStates
+	}
+	return nil
+}
+
+func (x *Banking) GetConditionalTransfers() []*ConditionalTransfer {
+	if x != nil {
+		return x.ConditionalTransfers
+	}
+	return nil
+}
+
+func (x *Banking) GetPendingWithdrawalBatches() []*PendingWithdrawalBatch {
+	if x != nil {
+		return x.PendingWithdrawalBatches
+	}
+	return nil
+}
+
+func (x *Banking) GetDelayedWithdrawals() []*DelayedWithdrawal {
+	if x != nil {
+		return x.DelayedWithdrawals
+	}
+	return nil
+}
+
 type BridgeState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1569,12 +1899,14 @@ type BridgeState struct {
 	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	LogIndex    uint64 `protobuf:"varint,3,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
 	ChainId     string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Last block seen on the collateral bridge, only set for the EVM bridges.
+	LastSeenBlock uint64 `protobuf:"varint,5,opt,name=last_seen_block,json=lastSeenBlock,proto3" json:"last_seen_block,omitempty"`
 }
 
 func (x *BridgeState) Reset() {
 	*x = BridgeState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[23]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1587,7 +1919,7 @@ func (x *BridgeState) String() string {
 func (*BridgeState) ProtoMessage() {}
 
 func (x *BridgeState) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[23]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +1932,7 @@ func (x *BridgeState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BridgeState.ProtoReflect.Descriptor instead.
 func (*BridgeState) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{23}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BridgeState) GetActive() bool {
@@ -1631,6 +1963,13 @@ func (x *BridgeState) GetChainId() string {
 	return ""
 }
 
+func (x *BridgeState) GetLastSeenBlock() uint64 {
+	if x != nil {
+		return x.LastSeenBlock
+	}
+	return 0
+}
+
 type Validators struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1644,7 +1983,7 @@ type Validators struct {
 func (x *Validators) Reset() {
 	*x = Validators{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[24]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1657,7 +1996,7 @@ func (x *Validators) String() string {
 func (*Validators) ProtoMessage() {}
 
 func (x *Validators) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[24]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1670,7 +2009,7 @@ func (x *Validators) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Validators.ProtoReflect.Descriptor instead.
 func (*Validators) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{24}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Validators) GetValidatorState() []*ValidatorState {
@@ -1711,7 +2050,7 @@ type ValidatorState struct {
 func (x *ValidatorState) Reset() {
 	*x = ValidatorState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[25]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1724,7 +2063,7 @@ func (x *ValidatorState) String() string {
 func (*ValidatorState) ProtoMessage() {}
 
 func (x *ValidatorState) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[25]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1737,7 +2076,7 @@ func (x *ValidatorState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidatorState.ProtoReflect.Descriptor instead.
 func (*ValidatorState) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{25}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ValidatorState) GetValidatorUpdate() *v1.ValidatorUpdate {
@@ -1801,7 +2140,7 @@ type Staking struct {
 func (x *Staking) Reset() {
 	*x = Staking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[26]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1814,7 +2153,7 @@ func (x *Staking) String() string {
 func (*Staking) ProtoMessage() {}
 
 func (x *Staking) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[26]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1827,7 +2166,7 @@ func (x *Staking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Staking.ProtoReflect.Descriptor instead.
 func (*Staking) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{26}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Staking) GetAccepted() []*v1.StakeLinking {
@@ -1857,7 +2196,7 @@ type MultisigControl struct {
 func (x *MultisigControl) Reset() {
 	*x = MultisigControl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[27]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1870,7 +2209,7 @@ func (x *MultisigControl) String() string {
 func (*MultisigControl) ProtoMessage() {}
 
 func (x *MultisigControl) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[27]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,7 +2222,7 @@ func (x *MultisigControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MultisigControl.ProtoReflect.Descriptor instead.
 func (*MultisigControl) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{27}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *MultisigControl) GetSigners() []*v1.ERC20MultiSigSignerEvent {
@@ -1922,7 +2261,7 @@ type MarketTracker struct {
 func (x *MarketTracker) Reset() {
 	*x = MarketTracker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[28]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1935,7 +2274,7 @@ func (x *MarketTracker) String() string {
 func (*MarketTracker) ProtoMessage() {}
 
 func (x *MarketTracker) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[28]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,7 +2287,7 @@ func (x *MarketTracker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketTracker.ProtoReflect.Descriptor instead.
 func (*MarketTracker) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{28}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *MarketTracker) GetMarketActivity() []*MarketActivityTracker {
@@ -2018,12 +2357,16 @@ type MarketActivityTracker struct {
 	TreasuryFees                    []*PartyFees                     `protobuf:"bytes,25,rep,name=treasury_fees,json=treasuryFees,proto3" json:"treasury_fees,omitempty"`
 	NotionalVolumeForEpoch          string                           `protobuf:"bytes,26,opt,name=notional_volume_for_epoch,json=notionalVolumeForEpoch,proto3" json:"notional_volume_for_epoch,omitempty"`
 	EpochNotionalVolume             []string                         `protobuf:"bytes,27,rep,name=epoch_notional_volume,json=epochNotionalVolume,proto3" json:"epoch_notional_volume,omitempty"`
+	QuotingDepth                    []*ReturnsData                   `protobuf:"bytes,28,rep,name=quoting_depth,json=quotingDepth,proto3" json:"quoting_depth,omitempty"`
+	QuotingDepthTime                int64                            `protobuf:"varint,29,opt,name=quoting_depth_time,json=quotingDepthTime,proto3" json:"quoting_depth_time,omitempty"`
+	CurrentEpochQuotingDepth        []*ReturnsData                   `protobuf:"bytes,30,rep,name=current_epoch_quoting_depth,json=currentEpochQuotingDepth,proto3" json:"current_epoch_quoting_depth,omitempty"`
+	QuotingDepthHistory             []*EpochReturnsData              `protobuf:"bytes,31,rep,name=quoting_depth_history,json=quotingDepthHistory,proto3" json:"quoting_depth_history,omitempty"`
 }
 
 func (x *MarketActivityTracker) Reset() {
 	*x = MarketActivityTracker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[29]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2036,7 +2379,7 @@ func (x *MarketActivityTracker) String() string {
 func (*MarketActivityTracker) ProtoMessage() {}
 
 func (x *MarketActivityTracker) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[29]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,7 +2392,7 @@ func (x *MarketActivityTracker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketActivityTracker.ProtoReflect.Descriptor instead.
 func (*MarketActivityTracker) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{29}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *MarketActivityTracker) GetMarket() string {
@@ -2241,6 +2584,34 @@ func (x *MarketActivityTracker) GetEpochNotionalVolume() []string {
 	return nil
 }
 
+func (x *MarketActivityTracker) GetQuotingDepth() []*ReturnsData {
+	if x != nil {
+		return x.QuotingDepth
+	}
+	return nil
+}
+
+func (x *MarketActivityTracker) GetQuotingDepthTime() int64 {
+	if x != nil {
+		return x.QuotingDepthTime
+	}
+	return 0
+}
+
+func (x *MarketActivityTracker) GetCurrentEpochQuotingDepth() []*ReturnsData {
+	if x != nil {
+		return x.CurrentEpochQuotingDepth
+	}
+	return nil
+}
+
+func (x *MarketActivityTracker) GetQuotingDepthHistory() []*EpochReturnsData {
+	if x != nil {
+		return x.QuotingDepthHistory
+	}
+	return nil
+}
+
 type GameEligibilityTracker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2253,7 +2624,7 @@ type GameEligibilityTracker struct {
 func (x *GameEligibilityTracker) Reset() {
 	*x = GameEligibilityTracker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[30]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2266,7 +2637,7 @@ func (x *GameEligibilityTracker) String() string {
 func (*GameEligibilityTracker) ProtoMessage() {}
 
 func (x *GameEligibilityTracker) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[30]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2279,7 +2650,7 @@ func (x *GameEligibilityTracker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GameEligibilityTracker.ProtoReflect.Descriptor instead.
 func (*GameEligibilityTracker) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{30}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GameEligibilityTracker) GetGameId() string {
@@ -2307,7 +2678,7 @@ type EpochEligibility struct {
 func (x *EpochEligibility) Reset() {
 	*x = EpochEligibility{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[31]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2320,7 +2691,7 @@ func (x *EpochEligibility) String() string {
 func (*EpochEligibility) ProtoMessage() {}
 
 func (x *EpochEligibility) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[31]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2333,7 +2704,7 @@ func (x *EpochEligibility) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochEligibility.ProtoReflect.Descriptor instead.
 func (*EpochEligibility) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{31}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *EpochEligibility) GetEligibleParties() []string {
@@ -2354,7 +2725,7 @@ type EpochPartyTakerFees struct {
 func (x *EpochPartyTakerFees) Reset() {
 	*x = EpochPartyTakerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[32]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2367,7 +2738,7 @@ func (x *EpochPartyTakerFees) String() string {
 func (*EpochPartyTakerFees) ProtoMessage() {}
 
 func (x *EpochPartyTakerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[32]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2380,7 +2751,7 @@ func (x *EpochPartyTakerFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochPartyTakerFees.ProtoReflect.Descriptor instead.
 func (*EpochPartyTakerFees) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{32}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *EpochPartyTakerFees) GetEpochPartyTakerFeesPaid() []*AssetMarketPartyTakerFees {
@@ -2401,7 +2772,7 @@ type EpochTimeWeightPositionData struct {
 func (x *EpochTimeWeightPositionData) Reset() {
 	*x = EpochTimeWeightPositionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[33]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2414,7 +2785,7 @@ func (x *EpochTimeWeightPositionData) String() string {
 func (*EpochTimeWeightPositionData) ProtoMessage() {}
 
 func (x *EpochTimeWeightPositionData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[33]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2798,7 @@ func (x *EpochTimeWeightPositionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochTimeWeightPositionData.ProtoReflect.Descriptor instead.
 func (*EpochTimeWeightPositionData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{33}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *EpochTimeWeightPositionData) GetPartyTimeWeightedPositions() []*PartyTimeWeightedPosition {
@@ -2448,7 +2819,7 @@ type EpochTimeWeightedNotionalData struct {
 func (x *EpochTimeWeightedNotionalData) Reset() {
 	*x = EpochTimeWeightedNotionalData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[34]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2461,7 +2832,7 @@ func (x *EpochTimeWeightedNotionalData) String() string {
 func (*EpochTimeWeightedNotionalData) ProtoMessage() {}
 
 func (x *EpochTimeWeightedNotionalData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[34]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2474,7 +2845,7 @@ func (x *EpochTimeWeightedNotionalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochTimeWeightedNotionalData.ProtoReflect.Descriptor instead.
 func (*EpochTimeWeightedNotionalData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{34}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *EpochTimeWeightedNotionalData) GetPartyTimeWeightedNotionals() []*PartyTimeWeightedNotional {
@@ -2496,7 +2867,7 @@ type PartyTimeWeightedNotional struct {
 func (x *PartyTimeWeightedNotional) Reset() {
 	*x = PartyTimeWeightedNotional{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[35]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2509,7 +2880,7 @@ func (x *PartyTimeWeightedNotional) String() string {
 func (*PartyTimeWeightedNotional) ProtoMessage() {}
 
 func (x *PartyTimeWeightedNotional) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[35]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2522,7 +2893,7 @@ func (x *PartyTimeWeightedNotional) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyTimeWeightedNotional.ProtoReflect.Descriptor instead.
 func (*PartyTimeWeightedNotional) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{35}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PartyTimeWeightedNotional) GetParty() string {
@@ -2551,7 +2922,7 @@ type PartyTimeWeightedPosition struct {
 func (x *PartyTimeWeightedPosition) Reset() {
 	*x = PartyTimeWeightedPosition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[36]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2564,7 +2935,7 @@ func (x *PartyTimeWeightedPosition) String() string {
 func (*PartyTimeWeightedPosition) ProtoMessage() {}
 
 func (x *PartyTimeWeightedPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[36]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,7 +2948,7 @@ func (x *PartyTimeWeightedPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyTimeWeightedPosition.ProtoReflect.Descriptor instead.
 func (*PartyTimeWeightedPosition) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{36}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PartyTimeWeightedPosition) GetParty() string {
@@ -2607,7 +2978,7 @@ type AssetMarketPartyTakerFees struct {
 func (x *AssetMarketPartyTakerFees) Reset() {
 	*x = AssetMarketPartyTakerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[37]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2620,7 +2991,7 @@ func (x *AssetMarketPartyTakerFees) String() string {
 func (*AssetMarketPartyTakerFees) ProtoMessage() {}
 
 func (x *AssetMarketPartyTakerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[37]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +3004,7 @@ func (x *AssetMarketPartyTakerFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetMarketPartyTakerFees.ProtoReflect.Descriptor instead.
 func (*AssetMarketPartyTakerFees) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{37}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AssetMarketPartyTakerFees) GetAsset() string {
@@ -2669,7 +3040,7 @@ type PartyTakerFees struct {
 func (x *PartyTakerFees) Reset() {
 	*x = PartyTakerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[38]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2682,7 +3053,7 @@ func (x *PartyTakerFees) String() string {
 func (*PartyTakerFees) ProtoMessage() {}
 
 func (x *PartyTakerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[38]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +3066,7 @@ func (x *PartyTakerFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyTakerFees.ProtoReflect.Descriptor instead.
 func (*PartyTakerFees) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{38}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PartyTakerFees) GetParty() string {
@@ -2723,7 +3094,7 @@ type EpochPartyFees struct {
 func (x *EpochPartyFees) Reset() {
 	*x = EpochPartyFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[39]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2736,7 +3107,7 @@ func (x *EpochPartyFees) String() string {
 func (*EpochPartyFees) ProtoMessage() {}
 
 func (x *EpochPartyFees) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[39]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2749,7 +3120,7 @@ func (x *EpochPartyFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochPartyFees.ProtoReflect.Descriptor instead.
 func (*EpochPartyFees) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{39}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EpochPartyFees) GetPartyFees() []*PartyFeesHistory {
@@ -2771,7 +3142,7 @@ type TakerNotionalVolume struct {
 func (x *TakerNotionalVolume) Reset() {
 	*x = TakerNotionalVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[40]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2784,7 +3155,7 @@ func (x *TakerNotionalVolume) String() string {
 func (*TakerNotionalVolume) ProtoMessage() {}
 
 func (x *TakerNotionalVolume) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[40]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +3168,7 @@ func (x *TakerNotionalVolume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TakerNotionalVolume.ProtoReflect.Descriptor instead.
 func (*TakerNotionalVolume) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{40}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *TakerNotionalVolume) GetParty() string {
@@ -2826,7 +3197,7 @@ type MarketToPartyTakerNotionalVolume struct {
 func (x *MarketToPartyTakerNotionalVolume) Reset() {
 	*x = MarketToPartyTakerNotionalVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[41]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2839,7 +3210,7 @@ func (x *MarketToPartyTakerNotionalVolume) String() string {
 func (*MarketToPartyTakerNotionalVolume) ProtoMessage() {}
 
 func (x *MarketToPartyTakerNotionalVolume) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[41]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3223,7 @@ func (x *MarketToPartyTakerNotionalVolume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketToPartyTakerNotionalVolume.ProtoReflect.Descriptor instead.
 func (*MarketToPartyTakerNotionalVolume) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{41}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *MarketToPartyTakerNotionalVolume) GetMarket() string {
@@ -2880,7 +3251,7 @@ type EpochReturnsData struct {
 func (x *EpochReturnsData) Reset() {
 	*x = EpochReturnsData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[42]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2893,7 +3264,7 @@ func (x *EpochReturnsData) String() string {
 func (*EpochReturnsData) ProtoMessage() {}
 
 func (x *EpochReturnsData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[42]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2906,7 +3277,7 @@ func (x *EpochReturnsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EpochReturnsData.ProtoReflect.Descriptor instead.
 func (*EpochReturnsData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{42}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EpochReturnsData) GetReturns() []*ReturnsData {
@@ -2928,7 +3299,7 @@ type ReturnsData struct {
 func (x *ReturnsData) Reset() {
 	*x = ReturnsData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[43]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2941,7 +3312,7 @@ func (x *ReturnsData) String() string {
 func (*ReturnsData) ProtoMessage() {}
 
 func (x *ReturnsData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[43]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2954,7 +3325,7 @@ func (x *ReturnsData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnsData.ProtoReflect.Descriptor instead.
 func (*ReturnsData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{43}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ReturnsData) GetParty() string {
@@ -2985,7 +3356,7 @@ type TWPositionData struct {
 func (x *TWPositionData) Reset() {
 	*x = TWPositionData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[44]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2998,7 +3369,7 @@ func (x *TWPositionData) String() string {
 func (*TWPositionData) ProtoMessage() {}
 
 func (x *TWPositionData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[44]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,7 +3382,7 @@ func (x *TWPositionData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TWPositionData.ProtoReflect.Descriptor instead.
 func (*TWPositionData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{44}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *TWPositionData) GetParty() string {
@@ -3057,7 +3428,7 @@ type TWNotionalData struct {
 func (x *TWNotionalData) Reset() {
 	*x = TWNotionalData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[45]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3070,7 +3441,7 @@ func (x *TWNotionalData) String() string {
 func (*TWNotionalData) ProtoMessage() {}
 
 func (x *TWNotionalData) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[45]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3083,7 +3454,7 @@ func (x *TWNotionalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TWNotionalData.ProtoReflect.Descriptor instead.
 func (*TWNotionalData) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{45}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *TWNotionalData) GetParty() string {
@@ -3133,7 +3504,7 @@ type PartyFees struct {
 func (x *PartyFees) Reset() {
 	*x = PartyFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[46]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3146,7 +3517,7 @@ func (x *PartyFees) String() string {
 func (*PartyFees) ProtoMessage() {}
 
 func (x *PartyFees) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[46]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3159,7 +3530,7 @@ func (x *PartyFees) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyFees.ProtoReflect.Descriptor instead.
 func (*PartyFees) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{46}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *PartyFees) GetParty() string {
@@ -3188,7 +3559,7 @@ type PartyFeesHistory struct {
 func (x *PartyFeesHistory) Reset() {
 	*x = PartyFeesHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[47]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3201,7 +3572,7 @@ func (x *PartyFeesHistory) String() string {
 func (*PartyFeesHistory) ProtoMessage() {}
 
 func (x *PartyFeesHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[47]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3214,7 +3585,7 @@ func (x *PartyFeesHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartyFeesHistory.ProtoReflect.Descriptor instead.
 func (*PartyFeesHistory) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{47}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *PartyFeesHistory) GetParty() string {
@@ -3254,7 +3625,7 @@ type AssetAction struct {
 func (x *AssetAction) Reset() {
 	*x = AssetAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[48]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3267,7 +3638,7 @@ func (x *AssetAction) String() string {
 func (*AssetAction) ProtoMessage() {}
 
 func (x *AssetAction) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[48]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3280,7 +3651,7 @@ func (x *AssetAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetAction.ProtoReflect.Descriptor instead.
 func (*AssetAction) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{48}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AssetAction) GetId() string {
@@ -3394,7 +3765,7 @@ type ELSShare struct {
 func (x *ELSShare) Reset() {
 	*x = ELSShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[49]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3407,7 +3778,7 @@ func (x *ELSShare) String() string {
 func (*ELSShare) ProtoMessage() {}
 
 func (x *ELSShare) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[49]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3420,7 +3791,7 @@ func (x *ELSShare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ELSShare.ProtoReflect.Descriptor instead.
 func (*ELSShare) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{49}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ELSShare) GetPartyId() string {
@@ -3483,7 +3854,7 @@ type MarketState struct {
 func (x *MarketState) Reset() {
 	*x = MarketState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[50]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3496,7 +3867,7 @@ func (x *MarketState) String() string {
 func (*MarketState) ProtoMessage() {}
 
 func (x *MarketState) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[50]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3509,7 +3880,7 @@ func (x *MarketState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketState.ProtoReflect.Descriptor instead.
 func (*MarketState) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{50}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MarketState) GetId() string {
@@ -3573,7 +3944,7 @@ type ExecutionState struct {
 func (x *ExecutionState) Reset() {
 	*x = ExecutionState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[51]
+		mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3586,7 +3957,7 @@ func (x *ExecutionState) String() string {
 func (*ExecutionState) ProtoMessage() {}
 
 func (x *ExecutionState) ProtoReflect() protoreflect.Message {
-	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[51]
+	mi := &file_vega_checkpoint_v1_checkpoint_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3599,7 +3970,7 @@ func (x *ExecutionState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecutionState.ProtoReflect.Descriptor instead.
 func (*ExecutionState) Descriptor() ([]byte, []int) {
-	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{51}
+	return file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ExecutionState) GetData() []*MarketState {
@@ -3697,7 +4068,7 @@ var file_vega_checkpoint_v1_checkpoint_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75,
 	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x70, 0x6f,
 	0x63, 0x68, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x53, 0x65, 0x71, 0x22, 0xab, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x6f, 0x63, 0x68, 0x53, 0x65, 0x71, 0x22, 0xf4, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x67,
 	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
@@ -3708,569 +4079,654 @@ var file_vega_checkpoint_v1_checkpoint_proto_rawDesc = []byte{
 	0x72, 0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61,
 	0x75, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1f, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45, 0x0a, 0x07, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79,
-	0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x0c,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4e, 0x0a,
-	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x0d,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0xf0, 0x01,
-	0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f,
-	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x42,
-	0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73,
-	0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53,
-	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0x3b, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x01,
-	0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1e,
-	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x29,
-	0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x50, 0x75,
-	0x62, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x50, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4b, 0x65, 0x79,
-	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19,
-	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
-	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x65,
-	0x67, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x65,
-	0x67, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0e, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x7d, 0x0a, 0x17,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x4f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x12,
-	0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x73, 0x12, 0x49, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72,
-	0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a,
-	0x12, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x4d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x12,
-	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x4e,
-	0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x88, 0x01, 0x0a, 0x21, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x6f, 0x76,
-	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x5f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x4f, 0x6e, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76,
-	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
-	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xae, 0x06, 0x0a, 0x07, 0x42,
-	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x57, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x57, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76,
 	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x12, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x3c, 0x0a, 0x1b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x70,
-	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
-	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x73, 0x12, 0x76, 0x0a, 0x1c,
-	0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x19, 0x67, 0x6f, 0x76, 0x65, 0x72,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x6c, 0x0a, 0x1e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x0d,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x3c, 0x0a,
+	0x0c, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x05, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45, 0x0a, 0x07,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x22, 0x7f, 0x0a, 0x0c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5f,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76,
+	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65,
+	0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3b, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x12, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x1c, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x19, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x97, 0x01, 0x0a, 0x1a, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3f, 0x0a, 0x1c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x11, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x41, 0x0a, 0x0f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x67, 0x61,
+	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x66, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x22, 0x76, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76,
 	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x52, 0x1c, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x47,
-	0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x73, 0x12, 0x55, 0x0a, 0x16, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x0a, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x30, 0x0a, 0x0a, 0x77,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x22, 0x72, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x41,
+	0x74, 0x22, 0x75, 0x0a, 0x16, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65,
+	0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x52, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x22, 0x7d, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x4f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x49,
+	0x0a, 0x13, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65,
+	0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x12, 0x47, 0x6f, 0x76, 0x65,
+	0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x76,
+	0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x4e, 0x65, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x88, 0x01, 0x0a, 0x21,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x4f, 0x6e,
+	0x12, 0x44, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x09, 0x0a, 0x07, 0x42, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x12, 0x57, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f,
+	0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x13, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x12, 0x51, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
 	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
-	0x5f, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x19, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x61, 0x72, 0x79, 0x45, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x80, 0x01, 0x0a, 0x0b,
-	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xaa,
-	0x02, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4b, 0x0a,
-	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x15, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
+	0x61, 0x74, 0x65, 0x52, 0x12, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72,
-	0x65, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b,
-	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4b,
-	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x0e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a,
-	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x74, 0x68, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x12, 0x65, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x37, 0x0a,
-	0x0d, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69,
-	0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x08, 0x52, 0x12, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x73, 0x22, 0x6b, 0x0a, 0x07,
-	0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65,
-	0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
-	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x0f, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x42, 0x0a,
-	0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x52, 0x43, 0x32, 0x30, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x73, 0x12, 0x53, 0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x81,
-	0x04, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
-	0x12, 0x52, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x65, 0x72, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x15, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x13, 0x74, 0x61,
-	0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x12, 0x85, 0x01, 0x0a, 0x25, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x5f,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x34, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x6f, 0x50,
-	0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54,
-	0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x10, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61,
-	0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0e, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x18,
-	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a,
+	0x1b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61,
+	0x72, 0x79, 0x5f, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x45, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x65, 0x6e, 0x52, 0x65, 0x66, 0x73, 0x12, 0x76, 0x0a, 0x1c, 0x67, 0x6f, 0x76, 0x65,
+	0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x5f, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
 	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x16, 0x67, 0x61, 0x6d, 0x65,
-	0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x65, 0x72, 0x22, 0xb5, 0x0e, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x4d, 0x0a, 0x13, 0x6d, 0x61,
-	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
-	0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x11, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x0f, 0x6d, 0x61, 0x6b,
-	0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65,
-	0x73, 0x52, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64,
-	0x12, 0x36, 0x0a, 0x07, 0x6c, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73,
-	0x52, 0x06, 0x6c, 0x70, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x70, 0x61,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x50,
-	0x61, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x72, 0x61,
-	0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
-	0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x58,
-	0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x47, 0x6f, 0x76,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x19, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x6c, 0x0a, 0x1e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x6f,
+	0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f,
+	0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x1c, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x76, 0x65, 0x72,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x55,
+	0x0a, 0x16, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x57, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
-	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x57,
-	0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x74, 0x69,
-	0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x61, 0x0a, 0x1b, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f,
-	0x66, 0x65, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x68, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65,
-	0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52,
-	0x18, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x59, 0x0a, 0x17, 0x6d, 0x61, 0x6b,
-	0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x65, 0x74, 0x68,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x45,
+	0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x42, 0x0a, 0x11, 0x74, 0x65, 0x61, 0x6d, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74, 0x65, 0x61, 0x6d, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x65,
+	0x76, 0x6d, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x65, 0x76, 0x6d, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x5c, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x14, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x68, 0x0a, 0x1a, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x65, 0x67,
 	0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x14,
-	0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x0f, 0x6c, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61,
+	0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x56, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
 	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65,
-	0x73, 0x52, 0x0d, 0x6c, 0x70, 0x46, 0x65, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x7d, 0x0a, 0x23, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
-	0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x1f,
-	0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
-	0x7f, 0x0a, 0x23, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
-	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x76,
-	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x1f, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x56, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x69, 0x6e, 0x66, 0x72,
-	0x61, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76,
-	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x66,
-	0x72, 0x61, 0x46, 0x65, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x6c, 0x70, 0x5f, 0x70, 0x61, 0x69,
-	0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76,
-	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0a, 0x6c, 0x70, 0x50,
-	0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x6c, 0x69,
-	0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
-	0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x16, 0x72, 0x65, 0x61,
-	0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6d, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x75, 0x79, 0x5f, 0x62, 0x61, 0x63, 0x6b,
-	0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x61, 0x6c, 0x52, 0x12, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0b, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65,
 	0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x42,
-	0x61, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x74, 0x72, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x5a, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
 	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0c, 0x74,
-	0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6e,
-	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x66,
-	0x6f, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
-	0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f,
-	0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
-	0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
-	0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x47,
-	0x61, 0x6d, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x51,
-	0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x22, 0x3d, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
-	0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x22, 0x82, 0x01, 0x0a, 0x13, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54,
-	0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1b, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65,
-	0x65, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61,
-	0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x17, 0x65, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x73, 0x0a, 0x1e, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xee, 0x02, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x74, 0x68, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x65, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x65, 0x67, 0x61,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x30, 0x0a, 0x14, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x73, 0x69, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x08, 0x52, 0x12, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67,
+	0x73, 0x22, 0x6b, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x08,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x6e, 0x22, 0xd2,
+	0x01, 0x0a, 0x0f, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x12, 0x42, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x69, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x0d, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x76, 0x65, 0x67, 0x61, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x52, 0x43, 0x32, 0x30, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x65, 0x65, 0x6e, 0x22, 0x81, 0x04, 0x0a, 0x0d, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x52, 0x0a, 0x0f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x5b, 0x0a, 0x15, 0x74, 0x61, 0x6b,
+	0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x13, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x25, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x20, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x51,
+	0x0a, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70,
 	0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x73, 0x50, 0x61, 0x69, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54,
-	0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x70, 0x0a, 0x1d, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76,
+	0x73, 0x52, 0x0e, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x64, 0x0a, 0x18, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x52,
+	0x16, 0x67, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x22, 0xe3, 0x10, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12,
+	0x4d, 0x0a, 0x13, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76,
 	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x1d, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x70, 0x0a, 0x1d, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
-	0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52,
-	0x1a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x22, 0x52, 0x0a, 0x19, 0x50,
-	0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
-	0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22,
-	0x52, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x77, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
-	0x41, 0x0a, 0x0a, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x11, 0x6d, 0x61, 0x6b,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x45,
+	0x0a, 0x0f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0d, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x6c, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x06, 0x6c, 0x70, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6e,
+	0x75, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x6f, 0x6e, 0x75, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x6f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61,
-	0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65,
-	0x65, 0x73, 0x22, 0x45, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0e, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x70,
-	0x61, 0x72, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73,
-	0x22, 0x43, 0x0a, 0x13, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x20, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x12, 0x5b, 0x0a, 0x15, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x13, 0x74, 0x61, 0x6b, 0x65,
-	0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22,
-	0x4d, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0x3b,
-	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x77, 0x0a, 0x0e, 0x54,
-	0x57, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x77, 0x50, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x57, 0x4e, 0x6f, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x77, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0a, 0x74, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x3a, 0x0a, 0x10, 0x50, 0x61, 0x72,
-	0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xa8, 0x04, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x42, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x5f, 0x64,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76,
-	0x65, 0x67, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x65, 0x72, 0x63, 0x32, 0x30,
-	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x52, 0x0c, 0x65, 0x72, 0x63, 0x32, 0x30, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x12, 0x33, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45, 0x52, 0x43, 0x32,
-	0x30, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x1a, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61,
-	0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x17, 0x65, 0x72, 0x63, 0x32, 0x30, 0x41,
-	0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x12, 0x65, 0x72, 0x63, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53, 0x74, 0x6f, 0x70,
-	0x70, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x12, 0x65, 0x72, 0x63, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x22, 0x99, 0x01, 0x0a, 0x08, 0x45, 0x4c, 0x53, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69,
-	0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x76,
-	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x76, 0x67, 0x22, 0xa9, 0x02, 0x0a,
-	0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x57, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x58, 0x0a,
+	0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x6e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x57, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x61, 0x0a, 0x1b, 0x6d,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x18, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x59,
+	0x0a, 0x17, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69,
+	0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46,
+	0x65, 0x65, 0x73, 0x52, 0x14, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x50, 0x61,
+	0x69, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x0f, 0x6c, 0x70, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52, 0x0d, 0x6c, 0x70, 0x46, 0x65, 0x65, 0x73, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x7d, 0x0a, 0x23, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x1f, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x23, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x1f, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3c, 0x0a,
+	0x0a, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73,
+	0x52, 0x09, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x46, 0x65, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x6c,
+	0x70, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73,
+	0x52, 0x0a, 0x6c, 0x70, 0x50, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x10,
+	0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65,
+	0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x5e, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x6c,
+	0x69, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67,
+	0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x16, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6d, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x6d, 0x6d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x62, 0x75, 0x79,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x0b, 0x62, 0x75, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x46, 0x65, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d,
+	0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x19, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65,
+	0x65, 0x73, 0x52, 0x0c, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x46, 0x65, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x19, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x44, 0x0a, 0x0d, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68,
+	0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x1b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x18, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x58, 0x0a, 0x15, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x1f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x13, 0x71, 0x75, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x70, 0x74, 0x68, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x84, 0x01,
+	0x0a, 0x16, 0x47, 0x61, 0x6d, 0x65, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x51, 0x0a, 0x11, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76,
 	0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x4c, 0x53, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69,
-	0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x12, 0x24, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x35, 0x5a, 0x33, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x10, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x22, 0x3d, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x45, 0x6c, 0x69,
+	0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72,
+	0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x6b, 0x0a, 0x1b, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x17, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x1b, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x70, 0x0a, 0x1d, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1a,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x1d, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x70, 0x0a, 0x1d,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69,
+	0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x52, 0x1a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x73, 0x22, 0x52,
+	0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x22, 0x52, 0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x77, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x09, 0x74, 0x61, 0x6b, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61,
+	0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0e,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x12, 0x43,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x79, 0x46,
+	0x65, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x20, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x54, 0x6f, 0x50, 0x61, 0x72, 0x74, 0x79, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x5b, 0x0a, 0x15, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x6e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x13, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x77,
+	0x0a, 0x0e, 0x54, 0x57, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x77, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x54, 0x57, 0x4e, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x77, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x74, 0x77, 0x4e, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x3a, 0x0a, 0x10,
+	0x50, 0x61, 0x72, 0x74, 0x79, 0x46, 0x65, 0x65, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0xa8, 0x04, 0x0a, 0x0b, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x42, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69,
+	0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c,
+	0x74, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x0d, 0x65, 0x72,
+	0x63, 0x32, 0x30, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x0c, 0x65, 0x72, 0x63, 0x32, 0x30, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x45,
+	0x52, 0x43, 0x32, 0x30, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5a, 0x0a, 0x1a, 0x65, 0x72, 0x63, 0x32,
+	0x30, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76,
+	0x65, 0x67, 0x61, 0x2e, 0x45, 0x52, 0x43, 0x32, 0x30, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x17, 0x65, 0x72, 0x63,
+	0x32, 0x30, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x65, 0x72, 0x63, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x53,
+	0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x72, 0x63, 0x32, 0x30, 0x5f,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x72, 0x63, 0x32, 0x30, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x08, 0x45, 0x4c, 0x53, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x76, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x76, 0x67, 0x22,
+	0xa9, 0x02, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x34, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x4c, 0x53, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x69, 0x6e, 0x73, 0x75, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x65,
+	0x67, 0x61, 0x2e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x35, 0x5a, 0x33, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4285,7 +4741,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_rawDescGZIP() []byte {
 	return file_vega_checkpoint_v1_checkpoint_proto_rawDescData
 }
 
-var file_vega_checkpoint_v1_checkpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_vega_checkpoint_v1_checkpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_vega_checkpoint_v1_checkpoint_proto_goTypes = []interface{}{
 	(*CheckpointState)(nil),                   // 0: vega.checkpoint.v1.CheckpointState
 	(*Checkpoint)(nil),                        // 1: vega.checkpoint.v1.Checkpoint
@@ -4297,145 +4753,165 @@ var file_vega_checkpoint_v1_checkpoint_proto_goTypes = []interface{}{
 	(*Proposals)(nil),                         // 7: vega.checkpoint.v1.Proposals
 	(*DelegateEntry)(nil),                     // 8: vega.checkpoint.v1.DelegateEntry
 	(*Delegate)(nil),                          // 9: vega.checkpoint.v1.Delegate
-	(*Block)(nil),                             // 10: vega.checkpoint.v1.Block
-	(*Rewards)(nil),                           // 11: vega.checkpoint.v1.Rewards
-	(*RewardPayout)(nil),                      // 12: vega.checkpoint.v1.RewardPayout
-	(*PendingRewardPayout)(nil),               // 13: vega.checkpoint.v1.PendingRewardPayout
-	(*PartyAmount)(nil),                       // 14: vega.checkpoint.v1.PartyAmount
-	(*PendingKeyRotation)(nil),                // 15: vega.checkpoint.v1.PendingKeyRotation
-	(*PendingEthereumKeyRotation)(nil),        // 16: vega.checkpoint.v1.PendingEthereumKeyRotation
-	(*ScheduledTransfer)(nil),                 // 17: vega.checkpoint.v1.ScheduledTransfer
-	(*ScheduledTransferAtTime)(nil),           // 18: vega.checkpoint.v1.ScheduledTransferAtTime
-	(*RecurringTransfers)(nil),                // 19: vega.checkpoint.v1.RecurringTransfers
-	(*GovernanceTransfer)(nil),                // 20: vega.checkpoint.v1.GovernanceTransfer
-	(*ScheduledGovernanceTransferAtTime)(nil), // 21: vega.checkpoint.v1.ScheduledGovernanceTransferAtTime
-	(*Banking)(nil),                           // 22: vega.checkpoint.v1.Banking
-	(*BridgeState)(nil),                       // 23: vega.checkpoint.v1.BridgeState
-	(*Validators)(nil),                        // 24: vega.checkpoint.v1.Validators
-	(*ValidatorState)(nil),                    // 25: vega.checkpoint.v1.ValidatorState
-	(*Staking)(nil),                           // 26: vega.checkpoint.v1.Staking
-	(*MultisigControl)(nil),                   // 27: vega.checkpoint.v1.MultisigControl
-	(*MarketTracker)(nil),                     // 28: vega.checkpoint.v1.MarketTracker
-	(*MarketActivityTracker)(nil),             // 29: vega.checkpoint.v1.MarketActivityTracker
-	(*GameEligibilityTracker)(nil),            // 30: vega.checkpoint.v1.GameEligibilityTracker
-	(*EpochEligibility)(nil),                  // 31: vega.checkpoint.v1.EpochEligibility
-	(*EpochPartyTakerFees)(nil),               // 32: vega.checkpoint.v1.EpochPartyTakerFees
-	(*EpochTimeWeightPositionData)(nil),       // 33: vega.checkpoint.v1.EpochTimeWeightPositionData
-	(*EpochTimeWeightedNotionalData)(nil),     // 34: vega.checkpoint.v1.EpochTimeWeightedNotionalData
-	(*PartyTimeWeightedNotional)(nil),         // 35: vega.checkpoint.v1.PartyTimeWeightedNotional
-	(*PartyTimeWeightedPosition)(nil),         // 36: vega.checkpoint.v1.PartyTimeWeightedPosition
-	(*AssetMarketPartyTakerFees)(nil),         // 37: vega.checkpoint.v1.AssetMarketPartyTakerFees
-	(*PartyTakerFees)(nil),                    // 38: vega.checkpoint.v1.PartyTakerFees
-	(*EpochPartyFees)(nil),                    // 39: vega.checkpoint.v1.EpochPartyFees
-	(*TakerNotionalVolume)(nil),               // 40: vega.checkpoint.v1.TakerNotionalVolume
-	(*MarketToPartyTakerNotionalVolume)(nil),  // 41: vega.checkpoint.v1.MarketToPartyTakerNotionalVolume
-	(*EpochReturnsData)(nil),                  // 42: vega.checkpoint.v1.EpochReturnsData
-	(*ReturnsData)(nil),                       // 43: vega.checkpoint.v1.ReturnsData
-	(*TWPositionData)(nil),                    // 44: vega.checkpoint.v1.TWPositionData
-	(*TWNotionalData)(nil),                    // 45: vega.checkpoint.v1.TWNotionalData
-	(*PartyFees)(nil),                         // 46: vega.checkpoint.v1.PartyFees
-	(*PartyFeesHistory)(nil),                  // 47: vega.checkpoint.v1.PartyFeesHistory
-	(*AssetAction)(nil),                       // 48: vega.checkpoint.v1.AssetAction
-	(*ELSShare)(nil),                          // 49: vega.checkpoint.v1.ELSShare
-	(*MarketState)(nil),                       // 50: vega.checkpoint.v1.MarketState
-	(*ExecutionState)(nil),                    // 51: vega.checkpoint.v1.ExecutionState
-	(*vega.AssetDetails)(nil),                 // 52: vega.AssetDetails
-	(*vega.NetworkParameter)(nil),             // 53: vega.NetworkParameter
-	(*vega.Proposal)(nil),                     // 54: vega.Proposal
-	(*vega.Transfer)(nil),                     // 55: vega.Transfer
-	(vega.AccountType)(0),                     // 56: vega.AccountType
-	(*v1.Transfer)(nil),                       // 57: vega.events.v1.Transfer
-	(v1.Transfer_Status)(0),                   // 58: vega.events.v1.Transfer.Status
-	(*vega.NewTransferConfiguration)(nil),     // 59: vega.NewTransferConfiguration
-	(*v1.ValidatorUpdate)(nil),                // 60: vega.events.v1.ValidatorUpdate
-	(*vega.RankingScore)(nil),                 // 61: vega.RankingScore
-	(*v1.StakeLinking)(nil),                   // 62: vega.events.v1.StakeLinking
-	(*v1.ERC20MultiSigSignerEvent)(nil),       // 63: vega.events.v1.ERC20MultiSigSignerEvent
-	(*v1.ERC20MultiSigThresholdSetEvent)(nil), // 64: vega.events.v1.ERC20MultiSigThresholdSetEvent
-	(*vega.BuiltinAssetDeposit)(nil),          // 65: vega.BuiltinAssetDeposit
-	(*vega.ERC20Deposit)(nil),                 // 66: vega.ERC20Deposit
-	(*vega.ERC20AssetList)(nil),               // 67: vega.ERC20AssetList
-	(*vega.ERC20AssetLimitsUpdated)(nil),      // 68: vega.ERC20AssetLimitsUpdated
-	(*vega.Market)(nil),                       // 69: vega.Market
+	(*SlashedStake)(nil),                      // 10: vega.checkpoint.v1.SlashedStake
+	(*Block)(nil),                             // 11: vega.checkpoint.v1.Block
+	(*Rewards)(nil),                           // 12: vega.checkpoint.v1.Rewards
+	(*RewardPayout)(nil),                      // 13: vega.checkpoint.v1.RewardPayout
+	(*PendingRewardPayout)(nil),               // 14: vega.checkpoint.v1.PendingRewardPayout
+	(*PartyAmount)(nil),                       // 15: vega.checkpoint.v1.PartyAmount
+	(*PendingKeyRotation)(nil),                // 16: vega.checkpoint.v1.PendingKeyRotation
+	(*PendingEthereumKeyRotation)(nil),        // 17: vega.checkpoint.v1.PendingEthereumKeyRotation
+	(*ScheduledTransfer)(nil),                 // 18: vega.checkpoint.v1.ScheduledTransfer
+	(*ConditionalTransfer)(nil),               // 19: vega.checkpoint.v1.ConditionalTransfer
+	(*Withdrawal)(nil),                        // 20: vega.checkpoint.v1.Withdrawal
+	(*DelayedWithdrawal)(nil),                 // 21: vega.checkpoint.v1.DelayedWithdrawal
+	(*PendingWithdrawalBatch)(nil),            // 22: vega.checkpoint.v1.PendingWithdrawalBatch
+	(*ScheduledTransferAtTime)(nil),           // 23: vega.checkpoint.v1.ScheduledTransferAtTime
+	(*RecurringTransfers)(nil),                // 24: vega.checkpoint.v1.RecurringTransfers
+	(*GovernanceTransfer)(nil),                // 25: vega.checkpoint.v1.GovernanceTransfer
+	(*ScheduledGovernanceTransferAtTime)(nil), // 26: vega.checkpoint.v1.ScheduledGovernanceTransferAtTime
+	(*Banking)(nil),                           // 27: vega.checkpoint.v1.Banking
+	(*BridgeState)(nil),                       // 28: vega.checkpoint.v1.BridgeState
+	(*Validators)(nil),                        // 29: vega.checkpoint.v1.Validators
+	(*ValidatorState)(nil),                    // 30: vega.checkpoint.v1.ValidatorState
+	(*Staking)(nil),                           // 31: vega.checkpoint.v1.Staking
+	(*MultisigControl)(nil),                   // 32: vega.checkpoint.v1.MultisigControl
+	(*MarketTracker)(nil),                     // 33: vega.checkpoint.v1.MarketTracker
+	(*MarketActivityTracker)(nil),             // 34: vega.checkpoint.v1.MarketActivityTracker
+	(*GameEligibilityTracker)(nil),            // 35: vega.checkpoint.v1.GameEligibilityTracker
+	(*EpochEligibility)(nil),                  // 36: vega.checkpoint.v1.EpochEligibility
+	(*EpochPartyTakerFees)(nil),               // 37: vega.checkpoint.v1.EpochPartyTakerFees
+	(*EpochTimeWeightPositionData)(nil),       // 38: vega.checkpoint.v1.EpochTimeWeightPositionData
+	(*EpochTimeWeightedNotionalData)(nil),     // 39: vega.checkpoint.v1.EpochTimeWeightedNotionalData
+	(*PartyTimeWeightedNotional)(nil),         // 40: vega.checkpoint.v1.PartyTimeWeightedNotional
+	(*PartyTimeWeightedPosition)(nil),         // 41: vega.checkpoint.v1.PartyTimeWeightedPosition
+	(*AssetMarketPartyTakerFees)(nil),         // 42: vega.checkpoint.v1.AssetMarketPartyTakerFees
+	(*PartyTakerFees)(nil),                    // 43: vega.checkpoint.v1.PartyTakerFees
+	(*EpochPartyFees)(nil),                    // 44: vega.checkpoint.v1.EpochPartyFees
+	(*TakerNotionalVolume)(nil),               // 45: vega.checkpoint.v1.TakerNotionalVolume
+	(*MarketToPartyTakerNotionalVolume)(nil),  // 46: vega.checkpoint.v1.MarketToPartyTakerNotionalVolume
+	(*EpochReturnsData)(nil),                  // 47: vega.checkpoint.v1.EpochReturnsData
+	(*ReturnsData)(nil),                       // 48: vega.checkpoint.v1.ReturnsData
+	(*TWPositionData)(nil),                    // 49: vega.checkpoint.v1.TWPositionData
+	(*TWNotionalData)(nil),                    // 50: vega.checkpoint.v1.TWNotionalData
+	(*PartyFees)(nil),                         // 51: vega.checkpoint.v1.PartyFees
+	(*PartyFeesHistory)(nil),                  // 52: vega.checkpoint.v1.PartyFeesHistory
+	(*AssetAction)(nil),                       // 53: vega.checkpoint.v1.AssetAction
+	(*ELSShare)(nil),                          // 54: vega.checkpoint.v1.ELSShare
+	(*MarketState)(nil),                       // 55: vega.checkpoint.v1.MarketState
+	(*ExecutionState)(nil),                    // 56: vega.checkpoint.v1.ExecutionState
+	(*vega.AssetDetails)(nil),                 // 57: vega.AssetDetails
+	(*vega.NetworkParameter)(nil),             // 58: vega.NetworkParameter
+	(*vega.Proposal)(nil),                     // 59: vega.Proposal
+	(*vega.Transfer)(nil),                     // 60: vega.Transfer
+	(vega.AccountType)(0),                     // 61: vega.AccountType
+	(*v1.Transfer)(nil),                       // 62: vega.events.v1.Transfer
+	(*vega.Withdrawal)(nil),                   // 63: vega.Withdrawal
+	(v1.Transfer_Status)(0),                   // 64: vega.events.v1.Transfer.Status
+	(*vega.NewTransferConfiguration)(nil),     // 65: vega.NewTransferConfiguration
+	(*vega.TeamCompetition)(nil),              // 66: vega.TeamCompetition
+	(*v1.ValidatorUpdate)(nil),                // 67: vega.events.v1.ValidatorUpdate
+	(*vega.RankingScore)(nil),                 // 68: vega.RankingScore
+	(*v1.StakeLinking)(nil),                   // 69: vega.events.v1.StakeLinking
+	(*v1.ERC20MultiSigSignerEvent)(nil),       // 70: vega.events.v1.ERC20MultiSigSignerEvent
+	(*v1.ERC20MultiSigThresholdSetEvent)(nil), // 71: vega.events.v1.ERC20MultiSigThresholdSetEvent
+	(*vega.BuiltinAssetDeposit)(nil),          // 72: vega.BuiltinAssetDeposit
+	(*vega.ERC20Deposit)(nil),                 // 73: vega.ERC20Deposit
+	(*vega.ERC20AssetList)(nil),               // 74: vega.ERC20AssetList
+	(*vega.ERC20AssetLimitsUpdated)(nil),      // 75: vega.ERC20AssetLimitsUpdated
+	(*vega.Market)(nil),                       // 76: vega.Market
 }
 var file_vega_checkpoint_v1_checkpoint_proto_depIdxs = []int32{
-	52, // 0: vega.checkpoint.v1.AssetEntry.asset_details:type_name -> vega.AssetDetails
+	57, // 0: vega.checkpoint.v1.AssetEntry.asset_details:type_name -> vega.AssetDetails
 	2,  // 1: vega.checkpoint.v1.Assets.assets:type_name -> vega.checkpoint.v1.AssetEntry
 	2,  // 2: vega.checkpoint.v1.Assets.pending_listing_assets:type_name -> vega.checkpoint.v1.AssetEntry
 	4,  // 3: vega.checkpoint.v1.Collateral.balances:type_name -> vega.checkpoint.v1.AssetBalance
-	53, // 4: vega.checkpoint.v1.NetParams.params:type_name -> vega.NetworkParameter
-	54, // 5: vega.checkpoint.v1.Proposals.proposals:type_name -> vega.Proposal
+	58, // 4: vega.checkpoint.v1.NetParams.params:type_name -> vega.NetworkParameter
+	59, // 5: vega.checkpoint.v1.Proposals.proposals:type_name -> vega.Proposal
 	8,  // 6: vega.checkpoint.v1.Delegate.active:type_name -> vega.checkpoint.v1.DelegateEntry
 	8,  // 7: vega.checkpoint.v1.Delegate.pending:type_name -> vega.checkpoint.v1.DelegateEntry
-	12, // 8: vega.checkpoint.v1.Rewards.rewards:type_name -> vega.checkpoint.v1.RewardPayout
-	13, // 9: vega.checkpoint.v1.RewardPayout.rewards_payout:type_name -> vega.checkpoint.v1.PendingRewardPayout
-	14, // 10: vega.checkpoint.v1.PendingRewardPayout.party_amount:type_name -> vega.checkpoint.v1.PartyAmount
-	55, // 11: vega.checkpoint.v1.ScheduledTransfer.transfer:type_name -> vega.Transfer
-	56, // 12: vega.checkpoint.v1.ScheduledTransfer.account_type:type_name -> vega.AccountType
-	57, // 13: vega.checkpoint.v1.ScheduledTransfer.oneoff_transfer:type_name -> vega.events.v1.Transfer
-	17, // 14: vega.checkpoint.v1.ScheduledTransferAtTime.transfers:type_name -> vega.checkpoint.v1.ScheduledTransfer
-	57, // 15: vega.checkpoint.v1.RecurringTransfers.recurring_transfers:type_name -> vega.events.v1.Transfer
-	58, // 16: vega.checkpoint.v1.GovernanceTransfer.status:type_name -> vega.events.v1.Transfer.Status
-	59, // 17: vega.checkpoint.v1.GovernanceTransfer.config:type_name -> vega.NewTransferConfiguration
-	20, // 18: vega.checkpoint.v1.ScheduledGovernanceTransferAtTime.transfers:type_name -> vega.checkpoint.v1.GovernanceTransfer
-	18, // 19: vega.checkpoint.v1.Banking.transfers_at_time:type_name -> vega.checkpoint.v1.ScheduledTransferAtTime
-	19, // 20: vega.checkpoint.v1.Banking.recurring_transfers:type_name -> vega.checkpoint.v1.RecurringTransfers
-	23, // 21: vega.checkpoint.v1.Banking.primary_bridge_state:type_name -> vega.checkpoint.v1.BridgeState
-	48, // 22: vega.checkpoint.v1.Banking.asset_actions:type_name -> vega.checkpoint.v1.AssetAction
-	21, // 23: vega.checkpoint.v1.Banking.governance_transfers_at_time:type_name -> vega.checkpoint.v1.ScheduledGovernanceTransferAtTime
-	20, // 24: vega.checkpoint.v1.Banking.recurring_governance_transfers:type_name -> vega.checkpoint.v1.GovernanceTransfer
-	23, // 25: vega.checkpoint.v1.Banking.secondary_bridge_state:type_name -> vega.checkpoint.v1.BridgeState
-	25, // 26: vega.checkpoint.v1.Validators.validator_state:type_name -> vega.checkpoint.v1.ValidatorState
-	15, // 27: vega.checkpoint.v1.Validators.pending_key_rotations:type_name -> vega.checkpoint.v1.PendingKeyRotation
-	16, // 28: vega.checkpoint.v1.Validators.pending_ethereum_key_rotations:type_name -> vega.checkpoint.v1.PendingEthereumKeyRotation
-	60, // 29: vega.checkpoint.v1.ValidatorState.validator_update:type_name -> vega.events.v1.ValidatorUpdate
-	61, // 30: vega.checkpoint.v1.ValidatorState.ranking_score:type_name -> vega.RankingScore
-	62, // 31: vega.checkpoint.v1.Staking.accepted:type_name -> vega.events.v1.StakeLinking
-	63, // 32: vega.checkpoint.v1.MultisigControl.signers:type_name -> vega.events.v1.ERC20MultiSigSignerEvent
-	64, // 33: vega.checkpoint.v1.MultisigControl.threshold_set:type_name -> vega.events.v1.ERC20MultiSigThresholdSetEvent
-	29, // 34: vega.checkpoint.v1.MarketTracker.market_activity:type_name -> vega.checkpoint.v1.MarketActivityTracker
-	40, // 35: vega.checkpoint.v1.MarketTracker.taker_notional_volume:type_name -> vega.checkpoint.v1.TakerNotionalVolume
-	41, // 36: vega.checkpoint.v1.MarketTracker.market_to_party_taker_notional_volume:type_name -> vega.checkpoint.v1.MarketToPartyTakerNotionalVolume
-	32, // 37: vega.checkpoint.v1.MarketTracker.epoch_taker_fees:type_name -> vega.checkpoint.v1.EpochPartyTakerFees
-	30, // 38: vega.checkpoint.v1.MarketTracker.game_eligibility_tracker:type_name -> vega.checkpoint.v1.GameEligibilityTracker
-	46, // 39: vega.checkpoint.v1.MarketActivityTracker.maker_fees_received:type_name -> vega.checkpoint.v1.PartyFees
-	46, // 40: vega.checkpoint.v1.MarketActivityTracker.maker_fees_paid:type_name -> vega.checkpoint.v1.PartyFees
-	46, // 41: vega.checkpoint.v1.MarketActivityTracker.lp_fees:type_name -> vega.checkpoint.v1.PartyFees
-	44, // 42: vega.checkpoint.v1.MarketActivityTracker.time_weighted_position:type_name -> vega.checkpoint.v1.TWPositionData
-	45, // 43: vega.checkpoint.v1.MarketActivityTracker.time_weighted_notional:type_name -> vega.checkpoint.v1.TWNotionalData
-	43, // 44: vega.checkpoint.v1.MarketActivityTracker.returns_data:type_name -> vega.checkpoint.v1.ReturnsData
-	39, // 45: vega.checkpoint.v1.MarketActivityTracker.maker_fees_received_history:type_name -> vega.checkpoint.v1.EpochPartyFees
-	39, // 46: vega.checkpoint.v1.MarketActivityTracker.maker_fees_paid_history:type_name -> vega.checkpoint.v1.EpochPartyFees
-	39, // 47: vega.checkpoint.v1.MarketActivityTracker.lp_fees_history:type_name -> vega.checkpoint.v1.EpochPartyFees
-	33, // 48: vega.checkpoint.v1.MarketActivityTracker.time_weighted_position_data_history:type_name -> vega.checkpoint.v1.EpochTimeWeightPositionData
-	34, // 49: vega.checkpoint.v1.MarketActivityTracker.time_weighted_notional_data_history:type_name -> vega.checkpoint.v1.EpochTimeWeightedNotionalData
-	42, // 50: vega.checkpoint.v1.MarketActivityTracker.returns_data_history:type_name -> vega.checkpoint.v1.EpochReturnsData
-	46, // 51: vega.checkpoint.v1.MarketActivityTracker.infra_fees:type_name -> vega.checkpoint.v1.PartyFees
-	46, // 52: vega.checkpoint.v1.MarketActivityTracker.lp_paid_fees:type_name -> vega.checkpoint.v1.PartyFees
-	43, // 53: vega.checkpoint.v1.MarketActivityTracker.realised_returns:type_name -> vega.checkpoint.v1.ReturnsData
-	42, // 54: vega.checkpoint.v1.MarketActivityTracker.realised_returns_history:type_name -> vega.checkpoint.v1.EpochReturnsData
-	46, // 55: vega.checkpoint.v1.MarketActivityTracker.buy_back_fees:type_name -> vega.checkpoint.v1.PartyFees
-	46, // 56: vega.checkpoint.v1.MarketActivityTracker.treasury_fees:type_name -> vega.checkpoint.v1.PartyFees
-	31, // 57: vega.checkpoint.v1.GameEligibilityTracker.epoch_eligibility:type_name -> vega.checkpoint.v1.EpochEligibility
-	37, // 58: vega.checkpoint.v1.EpochPartyTakerFees.epoch_party_taker_fees_paid:type_name -> vega.checkpoint.v1.AssetMarketPartyTakerFees
-	36, // 59: vega.checkpoint.v1.EpochTimeWeightPositionData.party_time_weighted_positions:type_name -> vega.checkpoint.v1.PartyTimeWeightedPosition
-	35, // 60: vega.checkpoint.v1.EpochTimeWeightedNotionalData.party_time_weighted_notionals:type_name -> vega.checkpoint.v1.PartyTimeWeightedNotional
-	38, // 61: vega.checkpoint.v1.AssetMarketPartyTakerFees.taker_fees:type_name -> vega.checkpoint.v1.PartyTakerFees
-	47, // 62: vega.checkpoint.v1.EpochPartyFees.party_fees:type_name -> vega.checkpoint.v1.PartyFeesHistory
-	40, // 63: vega.checkpoint.v1.MarketToPartyTakerNotionalVolume.taker_notional_volume:type_name -> vega.checkpoint.v1.TakerNotionalVolume
-	43, // 64: vega.checkpoint.v1.EpochReturnsData.returns:type_name -> vega.checkpoint.v1.ReturnsData
-	65, // 65: vega.checkpoint.v1.AssetAction.builtin_deposit:type_name -> vega.BuiltinAssetDeposit
-	66, // 66: vega.checkpoint.v1.AssetAction.erc20_deposit:type_name -> vega.ERC20Deposit
-	67, // 67: vega.checkpoint.v1.AssetAction.asset_list:type_name -> vega.ERC20AssetList
-	68, // 68: vega.checkpoint.v1.AssetAction.erc20_asset_limits_updated:type_name -> vega.ERC20AssetLimitsUpdated
-	49, // 69: vega.checkpoint.v1.MarketState.shares:type_name -> vega.checkpoint.v1.ELSShare
-	69, // 70: vega.checkpoint.v1.MarketState.market:type_name -> vega.Market
-	50, // 71: vega.checkpoint.v1.ExecutionState.data:type_name -> vega.checkpoint.v1.MarketState
-	72, // [72:72] is the sub-list for method output_type
-	72, // [72:72] is the sub-list for method input_type
-	72, // [72:72] is the sub-list for extension type_name
-	72, // [72:72] is the sub-list for extension extendee
-	0,  // [0:72] is the sub-list for field type_name
+	10, // 8: vega.checkpoint.v1.Delegate.slashed_stakes:type_name -> vega.checkpoint.v1.SlashedStake
+	13, // 9: vega.checkpoint.v1.Rewards.rewards:type_name -> vega.checkpoint.v1.RewardPayout
+	14, // 10: vega.checkpoint.v1.RewardPayout.rewards_payout:type_name -> vega.checkpoint.v1.PendingRewardPayout
+	15, // 11: vega.checkpoint.v1.PendingRewardPayout.party_amount:type_name -> vega.checkpoint.v1.PartyAmount
+	60, // 12: vega.checkpoint.v1.ScheduledTransfer.transfer:type_name -> vega.Transfer
+	61, // 13: vega.checkpoint.v1.ScheduledTransfer.account_type:type_name -> vega.AccountType
+	62, // 14: vega.checkpoint.v1.ScheduledTransfer.oneoff_transfer:type_name -> vega.events.v1.Transfer
+	18, // 15: vega.checkpoint.v1.ConditionalTransfer.transfer:type_name -> vega.checkpoint.v1.ScheduledTransfer
+	63, // 16: vega.checkpoint.v1.Withdrawal.withdrawal:type_name -> vega.Withdrawal
+	20, // 17: vega.checkpoint.v1.DelayedWithdrawal.withdrawal:type_name -> vega.checkpoint.v1.Withdrawal
+	20, // 18: vega.checkpoint.v1.PendingWithdrawalBatch.withdrawals:type_name -> vega.checkpoint.v1.Withdrawal
+	18, // 19: vega.checkpoint.v1.ScheduledTransferAtTime.transfers:type_name -> vega.checkpoint.v1.ScheduledTransfer
+	62, // 20: vega.checkpoint.v1.RecurringTransfers.recurring_transfers:type_name -> vega.events.v1.Transfer
+	64, // 21: vega.checkpoint.v1.GovernanceTransfer.status:type_name -> vega.events.v1.Transfer.Status
+	65, // 22: vega.checkpoint.v1.GovernanceTransfer.config:type_name -> vega.NewTransferConfiguration
+	25, // 23: vega.checkpoint.v1.ScheduledGovernanceTransferAtTime.transfers:type_name -> vega.checkpoint.v1.GovernanceTransfer
+	23, // 24: vega.checkpoint.v1.Banking.transfers_at_time:type_name -> vega.checkpoint.v1.ScheduledTransferAtTime
+	24, // 25: vega.checkpoint.v1.Banking.recurring_transfers:type_name -> vega.checkpoint.v1.RecurringTransfers
+	28, // 26: vega.checkpoint.v1.Banking.primary_bridge_state:type_name -> vega.checkpoint.v1.BridgeState
+	53, // 27: vega.checkpoint.v1.Banking.asset_actions:type_name -> vega.checkpoint.v1.AssetAction
+	26, // 28: vega.checkpoint.v1.Banking.governance_transfers_at_time:type_name -> vega.checkpoint.v1.ScheduledGovernanceTransferAtTime
+	25, // 29: vega.checkpoint.v1.Banking.recurring_governance_transfers:type_name -> vega.checkpoint.v1.GovernanceTransfer
+	28, // 30: vega.checkpoint.v1.Banking.secondary_bridge_state:type_name -> vega.checkpoint.v1.BridgeState
+	66, // 31: vega.checkpoint.v1.Banking.team_competitions:type_name -> vega.TeamCompetition
+	28, // 32: vega.checkpoint.v1.Banking.evm_bridge_states:type_name -> vega.checkpoint.v1.BridgeState
+	19, // 33: vega.checkpoint.v1.Banking.conditional_transfers:type_name -> vega.checkpoint.v1.ConditionalTransfer
+	22, // 34: vega.checkpoint.v1.Banking.pending_withdrawal_batches:type_name -> vega.checkpoint.v1.PendingWithdrawalBatch
+	21, // 35: vega.checkpoint.v1.Banking.delayed_withdrawals:type_name -> vega.checkpoint.v1.DelayedWithdrawal
+	30, // 36: vega.checkpoint.v1.Validators.validator_state:type_name -> vega.checkpoint.v1.ValidatorState
+	16, // 37: vega.checkpoint.v1.Validators.pending_key_rotations:type_name -> vega.checkpoint.v1.PendingKeyRotation
+	17, // 38: vega.checkpoint.v1.Validators.pending_ethereum_key_rotations:type_name -> vega.checkpoint.v1.PendingEthereumKeyRotation
+	67, // 39: vega.checkpoint.v1.ValidatorState.validator_update:type_name -> vega.events.v1.ValidatorUpdate
+	68, // 40: vega.checkpoint.v1.ValidatorState.ranking_score:type_name -> vega.RankingScore
+	69, // 41: vega.checkpoint.v1.Staking.accepted:type_name -> vega.events.v1.StakeLinking
+	70, // 42: vega.checkpoint.v1.MultisigControl.signers:type_name -> vega.events.v1.ERC20MultiSigSignerEvent
+	71, // 43: vega.checkpoint.v1.MultisigControl.threshold_set:type_name -> vega.events.v1.ERC20MultiSigThresholdSetEvent
+	34, // 44: vega.checkpoint.v1.MarketTracker.market_activity:type_name -> vega.checkpoint.v1.MarketActivityTracker
+	45, // 45: vega.checkpoint.v1.MarketTracker.taker_notional_volume:type_name -> vega.checkpoint.v1.TakerNotionalVolume
+	46, // 46: vega.checkpoint.v1.MarketTracker.market_to_party_taker_notional_volume:type_name -> vega.checkpoint.v1.MarketToPartyTakerNotionalVolume
+	37, // 47: vega.checkpoint.v1.MarketTracker.epoch_taker_fees:type_name -> vega.checkpoint.v1.EpochPartyTakerFees
+	35, // 48: vega.checkpoint.v1.MarketTracker.game_eligibility_tracker:type_name -> vega.checkpoint.v1.GameEligibilityTracker
+	51, // 49: vega.checkpoint.v1.MarketActivityTracker.maker_fees_received:type_name -> vega.checkpoint.v1.PartyFees
+	51, // 50: vega.checkpoint.v1.MarketActivityTracker.maker_fees_paid:type_name -> vega.checkpoint.v1.PartyFees
+	51, // 51: vega.checkpoint.v1.MarketActivityTracker.lp_fees:type_name -> vega.checkpoint.v1.PartyFees
+	49, // 52: vega.checkpoint.v1.MarketActivityTracker.time_weighted_position:type_name -> vega.checkpoint.v1.TWPositionData
+	50, // 53: vega.checkpoint.v1.MarketActivityTracker.time_weighted_notional:type_name -> vega.checkpoint.v1.TWNotionalData
+	48, // 54: vega.checkpoint.v1.MarketActivityTracker.returns_data:type_name -> vega.checkpoint.v1.ReturnsData
+	44, // 55: vega.checkpoint.v1.MarketActivityTracker.maker_fees_received_history:type_name -> vega.checkpoint.v1.EpochPartyFees
+	44, // 56: vega.checkpoint.v1.MarketActivityTracker.maker_fees_paid_history:type_name -> vega.checkpoint.v1.EpochPartyFees
+	44, // 57: vega.checkpoint.v1.MarketActivityTracker.lp_fees_history:type_name -> vega.checkpoint.v1.EpochPartyFees
+	38, // 58: vega.checkpoint.v1.MarketActivityTracker.time_weighted_position_data_history:type_name -> vega.checkpoint.v1.EpochTimeWeightPositionData
+	39, // 59: vega.checkpoint.v1.MarketActivityTracker.time_weighted_notional_data_history:type_name -> vega.checkpoint.v1.EpochTimeWeightedNotionalData
+	47, // 60: vega.checkpoint.v1.MarketActivityTracker.returns_data_history:type_name -> vega.checkpoint.v1.EpochReturnsData
+	51, // 61: vega.checkpoint.v1.MarketActivityTracker.infra_fees:type_name -> vega.checkpoint.v1.PartyFees
+	51, // 62: vega.checkpoint.v1.MarketActivityTracker.lp_paid_fees:type_name -> vega.checkpoint.v1.PartyFees
+	48, // 63: vega.checkpoint.v1.MarketActivityTracker.realised_returns:type_name -> vega.checkpoint.v1.ReturnsData
+	47, // 64: vega.checkpoint.v1.MarketActivityTracker.realised_returns_history:type_name -> vega.checkpoint.v1.EpochReturnsData
+	51, // 65: vega.checkpoint.v1.MarketActivityTracker.buy_back_fees:type_name -> vega.checkpoint.v1.PartyFees
+	51, // 66: vega.checkpoint.v1.MarketActivityTracker.treasury_fees:type_name -> vega.checkpoint.v1.PartyFees
+	48, // 67: vega.checkpoint.v1.MarketActivityTracker.quoting_depth:type_name -> vega.checkpoint.v1.ReturnsData
+	48, // 68: vega.checkpoint.v1.MarketActivityTracker.current_epoch_quoting_depth:type_name -> vega.checkpoint.v1.ReturnsData
+	47, // 69: vega.checkpoint.v1.MarketActivityTracker.quoting_depth_history:type_name -> vega.checkpoint.v1.EpochReturnsData
+	36, // 70: vega.checkpoint.v1.GameEligibilityTracker.epoch_eligibility:type_name -> vega.checkpoint.v1.EpochEligibility
+	42, // 71: vega.checkpoint.v1.EpochPartyTakerFees.epoch_party_taker_fees_paid:type_name -> vega.checkpoint.v1.AssetMarketPartyTakerFees
+	41, // 72: vega.checkpoint.v1.EpochTimeWeightPositionData.party_time_weighted_positions:type_name -> vega.checkpoint.v1.PartyTimeWeightedPosition
+	40, // 73: vega.checkpoint.v1.EpochTimeWeightedNotionalData.party_time_weighted_notionals:type_name -> vega.checkpoint.v1.PartyTimeWeightedNotional
+	43, // 74: vega.checkpoint.v1.AssetMarketPartyTakerFees.taker_fees:type_name -> vega.checkpoint.v1.PartyTakerFees
+	52, // 75: vega.checkpoint.v1.EpochPartyFees.party_fees:type_name -> vega.checkpoint.v1.PartyFeesHistory
+	45, // 76: vega.checkpoint.v1.MarketToPartyTakerNotionalVolume.taker_notional_volume:type_name -> vega.checkpoint.v1.TakerNotionalVolume
+	48, // 77: vega.checkpoint.v1.EpochReturnsData.returns:type_name -> vega.checkpoint.v1.ReturnsData
+	72, // 78: vega.checkpoint.v1.AssetAction.builtin_deposit:type_name -> vega.BuiltinAssetDeposit
+	73, // 79: vega.checkpoint.v1.AssetAction.erc20_deposit:type_name -> vega.ERC20Deposit
+	74, // 80: vega.checkpoint.v1.AssetAction.asset_list:type_name -> vega.ERC20AssetList
+	75, // 81: vega.checkpoint.v1.AssetAction.erc20_asset_limits_updated:type_name -> vega.ERC20AssetLimitsUpdated
+	54, // 82: vega.checkpoint.v1.MarketState.shares:type_name -> vega.checkpoint.v1.ELSShare
+	76, // 83: vega.checkpoint.v1.MarketState.market:type_name -> vega.Market
+	55, // 84: vega.checkpoint.v1.ExecutionState.data:type_name -> vega.checkpoint.v1.MarketState
+	85, // [85:85] is the sub-list for method output_type
+	85, // [85:85] is the sub-list for method input_type
+	85, // [85:85] is the sub-list for extension type_name
+	85, // [85:85] is the sub-list for extension extendee
+	0,  // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_vega_checkpoint_v1_checkpoint_proto_init() }
@@ -4565,7 +5041,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
+			switch v := v.(*SlashedStake); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4577,7 +5053,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rewards); i {
+			switch v := v.(*Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4589,7 +5065,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardPayout); i {
+			switch v := v.(*Rewards); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4601,7 +5077,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingRewardPayout); i {
+			switch v := v.(*RewardPayout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4613,7 +5089,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyAmount); i {
+			switch v := v.(*PendingRewardPayout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4625,7 +5101,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingKeyRotation); i {
+			switch v := v.(*PartyAmount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4637,7 +5113,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PendingEthereumKeyRotation); i {
+			switch v := v.(*PendingKeyRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4649,7 +5125,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledTransfer); i {
+			switch v := v.(*PendingEthereumKeyRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4661,7 +5137,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledTransferAtTime); i {
+			switch v := v.(*ScheduledTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4673,7 +5149,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecurringTransfers); i {
+			switch v := v.(*ConditionalTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4685,7 +5161,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GovernanceTransfer); i {
+			switch v := v.(*Withdrawal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4697,7 +5173,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScheduledGovernanceTransferAtTime); i {
+			switch v := v.(*DelayedWithdrawal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4709,7 +5185,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Banking); i {
+			switch v := v.(*PendingWithdrawalBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4721,7 +5197,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BridgeState); i {
+			switch v := v.(*ScheduledTransferAtTime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4733,7 +5209,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Validators); i {
+			switch v := v.(*RecurringTransfers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4745,7 +5221,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorState); i {
+			switch v := v.(*GovernanceTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4757,7 +5233,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Staking); i {
+			switch v := v.(*ScheduledGovernanceTransferAtTime); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4769,7 +5245,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultisigControl); i {
+			switch v := v.(*Banking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4781,7 +5257,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketTracker); i {
+			switch v := v.(*BridgeState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4793,7 +5269,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketActivityTracker); i {
+			switch v := v.(*Validators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4805,7 +5281,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameEligibilityTracker); i {
+			switch v := v.(*ValidatorState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4817,7 +5293,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochEligibility); i {
+			switch v := v.(*Staking); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4829,7 +5305,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochPartyTakerFees); i {
+			switch v := v.(*MultisigControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4841,7 +5317,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochTimeWeightPositionData); i {
+			switch v := v.(*MarketTracker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4853,7 +5329,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochTimeWeightedNotionalData); i {
+			switch v := v.(*MarketActivityTracker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4865,7 +5341,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyTimeWeightedNotional); i {
+			switch v := v.(*GameEligibilityTracker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4877,7 +5353,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyTimeWeightedPosition); i {
+			switch v := v.(*EpochEligibility); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4889,7 +5365,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetMarketPartyTakerFees); i {
+			switch v := v.(*EpochPartyTakerFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4901,7 +5377,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyTakerFees); i {
+			switch v := v.(*EpochTimeWeightPositionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4913,7 +5389,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochPartyFees); i {
+			switch v := v.(*EpochTimeWeightedNotionalData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4925,7 +5401,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TakerNotionalVolume); i {
+			switch v := v.(*PartyTimeWeightedNotional); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4937,7 +5413,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketToPartyTakerNotionalVolume); i {
+			switch v := v.(*PartyTimeWeightedPosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4949,7 +5425,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EpochReturnsData); i {
+			switch v := v.(*AssetMarketPartyTakerFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4961,7 +5437,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReturnsData); i {
+			switch v := v.(*PartyTakerFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4973,7 +5449,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TWPositionData); i {
+			switch v := v.(*EpochPartyFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4985,7 +5461,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TWNotionalData); i {
+			switch v := v.(*TakerNotionalVolume); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4997,7 +5473,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyFees); i {
+			switch v := v.(*MarketToPartyTakerNotionalVolume); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5009,7 +5485,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartyFeesHistory); i {
+			switch v := v.(*EpochReturnsData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5021,7 +5497,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetAction); i {
+			switch v := v.(*ReturnsData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5033,7 +5509,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ELSShare); i {
+			switch v := v.(*TWPositionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5045,7 +5521,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MarketState); i {
+			switch v := v.(*TWNotionalData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5057,6 +5533,66 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			}
 		}
 		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartyFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartyFeesHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELSShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vega_checkpoint_v1_checkpoint_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecutionState); i {
 			case 0:
 				return &v.state
@@ -5075,7 +5611,7 @@ func file_vega_checkpoint_v1_checkpoint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vega_checkpoint_v1_checkpoint_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   52,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -929,6 +929,8 @@ type ProtocolUpgradeProposal struct {
 	UpgradeBlockHeight uint64 `protobuf:"varint,1,opt,name=upgrade_block_height,json=upgradeBlockHeight,proto3" json:"upgrade_block_height,omitempty"`
 	// Release tag for the Vega binary.
 	VegaReleaseTag string `protobuf:"bytes,2,opt,name=vega_release_tag,json=vegaReleaseTag,proto3" json:"vega_release_tag,omitempty"`
+	// Outcome of the pre-flight checks of the candidate binary, not set if the checks were skipped.
+	Readiness *ProtocolUpgradeReadiness `protobuf:"bytes,3,opt,name=readiness,proto3" json:"readiness,omitempty"`
 }
 
 func (x *ProtocolUpgradeProposal) Reset() {
@@ -977,6 +979,71 @@ func (x *ProtocolUpgradeProposal) GetVegaReleaseTag() string {
 	return ""
 }
 
+func (x *ProtocolUpgradeProposal) GetReadiness() *ProtocolUpgradeReadiness {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+// Outcome of the pre-flight checks of a candidate binary, run against the latest local snapshot of the node.
+type ProtocolUpgradeReadiness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Block height of the snapshot the candidate binary restored the state from.
+	SnapshotHeight uint64 `protobuf:"varint,1,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	// Number of blocks following the snapshot replayed by the candidate binary with the same app hashes.
+	BlocksReplayed uint64 `protobuf:"varint,2,opt,name=blocks_replayed,json=blocksReplayed,proto3" json:"blocks_replayed,omitempty"`
+}
+
+func (x *ProtocolUpgradeReadiness) Reset() {
+	*x = ProtocolUpgradeReadiness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vega_commands_v1_validator_commands_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtocolUpgradeReadiness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtocolUpgradeReadiness) ProtoMessage() {}
+
+func (x *ProtocolUpgradeReadiness) ProtoReflect() protoreflect.Message {
+	mi := &file_vega_commands_v1_validator_commands_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtocolUpgradeReadiness.ProtoReflect.Descriptor instead.
+func (*ProtocolUpgradeReadiness) Descriptor() ([]byte, []int) {
+	return file_vega_commands_v1_validator_commands_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProtocolUpgradeReadiness) GetSnapshotHeight() uint64 {
+	if x != nil {
+		return x.SnapshotHeight
+	}
+	return 0
+}
+
+func (x *ProtocolUpgradeReadiness) GetBlocksReplayed() uint64 {
+	if x != nil {
+		return x.BlocksReplayed
+	}
+	return 0
+}
+
 var File_vega_commands_v1_validator_commands_proto protoreflect.FileDescriptor
 
 var file_vega_commands_v1_validator_commands_proto_rawDesc = []byte{
@@ -1133,36 +1200,47 @@ var file_vega_commands_v1_validator_commands_proto_rawDesc = []byte{
 	0x34, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x18, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x75, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x67, 0x61, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x65,
-	0x67, 0x61, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x61, 0x67, 0x2a, 0x97, 0x02, 0x0a,
-	0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41,
-	0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x44, 0x45, 0x5f,
-	0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41,
-	0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x4f,
-	0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e,
-	0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
-	0x41, 0x4c, 0x10, 0x02, 0x12, 0x33, 0x0a, 0x2f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47,
-	0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x43, 0x32,
-	0x30, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45,
-	0x52, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x35, 0x0a, 0x31, 0x4e, 0x4f, 0x44,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x12, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x67, 0x61, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76,
+	0x65, 0x67, 0x61, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x61, 0x67, 0x12, 0x48, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x6c, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x2a, 0x97, 0x02, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x1f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x45,
+	0x57, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x33, 0x0a,
+	0x2f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x43, 0x32, 0x30, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49,
+	0x53, 0x49, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x35, 0x0a, 0x31, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41,
+	0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x43, 0x32, 0x30, 0x5f,
+	0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f,
+	0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x4f, 0x44,
 	0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
-	0x5f, 0x45, 0x52, 0x43, 0x32, 0x30, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x53, 0x49, 0x47, 0x5f,
-	0x53, 0x49, 0x47, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x04,
-	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55,
-	0x52, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x42, 0x33, 0x5a, 0x31, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76,
-	0x65, 0x67, 0x61, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
-	0x65, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x05, 0x42,
+	0x33, 0x5a, 0x31, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x76, 0x65, 0x67, 0x61, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2f, 0x76, 0x65, 0x67, 0x61, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1178,7 +1256,7 @@ func file_vega_commands_v1_validator_commands_proto_rawDescGZIP() []byte {
 }
 
 var file_vega_commands_v1_validator_commands_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_vega_commands_v1_validator_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_vega_commands_v1_validator_commands_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_vega_commands_v1_validator_commands_proto_goTypes = []interface{}{
 	(NodeSignatureKind)(0),              // 0: vega.commands.v1.NodeSignatureKind
 	(NodeVote_Type)(0),                  // 1: vega.commands.v1.NodeVote.Type
@@ -1191,35 +1269,37 @@ var file_vega_commands_v1_validator_commands_proto_goTypes = []interface{}{
 	(*EthereumKeyRotateSubmission)(nil), // 8: vega.commands.v1.EthereumKeyRotateSubmission
 	(*StateVariableProposal)(nil),       // 9: vega.commands.v1.StateVariableProposal
 	(*ProtocolUpgradeProposal)(nil),     // 10: vega.commands.v1.ProtocolUpgradeProposal
-	(*Signature)(nil),                   // 11: vega.commands.v1.Signature
-	(*vega.BuiltinAssetEvent)(nil),      // 12: vega.BuiltinAssetEvent
-	(*vega.ERC20Event)(nil),             // 13: vega.ERC20Event
-	(*vega.StakingEvent)(nil),           // 14: vega.StakingEvent
-	(*vega.ERC20MultiSigEvent)(nil),     // 15: vega.ERC20MultiSigEvent
-	(*vega.EthContractCallEvent)(nil),   // 16: vega.EthContractCallEvent
-	(*vega.ERC20Heartbeat)(nil),         // 17: vega.ERC20Heartbeat
-	(*vega.StateValueProposal)(nil),     // 18: vega.StateValueProposal
+	(*ProtocolUpgradeReadiness)(nil),    // 11: vega.commands.v1.ProtocolUpgradeReadiness
+	(*Signature)(nil),                   // 12: vega.commands.v1.Signature
+	(*vega.BuiltinAssetEvent)(nil),      // 13: vega.BuiltinAssetEvent
+	(*vega.ERC20Event)(nil),             // 14: vega.ERC20Event
+	(*vega.StakingEvent)(nil),           // 15: vega.StakingEvent
+	(*vega.ERC20MultiSigEvent)(nil),     // 16: vega.ERC20MultiSigEvent
+	(*vega.EthContractCallEvent)(nil),   // 17: vega.EthContractCallEvent
+	(*vega.ERC20Heartbeat)(nil),         // 18: vega.ERC20Heartbeat
+	(*vega.StateValueProposal)(nil),     // 19: vega.StateValueProposal
 }
 var file_vega_commands_v1_validator_commands_proto_depIdxs = []int32{
-	11, // 0: vega.commands.v1.ValidatorHeartbeat.ethereum_signature:type_name -> vega.commands.v1.Signature
-	11, // 1: vega.commands.v1.ValidatorHeartbeat.vega_signature:type_name -> vega.commands.v1.Signature
-	11, // 2: vega.commands.v1.AnnounceNode.ethereum_signature:type_name -> vega.commands.v1.Signature
-	11, // 3: vega.commands.v1.AnnounceNode.vega_signature:type_name -> vega.commands.v1.Signature
+	12, // 0: vega.commands.v1.ValidatorHeartbeat.ethereum_signature:type_name -> vega.commands.v1.Signature
+	12, // 1: vega.commands.v1.ValidatorHeartbeat.vega_signature:type_name -> vega.commands.v1.Signature
+	12, // 2: vega.commands.v1.AnnounceNode.ethereum_signature:type_name -> vega.commands.v1.Signature
+	12, // 3: vega.commands.v1.AnnounceNode.vega_signature:type_name -> vega.commands.v1.Signature
 	1,  // 4: vega.commands.v1.NodeVote.type:type_name -> vega.commands.v1.NodeVote.Type
 	0,  // 5: vega.commands.v1.NodeSignature.kind:type_name -> vega.commands.v1.NodeSignatureKind
-	12, // 6: vega.commands.v1.ChainEvent.builtin:type_name -> vega.BuiltinAssetEvent
-	13, // 7: vega.commands.v1.ChainEvent.erc20:type_name -> vega.ERC20Event
-	14, // 8: vega.commands.v1.ChainEvent.staking_event:type_name -> vega.StakingEvent
-	15, // 9: vega.commands.v1.ChainEvent.erc20_multisig:type_name -> vega.ERC20MultiSigEvent
-	16, // 10: vega.commands.v1.ChainEvent.contract_call:type_name -> vega.EthContractCallEvent
-	17, // 11: vega.commands.v1.ChainEvent.heartbeat:type_name -> vega.ERC20Heartbeat
-	11, // 12: vega.commands.v1.EthereumKeyRotateSubmission.ethereum_signature:type_name -> vega.commands.v1.Signature
-	18, // 13: vega.commands.v1.StateVariableProposal.proposal:type_name -> vega.StateValueProposal
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	13, // 6: vega.commands.v1.ChainEvent.builtin:type_name -> vega.BuiltinAssetEvent
+	14, // 7: vega.commands.v1.ChainEvent.erc20:type_name -> vega.ERC20Event
+	15, // 8: vega.commands.v1.ChainEvent.staking_event:type_name -> vega.StakingEvent
+	16, // 9: vega.commands.v1.ChainEvent.erc20_multisig:type_name -> vega.ERC20MultiSigEvent
+	17, // 10: vega.commands.v1.ChainEvent.contract_call:type_name -> vega.EthContractCallEvent
+	18, // 11: vega.commands.v1.ChainEvent.heartbeat:type_name -> vega.ERC20Heartbeat
+	12, // 12: vega.commands.v1.EthereumKeyRotateSubmission.ethereum_signature:type_name -> vega.commands.v1.Signature
+	19, // 13: vega.commands.v1.StateVariableProposal.proposal:type_name -> vega.StateValueProposal
+	11, // 14: vega.commands.v1.ProtocolUpgradeProposal.readiness:type_name -> vega.commands.v1.ProtocolUpgradeReadiness
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_vega_commands_v1_validator_commands_proto_init() }
@@ -1337,6 +1417,18 @@ func file_vega_commands_v1_validator_commands_proto_init() {
 				return nil
 			}
 		}
+		file_vega_commands_v1_validator_commands_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtocolUpgradeReadiness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_vega_commands_v1_validator_commands_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*ChainEvent_Builtin)(nil),
@@ -1352,7 +1444,7 @@ func file_vega_commands_v1_validator_commands_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vega_commands_v1_validator_commands_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -107,6 +107,22 @@ func CheckSubmitTransactionRequest(req *walletpb.SubmitTransactionRequest) comma
 		cmdErr = commands.CheckAmendAMM(cmd.AmendAmm)
 	case *walletpb.SubmitTransactionRequest_CancelAmm:
 		cmdErr = commands.CheckCancelAMM(cmd.CancelAmm)
+	case *walletpb.SubmitTransactionRequest_NewTeamCompetition:
+		cmdErr = commands.CheckNewTeamCompetition(cmd.NewTeamCompetition)
+	case *walletpb.SubmitTransactionRequest_CancelTeamCompetition:
+		cmdErr = commands.CheckCancelTeamCompetition(cmd.CancelTeamCompetition)
+	case *walletpb.SubmitTransactionRequest_CreateSubAccount:
+		cmdErr = commands.CheckCreateSubAccount(cmd.CreateSubAccount)
+	case *walletpb.SubmitTransactionRequest_CancelDelayedWithdrawal:
+		cmdErr = commands.CheckCancelDelayedWithdrawal(cmd.CancelDelayedWithdrawal)
+	case *walletpb.SubmitTransactionRequest_DelegateVote:
+		cmdErr = commands.CheckDelegateVote(cmd.DelegateVote)
+	case *walletpb.SubmitTransactionRequest_VetoProposal:
+		cmdErr = commands.CheckVetoProposal(cmd.VetoProposal)
+	case *walletpb.SubmitTransactionRequest_RedelegateSubmission:
+		cmdErr = commands.CheckRedelegateSubmission(cmd.RedelegateSubmission)
+	case *walletpb.SubmitTransactionRequest_AutoCompoundSubmission:
+		cmdErr = commands.CheckAutoCompoundSubmission(cmd.AutoCompoundSubmission)
 	default:
 		errs.AddForProperty("input_data.command", commands.ErrIsNotSupported)
 	}
@@ -270,6 +286,38 @@ func WrapRequestCommandIntoInputData(data *commandspb.InputData, req *walletpb.S
 		data.Command = &commandspb.InputData_CancelAmm{
 			CancelAmm: req.GetCancelAmm(),
 		}
+	case *walletpb.SubmitTransactionRequest_NewTeamCompetition:
+		data.Command = &commandspb.InputData_NewTeamCompetition{
+			NewTeamCompetition: req.GetNewTeamCompetition(),
+		}
+	case *walletpb.SubmitTransactionRequest_CancelTeamCompetition:
+		data.Command = &commandspb.InputData_CancelTeamCompetition{
+			CancelTeamCompetition: req.GetCancelTeamCompetition(),
+		}
+	case *walletpb.SubmitTransactionRequest_CreateSubAccount:
+		data.Command = &commandspb.InputData_CreateSubAccount{
+			CreateSubAccount: req.GetCreateSubAccount(),
+		}
+	case *walletpb.SubmitTransactionRequest_CancelDelayedWithdrawal:
+		data.Command = &commandspb.InputData_CancelDelayedWithdrawal{
+			CancelDelayedWithdrawal: req.GetCancelDelayedWithdrawal(),
+		}
+	case *walletpb.SubmitTransactionRequest_DelegateVote:
+		data.Command = &commandspb.InputData_DelegateVote{
+			DelegateVote: req.GetDelegateVote(),
+		}
+	case *walletpb.SubmitTransactionRequest_VetoProposal:
+		data.Command = &commandspb.InputData_VetoProposal{
+			VetoProposal: req.GetVetoProposal(),
+		}
+	case *walletpb.SubmitTransactionRequest_RedelegateSubmission:
+		data.Command = &commandspb.InputData_RedelegateSubmission{
+			RedelegateSubmission: req.GetRedelegateSubmission(),
+		}
+	case *walletpb.SubmitTransactionRequest_AutoCompoundSubmission:
+		data.Command = &commandspb.InputData_AutoCompoundSubmission{
+			AutoCompoundSubmission: req.GetAutoCompoundSubmission(),
+		}
 	default:
 		panic(fmt.Sprintf("command %T is not supported", cmd))
 	}